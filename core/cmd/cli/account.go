@@ -20,7 +20,7 @@ func NewAccountCommand(cli *Cli) *cobra.Command {
 	c.cli = cli
 	c.cmd = &cobra.Command{
 		Use:   "account",
-		Short: "Operate an account or address: balance|new|newkeys|contracts|restore|decrypt.",
+		Short: "Operate an account or address: balance|new|newkeys|contracts|restore|decrypt|blskey.",
 	}
 	c.cmd.AddCommand(NewAccountBalanceCommand(cli))
 	c.cmd.AddCommand(NewAccountNewkeysCommand(cli))
@@ -29,6 +29,7 @@ func NewAccountCommand(cli *Cli) *cobra.Command {
 	c.cmd.AddCommand(NewAccountQueryCommand(cli))
 	c.cmd.AddCommand(NewAccountRestoreCommand(cli))
 	c.cmd.AddCommand(NewAccountDecryptCommand(cli))
+	c.cmd.AddCommand(NewAccountBlskeyCommand(cli))
 	return c.cmd
 }
 
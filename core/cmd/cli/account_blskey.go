@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2019. Baidu Inc. All Rights Reserved.
+ *
+ * Usage: Print the BLS public key and address derived from the private key.
+ *        ./xchain-cli account blskey --keys data/keys
+ */
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// AccountBlskeyCommand print BLS key struct
+type AccountBlskeyCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+}
+
+// NewAccountBlskeyCommand new BLS key command
+func NewAccountBlskeyCommand(cli *Cli) *cobra.Command {
+	t := new(AccountBlskeyCommand)
+	t.cli = cli
+	t.cmd = &cobra.Command{
+		Use:   "blskey",
+		Short: "Print the BLS public key and address derived from the private key, used by BLS multisig and chainedbft votes.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return t.printBlsKey()
+		},
+	}
+	return t.cmd
+}
+
+func (c *AccountBlskeyCommand) printBlsKey() error {
+	bc, err := createBlsClient()
+	if err != nil {
+		return err
+	}
+	priv, err := readBlsPrivateKey(bc, c.cli.RootOptions.Keys)
+	if err != nil {
+		return err
+	}
+	pubJSON, err := bc.GetBlsPublicKeyJsonFormatStr(priv)
+	if err != nil {
+		return err
+	}
+	addr, err := bc.GetAddressFromBlsPublicKey(priv.Public())
+	if err != nil {
+		return err
+	}
+	fmt.Printf("BLS public key: %s\n", pubJSON)
+	fmt.Printf("BLS address: %s\n", addr)
+	return nil
+}
@@ -18,9 +18,10 @@ func NewACLCommand(cli *Cli) *cobra.Command {
 	c.cli = cli
 	c.cmd = &cobra.Command{
 		Use:   "acl",
-		Short: "Operate an access control list(ACL): query.",
+		Short: "Operate an access control list(ACL): query|set.",
 	}
 	c.cmd.AddCommand(NewACLQueryCommand(cli))
+	c.cmd.AddCommand(NewACLSetCommand(cli))
 	return c.cmd
 }
 
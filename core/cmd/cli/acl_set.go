@@ -0,0 +1,182 @@
+/*
+ * Copyright (c) 2019. Baidu Inc. All Rights Reserved.
+ */
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/xuperchain/xuperchain/core/pb"
+	"github.com/xuperchain/xuperchain/core/utxo"
+)
+
+// ACLSetCommand set acl struct
+type ACLSetCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	accountName  string
+	contractName string
+	methodName   string
+	rule         string
+	acceptValue  float64
+	aks          string
+	fee          string
+	output       string
+	multiAddrs   string
+	// bounds of time lock, the ACL is wrapped with SIGN_TIMELOCK if any is set
+	notBeforeHeight    int64
+	notAfterHeight     int64
+	notBeforeTimestamp int64
+	notAfterTimestamp  int64
+}
+
+// NewACLSetCommand new acl set cmd
+func NewACLSetCommand(cli *Cli) *cobra.Command {
+	t := new(ACLSetCommand)
+	t.cli = cli
+	t.cmd = &cobra.Command{
+		Use:   "set [OPTIONS]",
+		Short: "generate a raw transaction to set the access control list(ACL) of an account or contract method.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return t.setACL(ctx)
+		},
+	}
+	t.addFlags()
+
+	return t.cmd
+}
+
+func (t *ACLSetCommand) addFlags() {
+	t.cmd.Flags().StringVar(&t.accountName, "account", "", "contract account name")
+	t.cmd.Flags().StringVar(&t.contractName, "contract", "", "contract name")
+	t.cmd.Flags().StringVar(&t.methodName, "method", "", "method name")
+	t.cmd.Flags().StringVar(&t.rule, "rule", "SIGN_THRESHOLD", "permission rule: SIGN_THRESHOLD, SIGN_RATE or SIGN_SUM")
+	t.cmd.Flags().Float64Var(&t.acceptValue, "accept", 1.0, "accept value of the permission rule")
+	t.cmd.Flags().StringVar(&t.aks, "aks", "", "ACL members with weight, e.g. ak1:1,ak2:0.5")
+	t.cmd.Flags().StringVar(&t.fee, "fee", "", "fee to run the transaction")
+	t.cmd.Flags().StringVarP(&t.output, "output", "o", "./tx.out", "serialized transaction data file")
+	t.cmd.Flags().StringVarP(&t.multiAddrs, "multiAddrs", "A", "data/acl/addrs", "multiAddrs to fill required accounts/addresses")
+	t.cmd.Flags().Int64Var(&t.notBeforeHeight, "not-before-height", 0, "the ACL takes effect from this block height, 0 means no limitation")
+	t.cmd.Flags().Int64Var(&t.notAfterHeight, "not-after-height", 0, "the ACL expires after this block height, 0 means no limitation")
+	t.cmd.Flags().Int64Var(&t.notBeforeTimestamp, "not-before-time", 0, "the ACL takes effect from this block time in unix seconds, 0 means no limitation")
+	t.cmd.Flags().Int64Var(&t.notAfterTimestamp, "not-after-time", 0, "the ACL expires after this block time in unix seconds, 0 means no limitation")
+}
+
+func (t *ACLSetCommand) setACL(ctx context.Context) error {
+	aclJSON, err := t.genACL()
+	if err != nil {
+		return err
+	}
+
+	ct := &CommTrans{
+		Amount:       "0",
+		Fee:          t.fee,
+		FrozenHeight: 0,
+		Version:      utxo.TxVersion,
+		ModuleName:   "xkernel",
+		Args:         make(map[string][]byte),
+		MultiAddrs:   t.multiAddrs,
+		Output:       t.output,
+		IsPrint:      true,
+		IsQuick:      true,
+
+		ChainName:    t.cli.RootOptions.Name,
+		Keys:         t.cli.RootOptions.Keys,
+		XchainClient: t.cli.XchainClient(),
+		CryptoType:   t.cli.RootOptions.CryptoType,
+		CliConf:      t.cli.RootOptions.CliConf,
+	}
+
+	switch {
+	case t.accountName != "":
+		ct.MethodName = "SetAccountACL"
+		ct.Args["account_name"] = []byte(t.accountName)
+	case t.contractName != "" && t.methodName != "":
+		ct.MethodName = "SetMethodACL"
+		ct.Args["contract_name"] = []byte(t.contractName)
+		ct.Args["method_name"] = []byte(t.methodName)
+	default:
+		return errors.New("param error, account or contract and method required")
+	}
+	ct.Args["acl"] = aclJSON
+
+	return ct.GenerateMultisigGenRawTx(ctx)
+}
+
+// genACL build the json format ACL with rule, accept value and members
+func (t *ACLSetCommand) genACL() ([]byte, error) {
+	ruleInt, ok := pb.PermissionRule_value[t.rule]
+	if !ok {
+		return nil, fmt.Errorf("unknown permission rule: %s", t.rule)
+	}
+	rule := pb.PermissionRule(ruleInt)
+	if rule != pb.PermissionRule_SIGN_THRESHOLD && rule != pb.PermissionRule_SIGN_RATE &&
+		rule != pb.PermissionRule_SIGN_SUM {
+		return nil, fmt.Errorf("permission rule %s is not supported by acl set", t.rule)
+	}
+	if rule == pb.PermissionRule_SIGN_RATE && (t.acceptValue <= 0 || t.acceptValue > 1) {
+		return nil, errors.New("accept value of SIGN_RATE should be in (0, 1]")
+	}
+
+	aksWeight, err := t.parseAks()
+	if err != nil {
+		return nil, err
+	}
+	acl := &pb.Acl{
+		Pm: &pb.PermissionModel{
+			Rule:        rule,
+			AcceptValue: t.acceptValue,
+		},
+		AksWeight: aksWeight,
+	}
+	if t.notBeforeHeight > 0 || t.notAfterHeight > 0 || t.notBeforeTimestamp > 0 || t.notAfterTimestamp > 0 {
+		if rule != pb.PermissionRule_SIGN_THRESHOLD {
+			return nil, errors.New("only SIGN_THRESHOLD can be time locked by acl set")
+		}
+		acl.Pm.Rule = pb.PermissionRule_SIGN_TIMELOCK
+		acl.TimeLock = &pb.TimeLock{
+			Rule:               rule,
+			NotBeforeHeight:    t.notBeforeHeight,
+			NotAfterHeight:     t.notAfterHeight,
+			NotBeforeTimestamp: t.notBeforeTimestamp,
+			NotAfterTimestamp:  t.notAfterTimestamp,
+		}
+	}
+	return json.Marshal(acl)
+}
+
+// parseAks parse members like ak1:1,ak2:0.5, weight defaults to 1 if omitted
+func (t *ACLSetCommand) parseAks() (map[string]float64, error) {
+	aksWeight := make(map[string]float64)
+	for _, item := range strings.Split(t.aks, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		kv := strings.SplitN(item, ":", 2)
+		weight := 1.0
+		if len(kv) == 2 {
+			w, err := strconv.ParseFloat(kv[1], 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid weight of %s: %v", kv[0], err)
+			}
+			weight = w
+		}
+		aksWeight[kv[0]] = weight
+	}
+	if len(aksWeight) == 0 {
+		return nil, errors.New("aks required")
+	}
+	return aksWeight, nil
+}
@@ -12,9 +12,10 @@ type ContractCommand struct {
 func NewContractCommand(cli *Cli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "contract",
-		Short: "Operate contract command, query",
+		Short: "Operate contract command, query, rent",
 	}
 	cmd.AddCommand(NewContractStatDataQueryCommand(cli))
+	cmd.AddCommand(NewContractRentCommand(cli))
 	return cmd
 }
 
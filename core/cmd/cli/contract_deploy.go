@@ -32,6 +32,7 @@ type ContractDeployCommand struct {
 	multiAddrs   string
 	output       string
 	abiFile      string
+	eventsFile   string
 }
 
 // NewContractDeployCommand new wasm/native/evm deploy cmd
@@ -63,6 +64,8 @@ func (c *ContractDeployCommand) addFlags() {
 	c.cmd.Flags().StringVarP(&c.output, "output", "o", "./tx.out", "tx draw data")
 	if c.module == string(bridge.TypeEvm) {
 		c.cmd.Flags().StringVarP(&c.abiFile, "abi", "", "", "the abi file of contract")
+	} else {
+		c.cmd.Flags().StringVarP(&c.eventsFile, "events", "", "", "the json file declaring indexed keys of events, e.g. {\"increase\": [\"key\"]}")
 	}
 }
 
@@ -100,7 +103,7 @@ func (c *ContractDeployCommand) deploy(ctx context.Context, codepath string) err
 		return err
 	}
 
-	var codeBuf, abiCode []byte
+	var codeBuf, abiCode, eventsBuf []byte
 	var x3args map[string][]byte
 
 	if c.module == string(bridge.TypeEvm) {
@@ -115,6 +118,11 @@ func (c *ContractDeployCommand) deploy(ctx context.Context, codepath string) err
 		if x3args, err = convertToXuper3Args(args); err != nil {
 			return err
 		}
+		if c.eventsFile != "" {
+			if eventsBuf, err = ioutil.ReadFile(c.eventsFile); err != nil {
+				return err
+			}
+		}
 	}
 
 	codeBuf, err = ioutil.ReadFile(codepath)
@@ -134,6 +142,9 @@ func (c *ContractDeployCommand) deploy(ctx context.Context, codepath string) err
 		"init_args":     initArgs,
 		"contract_abi":  abiCode,
 	}
+	if len(eventsBuf) != 0 {
+		ct.Args["contract_events"] = eventsBuf
+	}
 
 	if c.isMulti {
 		err = ct.GenerateMultisigGenRawTx(ctx)
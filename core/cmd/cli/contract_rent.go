@@ -0,0 +1,154 @@
+/*
+ * Copyright (c) 2019. Baidu Inc. All Rights Reserved.
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/xuperchain/xuperchain/core/global"
+	"github.com/xuperchain/xuperchain/core/pb"
+	"github.com/xuperchain/xuperchain/core/utxo"
+)
+
+// ContractRentCommand contract storage rent cmd
+type ContractRentCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	method       string
+	contractName string
+	height       int64
+	amount       int64
+	archiveFile  string
+	account      string
+	fee          string
+	isMulti      bool
+	multiAddrs   string
+	output       string
+}
+
+// NewContractRentCommand new contract storage rent cmd
+func NewContractRentCommand(cli *Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rent",
+		Short: "Operate the storage rent of contract: query|settle|pay|archive|restore",
+	}
+	cmd.AddCommand(newContractRentSubCommand(cli, "query", "StorageRent",
+		"query the storage rent of contract settled up to height"))
+	cmd.AddCommand(newContractRentSubCommand(cli, "settle", "SettleStorageRent",
+		"settle the storage rent of contract up to height"))
+	cmd.AddCommand(newContractRentSubCommand(cli, "pay", "PayStorageRent",
+		"pay the storage rent of contract in gas"))
+	cmd.AddCommand(newContractRentSubCommand(cli, "archive", "ArchiveStorage",
+		"archive a chunk of the storage of contract in arrears"))
+	cmd.AddCommand(newContractRentSubCommand(cli, "restore", "RestoreStorage",
+		"restore the last archived chunk of contract by paying the arrears"))
+	return cmd
+}
+
+func newContractRentSubCommand(cli *Cli, use, method, short string) *cobra.Command {
+	c := new(ContractRentCommand)
+	c.cli = cli
+	c.method = method
+	c.cmd = &cobra.Command{
+		Use:   use,
+		Short: short,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return c.run(ctx)
+		},
+	}
+	c.addFlags()
+	return c.cmd
+}
+
+func (c *ContractRentCommand) addFlags() {
+	c.cmd.Flags().StringVarP(&c.contractName, "cname", "n", "", "contract name")
+	c.cmd.Flags().Int64Var(&c.height, "height", 0, "settle the rent up to the height, default is the trunk height")
+	if c.method == "PayStorageRent" || c.method == "RestoreStorage" {
+		c.cmd.Flags().Int64Var(&c.amount, "amount", 0, "the rent paid in gas")
+	}
+	if c.method == "RestoreStorage" {
+		c.cmd.Flags().StringVar(&c.archiveFile, "archive", "", "the archived chunk, default is read from the archive tx")
+	}
+	c.cmd.Flags().StringVarP(&c.account, "account", "", "", "account name")
+	c.cmd.Flags().StringVar(&c.fee, "fee", "", "fee of one tx")
+	c.cmd.Flags().BoolVarP(&c.isMulti, "isMulti", "m", false, "multisig scene")
+	c.cmd.Flags().StringVarP(&c.multiAddrs, "multiAddrs", "A", "data/acl/addrs", "multiAddrs if multisig scene")
+	c.cmd.Flags().StringVarP(&c.output, "output", "o", "./tx.out", "tx draw data")
+}
+
+func (c *ContractRentCommand) trunkHeight(ctx context.Context) (int64, error) {
+	reply, err := c.cli.XchainClient().GetBlockChainStatus(ctx, &pb.BCStatus{
+		Header: global.GHeader(),
+		Bcname: c.cli.RootOptions.Name,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
+		return 0, errors.New(reply.Header.Error.String())
+	}
+	return reply.GetMeta().GetTrunkHeight(), nil
+}
+
+func (c *ContractRentCommand) run(ctx context.Context) error {
+	if c.contractName == "" {
+		return errors.New("contract name is empty")
+	}
+	var err error
+	if c.height == 0 {
+		c.height, err = c.trunkHeight(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	ct := &CommTrans{
+		Amount:       "0",
+		Fee:          c.fee,
+		FrozenHeight: 0,
+		Version:      utxo.TxVersion,
+		ModuleName:   "xkernel",
+		MethodName:   c.method,
+		Args: map[string][]byte{
+			"contract_name": []byte(c.contractName),
+			"height":        []byte(strconv.FormatInt(c.height, 10)),
+			"amount":        []byte(strconv.FormatInt(c.amount, 10)),
+		},
+		MultiAddrs:   c.multiAddrs,
+		From:         c.account,
+		Output:       c.output,
+		IsQuick:      c.isMulti,
+		ChainName:    c.cli.RootOptions.Name,
+		Keys:         c.cli.RootOptions.Keys,
+		XchainClient: c.cli.XchainClient(),
+		CryptoType:   c.cli.RootOptions.CryptoType,
+		CliConf:      c.cli.RootOptions.CliConf,
+	}
+	if c.archiveFile != "" {
+		ct.Args["archive"], err = ioutil.ReadFile(c.archiveFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	if c.method == "StorageRent" {
+		_, _, err = ct.GenPreExeRes(ctx)
+		return err
+	}
+	ct.To, err = readAddress(ct.Keys)
+	if err != nil {
+		return err
+	}
+	if c.isMulti {
+		return ct.GenerateMultisigGenRawTx(ctx)
+	}
+	return ct.Transfer(ctx)
+}
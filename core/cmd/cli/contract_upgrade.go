@@ -24,6 +24,7 @@ type ContractUpgradeCommand struct {
 	isMulti      bool
 	multiAddrs   string
 	output       string
+	eventsFile   string
 }
 
 // NewContractUpgradeCommand new wasm deploy cmd
@@ -50,6 +51,7 @@ func (c *ContractUpgradeCommand) addFlags() {
 	c.cmd.Flags().BoolVarP(&c.isMulti, "isMulti", "m", false, "multisig scene")
 	c.cmd.Flags().StringVarP(&c.multiAddrs, "multiAddrs", "A", "data/acl/addrs", "multiAddrs if multisig scene")
 	c.cmd.Flags().StringVarP(&c.output, "output", "o", "./tx.out", "tx draw data")
+	c.cmd.Flags().StringVarP(&c.eventsFile, "events", "", "", "the json file replacing the declared indexed keys of events, e.g. {\"increase\": [\"key\"]}")
 }
 
 func (c *ContractUpgradeCommand) upgrade(ctx context.Context, codepath string) error {
@@ -87,6 +89,11 @@ func (c *ContractUpgradeCommand) upgrade(ctx context.Context, codepath string) e
 		"contract_name": []byte(c.contractName),
 		"contract_code": codebuf,
 	}
+	if c.eventsFile != "" {
+		if ct.Args["contract_events"], err = ioutil.ReadFile(c.eventsFile); err != nil {
+			return err
+		}
+	}
 
 	if c.isMulti {
 		err = ct.GenerateMultisigGenRawTx(ctx)
@@ -4,7 +4,15 @@
 
 package main
 
-import "github.com/spf13/cobra"
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	bls_sign "github.com/xuperchain/xuperchain/core/crypto/bls"
+	crypto_client "github.com/xuperchain/xuperchain/core/crypto/client"
+	"github.com/xuperchain/xuperchain/core/crypto/client/service/bls"
+)
 
 // MultisigCommand Multisig set command
 type MultisigCommand struct {
@@ -24,6 +32,36 @@ type PartialSign struct {
 	Index int
 }
 
+// createBlsClient create the crypto client of BLS aggregated signature
+func createBlsClient() (bls.BlsClient, error) {
+	cc, err := crypto_client.CreateCryptoClient(crypto_client.CryptoTypeBls)
+	if err != nil {
+		return nil, err
+	}
+	bc, ok := cc.(bls.BlsClient)
+	if !ok {
+		return nil, fmt.Errorf("crypto client of type %s does not support BLS", crypto_client.CryptoTypeBls)
+	}
+	return bc, nil
+}
+
+// readBlsPrivateKey derive the BLS private key from the ecdsa private key in keys path
+func readBlsPrivateKey(bc bls.BlsClient, keys string) (*bls_sign.PrivateKey, error) {
+	scrkey, err := readPrivateKey(keys)
+	if err != nil {
+		return nil, err
+	}
+	xcc, err := crypto_client.CreateCryptoClientFromJSONPrivateKey([]byte(scrkey))
+	if err != nil {
+		return nil, err
+	}
+	priv, err := xcc.GetEcdsaPrivateKeyFromJsonStr(scrkey)
+	if err != nil {
+		return nil, err
+	}
+	return bc.GetBlsPrivateKeyFromEcdsa(priv)
+}
+
 // NewMultisigCommand MultisigCommand init method
 func NewMultisigCommand(cli *Cli) *cobra.Command {
 	cmd := &cobra.Command{
@@ -67,7 +67,7 @@ func (c *MultisigGenCommand) addFlags() {
 	c.cmd.Flags().StringVarP(&c.output, "output", "o", "./tx.out", "Serialized transaction data file.")
 	c.cmd.Flags().StringVarP(&c.multiAddrs, "multiAddrs", "A", "data/acl/addrs", "MultiAddrs to fill required accounts/addresses.")
 	c.cmd.Flags().StringVarP(&c.pubkeys, "publickeys", "P", "data/acl/pubkeys", "public keys of initiator and auth_require addresses.")
-	c.cmd.Flags().StringVar(&c.signType, "signtype", "", "type of signature, support multi/ring(Note: this is a demo feature, do NOT use it in production environment)")
+	c.cmd.Flags().StringVar(&c.signType, "signtype", "", "type of signature, support multi/bls/ring(Note: this is a demo feature, do NOT use it in production environment)")
 	c.cmd.Flags().StringVar(&c.from, "from", "", "Initiator of an transaction.")
 	c.cmd.Flags().StringVar(&c.moduleName, "module", "", "Contract type: xkernel or wasm or native, native is deprecated.")
 	c.cmd.Flags().StringVar(&c.contractName, "contract", "", "Contract name to be called.")
@@ -91,6 +91,16 @@ func (c *MultisigGenCommand) generateTx(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+	} else if c.signType == "bls" {
+		// BLS aggregated signature, public keys are BLS public keys of all parties
+		pubkeys, err := c.readPublicKeysFromFile(c.pubkeys)
+		if err != nil {
+			return err
+		}
+		msd, err = c.getBlsSignData(pubkeys)
+		if err != nil {
+			return err
+		}
 	} else if c.signType != "" {
 		fmt.Printf("SignType[%s] is not supported", c.signType)
 		return fmt.Errorf("SignType is not supported")
@@ -202,3 +212,22 @@ func (c *MultisigGenCommand) getMultiSignData(pubkeys [][]byte) (*MultisigData,
 	}
 	return msd, nil
 }
+
+func (c *MultisigGenCommand) getBlsSignData(pubkeys [][]byte) (*MultisigData, error) {
+	if len(pubkeys) < 2 {
+		fmt.Println("the number of public keys for multisig should more than 2")
+		return nil, fmt.Errorf("invalid public keys")
+	}
+	bc, err := createBlsClient()
+	if err != nil {
+		return nil, err
+	}
+	for _, pubkey := range pubkeys {
+		if _, err := bc.GetBlsPublicKeyFromJsonStr(string(pubkey)); err != nil {
+			return nil, fmt.Errorf("invalid BLS public key %s, err=%v", pubkey, err)
+		}
+	}
+	return &MultisigData{
+		PubKeys: pubkeys,
+	}, nil
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/spf13/cobra"
 
+	bls_sign "github.com/xuperchain/xuperchain/core/crypto/bls"
 	crypto_client "github.com/xuperchain/xuperchain/core/crypto/client"
 	"github.com/xuperchain/xuperchain/core/global"
 	"github.com/xuperchain/xuperchain/core/pb"
@@ -38,18 +39,22 @@ func NewMultisigSendCommand(cli *Cli) *cobra.Command {
 	c.cmd = &cobra.Command{
 		Use:   "send",
 		Short: "Post a raw transaction along with multi-signatures.",
-		Long: `./xchain-cli multisig --tx ./tx.out arg1 [arg2] --signtype [multi/ring]
+		Long: `./xchain-cli multisig --tx ./tx.out arg1 [arg2] --signtype [multi/bls/ring]
 If signtype is empty:
 	arg1: Initiator signature array, separated with commas; 
 	arg2: AuthRequire signature array, separated with commas.
 If signtype is "multi":
-    arg1: The signature array, separated with commas(Note: this is a demo feature, do NOT use it in production environment).`,
+    arg1: The signature array, separated with commas(Note: this is a demo feature, do NOT use it in production environment).
+If signtype is "bls":
+    arg1: The BLS signature array of all parties, separated with commas.`,
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.TODO()
 			if c.signType == "multi" {
 				fmt.Println("Note: this is a demo feature, do NOT use it in production environment.")
 				return c.sendXuper(ctx, args[0])
+			} else if c.signType == "bls" {
+				return c.sendBls(ctx, args[0])
 			} else if c.signType != "" {
 				return fmt.Errorf("SignType[%s] is not supported", c.signType)
 			}
@@ -65,7 +70,7 @@ If signtype is "multi":
 
 func (c *MultisigSendCommand) addFlags() {
 	c.cmd.Flags().StringVar(&c.tx, "tx", "./tx.out", "Serialized transaction data file")
-	c.cmd.Flags().StringVar(&c.signType, "signtype", "", "type of signature, support multi/ring")
+	c.cmd.Flags().StringVar(&c.signType, "signtype", "", "type of signature, support multi/bls/ring")
 }
 
 // send 命令的主入口
@@ -180,6 +185,86 @@ func (c *MultisigSendCommand) sendXuper(ctx context.Context, signs string) error
 	return nil
 }
 
+// sendBls aggregate the BLS partial signatures into XuperSign
+func (c *MultisigSendCommand) sendBls(ctx context.Context, signs string) error {
+	data, err := ioutil.ReadFile(c.tx)
+	if err != nil {
+		return errors.New("Fail to open serialized transaction data file")
+	}
+	tx := &pb.Transaction{}
+	err = proto.Unmarshal(data, tx)
+	if err != nil {
+		return errors.New("Fail to Unmarshal proto")
+	}
+
+	signData, err := ioutil.ReadFile(c.tx + ".ext")
+	if err != nil {
+		return err
+	}
+	msd := &MultisigData{}
+	err = json.Unmarshal(signData, msd)
+	if err != nil {
+		return fmt.Errorf("Unmarshal MultisigData failed, err=%v", err)
+	}
+	needLen := len(msd.PubKeys)
+	if needLen <= 1 {
+		return fmt.Errorf("multisig need at least two parties, but got %d", needLen)
+	}
+	bc, err := createBlsClient()
+	if err != nil {
+		return fmt.Errorf("create crypto client failed, err=%v", err)
+	}
+	pks := make([]*bls_sign.PublicKey, needLen)
+	for idx, pubkey := range msd.PubKeys {
+		pks[idx], err = bc.GetBlsPublicKeyFromJsonStr(string(pubkey))
+		if err != nil {
+			return fmt.Errorf("invalid BLS public key, err=%v", err)
+		}
+	}
+	slist := make([][]byte, needLen)
+	signSlice := strings.Split(signs, ",")
+	if len(signSlice) != needLen {
+		return fmt.Errorf("sign file is not equal to multisig public keys, need[%d] but got[%d]",
+			needLen, len(signSlice))
+	}
+	for _, signfile := range signSlice {
+		sign, err := ioutil.ReadFile(signfile)
+		if err != nil {
+			return errors.New("Failed to open sign file")
+		}
+		psi := &PartialSign{}
+		err = json.Unmarshal([]byte(sign), psi)
+		if err != nil {
+			return fmt.Errorf("Unmarshal PartialSign failed, err=%v", err)
+		}
+		if psi.Index > needLen-1 || psi.Index < 0 || slist[psi.Index] != nil {
+			return fmt.Errorf("partial signature data is invalid")
+		}
+		slist[psi.Index] = psi.Si
+	}
+	finalsign, err := bc.BlsAggregateSignatures(pks, slist)
+	if err != nil {
+		return fmt.Errorf("BlsAggregateSignatures failed, err=%v", err)
+	}
+	tx.XuperSign = &pb.XuperSignature{
+		PublicKeys: msd.PubKeys,
+		Signature:  finalsign,
+	}
+
+	tx.Txid, err = txhash.MakeTransactionID(tx)
+	if err != nil {
+		return errors.New("MakeTxDigesthash txid error")
+	}
+
+	txid, err := c.sendTx(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("sendTx failed, err=%v", err)
+	}
+	fmt.Printf("Tx id: %s\n", txid)
+
+	return nil
+}
+
 // getSigns 读文件，填充pb.SignatureInfo
 func (c *MultisigSendCommand) getSigns(path string) ([]*pb.SignatureInfo, error) {
 	signs := []*pb.SignatureInfo{}
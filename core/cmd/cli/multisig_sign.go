@@ -45,7 +45,7 @@ func NewMultisigSignCommand(cli *Cli) *cobra.Command {
 
 func (c *MultisigSignCommand) addFlags() {
 	c.cmd.Flags().StringVar(&c.tx, "tx", "./tx.out", "Raw serialized transaction data file")
-	c.cmd.Flags().StringVar(&c.signType, "signtype", "", "type of signature, support multi/ring(Note: this is a demo feature, do NOT use it in production environment)")
+	c.cmd.Flags().StringVar(&c.signType, "signtype", "", "type of signature, support multi/bls/ring(Note: this is a demo feature, do NOT use it in production environment)")
 	c.cmd.Flags().StringVar(&c.output, "output", "./sign.out", "Generate signature file for a transaction.")
 }
 
@@ -113,6 +113,8 @@ func (c *MultisigSignCommand) sign() error {
 			return errors.New("WriteFile error")
 		}
 		fmt.Println(string(jsonContent))
+	} else if c.signType == "bls" {
+		return c.blsSign(tx)
 	} else if c.signType != "" {
 		return fmt.Errorf("SignType[%s] is not supported", c.signType)
 	} else {
@@ -129,6 +131,63 @@ func (c *MultisigSignCommand) sign() error {
 	return nil
 }
 
+// blsSign generate the BLS partial signature of tx with the BLS key derived from private key
+func (c *MultisigSignCommand) blsSign(tx *pb.Transaction) error {
+	signData, err := ioutil.ReadFile(c.tx + ".ext")
+	if err != nil {
+		return err
+	}
+	msd := &MultisigData{}
+	err = json.Unmarshal(signData, msd)
+	if err != nil {
+		return err
+	}
+	bc, err := createBlsClient()
+	if err != nil {
+		return err
+	}
+	priv, err := readBlsPrivateKey(bc, c.cli.RootOptions.Keys)
+	if err != nil {
+		return err
+	}
+	pubJSON, err := bc.GetBlsPublicKeyJsonFormatStr(priv)
+	if err != nil {
+		return err
+	}
+	idx := -1
+	for i, pubkey := range msd.PubKeys {
+		if string(pubkey) == pubJSON {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return fmt.Errorf("BLS public key not found in multisig data")
+	}
+	digestHash, err := txhash.MakeTxDigestHash(tx)
+	if err != nil {
+		return err
+	}
+	si, err := bc.BlsSign(priv, digestHash)
+	if err != nil {
+		return err
+	}
+	psd := &PartialSign{
+		Si:    si,
+		Index: idx,
+	}
+	jsonContent, err := json.Marshal(psd)
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(c.output, jsonContent, 0755)
+	if err != nil {
+		return errors.New("WriteFile error")
+	}
+	fmt.Println(string(jsonContent))
+	return nil
+}
+
 // GetSignTx use privatekey to get sign
 func (c *MultisigSignCommand) genSignTx(tx *pb.Transaction) ([]byte, error) {
 	// create crypto client
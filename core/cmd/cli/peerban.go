@@ -0,0 +1,28 @@
+/*
+ * Copyright (c) 2019. Baidu Inc. All Rights Reserved.
+ */
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// PeerBanCommand peerBan cmd
+type PeerBanCommand struct {
+}
+
+// NewPeerBanCommand new peerBan cmd
+func NewPeerBanCommand(cli *Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "peerBan",
+		Short: "Operate the p2p peers banned because of low score: list|clear.",
+	}
+	cmd.AddCommand(NewPeerBanListCommand(cli))
+	cmd.AddCommand(NewPeerBanClearCommand(cli))
+	return cmd
+}
+
+func init() {
+	AddCommand(NewPeerBanCommand)
+}
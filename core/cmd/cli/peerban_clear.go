@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2019. Baidu Inc. All Rights Reserved.
+ */
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/xuperchain/xuperchain/core/pb"
+)
+
+// PeerBanClearCommand clear banned peers cmd
+type PeerBanClearCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+}
+
+// NewPeerBanClearCommand new clear banned peers cmd
+func NewPeerBanClearCommand(cli *Cli) *cobra.Command {
+	c := new(PeerBanClearCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "clear [peer ids]",
+		Short: "Clear bans of the given p2p peers, all bans are cleared if no peer id given. Only works on the node host.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.clearBannedPeers(context.TODO(), args)
+		},
+	}
+	return c.cmd
+}
+
+func (c *PeerBanClearCommand) clearBannedPeers(ctx context.Context, peerIDs []string) error {
+	client := c.cli.XchainClient()
+	res, err := client.ClearBannedPeers(ctx, &pb.ClearBannedPeersRequest{PeerIds: peerIDs})
+	if err != nil {
+		return err
+	}
+	if res.GetHeader().GetError() != pb.XChainErrorEnum_SUCCESS {
+		return fmt.Errorf("clear banned peers failed, %s", res.GetHeader().GetError())
+	}
+	output, err := json.MarshalIndent(res.GetPeers(), "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
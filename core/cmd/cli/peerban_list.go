@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2019. Baidu Inc. All Rights Reserved.
+ */
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/xuperchain/xuperchain/core/pb"
+)
+
+// PeerBanListCommand list banned peers cmd
+type PeerBanListCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+}
+
+// NewPeerBanListCommand new list banned peers cmd
+func NewPeerBanListCommand(cli *Cli) *cobra.Command {
+	c := new(PeerBanListCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "list",
+		Short: "List the p2p peers banned temporarily.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.listBannedPeers(context.TODO())
+		},
+	}
+	return c.cmd
+}
+
+func (c *PeerBanListCommand) listBannedPeers(ctx context.Context) error {
+	client := c.cli.XchainClient()
+	res, err := client.GetBannedPeers(ctx, &pb.CommonIn{})
+	if err != nil {
+		return err
+	}
+	if res.GetHeader().GetError() != pb.XChainErrorEnum_SUCCESS {
+		return fmt.Errorf("list banned peers failed, %s", res.GetHeader().GetError())
+	}
+	output, err := json.MarshalIndent(res.GetPeers(), "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
@@ -16,9 +16,10 @@ type TxCommand struct {
 func NewTxCommand(cli *Cli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "tx",
-		Short: "Operate tx command, query",
+		Short: "Operate tx command, query, trace",
 	}
 	cmd.AddCommand(NewTxQueryCommand(cli))
+	cmd.AddCommand(NewTxTraceCommand(cli))
 	return cmd
 }
 
@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2019. Baidu Inc. All Rights Reserved.
+ */
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/xuperchain/xuperchain/core/global"
+	"github.com/xuperchain/xuperchain/core/pb"
+)
+
+// TxTraceCommand tx trace cmd
+type TxTraceCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+}
+
+// NewTxTraceCommand new tx trace cmd
+func NewTxTraceCommand(cli *Cli) *cobra.Command {
+	t := new(TxTraceCommand)
+	t.cli = cli
+	t.cmd = &cobra.Command{
+		Use:   "trace txid",
+		Short: "re-execute the contract requests of transaction and print the call tree",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 1 {
+				return errors.New("expect txid")
+			}
+			ctx := context.TODO()
+			return t.traceTx(ctx, args[0])
+		},
+	}
+	return t.cmd
+}
+
+func (t *TxTraceCommand) traceTx(ctx context.Context, txid string) error {
+	client := t.cli.XchainClient()
+	rawTxid, err := hex.DecodeString(txid)
+	if err != nil {
+		return fmt.Errorf("bad txid:%s", txid)
+	}
+	request := &pb.TraceTxRequest{
+		Header: &pb.Header{
+			Logid: global.Glogid(),
+		},
+		Bcname: t.cli.RootOptions.Name,
+		Txid:   rawTxid,
+	}
+	reply, err := client.TraceTx(ctx, request)
+	if err != nil {
+		return err
+	}
+	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
+		return errors.New(reply.Header.Error.String())
+	}
+	var output bytes.Buffer
+	if err := json.Indent(&output, []byte(reply.Trace), "", "  "); err != nil {
+		return err
+	}
+	fmt.Println(output.String())
+	return nil
+}
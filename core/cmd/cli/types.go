@@ -70,6 +70,7 @@ type GasPrice struct {
 	MemRate  int64 `json:"mem_rate"`
 	DiskRate int64 `json:"disk_rate"`
 	XfeeRate int64 `json:"xfee_rate"`
+	RentRate int64 `json:"rent_rate"`
 }
 
 // SignatureInfo proto.SignatureInfo
@@ -98,12 +99,15 @@ type SignInfo struct {
 }
 
 // QCSignInfos is the signs of the leader gathered from replicas of a specifically certType.
-// A slice of signs is used at present.
-// TODO @qizheng09: It will be change to Threshold-Signatures after
-// Crypto lib support Threshold-Signatures.
+// A slice of signs is used by default, while BLS is enabled the signs are aggregated
+// into one signature, and the signers are marked in a bitmap of validate sets.
 type QCSignInfos struct {
 	// QCSignInfos
 	QCSignInfos []*SignInfo `protobuf:"bytes,1,rep,name=QCSignInfos,proto3" json:"QCSignInfos,omitempty"`
+	// AggregatedSign is the aggregated BLS signature of replicas
+	AggregatedSign []byte `json:"AggregatedSign,omitempty"`
+	// SignerBitmap marks the signers in validate sets
+	SignerBitmap []byte `json:"SignerBitmap,omitempty"`
 }
 
 // QuorumCert is a data type that combines a collection of signatures from replicas.
@@ -279,6 +283,8 @@ type InternalBlock struct {
 	CurTerm      int64             `json:"curTerm"`
 	CurBlockNum  int64             `json:"curBlockNum"`
 	Justify      *QuorumCert       `json:"justify"`
+	StateRoot    HexID             `json:"stateRoot,omitempty"`
+	VrfProof     HexID             `json:"vrfProof,omitempty"`
 }
 
 // FromInternalBlockPB block info
@@ -291,6 +297,8 @@ func FromInternalBlockPB(block *pb.InternalBlock) *InternalBlock {
 		Sign:        block.Sign,
 		Pubkey:      string(block.Pubkey),
 		MerkleRoot:  block.MerkleRoot,
+		StateRoot:   block.StateRoot,
+		VrfProof:    block.VrfProof,
 		Height:      block.Height,
 		Timestamp:   block.Timestamp,
 		TxCount:     block.TxCount,
@@ -321,7 +329,9 @@ func FromPBJustify(qc *pb.QuorumCert) *QuorumCert {
 		justify.Type = QCState(int(qc.Type))
 		justify.ViewNumber = qc.ViewNumber
 		justify.SignInfos = &QCSignInfos{
-			QCSignInfos: make([]*SignInfo, 0),
+			QCSignInfos:    make([]*SignInfo, 0),
+			AggregatedSign: qc.GetSignInfos().GetAggregatedSign(),
+			SignerBitmap:   qc.GetSignInfos().GetSignerBitmap(),
 		}
 		for _, sign := range qc.SignInfos.QCSignInfos {
 			tmpSign := &SignInfo{
@@ -386,6 +396,17 @@ type ChainStatus struct {
 	UtxoMeta   UtxoMeta   `json:"utxo"`
 	// add BranchBlockid
 	BranchBlockid []string `json:"branchBlockid"`
+	// progress of pipelined block sync
+	SyncStatus *SyncStatus `json:"syncStatus,omitempty"`
+}
+
+// SyncStatus proto.SyncStatus
+type SyncStatus struct {
+	Syncing       bool     `json:"syncing"`
+	StartHeight   int64    `json:"startHeight"`
+	CurrentHeight int64    `json:"currentHeight"`
+	TargetHeight  int64    `json:"targetHeight"`
+	Peers         []string `json:"peers"`
 }
 
 // SystemStatus proto.SystemStatus
@@ -395,6 +416,20 @@ type SystemStatus struct {
 	Speeds      *pb.Speeds    `json:"speeds"`
 }
 
+// FromSyncStatusPB convert sync status, returns nil if the node never started pipelined sync
+func FromSyncStatusPB(statuspb *pb.SyncStatus) *SyncStatus {
+	if statuspb == nil {
+		return nil
+	}
+	return &SyncStatus{
+		Syncing:       statuspb.GetSyncing(),
+		StartHeight:   statuspb.GetStartHeight(),
+		CurrentHeight: statuspb.GetCurrentHeight(),
+		TargetHeight:  statuspb.GetTargetHeight(),
+		Peers:         statuspb.GetPeers(),
+	}
+}
+
 // FromSystemStatusPB systemstatus info
 func FromSystemStatusPB(statuspb *pb.SystemsStatus) *SystemStatus {
 	status := &SystemStatus{}
@@ -434,6 +469,7 @@ func FromSystemStatusPB(statuspb *pb.SystemsStatus) *SystemStatus {
 			MemRate:  gasPricePB.GetMemRate(),
 			DiskRate: gasPricePB.GetDiskRate(),
 			XfeeRate: gasPricePB.GetXfeeRate(),
+			RentRate: gasPricePB.GetRentRate(),
 		}
 		status.ChainStatus = append(status.ChainStatus, ChainStatus{
 			Name: chain.GetBcname(),
@@ -459,6 +495,7 @@ func FromSystemStatusPB(statuspb *pb.SystemsStatus) *SystemStatus {
 				GasPrice: gasPrice,
 			},
 			BranchBlockid: chain.GetBranchBlockid(),
+			SyncStatus:    FromSyncStatusPB(chain.GetSyncStatus()),
 		})
 	}
 	status.Peers = statuspb.GetPeerUrls()
@@ -492,8 +529,9 @@ type FilteredBlock struct {
 
 // FilteredTransaction pb.FilteredTransaction
 type FilteredTransaction struct {
-	Txid   string           `json:"txid,omitempty"`
-	Events []*ContractEvent `json:"events,omitempty"`
+	Txid          string                     `json:"txid,omitempty"`
+	Events        []*ContractEvent           `json:"events,omitempty"`
+	DecodedEvents []*pb.DecodedContractEvent `json:"decoded_events,omitempty"`
 }
 
 // ContractEvent pb.ContractEvent
@@ -514,8 +552,9 @@ func FromFilteredBlockPB(pbblock *pb.FilteredBlock) *FilteredBlock {
 
 	for _, pbtx := range pbblock.Txs {
 		tx := &FilteredTransaction{
-			Txid:   pbtx.Txid,
-			Events: make([]*ContractEvent, 0, len(pbtx.Events)),
+			Txid:          pbtx.Txid,
+			Events:        make([]*ContractEvent, 0, len(pbtx.Events)),
+			DecodedEvents: pbtx.DecodedEvents,
 		}
 		for _, pbevent := range pbtx.Events {
 			tx.Events = append(tx.Events, &ContractEvent{
@@ -0,0 +1,84 @@
+// kvmigrate converts the storage of a stopped chain between kvdb engines,
+// e.g. from leveldb(default) to badger, without resyncing from genesis.
+//
+//	./kvmigrate --src data/blockchain/xuper --dst data/blockchain_badger/xuper --src-engine default --dst-engine badger
+//
+// After migration, stop the node, replace the data path of chain with dst and restart.
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/xuperchain/xuperchain/core/kv/kvdb/migrate"
+)
+
+type migrateCommand struct {
+	opt           migrate.ChainOptions
+	srcOtherPaths string
+	dstOtherPaths string
+}
+
+func newMigrateCommand() *cobra.Command {
+	c := new(migrateCommand)
+	cmd := &cobra.Command{
+		Use:   "kvmigrate [OPTIONS]",
+		Short: "migrate the storage of a stopped chain to another kv engine.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.migrate()
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().StringVar(&c.opt.SrcPath, "src", "", "data path of the chain to migrate, e.g. data/blockchain/xuper")
+	cmd.Flags().StringVar(&c.opt.DstPath, "dst", "", "data path of the migrated chain")
+	cmd.Flags().StringVar(&c.opt.SrcEngine, "src-engine", "default", "kv engine of src, the subtype in plugins.conf")
+	cmd.Flags().StringVar(&c.opt.DstEngine, "dst-engine", "", "kv engine of dst, the subtype in plugins.conf")
+	cmd.Flags().StringVar(&c.srcOtherPaths, "src-other-paths", "", "comma separated data paths of src multi disk engine")
+	cmd.Flags().StringVar(&c.dstOtherPaths, "dst-other-paths", "", "comma separated data paths of dst multi disk engine")
+	cmd.Flags().IntVar(&c.opt.BatchSize, "batch-size", migrate.DefaultBatchSize, "bytes of values written in one batch")
+	cmd.Flags().IntVar(&c.opt.MemCacheSize, "cache", 128, "memory cache size in MB of kv engines")
+	cmd.Flags().IntVar(&c.opt.FileHandlersCacheSize, "fds", 512, "file handlers cache size of kv engines")
+	return cmd
+}
+
+func splitPaths(paths string) []string {
+	if paths == "" {
+		return nil
+	}
+	return strings.Split(paths, ",")
+}
+
+func (c *migrateCommand) migrate() error {
+	if c.opt.DstEngine == "" {
+		return fmt.Errorf("dst-engine required")
+	}
+	c.opt.SrcOtherPaths = splitPaths(c.srcOtherPaths)
+	c.opt.DstOtherPaths = splitPaths(c.dstOtherPaths)
+	c.opt.Progress = func(db string, count int64) {
+		fmt.Printf("\r%s: %d records copied", db, count)
+	}
+
+	result, err := migrate.MigrateChain(&c.opt)
+	fmt.Println()
+	if err != nil {
+		return err
+	}
+	for _, db := range migrate.ChainDBs {
+		stats := result[db]
+		fmt.Printf("%s verified:\n", db)
+		for _, prefix := range stats.Prefixes() {
+			fmt.Printf("  table %-2s records %-10d sha256 %x\n", prefix, stats[prefix].Count, stats[prefix].Hash)
+		}
+	}
+	fmt.Printf("migrate %s(%s) to %s(%s) succeeded\n", c.opt.SrcPath, c.opt.SrcEngine, c.opt.DstPath, c.opt.DstEngine)
+	return nil
+}
+
+func main() {
+	if err := newMigrateCommand().Execute(); err != nil {
+		os.Exit(1)
+	}
+}
@@ -1,14 +1,17 @@
 package relayer
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 
+	"github.com/golang/protobuf/proto"
 	"google.golang.org/grpc"
 
 	relayerpb "github.com/xuperchain/xuperchain/core/cmd/relayer/pb"
 	"github.com/xuperchain/xuperchain/core/global"
+	"github.com/xuperchain/xuperchain/core/ledger"
 	"github.com/xuperchain/xuperchain/core/pb"
 )
 
@@ -89,6 +92,44 @@ func (cmd *QueryBlockCommand) FetchBlockFromSrcChain(height int64) (*pb.Internal
 	return block, nil
 }
 
+// QueryTxProof 从原链获取交易的merkle证明, 并用本地已存储的区块头校验, 不需要信任原链节点
+func (cmd *QueryBlockCommand) QueryTxProof(txid []byte) (*pb.TxProof, error) {
+	in := &pb.TxProofRequest{
+		Header: &pb.Header{
+			Logid: global.Glogid(),
+		},
+		Bcname: cmd.Cfg.Bcname,
+		Txid:   txid,
+	}
+	resp, err := cmd.client.GetTxProof(context.TODO(), in)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Header.Error != pb.XChainErrorEnum_SUCCESS {
+		return nil, errors.New(resp.Header.Error.String())
+	}
+	proof := resp.GetProof()
+	if proof == nil || proof.GetBlock() == nil {
+		return nil, errors.New("tx proof not found")
+	}
+	// 证明中的区块必须是本地已经同步的区块
+	blockBuf, err := cmd.Storage.GetBlockHeader(proof.Block.Blockid)
+	if err != nil {
+		return nil, fmt.Errorf("block %x of tx proof is not in local storage, err:%v", proof.Block.Blockid, err)
+	}
+	localBlock := &pb.InternalBlock{}
+	if err := proto.Unmarshal(blockBuf, localBlock); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(localBlock.MerkleRoot, proof.Block.MerkleRoot) {
+		return nil, errors.New("merkle root of tx proof mismatch local block header")
+	}
+	if err := ledger.VerifyTxProof(proof); err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
 // LoadQueryMeta load query meta
 func (cmd *QueryBlockCommand) LoadQueryMeta() (*relayerpb.QueryMeta, error) {
 	return cmd.Storage.LoadQueryMeta()
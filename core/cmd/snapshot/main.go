@@ -0,0 +1,112 @@
+// snapshot imports a state snapshot exported by a running node into the data path of a new node,
+// so that the new node starts from the snapshot block instead of syncing from genesis.
+//
+//	./snapshot inspect --dir data/snapshot/xuper-100000
+//	./snapshot import --dir data/snapshot/xuper-100000 --datapath data/blockchain/xuper --blockid <trusted blockid>
+//	./snapshot import --dir data/snapshot/xuper-100000 --datapath data/blockchain/xuper --genesis data/config/xuper.json
+//
+// The snapshot is checked against the trusted blockid or genesis config, at least one of them is required.
+// The imported state is verified against the state root of snapshot block, chains without state root
+// can only be imported with --unsafe and --blockid.
+//
+// Snapshots are exported by setting snapshot.switch in xchain.yaml of a synced node.
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/xuperchain/xuperchain/core/kv/kvdb/migrate"
+	"github.com/xuperchain/xuperchain/core/snapshot"
+)
+
+type importCommand struct {
+	opt        snapshot.ImportOptions
+	otherPaths string
+}
+
+func newImportCommand() *cobra.Command {
+	c := new(importCommand)
+	cmd := &cobra.Command{
+		Use:   "import [OPTIONS]",
+		Short: "verify a snapshot and import it into the data path of a new chain.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.importSnapshot()
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().StringVar(&c.opt.Dir, "dir", "", "snapshot directory, e.g. data/snapshot/xuper-100000")
+	cmd.Flags().StringVar(&c.opt.Datapath, "datapath", "", "data path of the chain to create, e.g. data/blockchain/xuper")
+	cmd.Flags().StringVar(&c.otherPaths, "other-paths", "", "comma separated data paths of multi disk engine")
+	cmd.Flags().StringVar(&c.opt.KVEngine, "kvengine", "", "kv engine of the new chain, default is the kvengine in genesis config")
+	cmd.Flags().StringVar(&c.opt.TrustedBlockid, "blockid", "", "blockid at the snapshot height got from a trusted node")
+	cmd.Flags().StringVar(&c.opt.TrustedGenesis, "genesis", "", "genesis config of the chain got from a trusted source, e.g. data/config/xuper.json")
+	cmd.Flags().BoolVar(&c.opt.Unsafe, "unsafe", false, "import the snapshot of a chain without state root whose state can't be verified, --blockid is required")
+	cmd.Flags().IntVar(&c.opt.BatchSize, "batch-size", migrate.DefaultBatchSize, "bytes of values written in one batch")
+	return cmd
+}
+
+func (c *importCommand) importSnapshot() error {
+	if c.opt.Dir == "" || c.opt.Datapath == "" {
+		return fmt.Errorf("dir and datapath required")
+	}
+	if c.otherPaths != "" {
+		c.opt.OtherPaths = strings.Split(c.otherPaths, ",")
+	}
+	if c.opt.TrustedBlockid == "" && c.opt.TrustedGenesis == "" {
+		return fmt.Errorf("blockid or genesis required to check the snapshot against a trusted source")
+	}
+	if c.opt.Unsafe {
+		fmt.Println("warning: --unsafe set, the snapshot state is not verified if the chain has no state root")
+	}
+	manifest, err := snapshot.Import(&c.opt)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("import snapshot of %s at height %d blockid %s into %s succeeded\n",
+		manifest.Bcname, manifest.Height, manifest.Blockid, c.opt.Datapath)
+	return nil
+}
+
+func newInspectCommand() *cobra.Command {
+	var dir string
+	cmd := &cobra.Command{
+		Use:   "inspect [OPTIONS]",
+		Short: "print the manifest of a snapshot.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifest, err := snapshot.ReadManifest(dir)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("bcname:       %s\n", manifest.Bcname)
+			fmt.Printf("height:       %d\n", manifest.Height)
+			fmt.Printf("blockid:      %s\n", manifest.Blockid)
+			fmt.Printf("root blockid: %s\n", manifest.RootBlockid)
+			for db, stats := range manifest.Tables {
+				fmt.Printf("%s:\n", db)
+				for _, prefix := range stats.Prefixes() {
+					fmt.Printf("  table %-2s records %-10d sha256 %x\n", prefix, stats[prefix].Count, stats[prefix].Hash)
+				}
+			}
+			return nil
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().StringVar(&dir, "dir", "", "snapshot directory")
+	return cmd
+}
+
+func main() {
+	root := &cobra.Command{
+		Use:   "snapshot",
+		Short: "import and inspect the state snapshots of chain.",
+	}
+	root.AddCommand(newImportCommand())
+	root.AddCommand(newInspectCommand())
+	if err := root.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
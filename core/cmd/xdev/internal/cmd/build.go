@@ -41,6 +41,7 @@ type buildCommand struct {
 
 	genCompileCommand bool
 	makeFileOnly      bool
+	debug             bool
 	output            string
 	compiler          string
 	makeFlags         string
@@ -58,6 +59,7 @@ func newBuildCommand() *cobra.Command {
 	cmd.Flags().BoolVarP(&c.makeFileOnly, "makefile", "m", false, "generate makefile and exit")
 	cmd.Flags().BoolVarP(&c.genCompileCommand, "compile_command", "p", false, "generate compile_commands.json for IDE")
 	cmd.Flags().StringVarP(&c.output, "output", "o", "", "output file name")
+	cmd.Flags().BoolVarP(&c.debug, "debug", "g", false, "build with debug info for xdev debug")
 	cmd.Flags().StringVarP(&c.compiler, "compiler", "", "docker", "compiler env docker|host")
 	cmd.Flags().StringVarP(&c.makeFlags, "mkflags", "", "", "extra flags passing to make command")
 	return cmd
@@ -137,12 +139,30 @@ func (c *buildCommand) xdevCacheDir() (string, error) {
 func (c *buildCommand) initCompileFlags(xroot string) error {
 	c.cxxFlags = append([]string{}, defaultCxxFlags...)
 	c.ldflags = append([]string{}, defaultLDFlags...)
+	if c.debug {
+		// 保留DWARF调试信息供xdev debug使用
+		c.cxxFlags = replaceFlag(c.cxxFlags, "-Os", "-O0", "-g")
+		c.ldflags = replaceFlag(c.ldflags, "-Oz", "-O0", "-g")
+	}
 
 	exportJsPath := filepath.Join(xroot, "src", "xchain", "exports.js")
 	c.ldflags = append(c.ldflags, "--js-library "+exportJsPath)
 	return nil
 }
 
+// replaceFlag replaces flag with newFlags
+func replaceFlag(flags []string, flag string, newFlags ...string) []string {
+	var ret []string
+	for _, f := range flags {
+		if f == flag {
+			ret = append(ret, newFlags...)
+			continue
+		}
+		ret = append(ret, f)
+	}
+	return ret
+}
+
 func (c *buildCommand) build(args []string) error {
 	var err error
 	if c.output != "" && !filepath.IsAbs(c.output) {
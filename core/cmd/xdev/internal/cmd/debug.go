@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"bufio"
+	"os"
+
+	"github.com/spf13/cobra"
+	log15 "github.com/xuperchain/log15"
+	"github.com/xuperchain/xuperchain/core/cmd/xdev/internal/jstest/xchain"
+	"github.com/xuperchain/xuperchain/core/common/log"
+)
+
+type debugCommand struct {
+	test        testCommand
+	contracts   []string
+	breakpoints []string
+}
+
+func newDebugCommand() *cobra.Command {
+	c := &debugCommand{}
+	c.test.cmd = &cobra.Command{
+		Use:   "debug [contract.test.js]",
+		Short: "debug runs the tests with debugger attached to wasm contracts",
+		Long: `debug runs the tests like the test command, the wasm contracts run in interpreter mode
+and pause at the first source line, or the breakpoints if any.
+Build the contract with "xdev build -g" to set breakpoints by source line.
+Type help when paused to show the commands.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			log.DefaultLogger.SetHandler(log15.DiscardHandler())
+
+			c.test.adapter = xchain.NewDebugAdapter(&xchain.DebugOption{
+				Contracts:   c.contracts,
+				Breakpoints: c.breakpoints,
+				In:          bufio.NewReader(os.Stdin),
+				Out:         os.Stdout,
+			})
+			return c.test.test(args)
+		},
+	}
+	c.test.addFlags()
+	flags := c.test.cmd.Flags()
+	flags.StringSliceVarP(&c.contracts, "contract", "c", nil, "debug only the contracts, all wasm contracts are debugged by default")
+	flags.StringSliceVarP(&c.breakpoints, "break", "b", nil, "set breakpoints at function, file:line or 0xaddr")
+	return c.test.cmd
+}
+
+func init() {
+	addCommand(newDebugCommand)
+}
@@ -15,10 +15,13 @@ type testCommand struct {
 	cmd       *cobra.Command
 	quiet     bool
 	runPatten string
+	adapter   jstest.Adapter
 }
 
 func newTestCommand() *cobra.Command {
-	c := &testCommand{}
+	c := &testCommand{
+		adapter: xchain.NewAdapter(),
+	}
 	c.cmd = &cobra.Command{
 		Use:   "test [contract.test.js]",
 		Short: "test perform unit test",
@@ -53,7 +56,7 @@ func (c *testCommand) testFiles(wd string, files []string) error {
 	runner, err := jstest.NewRunner(&jstest.RunOption{
 		Quiet:  c.quiet,
 		Patten: c.runPatten,
-	}, xchain.NewAdapter())
+	}, c.adapter)
 
 	if err != nil {
 		return err
@@ -73,8 +73,8 @@ type xchainObject struct {
 	env *environment
 }
 
-func newXchainObject() (*xchainObject, error) {
-	env, err := newEnvironment()
+func newXchainObject(debug *DebugOption) (*xchainObject, error) {
+	env, err := newEnvironment(debug)
 	if err != nil {
 		return nil, err
 	}
@@ -149,6 +149,7 @@ func (x *xchainObject) Deploy(args deployArgs) *contractObject {
 }
 
 type xchainAdapter struct {
+	debug *DebugOption
 }
 
 // NewAdapter is the xchain adapter
@@ -158,11 +159,11 @@ func NewAdapter() jstest.Adapter {
 
 func (x *xchainAdapter) OnSetup(r *jstest.Runner) {
 	r.GlobalObject().Set("Xchain", func() *xchainObject {
-		x, err := newXchainObject()
+		xctx, err := newXchainObject(x.debug)
 		if err != nil {
 			jstest.Throw(err)
 		}
-		return x
+		return xctx
 	})
 }
 
@@ -171,7 +172,7 @@ func (x *xchainAdapter) OnTeardown(r *jstest.Runner) {
 
 func (x *xchainAdapter) OnTestCase(r *jstest.Runner, test jstest.TestCase) jstest.TestCase {
 	body := func(t *testing.T) {
-		xctx, err := newXchainObject()
+		xctx, err := newXchainObject(x.debug)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -0,0 +1,71 @@
+package xchain
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/xuperchain/xuperchain/core/cmd/xdev/internal/jstest"
+	"github.com/xuperchain/xuperchain/core/xvm/debug"
+	"github.com/xuperchain/xuperchain/core/xvm/debug/instrument"
+	"github.com/xuperchain/xuperchain/core/xvm/exec"
+)
+
+// DebugOption attaches the debugger to the wasm contracts running in the environment
+type DebugOption struct {
+	// Contracts are the names of debugged contracts, all wasm contracts are debugged if empty
+	Contracts []string
+	// Breakpoints are set to every debugged contract, see debug.Debugger.AddBreakpoint
+	Breakpoints []string
+	// In is shared by the debuggers of all contracts
+	In  *bufio.Reader
+	Out io.Writer
+}
+
+func (d *DebugOption) match(contractName string) bool {
+	if len(d.Contracts) == 0 {
+		return true
+	}
+	for _, name := range d.Contracts {
+		if name == contractName {
+			return true
+		}
+	}
+	return false
+}
+
+type contractDebugger struct {
+	info     *instrument.Info
+	debugger *debug.Debugger
+}
+
+// newDebugHook returns the debugger of contract, the debugger is kept until the contract code changes
+func (e *environment) newDebugHook(contractName string, info *instrument.Info) exec.DebugHook {
+	opt := e.debug
+	if !opt.match(contractName) {
+		return nil
+	}
+	d, ok := e.debuggers[contractName]
+	if !ok || d.info != info {
+		d = &contractDebugger{
+			info:     info,
+			debugger: debug.NewDebugger(info, opt.In, opt.Out),
+		}
+		for _, bp := range opt.Breakpoints {
+			if err := d.debugger.AddBreakpoint(bp); err != nil {
+				fmt.Fprintf(opt.Out, "contract %s: %s\n", contractName, err)
+			}
+		}
+		e.debuggers[contractName] = d
+	}
+	fmt.Fprintf(opt.Out, "enter contract %s\n", contractName)
+	d.debugger.Start()
+	return d.debugger
+}
+
+// NewDebugAdapter is the xchain adapter with the debugger attached to wasm contracts
+func NewDebugAdapter(opt *DebugOption) jstest.Adapter {
+	return &xchainAdapter{
+		debug: opt,
+	}
+}
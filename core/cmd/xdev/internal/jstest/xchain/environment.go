@@ -13,7 +13,7 @@ import (
 	"github.com/xuperchain/xuperchain/core/contract/bridge"
 	_ "github.com/xuperchain/xuperchain/core/contract/evm"
 	_ "github.com/xuperchain/xuperchain/core/contract/native"
-	_ "github.com/xuperchain/xuperchain/core/contract/wasm/xvm"
+	"github.com/xuperchain/xuperchain/core/contract/wasm/xvm"
 	"github.com/xuperchain/xuperchain/core/pb"
 )
 
@@ -21,17 +21,32 @@ type environment struct {
 	xbridge *bridge.XBridge
 	model   *mockStore
 	basedir string
+
+	debug     *DebugOption
+	debuggers map[string]*contractDebugger
 }
 
-func newEnvironment() (*environment, error) {
+// newEnvironment creates an in-memory chain, the wasm contracts are debugged if debug is not nil
+func newEnvironment(debug *DebugOption) (*environment, error) {
 	basedir, err := ioutil.TempDir("", "xdev-env")
 	if err != nil {
 		return nil, err
 	}
 	store := newMockStore()
-	wasmconfig := &config.WasmConfig{
+	env := &environment{
+		model:     store,
+		basedir:   basedir,
+		debug:     debug,
+		debuggers: make(map[string]*contractDebugger),
+	}
+	var wasmconfig bridge.VMConfig = &config.WasmConfig{
 		Driver: "ixvm",
 	}
+	if debug != nil {
+		wasmconfig = &xvm.DebugVMConfig{
+			NewHook: env.newDebugHook,
+		}
+	}
 	nativeconfig := &config.NativeConfig{
 		Enable: true,
 	}
@@ -55,11 +70,8 @@ func newEnvironment() (*environment, error) {
 		return nil, err
 	}
 
-	return &environment{
-		xbridge: xbridge,
-		model:   store,
-		basedir: basedir,
-	}, nil
+	env.xbridge = xbridge
+	return env, nil
 }
 
 type deployArgs struct {
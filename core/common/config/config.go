@@ -14,7 +14,9 @@ const (
 	// NodeModeNormal NODE_MODE_NORMAL node mode for normal
 	NodeModeNormal = "Normal"
 	// NodeModeFastSync NODE_MODE_FAST_SYNC node mode for fast
-	NodeModeFastSync        = "FastSync"
+	NodeModeFastSync = "FastSync"
+	// NodeModeLight NODE_MODE_LIGHT node mode for light node which keeps block headers only
+	NodeModeLight           = "Light"
 	DefaultNetPort          = 47101            // p2p port
 	DefaultNetKeyPath       = "./data/netkeys" // node private key path
 	DefaultCertPath         = "./data/cert"
@@ -36,6 +38,12 @@ const (
 	DefaultP2PModuleName         = "p2pv2"
 	DefaultServiceName           = ""
 	DefaultIsBroadCast           = true
+	// peer whose score falls to DefaultPeerBanScore will be banned for DefaultPeerBanDuration seconds
+	DefaultPeerBanScore    = -100
+	DefaultPeerBanDuration = 3600
+	// size of each priority queue and number of goroutines handling received p2p messages of each priority
+	DefaultMsgQueueSize = 50000
+	DefaultMsgWorkers   = 16
 )
 
 // LogConfig is the log config of node
@@ -117,6 +125,26 @@ type P2PConfig struct {
 	IsUseCert bool `yaml:"isUseCert,omitempty"`
 	// ServiceName
 	ServiceName string `yaml:"serviceName,omitempty"`
+	// PeerBanScore peer will be disconnected and banned when its score falls to this value
+	PeerBanScore int64 `yaml:"peerBanScore,omitempty"`
+	// PeerBanDuration define how long(in seconds) a peer will be banned
+	PeerBanDuration int64 `yaml:"peerBanDuration,omitempty"`
+	// MsgRateLimits config the token bucket of each message type for every peer,
+	// key is the message type name such as POSTTX, types not configured are not limited
+	MsgRateLimits map[string]MsgRateLimit `yaml:"msgRateLimits,omitempty"`
+	// MsgQueueSize is the size of each priority queue of received messages
+	MsgQueueSize int `yaml:"msgQueueSize,omitempty"`
+	// MsgWorkers is the number of goroutines handling received messages of each priority,
+	// the low priority tx messages are handled by half of them
+	MsgWorkers int `yaml:"msgWorkers,omitempty"`
+}
+
+// MsgRateLimit config the token bucket of a message type
+type MsgRateLimit struct {
+	// Rate is the number of messages allowed per second
+	Rate float64 `yaml:"rate,omitempty"`
+	// Burst is the max number of messages allowed at once
+	Burst int64 `yaml:"burst,omitempty"`
 }
 
 // MinerConfig is the config of miner
@@ -269,9 +297,10 @@ type NodeConfig struct {
 	EVM      EVMConfig      `yaml:"evm,omitempty"`
 
 	DBCache DBCacheConfig `yaml:"dbcache,omitempty"`
-	// 节点模式: NORMAL | FAST_SYNC 两种模式
+	// 节点模式: NORMAL | FAST_SYNC | LIGHT 三种模式
 	// NORMAL: 为普通的全节点模式
 	// FAST_SYNC 模式下:节点需要连接一个可信的全节点; 拒绝事务提交; 同步区块时跳过块验证和tx验证; 去掉load未确认事务;
+	// LIGHT 模式下:只同步区块头, 通过共识规则和QuorumCert校验区块头; 拒绝事务提交; 余额和交易从全节点获取并校验Merkle证明;
 	NodeMode        string `yaml:"nodeMode,omitempty"`
 	PluginConfPath  string `yaml:"pluginConfPath,omitempty"` // plugin config file path
 	PluginLoadPath  string `yaml:"pluginLoadPath,omitempty"` // plugin auto-load path
@@ -287,15 +316,23 @@ type NodeConfig struct {
 	EnableCompress bool `yaml:"enableCompress,omitempty"`
 	// prune ledger option
 	Prune PruneOption `yaml:"prune,omitempty"`
+	// export state snapshot option
+	Snapshot SnapshotOption `yaml:"snapshot,omitempty"`
+	// block sync option
+	Sync SyncOption `yaml:"sync,omitempty"`
 
 	// BlockBroadcaseMode is the mode for broadcast new block
 	//  * Full_BroadCast_Mode = 0, means send full block data
 	//  * Interactive_BroadCast_Mode = 1, means send block id and the receiver get block data by itself
 	//  * Mixed_BroadCast_Mode = 2, means miner use Full_BroadCast_Mode, other nodes use Interactive_BroadCast_Mode
+	//  * Compact_BroadCast_Mode = 3, means send block header with short txids, the receiver rebuild block
+	//    from unconfirmed txs and only get the missing txs
 	//  1. 一种是完全块广播模式(Full_BroadCast_Mode)，即直接广播原始块给所有相邻节点;
 	//  2. 一种是问询式块广播模式(Interactive_BroadCast_Mode)，即先广播新块的头部给相邻节点，
 	//     相邻节点在没有相同块的情况下通过GetBlock主动获取块数据.
 	//  3. Mixed_BroadCast_Mode是指出块节点将新块用Full_BroadCast_Mode模式广播，其他节点使用Interactive_BroadCast_Mode
+	//  4. 紧凑块广播模式(Compact_BroadCast_Mode)，即广播区块头和交易的短id，接收节点从未确认交易中重建区块，
+	//     只向发送节点获取本地缺失的交易.
 	BlockBroadcaseMode uint8 `yaml:"blockBroadcaseMode,omitempty"`
 	// cloud storage config
 	CloudStorage CloudStorageConfig `yaml:"cloudStorage,omitempty"`
@@ -319,6 +356,28 @@ type PruneOption struct {
 	TargetBlockid string `yaml:"targetBlockid,omitempty"`
 }
 
+// SnapshotOption state snapshot export option
+type SnapshotOption struct {
+	Switch bool   `yaml:"switch,omitempty"`
+	Bcname string `yaml:"bcname,omitempty"`
+	// Path is the directory to save snapshots, snapshot of each height is a sub directory
+	Path string `yaml:"path,omitempty"`
+}
+
+// SyncOption pipelined block sync option
+type SyncOption struct {
+	// Threshold the pipelined sync is used only when the local height is behind peers more than it
+	Threshold int64 `yaml:"threshold,omitempty"`
+	// MaxPeers the max number of peers to download blocks from
+	MaxPeers int `yaml:"maxPeers,omitempty"`
+	// Workers the number of goroutines downloading block bodies
+	Workers int `yaml:"workers,omitempty"`
+	// HeaderBatchSize the number of headers requested in one message
+	HeaderBatchSize int64 `yaml:"headerBatchSize,omitempty"`
+	// Window the max number of blocks downloaded but not confirmed
+	Window int64 `yaml:"window,omitempty"`
+}
+
 // DBCacheConfig db cache config
 type DBCacheConfig struct {
 	MemCacheSize int `yaml:"memcache,omitempty"`
@@ -400,6 +459,16 @@ func (nc *NodeConfig) defaultNodeConfig() {
 	nc.DedupTimeLimit = 15 //seconds
 	nc.MemberWhiteList = make(map[string]bool)
 	nc.NodeMode = NodeModeNormal
+	nc.Snapshot = SnapshotOption{
+		Path: "./data/snapshot",
+	}
+	nc.Sync = SyncOption{
+		Threshold:       100,
+		MaxPeers:        8,
+		Workers:         16,
+		HeaderBatchSize: 500,
+		Window:          2000,
+	}
 	nc.Wasm = WasmConfig{
 		Driver: "xvm",
 		XVM: XVMConfig{
@@ -471,12 +540,25 @@ func newP2pConfigWithDefault() P2PConfig {
 		IsUseCert:             true,
 		ServiceName:           DefaultServiceName,
 		IsBroadCast:           DefaultIsBroadCast,
+		PeerBanScore:          DefaultPeerBanScore,
+		PeerBanDuration:       DefaultPeerBanDuration,
+		// viper会将配置文件中的key转为小写, 默认值也使用小写以便被配置文件覆盖
+		MsgRateLimits: map[string]MsgRateLimit{
+			"posttx":               {Rate: 5000, Burst: 10000},
+			"batchposttx":          {Rate: 100, Burst: 200},
+			"get_block":            {Rate: 200, Burst: 500},
+			"get_blockchainstatus": {Rate: 10, Burst: 50},
+			"get_block_headers":    {Rate: 20, Burst: 50},
+			"get_block_txs":        {Rate: 200, Burst: 500},
+		},
+		MsgQueueSize: DefaultMsgQueueSize,
+		MsgWorkers:   DefaultMsgWorkers,
 	}
 }
 
 // Validate valid if
 func (nc *NodeConfig) Validate() error {
-	if nc.NodeMode != NodeModeNormal && nc.NodeMode != NodeModeFastSync {
+	if nc.NodeMode != NodeModeNormal && nc.NodeMode != NodeModeFastSync && nc.NodeMode != NodeModeLight {
 		return errors.New("Node mode not legal")
 	}
 	return nil
@@ -21,6 +21,14 @@ const (
 	ProposerReady = 1000
 	// ProposerChanged next round consensus proposers ready for use
 	ProposerChanged = 1010
+	// ValidatorsChanged validators of consensus updated, such as xpoa validates updated
+	ValidatorsChanged = 1020
+
+	/* Events for ledger and utxo, start from 2000 */
+	// UnconfirmedTxAdded a transaction entered the unconfirmed pool
+	UnconfirmedTxAdded = 2000
+	// ChainReorganized blocks undone and redone when switching to a new trunk
+	ChainReorganized = 2010
 )
 
 // EventMessage is the event message body
@@ -47,3 +47,22 @@ type ConsensusInterface interface {
 	// IsActive return whether the cosensus is active
 	IsActive() bool
 }
+
+// SwitchableInterface is implemented by consensus which could hand over its validators
+// and chained-bft state to the next consensus while consensus update
+type SwitchableInterface interface {
+	// GetSwitchState return the state of consensus when switching at height
+	GetSwitchState(height int64) (*SwitchState, error)
+}
+
+// SlashableInterface is implemented by consensus which could punish validators for double-signing
+type SlashableInterface interface {
+	// Slash remove the offender from validators and freeze its deposit,
+	// FrozenDeposit of record is filled by consensus
+	Slash(record *SlashRecord) error
+	// RollbackSlash restore the offender slashed by record
+	RollbackSlash(record *SlashRecord) error
+	// BlockSlot return the proposing slot of block, a proposer is allowed to sign only one block
+	// of a height in its slot
+	BlockSlot(block *pb.InternalBlock) int64
+}
@@ -0,0 +1,66 @@
+package base
+
+import (
+	"sync"
+
+	"github.com/xuperchain/xuperchain/core/pb"
+)
+
+// maxCachedAnnouncers limits the number of blocks cached by HeaderValidators
+const maxCachedAnnouncers = 1 << 14
+
+// HeaderValidators finds the validators announced by NextValidators of block headers,
+// which is used by light nodes following the validators without executing transactions.
+// The nearest announcing block found from every visited block is cached
+type HeaderValidators struct {
+	mutex       sync.Mutex
+	queryHeader func(blockid []byte) (*pb.InternalBlock, error)
+	// announcers maps blockid to the nearest block at or before it announcing validators, empty if none
+	announcers map[string]string
+}
+
+// NewHeaderValidators new a HeaderValidators reading headers by queryHeader
+func NewHeaderValidators(queryHeader func(blockid []byte) (*pb.InternalBlock, error)) *HeaderValidators {
+	return &HeaderValidators{
+		queryHeader: queryHeader,
+		announcers:  make(map[string]string),
+	}
+}
+
+// Find walks back from the block of blockid and returns the nearest header at or before it
+// announcing validators, nil is returned if no header announces validators back to the genesis block
+func (hv *HeaderValidators) Find(blockid []byte) (*pb.InternalBlock, error) {
+	hv.mutex.Lock()
+	defer hv.mutex.Unlock()
+	var visited []string
+	var announcer string
+	for id := blockid; ; {
+		if v, ok := hv.announcers[string(id)]; ok {
+			announcer = v
+			break
+		}
+		visited = append(visited, string(id))
+		header, err := hv.queryHeader(id)
+		if err != nil {
+			return nil, err
+		}
+		if len(header.NextValidators) > 0 {
+			announcer = string(id)
+			break
+		}
+		if len(header.PreHash) == 0 {
+			break
+		}
+		id = header.PreHash
+	}
+	if len(hv.announcers)+len(visited) > maxCachedAnnouncers {
+		hv.announcers = make(map[string]string)
+	}
+	for _, id := range visited {
+		hv.announcers[id] = announcer
+	}
+	if announcer == "" {
+		return nil, nil
+	}
+	return hv.queryHeader([]byte(announcer))
+}
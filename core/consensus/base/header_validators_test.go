@@ -0,0 +1,58 @@
+package base
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/xuperchain/xuperchain/core/pb"
+)
+
+func TestHeaderValidators(t *testing.T) {
+	headers := make(map[string]*pb.InternalBlock)
+	var preHash []byte
+	for i := 0; i < 10; i++ {
+		block := &pb.InternalBlock{
+			Blockid: []byte(fmt.Sprintf("block%d", i)),
+			PreHash: preHash,
+			Height:  int64(i),
+		}
+		if i == 3 || i == 6 {
+			block.NextValidators = []byte(fmt.Sprintf("validators%d", i))
+		}
+		headers[string(block.Blockid)] = block
+		preHash = block.Blockid
+	}
+	queries := 0
+	hv := NewHeaderValidators(func(blockid []byte) (*pb.InternalBlock, error) {
+		queries++
+		if block, ok := headers[string(blockid)]; ok {
+			return block, nil
+		}
+		return nil, errors.New("block not found")
+	})
+
+	cases := map[string]string{
+		"block9": "validators6",
+		"block6": "validators6",
+		"block5": "validators3",
+		"block2": "",
+	}
+	for blockid, expect := range cases {
+		header, err := hv.Find([]byte(blockid))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expect == "" && header != nil || expect != "" && string(header.GetNextValidators()) != expect {
+			t.Errorf("unexpected announcer of %s, %v", blockid, header)
+		}
+	}
+
+	queries = 0
+	if header, _ := hv.Find([]byte("block8")); string(header.GetNextValidators()) != "validators6" || queries != 1 {
+		t.Errorf("expect cached announcer, queries %d", queries)
+	}
+	if _, err := hv.Find([]byte("block10")); err == nil {
+		t.Error("expect error of missing block")
+	}
+}
@@ -1,5 +1,11 @@
 package base
 
+import (
+	"bytes"
+
+	"github.com/xuperchain/xuperchain/core/pb"
+)
+
 // MinerInfo defines the essential info of miner
 type MinerInfo struct {
 	Address  string // xchain address
@@ -27,6 +33,8 @@ type CandidateInfo struct {
 	Address string
 	// Neturl of node
 	PeerAddr string
+	// BlsPublicKey is the json format BLS public key of node, which is used to verify aggregated QC
+	BlsPublicKey string `json:",omitempty"`
 }
 
 // CandidateInfoEqual return whether candidate info is equal
@@ -35,7 +43,8 @@ func CandidateInfoEqual(left, right []*CandidateInfo) bool {
 		return false
 	}
 	for idx := 0; idx < len(left); idx++ {
-		if left[idx].Address != right[idx].Address || left[idx].PeerAddr != right[idx].PeerAddr {
+		if left[idx].Address != right[idx].Address || left[idx].PeerAddr != right[idx].PeerAddr ||
+			left[idx].BlsPublicKey != right[idx].BlsPublicKey {
 			return false
 		}
 	}
@@ -46,3 +55,53 @@ func CandidateInfoEqual(left, right []*CandidateInfo) bool {
 type CandidateInfos struct {
 	Proposers []*CandidateInfo `json:"proposers"`
 }
+
+// SwitchState is the state handed over from the outgoing consensus to the incoming one
+// when consensus is switched with validators inherited
+type SwitchState struct {
+	// Validators is the validators of outgoing consensus at the switch height
+	Validators []*CandidateInfo
+	// EnableBFT is whether the outgoing consensus runs chained-bft
+	EnableBFT bool
+	// BFTStartHeight is the height which chained-bft of outgoing consensus started from
+	BFTStartHeight int64
+	// QC chain of chained-bft in outgoing consensus
+	ProposalQC *pb.QuorumCert
+	GenerateQC *pb.QuorumCert
+	LockedQC   *pb.QuorumCert
+}
+
+// QCMatchTip return whether the QC chain of switch state is generated on the tip block,
+// only in this case the QC chain could be inherited by the incoming chained-bft
+func (s *SwitchState) QCMatchTip(tipBlockid []byte) bool {
+	if s == nil || !s.EnableBFT || s.GenerateQC == nil {
+		return false
+	}
+	return bytes.Equal(s.GenerateQC.GetProposalId(), tipBlockid)
+}
+
+// DoubleSignEvidence is the proof that a validator signed two conflicting messages,
+// either two ChainedBftPhaseMessages at the same view or two blocks at the same height
+type DoubleSignEvidence struct {
+	PhaseMsgs []*pb.ChainedBftPhaseMessage `json:"phase_msgs,omitempty"`
+	Blocks    []*pb.InternalBlock          `json:"blocks,omitempty"`
+}
+
+// SlashRecord is the record of a slashed validator
+type SlashRecord struct {
+	// Offender is the address of slashed validator
+	Offender string `json:"offender"`
+	// Height is the height of block which the evidence tx is in
+	Height int64 `json:"height"`
+	// Txid is the id of evidence tx in hex
+	Txid string `json:"txid"`
+	// Consensus is the type of consensus which slashed the validator
+	Consensus string `json:"consensus"`
+	// FrozenDeposit is the deposit of validator frozen by slashing
+	FrozenDeposit string `json:"frozen_deposit,omitempty"`
+}
+
+// GenSlashKey generate the key of slash record
+func GenSlashKey(address string) string {
+	return pb.ConsSlashPrefix + address
+}
@@ -111,7 +111,32 @@ func (cb *ChainedBft) UnRegisterToNetwork() error {
 	return cb.smr.UnRegisterToNetwork()
 }
 
+// GetQcStatus return the QC chain of smr, used to hand over chained-bft state while consensus update
+func (cb *ChainedBft) GetQcStatus() (proposalQC, generateQC, lockedQC *pb.QuorumCert) {
+	return cb.smr.GetQcStatus()
+}
+
 // UpdateSmrState update smr status
 func (cb *ChainedBft) UpdateSmrState(generateQC *pb.QuorumCert) {
 	cb.smr.UpdateSmrState(generateQC)
 }
+
+// ProcessLocalTimeout used to broadcast timeout vote while the local view is timeout
+func (cb *ChainedBft) ProcessLocalTimeout(viewNumber int64) error {
+	return cb.smr.ProcessLocalTimeout(viewNumber)
+}
+
+// SetTimeoutCertHandler set the handler which is called while a higher TimeoutCert is gathered or received
+func (cb *ChainedBft) SetTimeoutCertHandler(handler func(*pb.TimeoutCert)) {
+	cb.smr.SetTimeoutCertHandler(handler)
+}
+
+// GetHighTC return the highest TimeoutCert of this node
+func (cb *ChainedBft) GetHighTC() *pb.TimeoutCert {
+	return cb.smr.GetHighTC()
+}
+
+// IsTimeoutCertValidate return whether TimeoutCert is validated
+func (cb *ChainedBft) IsTimeoutCertValidate(tc *pb.TimeoutCert) (bool, error) {
+	return cb.smr.IsTimeoutCertValidate(tc)
+}
@@ -1,10 +1,86 @@
 package config
 
+import (
+	"strconv"
+	"time"
+)
+
+const (
+	// PacemakerDefault view advances with block production of external consensus
+	PacemakerDefault = "default"
+	// PacemakerHotstuff view times out locally and advances with timeout certificates
+	PacemakerHotstuff = "hotstuff"
+
+	// DefaultBaseTimeout is the default timeout of a view without consecutive timeouts
+	DefaultBaseTimeout = 3 * time.Second
+	// DefaultMaxTimeout is the default upper limit of view timeout
+	DefaultMaxTimeout = 60 * time.Second
+	// DefaultBackoffFactor is the default multiplier of view timeout
+	DefaultBackoffFactor = 2.0
+
+	// SignTypeDefault votes are signed with ecdsa and QC carries all votes
+	SignTypeDefault = "default"
+	// SignTypeBls votes are signed with BLS12-381 and QC carries one aggregated signature with signer bitmap
+	SignTypeBls = "bls"
+)
+
 // Config is the config of ChainedBFT, it initialized by Different Consensus
 type Config struct {
+	// Pacemaker is the type of pacemaker, "default" or "hotstuff"
+	Pacemaker string
+	// BaseTimeout is the timeout of a view without consecutive timeouts,
+	// it should be longer than the block interval of external consensus
+	BaseTimeout time.Duration
+	// MaxTimeout is the upper limit of view timeout while backing off
+	MaxTimeout time.Duration
+	// BackoffFactor is the multiplier of view timeout for each consecutive timeout
+	BackoffFactor float64
+	// SignType is the signature type of votes, "default" or "bls",
+	// BLS is used only by the validates with BlsPublicKey in candidate info
+	SignType string
 }
 
-// MakeConfig return config from raw json struct
+// MakeConfig return config from raw json struct, e.g. bft_config of tdpos and xpoa:
+// {"pacemaker": "hotstuff", "base_timeout": "3000", "max_timeout": "60000", "backoff_factor": "2", "sign_type": "bls"},
+// timeouts are in milliseconds, invalid values are replaced by default values
 func MakeConfig(rawConf map[string]interface{}) *Config {
-	return &Config{}
+	cfg := &Config{
+		Pacemaker:     PacemakerDefault,
+		BaseTimeout:   DefaultBaseTimeout,
+		MaxTimeout:    DefaultMaxTimeout,
+		BackoffFactor: DefaultBackoffFactor,
+		SignType:      SignTypeDefault,
+	}
+	if pacemaker, ok := rawConf["pacemaker"].(string); ok && pacemaker == PacemakerHotstuff {
+		cfg.Pacemaker = PacemakerHotstuff
+	}
+	if v, ok := parseFloat(rawConf["base_timeout"]); ok && v > 0 {
+		cfg.BaseTimeout = time.Duration(v) * time.Millisecond
+	}
+	if v, ok := parseFloat(rawConf["max_timeout"]); ok && v > 0 {
+		cfg.MaxTimeout = time.Duration(v) * time.Millisecond
+	}
+	if cfg.MaxTimeout < cfg.BaseTimeout {
+		cfg.MaxTimeout = cfg.BaseTimeout
+	}
+	if v, ok := parseFloat(rawConf["backoff_factor"]); ok && v >= 1 {
+		cfg.BackoffFactor = v
+	}
+	if signType, ok := rawConf["sign_type"].(string); ok && signType == SignTypeBls {
+		cfg.SignType = SignTypeBls
+	}
+	return cfg
+}
+
+// parseFloat parse number in json, which may be a string or a float64
+func parseFloat(v interface{}) (float64, bool) {
+	switch val := v.(type) {
+	case string:
+		f, err := strconv.ParseFloat(val, 64)
+		return f, err == nil
+	case float64:
+		return val, true
+	default:
+		return 0, false
+	}
 }
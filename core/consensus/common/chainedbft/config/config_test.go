@@ -0,0 +1,35 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMakeConfig(t *testing.T) {
+	cfg := MakeConfig(map[string]interface{}{})
+	if cfg.Pacemaker != PacemakerDefault || cfg.BaseTimeout != DefaultBaseTimeout ||
+		cfg.MaxTimeout != DefaultMaxTimeout || cfg.BackoffFactor != DefaultBackoffFactor || cfg.SignType != SignTypeDefault {
+		t.Error("TestMakeConfig default config error", cfg)
+	}
+
+	cfg = MakeConfig(map[string]interface{}{
+		"pacemaker":      "hotstuff",
+		"base_timeout":   "2000",
+		"max_timeout":    float64(1000),
+		"backoff_factor": "1.5",
+		"sign_type":      "bls",
+	})
+	if cfg.Pacemaker != PacemakerHotstuff || cfg.BaseTimeout != 2*time.Second ||
+		cfg.MaxTimeout != 2*time.Second || cfg.BackoffFactor != 1.5 || cfg.SignType != SignTypeBls {
+		t.Error("TestMakeConfig hotstuff config error", cfg)
+	}
+
+	cfg = MakeConfig(map[string]interface{}{
+		"pacemaker":      "unknown",
+		"base_timeout":   "-1",
+		"backoff_factor": "0.5",
+	})
+	if cfg.Pacemaker != PacemakerDefault || cfg.BaseTimeout != DefaultBaseTimeout || cfg.BackoffFactor != DefaultBackoffFactor {
+		t.Error("TestMakeConfig invalid config error", cfg)
+	}
+}
@@ -19,7 +19,7 @@ type DefaultCbftBridge struct {
 	ledger    *ledger.Ledger
 	log       log.Logger
 	cons      base.ConsensusInterface
-	paceMaker *DefaultPaceMaker
+	paceMaker PacemakerInterface
 }
 
 // NewDefaultCbftBridge create new instance of CbftBridge
@@ -32,8 +32,8 @@ func NewDefaultCbftBridge(bcname string, ledger *ledger.Ledger, xlog log.Logger,
 	}
 }
 
-// SetPaceMaker set pacemaker
-func (cb *DefaultCbftBridge) SetPaceMaker(paceMaker *DefaultPaceMaker) {
+// SetPaceMaker set pacemaker, which is the pacemaker used by consensus
+func (cb *DefaultCbftBridge) SetPaceMaker(paceMaker PacemakerInterface) {
 	cb.paceMaker = paceMaker
 }
 
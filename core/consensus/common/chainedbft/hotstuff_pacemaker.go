@@ -0,0 +1,255 @@
+package chainedbft
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	cons_base "github.com/xuperchain/xuperchain/core/consensus/base"
+	"github.com/xuperchain/xuperchain/core/consensus/common/chainedbft/config"
+	"github.com/xuperchain/xuperchain/core/pb"
+)
+
+// ProposerElection returns the proposer of view entered by TimeoutCert. It must be derived from the view
+// and the chain deterministically, so that the block of the rotated proposer could be validated by other nodes
+type ProposerElection func(view int64) (string, error)
+
+// HotstuffPaceMaker is the HotStuff-style implementation of PacemakerInterface,
+// the view times out locally with exponential back-off, timeout votes are gathered into TimeoutCert
+// which is carried in NEW_VIEW msg, and the view advances with QC and TimeoutCert instead of block production.
+// While a view is entered by TimeoutCert, the proposer of the view is elected by ProposerElection,
+// and NEW_VIEW msg is sent to it as the leader of the view
+type HotstuffPaceMaker struct {
+	*DefaultPaceMaker
+	cfg *config.Config
+	// view is the current view of pacemaker, not less than the view of latest proposal
+	view int64
+	// progressView is the view entered by last progress of proposals or QCs
+	progressView int64
+	// proposer is the leader of current view
+	proposer string
+	election ProposerElection
+	// timeouts is the number of consecutive timeouts since last progress
+	timeouts int
+	// resetCh is used to reset the view timer while entering a new view
+	resetCh chan struct{}
+	quitCh  chan bool
+	lk      *sync.Mutex
+}
+
+// NewHotstuffPaceMaker create new HotstuffPaceMaker instance based on DefaultPaceMaker
+func NewHotstuffPaceMaker(dpm *DefaultPaceMaker, cfg *config.Config) (*HotstuffPaceMaker, error) {
+	if dpm == nil {
+		return nil, fmt.Errorf("DefaultPaceMaker instance is nil")
+	}
+	if cfg == nil || cfg.BaseTimeout <= 0 {
+		cfg = config.MakeConfig(nil)
+	}
+	hpm := &HotstuffPaceMaker{
+		DefaultPaceMaker: dpm,
+		cfg:              cfg,
+		view:             dpm.currentView,
+		progressView:     dpm.currentView,
+		resetCh:          make(chan struct{}, 1),
+		quitCh:           make(chan bool, 1),
+		lk:               &sync.Mutex{},
+	}
+	dpm.cbft.SetTimeoutCertHandler(hpm.onTimeoutCert)
+	return hpm, nil
+}
+
+// SetProposerElection set the election of proposers of the views entered by TimeoutCert,
+// the proposer is not rotated by TimeoutCert if election is not set
+func (hpm *HotstuffPaceMaker) SetProposerElection(election ProposerElection) {
+	hpm.lk.Lock()
+	defer hpm.lk.Unlock()
+	hpm.election = election
+}
+
+// Proposer get the leader of current view
+func (hpm *HotstuffPaceMaker) Proposer() string {
+	hpm.lk.Lock()
+	defer hpm.lk.Unlock()
+	return hpm.proposer
+}
+
+// CurrentView get current view number of pacemaker
+func (hpm *HotstuffPaceMaker) CurrentView() int64 {
+	hpm.lk.Lock()
+	defer hpm.lk.Unlock()
+	return hpm.view
+}
+
+// NextNewView is used submit NewView event to bft network, and enter the view
+func (hpm *HotstuffPaceMaker) NextNewView(viewNum int64, proposer, preProposer string) error {
+	if err := hpm.DefaultPaceMaker.NextNewView(viewNum, proposer, preProposer); err != nil {
+		return err
+	}
+	hpm.enterView(viewNum, false)
+	hpm.lk.Lock()
+	if viewNum >= hpm.view {
+		hpm.proposer = proposer
+	}
+	hpm.lk.Unlock()
+	return nil
+}
+
+// NextNewProposal used to submit new proposal to bft network, a new proposal means progress of views
+func (hpm *HotstuffPaceMaker) NextNewProposal(proposalID []byte, data interface{}, validatesInfos []*cons_base.CandidateInfo) error {
+	if err := hpm.DefaultPaceMaker.NextNewProposal(proposalID, data, validatesInfos); err != nil {
+		return err
+	}
+	if block, ok := data.(*pb.Block); ok {
+		hpm.enterView(block.GetBlock().GetHeight(), true)
+	}
+	return nil
+}
+
+// UpdateSmrState update smr status of chainedbft, the view after generateQC is entered
+func (hpm *HotstuffPaceMaker) UpdateSmrState(generateQC *pb.QuorumCert) {
+	hpm.DefaultPaceMaker.UpdateSmrState(generateQC)
+	if generateQC != nil {
+		hpm.enterView(generateQC.GetViewNumber()+1, true)
+	}
+}
+
+// Start run BFT and the view timer
+func (hpm *HotstuffPaceMaker) Start() error {
+	if err := hpm.DefaultPaceMaker.Start(); err != nil {
+		return err
+	}
+	go hpm.run()
+	return nil
+}
+
+// Stop finish running BFT and the view timer
+func (hpm *HotstuffPaceMaker) Stop() error {
+	select {
+	case hpm.quitCh <- true:
+	default:
+	}
+	return hpm.DefaultPaceMaker.Stop()
+}
+
+// run is the view timer loop
+func (hpm *HotstuffPaceMaker) run() {
+	timer := time.NewTimer(hpm.viewTimeout())
+	defer timer.Stop()
+	for {
+		select {
+		case <-timer.C:
+			hpm.onLocalTimeout()
+		case <-hpm.resetCh:
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+		case <-hpm.quitCh:
+			hpm.log.Info("Quit hotstuff pacemaker")
+			return
+		}
+		timer.Reset(hpm.viewTimeout())
+	}
+}
+
+// onLocalTimeout broadcast timeout vote of current view, and back off the timeout of next round
+func (hpm *HotstuffPaceMaker) onLocalTimeout() {
+	hpm.lk.Lock()
+	view := hpm.view
+	hpm.timeouts++
+	timeouts := hpm.timeouts
+	hpm.lk.Unlock()
+	err := hpm.cbft.ProcessLocalTimeout(view)
+	hpm.log.Warn("HotstuffPaceMaker view timeout", "viewNum", view, "timeouts", timeouts,
+		"nextTimeout", hpm.viewTimeout(), "error", err)
+}
+
+// onTimeoutCert enter the next view of TimeoutCert and rotate the proposer
+func (hpm *HotstuffPaceMaker) onTimeoutCert(tc *pb.TimeoutCert) {
+	hpm.log.Info("HotstuffPaceMaker receive TimeoutCert", "viewNum", tc.GetViewNumber(),
+		"votes", len(tc.GetSignInfos().GetQCSignInfos()))
+	view := tc.GetViewNumber() + 1
+	if hpm.enterView(view, false) {
+		hpm.rotateProposer(view)
+	}
+}
+
+// rotateProposer elect the proposer of view entered by TimeoutCert, and send NEW_VIEW msg to it,
+// the timed out proposer sends its QC in the msg so that the new proposer could extend it
+func (hpm *HotstuffPaceMaker) rotateProposer(view int64) {
+	proposer, preProposer, ok := hpm.electProposer(view)
+	if !ok {
+		return
+	}
+	err := hpm.cbft.ProcessNewView(view, proposer, preProposer)
+	hpm.log.Info("HotstuffPaceMaker rotate proposer", "viewNum", view,
+		"proposer", proposer, "preProposer", preProposer, "error", err)
+}
+
+// electProposer elect the proposer of view and make it the leader of current view,
+// it returns false if the proposer can't be elected or the view is passed
+func (hpm *HotstuffPaceMaker) electProposer(view int64) (string, string, bool) {
+	hpm.lk.Lock()
+	election := hpm.election
+	rounds := view - hpm.progressView
+	hpm.lk.Unlock()
+	if election == nil || rounds <= 0 {
+		return "", "", false
+	}
+	proposer, err := election(view)
+	if err != nil {
+		hpm.log.Warn("HotstuffPaceMaker elect proposer error", "viewNum", view, "rounds", rounds, "error", err)
+		return "", "", false
+	}
+	hpm.lk.Lock()
+	defer hpm.lk.Unlock()
+	// 选举期间已进入更高的view
+	if view != hpm.view {
+		return "", "", false
+	}
+	preProposer := hpm.proposer
+	hpm.proposer = proposer
+	return proposer, preProposer, true
+}
+
+// enterView advance the view if it's higher than current view, and return whether the view is changed.
+// The consecutive timeouts are cleared while there is progress of proposals or QCs
+func (hpm *HotstuffPaceMaker) enterView(view int64, progress bool) bool {
+	hpm.lk.Lock()
+	changed := view > hpm.view
+	if changed {
+		hpm.view = view
+	}
+	if progress {
+		hpm.timeouts = 0
+		hpm.progressView = hpm.view
+	}
+	hpm.lk.Unlock()
+	if !changed && !progress {
+		return false
+	}
+	select {
+	case hpm.resetCh <- struct{}{}:
+	default:
+	}
+	return changed
+}
+
+// viewTimeout return the timeout of current view
+func (hpm *HotstuffPaceMaker) viewTimeout() time.Duration {
+	hpm.lk.Lock()
+	defer hpm.lk.Unlock()
+	return backoffTimeout(hpm.cfg, hpm.timeouts)
+}
+
+// backoffTimeout return BaseTimeout * BackoffFactor^timeouts, but not more than MaxTimeout
+func backoffTimeout(cfg *config.Config, timeouts int) time.Duration {
+	timeout := float64(cfg.BaseTimeout) * math.Pow(cfg.BackoffFactor, float64(timeouts))
+	if timeout > float64(cfg.MaxTimeout) {
+		return cfg.MaxTimeout
+	}
+	return time.Duration(timeout)
+}
@@ -0,0 +1,79 @@
+package chainedbft
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	log "github.com/xuperchain/log15"
+
+	"github.com/xuperchain/xuperchain/core/consensus/common/chainedbft/config"
+)
+
+func TestBackoffTimeout(t *testing.T) {
+	cfg := config.MakeConfig(map[string]interface{}{
+		"pacemaker":      "hotstuff",
+		"base_timeout":   "1000",
+		"max_timeout":    "5000",
+		"backoff_factor": "2",
+	})
+	cases := map[int]time.Duration{
+		0:  time.Second,
+		1:  2 * time.Second,
+		2:  4 * time.Second,
+		3:  5 * time.Second,
+		64: 5 * time.Second,
+	}
+	for timeouts, expect := range cases {
+		if timeout := backoffTimeout(cfg, timeouts); timeout != expect {
+			t.Error("TestBackoffTimeout error", "timeouts", timeouts, "expect", expect, "actual", timeout)
+		}
+	}
+}
+
+func TestElectProposer(t *testing.T) {
+	validators := []string{"a", "b", "c"}
+	hpm := &HotstuffPaceMaker{
+		DefaultPaceMaker: &DefaultPaceMaker{log: log.New("module", "test")},
+		view:             10,
+		progressView:     10,
+		proposer:         "a",
+		resetCh:          make(chan struct{}, 1),
+		lk:               &sync.Mutex{},
+	}
+	if _, _, ok := hpm.electProposer(11); ok {
+		t.Fatal("expect no rotation without election")
+	}
+	// 模拟由链上最新区块和view确定的轮换
+	tipView := int64(10)
+	hpm.SetProposerElection(func(view int64) (string, error) {
+		return validators[(view-tipView)%int64(len(validators))], nil
+	})
+
+	// 每个超时证书使leader轮换到下一个验证者
+	for i, expect := range []string{"b", "c", "a"} {
+		view := int64(11 + i)
+		hpm.enterView(view, false)
+		proposer, preProposer, ok := hpm.electProposer(view)
+		if !ok || proposer != expect || preProposer != validators[i] {
+			t.Fatalf("view %d expect proposer %s got %s %s %v", view, expect, proposer, preProposer, ok)
+		}
+		if hpm.Proposer() != expect {
+			t.Fatalf("expect leader %s got %s", expect, hpm.Proposer())
+		}
+	}
+	// 已经过去的view不再轮换
+	if _, _, ok := hpm.electProposer(12); ok {
+		t.Fatal("expect no rotation of passed view")
+	}
+	// 有进展后重新从共识调度的proposer开始
+	hpm.enterView(14, true)
+	tipView = 14
+	if _, _, ok := hpm.electProposer(14); ok {
+		t.Fatal("expect no rotation of view with progress")
+	}
+	hpm.enterView(15, false)
+	if proposer, _, _ := hpm.electProposer(15); proposer != "b" {
+		t.Fatalf("expect proposer b got %s", proposer)
+	}
+}
@@ -27,6 +27,8 @@ type PacemakerInterface interface {
 	UpdateSmrState(generateQC *pb.QuorumCert)
 	// IsLastViewConfirmed check if last block is confirmed
 	IsLastViewConfirmed() (bool, error)
+	// IsFirstProposal check if qc is of the first view of BFT
+	IsFirstProposal(qc *pb.QuorumCert) bool
 	GetChainedBFT() *ChainedBft
 	Start() error
 	Stop() error
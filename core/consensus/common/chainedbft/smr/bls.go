@@ -0,0 +1,159 @@
+package smr
+
+import (
+	"bytes"
+	"sort"
+
+	cons_base "github.com/xuperchain/xuperchain/core/consensus/base"
+	"github.com/xuperchain/xuperchain/core/consensus/common/chainedbft/config"
+	"github.com/xuperchain/xuperchain/core/consensus/common/chainedbft/utils"
+	bls_sign "github.com/xuperchain/xuperchain/core/crypto/bls"
+	pb "github.com/xuperchain/xuperchain/core/pb"
+)
+
+// blsEnabled return whether votes are signed with BLS
+func (s *Smr) blsEnabled() bool {
+	return s.config != nil && s.config.SignType == config.SignTypeBls && s.blsPrivateKey != nil
+}
+
+// getBlsPublicKey return the BLS public key of address in validates,
+// nil is returned if BLS is not enabled or the validate has no BLS public key
+func (s *Smr) getBlsPublicKey(validates []*cons_base.CandidateInfo, address string) *bls_sign.PublicKey {
+	if !s.blsEnabled() {
+		return nil
+	}
+	for _, v := range validates {
+		if v.Address == address {
+			return s.parseBlsPublicKey(v.BlsPublicKey)
+		}
+	}
+	return nil
+}
+
+// parseBlsPublicKey parse the json format public key, the parsed keys are cached
+// since the subgroup check of public key is expensive
+func (s *Smr) parseBlsPublicKey(keyStr string) *bls_sign.PublicKey {
+	if keyStr == "" {
+		return nil
+	}
+	if v, ok := s.blsPublicKeys.Load(keyStr); ok {
+		return v.(*bls_sign.PublicKey)
+	}
+	pk, err := bls_sign.ParsePublicKeyJSON([]byte(keyStr))
+	if err != nil {
+		s.slog.Warn("parseBlsPublicKey error", "publicKey", keyStr, "error", err)
+		return nil
+	}
+	s.blsPublicKeys.Store(keyStr, pk)
+	return pk
+}
+
+// makeVoteSign sign the vote with BLS if the BLS public key of this node is in validate sets,
+// otherwise the vote is signed with ecdsa
+func (s *Smr) makeVoteSign(sign *pb.SignInfo, msg []byte) error {
+	pk := s.getBlsPublicKey(s.validates, s.address)
+	if pk == nil {
+		pk = s.getBlsPublicKey(s.preValidates, s.address)
+	}
+	if pk != nil {
+		if bytes.Equal(pk.Bytes(), s.blsPrivateKey.Public().Bytes()) {
+			sign.Sign = bls_sign.Sign(s.blsPrivateKey, msg)
+			return nil
+		}
+		s.slog.Warn("makeVoteSign BLS public key in validates doesn't match the key of node", "address", s.address)
+	}
+	_, err := utils.MakeVoteMsgSign(s.cryptoClient, s.privateKey, sign, msg)
+	return err
+}
+
+// verifyVoteSign verify the vote with BLS public key of the validate if it exists, otherwise with ecdsa
+func (s *Smr) verifyVoteSign(sign *pb.SignInfo, validates []*cons_base.CandidateInfo, msg []byte) (bool, error) {
+	if pk := s.getBlsPublicKey(validates, sign.GetAddress()); pk != nil {
+		if !bls_sign.Verify(pk, sign.GetSign(), msg) {
+			return false, ErrVerifyVoteSign
+		}
+		return true, nil
+	}
+	return utils.VerifyVoteMsgSign(s.cryptoClient, sign, msg)
+}
+
+// makeQCSignInfos aggregate the votes into one signature with signer bitmap,
+// votes are kept as they are if BLS is not enabled or some signer has no BLS public key
+func (s *Smr) makeQCSignInfos(votes *pb.QCSignInfos) *pb.QCSignInfos {
+	if !s.blsEnabled() || len(votes.GetAggregatedSign()) > 0 || len(votes.GetQCSignInfos()) == 0 {
+		return votes
+	}
+	validates := s.validates
+	type signer struct {
+		index int
+		pk    *bls_sign.PublicKey
+		sign  []byte
+	}
+	signers := make([]signer, 0, len(votes.GetQCSignInfos()))
+	for _, vote := range votes.GetQCSignInfos() {
+		index := -1
+		for i, v := range validates {
+			if v.Address == vote.GetAddress() {
+				index = i
+				break
+			}
+		}
+		pk := s.getBlsPublicKey(validates, vote.GetAddress())
+		if index < 0 || pk == nil {
+			return votes
+		}
+		signers = append(signers, signer{index: index, pk: pk, sign: vote.GetSign()})
+	}
+	// 按照验证人顺序聚合, 验证时根据位图恢复相同的公钥顺序
+	sort.Slice(signers, func(i, j int) bool {
+		return signers[i].index < signers[j].index
+	})
+	pks := make([]*bls_sign.PublicKey, len(signers))
+	signs := make([][]byte, len(signers))
+	bitmap := make([]byte, (len(validates)+7)/8)
+	for i, v := range signers {
+		pks[i] = v.pk
+		signs[i] = v.sign
+		bitmap[v.index/8] |= 1 << uint(v.index%8)
+	}
+	aggSign, err := bls_sign.AggregateSignatures(pks, signs)
+	if err != nil {
+		s.slog.Warn("makeQCSignInfos AggregateSignatures error", "error", err)
+		return votes
+	}
+	return &pb.QCSignInfos{
+		AggregatedSign: aggSign,
+		SignerBitmap:   bitmap,
+	}
+}
+
+// verifyAggregatedVotes verify the aggregated signature of QC with public keys of signers in bitmap
+func (s *Smr) verifyAggregatedVotes(signInfos *pb.QCSignInfos, validateSets []*cons_base.CandidateInfo, proposalID []byte) (bool, error) {
+	bitmap := signInfos.GetSignerBitmap()
+	if len(bitmap) != (len(validateSets)+7)/8 {
+		return false, ErrParams
+	}
+	pks := []*bls_sign.PublicKey{}
+	for i := 0; i < len(bitmap)*8; i++ {
+		if bitmap[i/8]&(1<<uint(i%8)) == 0 {
+			continue
+		}
+		if i >= len(validateSets) {
+			return false, ErrParams
+		}
+		pk := s.parseBlsPublicKey(validateSets[i].BlsPublicKey)
+		if pk == nil {
+			return false, ErrVerifyVoteSign
+		}
+		pks = append(pks, pk)
+	}
+	s.slog.Trace("verifyAggregatedVotes", "autual", len(pks), "require", (len(validateSets)+1)*2/3-1)
+	if len(pks) == 0 || len(pks) < ((len(validateSets)+1)*2/3-1) {
+		return false, ErrJustifySignNotEnough
+	}
+	if !bls_sign.VerifyAggregatedSignature(pks, signInfos.GetAggregatedSign(), proposalID) {
+		s.slog.Error("verifyAggregatedVotes verify aggregated sign error")
+		return false, ErrVerifyVoteSign
+	}
+	return true, nil
+}
@@ -45,6 +45,14 @@ func (s *Smr) IsQuorumCertValidate(justify *pb.QuorumCert) (bool, error) {
 	if justify == nil || justify.GetSignInfos() == nil || justify.GetProposalId() == nil {
 		return false, ErrParams
 	}
+	// 开启BLS后QC中只有聚合签名和签名者位图
+	if len(justify.GetSignInfos().GetAggregatedSign()) > 0 {
+		ok, _ := s.verifyAggregatedVotes(justify.GetSignInfos(), s.validates, justify.GetProposalId())
+		if !ok {
+			return s.verifyAggregatedVotes(justify.GetSignInfos(), s.preValidates, justify.GetProposalId())
+		}
+		return true, nil
+	}
 	justifySigns := justify.GetSignInfos().GetQCSignInfos()
 	// verify justify sign
 	s.slog.Info("IsQuorumCertValidate verify justify sign", "view", justify.GetViewNumber(), "vscView", s.vscView)
@@ -67,9 +75,9 @@ func (s *Smr) verifyVotes(signs []*pb.SignInfo, validateSets []*cons_base.Candid
 			s.slog.Error("verifyVotes IsInValidateSets error")
 			return false, ErrInValidateSets
 		}
-		ok, err := utils.VerifyVoteMsgSign(s.cryptoClient, v, proposalID)
+		ok, err := s.verifyVoteSign(v, validateSets, proposalID)
 		if !ok || err != nil {
-			s.slog.Error("verifyVotes VerifyVoteMsgSign error", "ok", ok, "error", err)
+			s.slog.Error("verifyVotes verifyVoteSign error", "ok", ok, "error", err)
 			return false, ErrVerifyVoteSign
 		}
 	}
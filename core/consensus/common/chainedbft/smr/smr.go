@@ -16,6 +16,7 @@ import (
 	"github.com/xuperchain/xuperchain/core/consensus/common/chainedbft/config"
 	"github.com/xuperchain/xuperchain/core/consensus/common/chainedbft/external"
 	"github.com/xuperchain/xuperchain/core/consensus/common/chainedbft/utils"
+	bls_sign "github.com/xuperchain/xuperchain/core/crypto/bls"
 	p2p_base "github.com/xuperchain/xuperchain/core/p2p/base"
 	p2p_pb "github.com/xuperchain/xuperchain/core/p2p/pb"
 	pb "github.com/xuperchain/xuperchain/core/pb"
@@ -49,6 +50,10 @@ var (
 	ErrCallPreQcStatus = errors.New("call pre qc status error")
 	// ErrGetLocalProposalQC return LocalProposalQC error
 	ErrGetLocalProposalQC = errors.New("get local proposalQC error")
+	// ErrTimeoutVoted return timeout vote repeated error
+	ErrTimeoutVoted = errors.New("timeout vote repeated error")
+	// ErrTimeoutCertOutdated return timeout cert outdated error
+	ErrTimeoutCertOutdated = errors.New("timeout cert outdated error")
 )
 
 // NewSmr return smr instance
@@ -71,6 +76,7 @@ func NewSmr(
 	// set up smr
 	smr := &Smr{
 		slog:           slog,
+		config:         cfg,
 		bcname:         bcname,
 		address:        address,
 		publicKey:      publicKey,
@@ -85,10 +91,20 @@ func NewSmr(
 		localProposal:  &sync.Map{},
 		qcVoteMsgs:     &sync.Map{},
 		newViewMsgs:    &sync.Map{},
+		timeoutVotes:   &sync.Map{},
+		blsPublicKeys:  &sync.Map{},
 		effectiveDelay: effectiveDelay,
 		lk:             &sync.Mutex{},
 		QuitCh:         make(chan bool, 1),
 	}
+	if cfg != nil && cfg.SignType == config.SignTypeBls {
+		blsPrivateKey, err := bls_sign.NewPrivateKeyFromEcdsa(privateKey)
+		if err != nil {
+			slog.Error("smr derive BLS private key error", "error", err)
+			return nil, err
+		}
+		smr.blsPrivateKey = blsPrivateKey
+	}
 	if err := smr.updateQcStatus(proposalQC, generateQC, lockedQC); err != nil {
 		slog.Error("smr updateQcStatus error", "error", err)
 		return nil, err
@@ -205,13 +221,13 @@ func (s *Smr) GetGenerateQC() (*pb.QuorumCert, error) {
 	res := s.generateQC
 	if res != nil {
 		res.ProposalMsg = nil
-		if len(res.SignInfos.GetQCSignInfos()) == 0 {
+		if len(res.SignInfos.GetQCSignInfos()) == 0 && len(res.SignInfos.GetAggregatedSign()) == 0 {
 			v, ok := s.qcVoteMsgs.Load(string(res.GetProposalId()))
 			if !ok {
 				s.slog.Error("handleReceivedVoteMsg get votes error")
 				return nil, ErrGetVotes
 			}
-			res.SignInfos = v.(*pb.QCSignInfos)
+			res.SignInfos = s.makeQCSignInfos(v.(*pb.QCSignInfos))
 		}
 	}
 	s.slog.Info("GetGenerateQC res", "ProposalId", hex.EncodeToString(res.GetProposalId()),
@@ -333,7 +349,7 @@ func (s *Smr) handleReceivedVoteMsg(msg *p2p_pb.XuperMessage) error {
 			s.slog.Error("handleReceivedVoteMsg get votes error")
 			return ErrGetVotes
 		}
-		s.generateQC.SignInfos = v.(*pb.QCSignInfos)
+		s.generateQC.SignInfos = s.makeQCSignInfos(v.(*pb.QCSignInfos))
 	}
 	return nil
 }
@@ -346,6 +362,15 @@ func (s *Smr) handleReceivedNewView(msg *p2p_pb.XuperMessage) error {
 			"logid", msg.GetHeader().GetLogid(), "error", err)
 		return err
 	}
+	// 携带超时证书的NEW_VIEW消息由pacemaker处理
+	if newViewMsg.GetTimeoutCert() != nil {
+		if err := s.addTimeoutMsg(newViewMsg); err != nil {
+			s.slog.Warn("handleReceivedNewView add timeout msg error",
+				"logid", msg.GetHeader().GetLogid(), "error", err)
+			return err
+		}
+		return nil
+	}
 	if err := s.addViewMsg(newViewMsg); err != nil {
 		s.slog.Error("handleReceivedNewView add vote msg error",
 			"logid", msg.GetHeader().GetLogid(), "error", err)
@@ -458,6 +483,13 @@ func (s *Smr) updateQcStatus(proposalQC, generateQC, lockedQC *pb.QuorumCert) er
 	return nil
 }
 
+// GetQcStatus return the current proposalQC, generateQC and lockedQC
+func (s *Smr) GetQcStatus() (*pb.QuorumCert, *pb.QuorumCert, *pb.QuorumCert) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+	return s.proposalQC, s.generateQC, s.lockedQC
+}
+
 // voteProposal vote for this proposal
 func (s *Smr) voteProposal(propsQC *pb.QuorumCert, voteTo, logid string) error {
 	voteMsg := &pb.ChainedBftVoteMessage{
@@ -467,7 +499,7 @@ func (s *Smr) voteProposal(propsQC *pb.QuorumCert, voteTo, logid string) error {
 			PublicKey: s.publicKey,
 		},
 	}
-	_, err := utils.MakeVoteMsgSign(s.cryptoClient, s.privateKey, voteMsg.GetSignature(), propsQC.GetProposalId())
+	err := s.makeVoteSign(voteMsg.GetSignature(), propsQC.GetProposalId())
 	if err != nil {
 		s.slog.Error("voteProposal MakeVoteMsgSign error", "error", err)
 		return err
@@ -559,9 +591,9 @@ func (s *Smr) addVoteMsg(msg *pb.ChainedBftVoteMessage) error {
 	}
 
 	// check msg sign
-	ok, err := utils.VerifyVoteMsgSign(s.cryptoClient, msg.GetSignature(), msg.GetProposalId())
+	ok, err := s.verifyVoteSign(msg.GetSignature(), s.validates, msg.GetProposalId())
 	if !ok || err != nil {
-		s.slog.Error("addVoteMsg VerifyVoteMsgSign error", "ok", ok, "error", err)
+		s.slog.Error("addVoteMsg verifyVoteSign error", "ok", ok, "error", err)
 		return ErrVerifyVoteSign
 	}
 
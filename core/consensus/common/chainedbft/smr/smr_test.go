@@ -10,6 +10,7 @@ import (
 	"github.com/xuperchain/xuperchain/core/consensus/common/chainedbft/config"
 	"github.com/xuperchain/xuperchain/core/consensus/common/chainedbft/external"
 	"github.com/xuperchain/xuperchain/core/consensus/common/chainedbft/utils"
+	bls_sign "github.com/xuperchain/xuperchain/core/crypto/bls"
 	crypto_client "github.com/xuperchain/xuperchain/core/crypto/client"
 	p2p_base "github.com/xuperchain/xuperchain/core/p2p/base"
 	p2p_pb "github.com/xuperchain/xuperchain/core/p2p/pb"
@@ -376,3 +377,167 @@ func TestUpdateValidateSets(t *testing.T) {
 		return
 	}
 }
+
+func TestGetQcStatus(t *testing.T) {
+	smr, err := MakeSmr(t)
+	if err != nil {
+		t.Error("TestGetQcStatus MakeSmr error", "error", err)
+		return
+	}
+	proposalQC, generateQC, lockedQC := smr.GetQcStatus()
+	if proposalQC.GetViewNumber() != 1005 || generateQC.GetViewNumber() != 1004 ||
+		lockedQC.GetViewNumber() != 1003 {
+		t.Error("TestGetQcStatus error", "proposalQC", proposalQC, "generateQC", generateQC,
+			"lockedQC", lockedQC)
+	}
+}
+
+func TestTimeoutCert(t *testing.T) {
+	smr, err := MakeSmr(t)
+	if err != nil {
+		t.Error("TestTimeoutCert MakeSmr error", err)
+		return
+	}
+	var gathered *pb.TimeoutCert
+	smr.SetTimeoutCertHandler(func(tc *pb.TimeoutCert) {
+		gathered = tc
+	})
+	// 只有一个验证节点时, 本地超时即可生成超时证书
+	if err := smr.ProcessLocalTimeout(1010); err != nil {
+		t.Error("TestTimeoutCert ProcessLocalTimeout error", err)
+		return
+	}
+	if gathered == nil || gathered.GetViewNumber() != 1010 || smr.GetHighTC() != gathered {
+		t.Error("TestTimeoutCert timeout cert not gathered", gathered)
+		return
+	}
+	if ok, err := smr.IsTimeoutCertValidate(gathered); !ok {
+		t.Error("TestTimeoutCert IsTimeoutCertValidate error", err)
+		return
+	}
+	dup := &pb.TimeoutCert{
+		ViewNumber: 1010,
+		SignInfos: &pb.QCSignInfos{
+			QCSignInfos: append(gathered.GetSignInfos().GetQCSignInfos(), gathered.GetSignInfos().GetQCSignInfos()...),
+		},
+	}
+	if ok, _ := smr.IsTimeoutCertValidate(dup); ok {
+		t.Error("TestTimeoutCert repeated votes should be invalid")
+		return
+	}
+	forged := &pb.TimeoutCert{
+		ViewNumber: 1011,
+		SignInfos:  gathered.GetSignInfos(),
+	}
+	if ok, _ := smr.IsTimeoutCertValidate(forged); ok {
+		t.Error("TestTimeoutCert votes of other view should be invalid")
+		return
+	}
+	smr.bcname = "other"
+	if ok, _ := smr.IsTimeoutCertValidate(gathered); ok {
+		t.Error("TestTimeoutCert votes of other chain should be invalid")
+		return
+	}
+	smr.bcname = "xuper"
+	// 4个验证节点时, 与收集超时投票相同需要3票
+	validates := smr.validates
+	smr.validates = append(smr.validates, &cons_base.CandidateInfo{Address: "a"},
+		&cons_base.CandidateInfo{Address: "b"}, &cons_base.CandidateInfo{Address: "c"})
+	if ok, _ := smr.IsTimeoutCertValidate(gathered); ok {
+		t.Error("TestTimeoutCert votes less than 2f+1 should be invalid")
+		return
+	}
+	smr.validates = validates
+
+	// 收到其他节点转发的超时证书
+	newViewMsg := &pb.ChainedBftPhaseMessage{
+		Type:        pb.QCState_NEW_VIEW,
+		ViewNumber:  1011,
+		TimeoutCert: gathered,
+		Signature: &pb.SignInfo{
+			Address:   smr.address,
+			PublicKey: smr.publicKey,
+		},
+	}
+	newViewMsg, _ = utils.MakePhaseMsgSign(smr.cryptoClient, smr.privateKey, newViewMsg)
+	if err := smr.addTimeoutMsg(newViewMsg); err != ErrTimeoutCertOutdated {
+		t.Error("TestTimeoutCert outdated timeout cert should be refused", err)
+		return
+	}
+	newViewMsg.ViewNumber = 1012
+	newViewMsg, _ = utils.MakePhaseMsgSign(smr.cryptoClient, smr.privateKey, newViewMsg)
+	if err := smr.addTimeoutMsg(newViewMsg); err != ErrNewViewNum {
+		t.Error("TestTimeoutCert mismatched view number should be refused", err)
+		return
+	}
+}
+
+func TestAggregatedQC(t *testing.T) {
+	smr, err := MakeSmr(t)
+	if err != nil {
+		t.Error("TestAggregatedQC MakeSmr error", err)
+		return
+	}
+	smr.config = &config.Config{SignType: config.SignTypeBls}
+	smr.blsPrivateKey, _ = bls_sign.NewPrivateKeyFromEcdsa(smr.privateKey)
+	pkJSON, _ := smr.blsPrivateKey.Public().MarshalJSON()
+	keys := []*bls_sign.PrivateKey{smr.blsPrivateKey}
+	validates := []*cons_base.CandidateInfo{
+		&cons_base.CandidateInfo{Address: smr.address, BlsPublicKey: string(pkJSON)},
+	}
+	for _, addr := range []string{"addr1", "addr2", "addr3"} {
+		k, _ := bls_sign.GenerateKey(nil)
+		pkJSON, _ := k.Public().MarshalJSON()
+		keys = append(keys, k)
+		validates = append(validates, &cons_base.CandidateInfo{Address: addr, BlsPublicKey: string(pkJSON)})
+	}
+	smr.validates = validates
+
+	proposalID := []byte("aggregated proposal")
+	votes := &pb.QCSignInfos{}
+	for i, v := range validates[:3] {
+		sign := &pb.SignInfo{Address: v.Address}
+		if i == 0 {
+			if err := smr.makeVoteSign(sign, proposalID); err != nil {
+				t.Error("TestAggregatedQC makeVoteSign error", err)
+				return
+			}
+		} else {
+			sign.Sign = bls_sign.Sign(keys[i], proposalID)
+		}
+		if ok, err := smr.verifyVoteSign(sign, validates, proposalID); !ok {
+			t.Error("TestAggregatedQC verifyVoteSign error", err)
+			return
+		}
+		votes.QCSignInfos = append(votes.QCSignInfos, sign)
+	}
+	signInfos := smr.makeQCSignInfos(votes)
+	if len(signInfos.GetQCSignInfos()) != 0 || len(signInfos.GetAggregatedSign()) != bls_sign.SignatureLength ||
+		len(signInfos.GetSignerBitmap()) != 1 || signInfos.GetSignerBitmap()[0] != 0x07 {
+		t.Error("TestAggregatedQC makeQCSignInfos error", signInfos)
+		return
+	}
+	qc := &pb.QuorumCert{
+		ProposalId: proposalID,
+		ViewNumber: 1006,
+		SignInfos:  signInfos,
+	}
+	if ok, err := smr.IsQuorumCertValidate(qc); !ok {
+		t.Error("TestAggregatedQC IsQuorumCertValidate error", err)
+		return
+	}
+	// 位图与聚合签名不一致
+	qc.SignInfos = &pb.QCSignInfos{
+		AggregatedSign: signInfos.GetAggregatedSign(),
+		SignerBitmap:   []byte{0x0b},
+	}
+	if ok, _ := smr.IsQuorumCertValidate(qc); ok {
+		t.Error("TestAggregatedQC mismatched bitmap should be invalid")
+		return
+	}
+	qc.SignInfos.SignerBitmap = []byte{0x17}
+	if ok, _ := smr.IsQuorumCertValidate(qc); ok {
+		t.Error("TestAggregatedQC bitmap out of validates should be invalid")
+		return
+	}
+}
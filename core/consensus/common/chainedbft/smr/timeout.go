@@ -0,0 +1,214 @@
+package smr
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/proto"
+	cons_base "github.com/xuperchain/xuperchain/core/consensus/base"
+	"github.com/xuperchain/xuperchain/core/consensus/common/chainedbft/utils"
+	p2p_base "github.com/xuperchain/xuperchain/core/p2p/base"
+	p2p_pb "github.com/xuperchain/xuperchain/core/p2p/pb"
+	pb "github.com/xuperchain/xuperchain/core/pb"
+)
+
+// SetTimeoutCertHandler set the handler which is called while a higher TimeoutCert is gathered or received
+func (s *Smr) SetTimeoutCertHandler(handler func(*pb.TimeoutCert)) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+	s.timeoutCertHandler = handler
+}
+
+// GetHighTC return the highest TimeoutCert of this node
+func (s *Smr) GetHighTC() *pb.TimeoutCert {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+	return s.highTC
+}
+
+// ProcessLocalTimeout used to process while the local view is timeout,
+// it signs a timeout vote of viewNumber and broadcasts it to other replicas in NEW_VIEW msg
+func (s *Smr) ProcessLocalTimeout(viewNumber int64) error {
+	if !utils.IsInValidateSets(s.validates, s.address) {
+		return ErrInValidateSets
+	}
+	sign := &pb.SignInfo{
+		Address:   s.address,
+		PublicKey: s.publicKey,
+	}
+	err := s.makeVoteSign(sign, utils.MakeTimeoutDigest(s.bcname, viewNumber))
+	if err != nil {
+		s.slog.Error("ProcessLocalTimeout makeVoteSign error", "error", err)
+		return err
+	}
+	tc := &pb.TimeoutCert{
+		ViewNumber: viewNumber,
+		SignInfos: &pb.QCSignInfos{
+			QCSignInfos: []*pb.SignInfo{sign},
+		},
+	}
+	if err := s.broadcastTimeoutCert(tc); err != nil {
+		return err
+	}
+	return s.addTimeoutVote(viewNumber, sign)
+}
+
+// broadcastTimeoutCert send NEW_VIEW msg with TimeoutCert to other replicas
+func (s *Smr) broadcastTimeoutCert(tc *pb.TimeoutCert) error {
+	newViewMsg := &pb.ChainedBftPhaseMessage{
+		Type:        pb.QCState_NEW_VIEW,
+		ViewNumber:  tc.GetViewNumber() + 1,
+		TimeoutCert: tc,
+		Signature: &pb.SignInfo{
+			Address:   s.address,
+			PublicKey: s.publicKey,
+		},
+	}
+	newViewMsg, err := utils.MakePhaseMsgSign(s.cryptoClient, s.privateKey, newViewMsg)
+	if err != nil {
+		s.slog.Error("broadcastTimeoutCert MakePhaseMsgSign error", "error", err)
+		return err
+	}
+	msgBuf, err := proto.Marshal(newViewMsg)
+	if err != nil {
+		s.slog.Error("broadcastTimeoutCert marshal msg error", "error", err)
+		return err
+	}
+	netMsg, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion3, s.bcname, "",
+		p2p_pb.XuperMessage_CHAINED_BFT_NEW_VIEW_MSG, msgBuf, p2p_pb.XuperMessage_NONE)
+	opts := []p2p_base.MessageOption{
+		p2p_base.WithBcName(s.bcname),
+		p2p_base.WithTargetPeerAddrs(s.getReplicasURL(s.validates)),
+	}
+	go s.p2p.SendMessage(context.Background(), netMsg, opts...)
+	return nil
+}
+
+// addTimeoutMsg check and add the TimeoutCert carried in new view msg
+// 1: check sign of msg and if the msg from validate sets
+// 2: a single timeout vote of the sender is gathered, a TimeoutCert with votes of others is verified as a whole
+func (s *Smr) addTimeoutMsg(msg *pb.ChainedBftPhaseMessage) error {
+	ok, err := utils.VerifyPhaseMsgSign(s.cryptoClient, msg)
+	if !ok || err != nil {
+		s.slog.Error("addTimeoutMsg VerifyPhaseMsgSign error", "ok", ok, "error", err)
+		return errors.New("addTimeoutMsg VerifyPhaseMsgSign error")
+	}
+	tc := msg.GetTimeoutCert()
+	if msg.GetViewNumber() != tc.GetViewNumber()+1 {
+		return ErrNewViewNum
+	}
+	sender := msg.GetSignature().GetAddress()
+	if !utils.IsInValidateSets(s.validates, sender) && !utils.IsInValidateSets(s.preValidates, sender) {
+		s.slog.Debug("addTimeoutMsg checkValidateSets error", "viewNumber", tc.GetViewNumber(), "address", sender)
+		return ErrInValidateSets
+	}
+	if highTC := s.GetHighTC(); highTC != nil && tc.GetViewNumber() <= highTC.GetViewNumber() {
+		return ErrTimeoutCertOutdated
+	}
+
+	signs := tc.GetSignInfos().GetQCSignInfos()
+	if len(signs) == 1 && signs[0].GetAddress() == sender {
+		validates := s.validates
+		if !utils.IsInValidateSets(validates, sender) {
+			validates = s.preValidates
+		}
+		ok, err := s.verifyVoteSign(signs[0], validates, utils.MakeTimeoutDigest(s.bcname, tc.GetViewNumber()))
+		if !ok || err != nil {
+			s.slog.Error("addTimeoutMsg verifyVoteSign error", "ok", ok, "error", err)
+			return ErrVerifyVoteSign
+		}
+		return s.addTimeoutVote(tc.GetViewNumber(), signs[0])
+	}
+	if ok, err := s.IsTimeoutCertValidate(tc); !ok {
+		s.slog.Warn("addTimeoutMsg IsTimeoutCertValidate error", "viewNumber", tc.GetViewNumber(), "error", err)
+		return err
+	}
+	s.updateHighTC(tc)
+	return nil
+}
+
+// addTimeoutVote add timeout vote of viewNumber, a TimeoutCert is generated while votes more than (n-f)
+func (s *Smr) addTimeoutVote(viewNumber int64, sign *pb.SignInfo) error {
+	s.lk.Lock()
+	votes := &pb.QCSignInfos{}
+	if v, ok := s.timeoutVotes.Load(viewNumber); ok {
+		votes = v.(*pb.QCSignInfos)
+	}
+	if utils.CheckIsVoted(votes, sign) {
+		s.lk.Unlock()
+		return ErrTimeoutVoted
+	}
+	votes.QCSignInfos = append(votes.QCSignInfos, sign)
+	s.timeoutVotes.Store(viewNumber, votes)
+	enough := isTimeoutQuorum(len(votes.GetQCSignInfos()), s.validates)
+	tc := &pb.TimeoutCert{
+		ViewNumber: viewNumber,
+		SignInfos: &pb.QCSignInfos{
+			QCSignInfos: append([]*pb.SignInfo{}, votes.GetQCSignInfos()...),
+		},
+	}
+	s.lk.Unlock()
+	s.slog.Debug("addTimeoutVote", "viewNumber", viewNumber, "votes", len(tc.SignInfos.QCSignInfos), "enough", enough)
+
+	if enough && s.updateHighTC(tc) {
+		// 将超时证书发给其他节点, 使未收集到足够超时投票的节点也能进入新的view
+		return s.broadcastTimeoutCert(tc)
+	}
+	return nil
+}
+
+// updateHighTC update the highest TimeoutCert and notify the handler, return false if tc is not higher
+func (s *Smr) updateHighTC(tc *pb.TimeoutCert) bool {
+	s.lk.Lock()
+	if s.highTC != nil && tc.GetViewNumber() <= s.highTC.GetViewNumber() {
+		s.lk.Unlock()
+		return false
+	}
+	s.highTC = tc
+	handler := s.timeoutCertHandler
+	s.lk.Unlock()
+
+	// 已超时view的投票不再需要
+	s.timeoutVotes.Range(func(k, v interface{}) bool {
+		if k.(int64) <= tc.GetViewNumber() {
+			s.timeoutVotes.Delete(k)
+		}
+		return true
+	})
+	s.slog.Info("updateHighTC", "viewNumber", tc.GetViewNumber(), "votes", len(tc.GetSignInfos().GetQCSignInfos()))
+	if handler != nil {
+		handler(tc)
+	}
+	return true
+}
+
+// IsTimeoutCertValidate return whether TimeoutCert is validated
+func (s *Smr) IsTimeoutCertValidate(tc *pb.TimeoutCert) (bool, error) {
+	signs := tc.GetSignInfos().GetQCSignInfos()
+	if len(signs) == 0 {
+		return false, ErrParams
+	}
+	voted := &pb.QCSignInfos{}
+	for _, sign := range signs {
+		if utils.CheckIsVoted(voted, sign) {
+			return false, ErrTimeoutVoted
+		}
+		voted.QCSignInfos = append(voted.QCSignInfos, sign)
+	}
+	digest := utils.MakeTimeoutDigest(s.bcname, tc.GetViewNumber())
+	if isTimeoutQuorum(len(signs), s.validates) {
+		if ok, _ := s.verifyVotes(signs, s.validates, digest); ok {
+			return true, nil
+		}
+	}
+	if !isTimeoutQuorum(len(signs), s.preValidates) {
+		return false, ErrJustifySignNotEnough
+	}
+	return s.verifyVotes(signs, s.preValidates, digest)
+}
+
+// isTimeoutQuorum return whether votes are enough to form a TimeoutCert of validates,
+// the same count is used while gathering and verifying TimeoutCert
+func isTimeoutQuorum(votes int, validates []*cons_base.CandidateInfo) bool {
+	return len(validates) > 0 && votes > (len(validates)-1)*2/3
+}
@@ -9,6 +9,7 @@ import (
 	cons_base "github.com/xuperchain/xuperchain/core/consensus/base"
 	"github.com/xuperchain/xuperchain/core/consensus/common/chainedbft/config"
 	"github.com/xuperchain/xuperchain/core/consensus/common/chainedbft/external"
+	bls_sign "github.com/xuperchain/xuperchain/core/crypto/bls"
 	p2p_base "github.com/xuperchain/xuperchain/core/p2p/base"
 	xuper_p2p "github.com/xuperchain/xuperchain/core/p2p/pb"
 	pb "github.com/xuperchain/xuperchain/core/pb"
@@ -26,6 +27,10 @@ type Smr struct {
 	publicKey string
 	// private key
 	privateKey *ecdsa.PrivateKey
+	// blsPrivateKey is derived from privateKey, it's used to sign votes while BLS is enabled
+	blsPrivateKey *bls_sign.PrivateKey
+	// blsPublicKeys caches the parsed BLS public keys of validates, key: json format public key
+	blsPublicKeys *sync.Map
 	// last validates sets, changes with external layer consensus
 	preValidates []*cons_base.CandidateInfo
 	// validates sets, changes with external layer consensus
@@ -58,6 +63,12 @@ type Smr struct {
 	qcVoteMsgs *sync.Map
 	// new view msg gathered from other replicas, key: viewNumber, value: []*pb.ChainedBftPhaseMessage
 	newViewMsgs *sync.Map
+	// timeout votes gathered from replicas, key: viewNumber, value: *pb.QCSignInfos
+	timeoutVotes *sync.Map
+	// highTC is the highest TimeoutCert of this node
+	highTC *pb.TimeoutCert
+	// timeoutCertHandler is called while a higher TimeoutCert is gathered or received
+	timeoutCertHandler func(*pb.TimeoutCert)
 	// effectiveDelay is the interval height after validates are changed, where the new validates will become effective to the whole network.
 	effectiveDelay int64
 	// lk lock
@@ -5,13 +5,22 @@ import (
 	"crypto/ecdsa"
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/xuperchain/xuperchain/core/crypto/hash"
 
 	crypto_base "github.com/xuperchain/crypto/client/service/base"
+	"github.com/xuperchain/xuperchain/core/ledger"
 	"github.com/xuperchain/xuperchain/core/pb"
 )
 
+var (
+	// ErrInvalidEvidence the evidence of double-signing is malformed or the signature is invalid
+	ErrInvalidEvidence = errors.New("invalid double-sign evidence")
+	// ErrNotEquivocation the two signed messages don't conflict with each other
+	ErrNotEquivocation = errors.New("evidence is not equivocation")
+)
+
 func encodeChainedBftPhaseMessage(msg *pb.ChainedBftPhaseMessage) ([]byte, error) {
 	var msgBuf bytes.Buffer
 	encoder := json.NewEncoder(&msgBuf)
@@ -27,6 +36,12 @@ func encodeChainedBftPhaseMessage(msg *pb.ChainedBftPhaseMessage) ([]byte, error
 	if err := encoder.Encode(msg.JustifyQC); err != nil {
 		return nil, err
 	}
+	// 超时证书只在超时的NEW_VIEW消息中出现, 为空时保持原有编码
+	if msg.TimeoutCert != nil {
+		if err := encoder.Encode(msg.TimeoutCert); err != nil {
+			return nil, err
+		}
+	}
 	return msgBuf.Bytes(), nil
 }
 
@@ -118,3 +133,90 @@ func VerifyVoteMsgSign(cryptoClient crypto_base.CryptoClient, sig *pb.SignInfo,
 	}
 	return cryptoClient.VerifyECDSA(ak, sig.GetSign(), msg)
 }
+
+// MakeTimeoutDigest make the digest which timeout votes of viewNumber of chain bcname sign for
+func MakeTimeoutDigest(bcname string, viewNumber int64) []byte {
+	return hash.DoubleSha256([]byte(fmt.Sprintf("chainedbft_timeout_%s_%d", bcname, viewNumber)))
+}
+
+// VerifyPhaseMsgEquivocation verify that the two proposals are signed by the same address
+// with the same view number but different content, return the address of the signer.
+// Only PREPARE msgs are counted, a replica may resend NEW_VIEW msgs of a view to different leaders,
+// and votes sign the proposal id only which can't be bound to a view
+func VerifyPhaseMsgEquivocation(cryptoClient crypto_base.CryptoClient, left,
+	right *pb.ChainedBftPhaseMessage) (string, error) {
+	if left == nil || right == nil || left.GetSignature() == nil || right.GetSignature() == nil {
+		return "", ErrInvalidEvidence
+	}
+	if left.GetType() != pb.QCState_PREPARE || right.GetType() != pb.QCState_PREPARE {
+		return "", ErrNotEquivocation
+	}
+	signer := left.GetSignature().GetAddress()
+	if signer == "" || signer != right.GetSignature().GetAddress() || left.GetViewNumber() != right.GetViewNumber() {
+		return "", ErrNotEquivocation
+	}
+	leftDigest, err := MakePhaseMsgDigest(left)
+	if err != nil {
+		return "", err
+	}
+	rightDigest, err := MakePhaseMsgDigest(right)
+	if err != nil {
+		return "", err
+	}
+	if bytes.Equal(leftDigest, rightDigest) {
+		return "", ErrNotEquivocation
+	}
+	for _, msg := range []*pb.ChainedBftPhaseMessage{left, right} {
+		if ok, err := VerifyPhaseMsgSign(cryptoClient, msg); !ok || err != nil {
+			return "", ErrInvalidEvidence
+		}
+	}
+	return signer, nil
+}
+
+// VerifyBlockEquivocation verify that the two blocks are signed by the same proposer at the same height
+// in the same slot, no matter which parents they are on, return the address of the proposer.
+// slotOf returns the proposing slot of block scheduled by consensus
+func VerifyBlockEquivocation(cryptoClient crypto_base.CryptoClient, left, right *pb.InternalBlock,
+	slotOf func(*pb.InternalBlock) int64) (string, error) {
+	if left == nil || right == nil {
+		return "", ErrInvalidEvidence
+	}
+	proposer := string(left.GetProposer())
+	if proposer == "" || proposer != string(right.GetProposer()) || left.GetHeight() != right.GetHeight() ||
+		slotOf(left) != slotOf(right) {
+		return "", ErrNotEquivocation
+	}
+	if bytes.Equal(left.GetBlockid(), right.GetBlockid()) {
+		return "", ErrNotEquivocation
+	}
+	for _, block := range []*pb.InternalBlock{left, right} {
+		if ok, err := verifyBlockSign(cryptoClient, block); !ok || err != nil {
+			return "", ErrInvalidEvidence
+		}
+	}
+	return proposer, nil
+}
+
+// verifyBlockSign verify blockid and the signature of proposer, txs of block are not needed
+func verifyBlockSign(cryptoClient crypto_base.CryptoClient, block *pb.InternalBlock) (bool, error) {
+	blockid, err := ledger.MakeBlockID(block)
+	if err != nil {
+		return false, err
+	}
+	if !bytes.Equal(blockid, block.GetBlockid()) {
+		return false, errors.New("verifyBlockSign error, blockid not match")
+	}
+	ak, err := cryptoClient.GetEcdsaPublicKeyFromJsonStr(string(block.GetPubkey()))
+	if err != nil {
+		return false, err
+	}
+	addr, err := cryptoClient.GetAddressFromPublicKey(ak)
+	if err != nil {
+		return false, err
+	}
+	if addr != string(block.GetProposer()) {
+		return false, errors.New("verifyBlockSign error, addr not match pk")
+	}
+	return cryptoClient.VerifyECDSA(ak, block.GetSign(), blockid)
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	crypto_client "github.com/xuperchain/xuperchain/core/crypto/client"
+	"github.com/xuperchain/xuperchain/core/ledger"
 	"github.com/xuperchain/xuperchain/core/pb"
 )
 
@@ -77,3 +78,124 @@ func TestVoteMsgSign(t *testing.T) {
 		return
 	}
 }
+
+func TestPhaseMsgEquivocation(t *testing.T) {
+	user := &User{
+		address:    `dpzuVdosQrF2kmzumhVeFQZa1aYcdgFpN`,
+		publicKey:  `{"Curvname":"P-256","X":74695617477160058757747208220371236837474210247114418775262229497812962582435,"Y":51348715319124770392993866417088542497927816017012182211244120852620959209571}`,
+		privateKey: `{"Curvname":"P-256","X":74695617477160058757747208220371236837474210247114418775262229497812962582435,"Y":51348715319124770392993866417088542497927816017012182211244120852620959209571,"D":29079635126530934056640915735344231956621504557963207107451663058887647996601}`,
+	}
+	cryptoClient, err := crypto_client.CreateCryptoClient(crypto_client.CryptoTypeDefault)
+	if err != nil {
+		t.Error("TestPhaseMsgEquivocation CreateCryptoClient error ", "error", err)
+		return
+	}
+	priKey, _ := cryptoClient.GetEcdsaPrivateKeyFromJsonStr(user.privateKey)
+	makeMsg := func(proposalID string) *pb.ChainedBftPhaseMessage {
+		msg := &pb.ChainedBftPhaseMessage{
+			Type:       pb.QCState_PREPARE,
+			ViewNumber: 1000,
+			ProposalQC: &pb.QuorumCert{ProposalId: []byte(proposalID)},
+			JustifyQC:  &pb.QuorumCert{},
+			Signature: &pb.SignInfo{
+				Address:   user.address,
+				PublicKey: user.publicKey,
+			},
+		}
+		msg, _ = MakePhaseMsgSign(cryptoClient, priKey, msg)
+		return msg
+	}
+
+	signer, err := VerifyPhaseMsgEquivocation(cryptoClient, makeMsg("a"), makeMsg("b"))
+	if err != nil || signer != user.address {
+		t.Error("TestPhaseMsgEquivocation verify error", "error", err, "signer", signer)
+		return
+	}
+	if _, err := VerifyPhaseMsgEquivocation(cryptoClient, makeMsg("a"), makeMsg("a")); err != ErrNotEquivocation {
+		t.Error("TestPhaseMsgEquivocation same msg should not be equivocation", "error", err)
+		return
+	}
+	other := makeMsg("b")
+	other.ViewNumber++
+	if _, err := VerifyPhaseMsgEquivocation(cryptoClient, makeMsg("a"), other); err != ErrNotEquivocation {
+		t.Error("TestPhaseMsgEquivocation different view should not be equivocation", "error", err)
+		return
+	}
+	forged := makeMsg("b")
+	forged.ProposalQC.ProposalId = []byte("c")
+	if _, err := VerifyPhaseMsgEquivocation(cryptoClient, makeMsg("a"), forged); err != ErrInvalidEvidence {
+		t.Error("TestPhaseMsgEquivocation forged msg should be invalid", "error", err)
+		return
+	}
+	// 同一视图中重发给不同leader的NEW_VIEW消息不算双签
+	newViews := []*pb.ChainedBftPhaseMessage{makeMsg("a"), makeMsg("b")}
+	newViews[1].TimeoutCert = &pb.TimeoutCert{ViewNumber: 999}
+	for i, msg := range newViews {
+		msg.Type = pb.QCState_NEW_VIEW
+		newViews[i], _ = MakePhaseMsgSign(cryptoClient, priKey, msg)
+	}
+	if _, err := VerifyPhaseMsgEquivocation(cryptoClient, newViews[0], newViews[1]); err != ErrNotEquivocation {
+		t.Error("TestPhaseMsgEquivocation new view msg should not be equivocation", "error", err)
+		return
+	}
+}
+
+func TestBlockEquivocation(t *testing.T) {
+	user := &User{
+		address:    `dpzuVdosQrF2kmzumhVeFQZa1aYcdgFpN`,
+		publicKey:  `{"Curvname":"P-256","X":74695617477160058757747208220371236837474210247114418775262229497812962582435,"Y":51348715319124770392993866417088542497927816017012182211244120852620959209571}`,
+		privateKey: `{"Curvname":"P-256","X":74695617477160058757747208220371236837474210247114418775262229497812962582435,"Y":51348715319124770392993866417088542497927816017012182211244120852620959209571,"D":29079635126530934056640915735344231956621504557963207107451663058887647996601}`,
+	}
+	cryptoClient, err := crypto_client.CreateCryptoClient(crypto_client.CryptoTypeDefault)
+	if err != nil {
+		t.Error("TestBlockEquivocation CreateCryptoClient error ", "error", err)
+		return
+	}
+	priKey, _ := cryptoClient.GetEcdsaPrivateKeyFromJsonStr(user.privateKey)
+	makeBlock := func(timestamp int64) *pb.InternalBlock {
+		block := &pb.InternalBlock{
+			Version:   ledger.BlockVersion,
+			Height:    100,
+			PreHash:   []byte("prehash"),
+			Proposer:  []byte(user.address),
+			Pubkey:    []byte(user.publicKey),
+			Timestamp: timestamp,
+		}
+		block.Blockid, _ = ledger.MakeBlockID(block)
+		block.Sign, _ = cryptoClient.SignECDSA(priKey, block.Blockid)
+		return block
+	}
+
+	// 每10纳秒为一个出块时间片
+	slotOf := func(block *pb.InternalBlock) int64 {
+		return block.GetTimestamp() / 10
+	}
+
+	proposer, err := VerifyBlockEquivocation(cryptoClient, makeBlock(1), makeBlock(2), slotOf)
+	if err != nil || proposer != user.address {
+		t.Error("TestBlockEquivocation verify error", "error", err, "proposer", proposer)
+		return
+	}
+	if _, err := VerifyBlockEquivocation(cryptoClient, makeBlock(1), makeBlock(1), slotOf); err != ErrNotEquivocation {
+		t.Error("TestBlockEquivocation same block should not be equivocation", "error", err)
+		return
+	}
+	fork := makeBlock(2)
+	fork.PreHash = []byte("otherhash")
+	fork.Blockid, _ = ledger.MakeBlockID(fork)
+	fork.Sign, _ = cryptoClient.SignECDSA(priKey, fork.Blockid)
+	if _, err := VerifyBlockEquivocation(cryptoClient, makeBlock(1), fork, slotOf); err != nil {
+		t.Error("TestBlockEquivocation blocks on different parents in a slot should be equivocation", "error", err)
+		return
+	}
+	if _, err := VerifyBlockEquivocation(cryptoClient, makeBlock(1), makeBlock(12), slotOf); err != ErrNotEquivocation {
+		t.Error("TestBlockEquivocation blocks in different slots should not be equivocation", "error", err)
+		return
+	}
+	forged := makeBlock(2)
+	forged.Timestamp = 3
+	if _, err := VerifyBlockEquivocation(cryptoClient, makeBlock(1), forged, slotOf); err != ErrInvalidEvidence {
+		t.Error("TestBlockEquivocation forged block should be invalid", "error", err)
+		return
+	}
+}
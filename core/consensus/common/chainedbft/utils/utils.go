@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"errors"
+
 	cons_base "github.com/xuperchain/xuperchain/core/consensus/base"
 	pb "github.com/xuperchain/xuperchain/core/pb"
 )
@@ -24,3 +26,20 @@ func CheckIsVoted(votedMsgs *pb.QCSignInfos, voteMsg *pb.SignInfo) bool {
 	}
 	return false
 }
+
+// TimeoutRounds return the number of views timed out since preBlock, which is the rounds that the
+// proposer is rotated by the TimeoutCert of tcView on preBlock. Each TimeoutCert is used once along the chain,
+// so tcView should be higher than the TimeoutCert carried by preBlock
+func TimeoutRounds(tcView int64, preBlock *pb.InternalBlock) (int64, error) {
+	if preBlock == nil {
+		return 0, errors.New("pre block is nil")
+	}
+	if preBlock.GetTimeoutCert() != nil && tcView <= preBlock.GetTimeoutCert().GetViewNumber() {
+		return 0, errors.New("timeout cert is not higher than pre block")
+	}
+	rounds := tcView - preBlock.GetHeight()
+	if rounds <= 0 {
+		return 0, errors.New("timeout cert is outdated")
+	}
+	return rounds, nil
+}
@@ -106,3 +106,25 @@ func TestCheckIsVoted(t *testing.T) {
 		}
 	}
 }
+
+func TestTimeoutRounds(t *testing.T) {
+	preBlock := &pb.InternalBlock{Height: 10}
+	testCases := map[string]struct {
+		tcView   int64
+		preTC    *pb.TimeoutCert
+		expected int64
+	}{
+		"case1": {tcView: 11, expected: 1},
+		"case2": {tcView: 13, expected: 3},
+		"case3": {tcView: 10, expected: 0},
+		"case4": {tcView: 12, preTC: &pb.TimeoutCert{ViewNumber: 12}, expected: 0},
+		"case5": {tcView: 13, preTC: &pb.TimeoutCert{ViewNumber: 12}, expected: 3},
+	}
+	for k, v := range testCases {
+		preBlock.TimeoutCert = v.preTC
+		rounds, err := TimeoutRounds(v.tcView, preBlock)
+		if rounds != v.expected || (err == nil) != (v.expected > 0) {
+			t.Error("test TimeoutRounds error", "casename", k, "expected", v.expected, "actual", rounds, "err", err)
+		}
+	}
+}
@@ -52,6 +52,8 @@ type PluggableConsensus struct {
 	cryptoClient crypto_base.CryptoClient
 	mutex        *sync.RWMutex
 	p2psvr       p2p_base.P2PServer
+	// 当前块内已经被惩罚的验证人, 避免同一块内重复惩罚
+	slashCache map[string]bool
 }
 
 func genPlugConsKey(height int64, timestamp int64) string {
@@ -333,6 +335,14 @@ func (pc *PluggableConsensus) newUpdateConsensus(name string, height int64, time
 		extParams["p2psvr"] = pc.p2psvr
 		extParams["height"] = height
 	}
+	// 继承当前共识的验证人集合和chained-bft状态
+	if needInheritValidators(consConf) && len(pc.cons) > 0 {
+		seeded, err := pc.inheritValidators(name, height, consConf, extParams)
+		if err != nil {
+			return nil, err
+		}
+		consConf = seeded
+	}
 	// create and config consensus instance
 	cons, err := pc.updateConsensusByName(name, height, consConf, extParams)
 	if err != nil {
@@ -468,6 +478,8 @@ func (pc *PluggableConsensus) Run(desc *contract.TxDesc) error {
 			return err
 		}
 		return pc.updateConsensus(name, consConf, desc.Tx.Txid, pc.context.Block)
+	case slashMethod:
+		return pc.runSlash(desc)
 	default:
 		pc.xlog.Warn("method not defined", "module", desc.Method, "method", desc.Method)
 		return errors.New("PluggableConsensus not define this method")
@@ -484,6 +496,8 @@ func (pc *PluggableConsensus) Rollback(desc *contract.TxDesc) error {
 			return err
 		}
 		return pc.rollbackConsensus(name, consConf, desc.Tx.Txid, pc.context.Block)
+	case slashMethod:
+		return pc.rollbackSlash(desc)
 	default:
 		pc.xlog.Warn("method not defined", "module", desc.Method, "method", desc.Method)
 		return errors.New("PluggableConsensus not define this method")
@@ -498,6 +512,7 @@ func (pc *PluggableConsensus) Finalize(blockid []byte) error {
 // SetContext is the specific implementation of interface contract
 func (pc *PluggableConsensus) SetContext(context *contract.TxContext) error {
 	pc.context = context
+	pc.slashCache = make(map[string]bool)
 	return nil
 }
 
@@ -329,3 +329,9 @@ func (pc *PowConsensus) Activate() error {
 func (pc *PowConsensus) IsActive() bool {
 	return pc.state == cons_base.RUNNING
 }
+
+// GetSwitchState is the specific implementation of SwitchableInterface,
+// pow has no validators to hand over
+func (pc *PowConsensus) GetSwitchState(height int64) (*cons_base.SwitchState, error) {
+	return &cons_base.SwitchState{}, nil
+}
@@ -216,3 +216,14 @@ func (sc *SingleConsensus) Activate() error {
 func (sc *SingleConsensus) IsActive() bool {
 	return sc.state == cons_base.RUNNING
 }
+
+// GetSwitchState is the specific implementation of SwitchableInterface
+func (sc *SingleConsensus) GetSwitchState(height int64) (*cons_base.SwitchState, error) {
+	return &cons_base.SwitchState{
+		Validators: []*cons_base.CandidateInfo{
+			&cons_base.CandidateInfo{
+				Address: string(sc.masterAddr),
+			},
+		},
+	}, nil
+}
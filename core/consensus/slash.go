@@ -0,0 +1,171 @@
+package consensus
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+
+	"github.com/xuperchain/xuperchain/core/common"
+	cons_base "github.com/xuperchain/xuperchain/core/consensus/base"
+	bft_utils "github.com/xuperchain/xuperchain/core/consensus/common/chainedbft/utils"
+	"github.com/xuperchain/xuperchain/core/contract"
+	"github.com/xuperchain/xuperchain/core/ledger"
+)
+
+// 提交双签证据, 惩罚作恶的验证人
+const slashMethod = "slash"
+
+var (
+	// ErrNotSlashable current consensus doesn't support slashing
+	ErrNotSlashable = errors.New("current consensus doesn't support slashing")
+	// ErrAlreadySlashed the offender has been slashed
+	ErrAlreadySlashed = errors.New("the offender has been slashed")
+	// ErrSlashNotActive slashing is not activated at current height by the slash fork height in genesis
+	ErrSlashNotActive = errors.New("slashing is not activated")
+)
+
+// parseEvidence parse the double-sign evidence in args of slash tx
+func parseEvidence(args map[string]interface{}) (*cons_base.DoubleSignEvidence, error) {
+	if args["evidence"] == nil {
+		return nil, errors.New("Slash evidence can not be null")
+	}
+	raw, err := json.Marshal(args["evidence"])
+	if err != nil {
+		return nil, err
+	}
+	evidence := &cons_base.DoubleSignEvidence{}
+	if err := json.Unmarshal(raw, evidence); err != nil {
+		return nil, err
+	}
+	return evidence, nil
+}
+
+// verifyEvidence verify the double-sign evidence, return the address of offender
+func (pc *PluggableConsensus) verifyEvidence(evidence *cons_base.DoubleSignEvidence,
+	sc cons_base.SlashableInterface) (string, error) {
+	switch {
+	case len(evidence.PhaseMsgs) == 2 && len(evidence.Blocks) == 0:
+		return bft_utils.VerifyPhaseMsgEquivocation(pc.cryptoClient, evidence.PhaseMsgs[0], evidence.PhaseMsgs[1])
+	case len(evidence.Blocks) == 2 && len(evidence.PhaseMsgs) == 0:
+		return bft_utils.VerifyBlockEquivocation(pc.cryptoClient, evidence.Blocks[0], evidence.Blocks[1], sc.BlockSlot)
+	default:
+		return "", bft_utils.ErrInvalidEvidence
+	}
+}
+
+// getSlashRecord return the slash record of address, nil if not slashed
+func (pc *PluggableConsensus) getSlashRecord(address string) (*cons_base.SlashRecord, error) {
+	val, err := pc.utxoVM.GetFromTable(nil, []byte(cons_base.GenSlashKey(address)))
+	if common.NormalizedKVError(err) == common.ErrKVNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	record := &cons_base.SlashRecord{}
+	if err := json.Unmarshal(val, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// currentSlashable return the consensus of height which supports slashing
+func (pc *PluggableConsensus) currentSlashable(height int64) (cons_base.ConsensusInterface, cons_base.SlashableInterface, error) {
+	for i := len(pc.cons) - 1; i >= 0; i-- {
+		if height >= pc.cons[i].StartHeight {
+			sc, ok := pc.cons[i].Conn.(cons_base.SlashableInterface)
+			if !ok {
+				return nil, nil, ErrNotSlashable
+			}
+			return pc.cons[i].Conn, sc, nil
+		}
+	}
+	return nil, nil, ErrNotSlashable
+}
+
+// runSlash verify the evidence and slash the offender
+func (pc *PluggableConsensus) runSlash(desc *contract.TxDesc) error {
+	height := pc.context.Block.Height
+	if height == 0 {
+		height = pc.ledger.GetMeta().TrunkHeight + 1
+	}
+	if !ledger.ForkActive(pc.ledger.GetForkHeights().Slash, height) {
+		return ErrSlashNotActive
+	}
+	cons, sc, err := pc.currentSlashable(height)
+	if err != nil {
+		return err
+	}
+	evidence, err := parseEvidence(desc.Args)
+	if err != nil {
+		pc.xlog.Warn("runSlash parse evidence error", "error", err)
+		return err
+	}
+	offender, err := pc.verifyEvidence(evidence, sc)
+	if err != nil {
+		pc.xlog.Warn("runSlash verify evidence error", "error", err)
+		return err
+	}
+	if pc.slashCache[offender] {
+		return ErrAlreadySlashed
+	}
+	if record, err := pc.getSlashRecord(offender); err != nil || record != nil {
+		pc.xlog.Warn("runSlash offender has been slashed or get slash record error", "offender", offender,
+			"error", err)
+		return ErrAlreadySlashed
+	}
+
+	record := &cons_base.SlashRecord{
+		Offender:  offender,
+		Height:    height,
+		Txid:      hex.EncodeToString(desc.Tx.Txid),
+		Consensus: cons.Type(),
+	}
+	if err := sc.Slash(record); err != nil {
+		pc.xlog.Warn("runSlash slash offender error", "offender", offender, "error", err)
+		return err
+	}
+	recordJSON, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	pc.slashCache[offender] = true
+	pc.context.UtxoBatch.Put([]byte(cons_base.GenSlashKey(offender)), recordJSON)
+	pc.xlog.Info("slash offender successfully", "offender", offender, "height", height,
+		"frozenDeposit", record.FrozenDeposit)
+	return nil
+}
+
+// rollbackSlash restore the offender slashed by the tx
+func (pc *PluggableConsensus) rollbackSlash(desc *contract.TxDesc) error {
+	evidence, err := parseEvidence(desc.Args)
+	if err != nil {
+		return nil
+	}
+	// 回滚的总是当前主干的最新区块
+	_, sc, err := pc.currentSlashable(pc.ledger.GetMeta().TrunkHeight)
+	if err != nil {
+		return nil
+	}
+	offender, err := pc.verifyEvidence(evidence, sc)
+	if err != nil {
+		return nil
+	}
+	record, err := pc.getSlashRecord(offender)
+	if err != nil {
+		return err
+	}
+	if record == nil || record.Txid != hex.EncodeToString(desc.Tx.Txid) {
+		pc.xlog.Warn("rollbackSlash omit record not match this tx", "offender", offender)
+		return nil
+	}
+	_, sc, err = pc.currentSlashable(record.Height)
+	if err != nil {
+		return err
+	}
+	if err := sc.RollbackSlash(record); err != nil {
+		return err
+	}
+	pc.context.UtxoBatch.Delete([]byte(cons_base.GenSlashKey(offender)))
+	return nil
+}
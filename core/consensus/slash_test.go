@@ -0,0 +1,79 @@
+package consensus
+
+import (
+	"testing"
+
+	cons_base "github.com/xuperchain/xuperchain/core/consensus/base"
+	bft_utils "github.com/xuperchain/xuperchain/core/consensus/common/chainedbft/utils"
+	"github.com/xuperchain/xuperchain/core/contract"
+	"github.com/xuperchain/xuperchain/core/pb"
+)
+
+func TestParseEvidence(t *testing.T) {
+	if _, err := parseEvidence(map[string]interface{}{}); err == nil {
+		t.Error("parseEvidence should fail without evidence")
+	}
+	args := map[string]interface{}{
+		"evidence": map[string]interface{}{
+			"phase_msgs": []interface{}{
+				map[string]interface{}{"ViewNumber": 10},
+				map[string]interface{}{"ViewNumber": 10},
+			},
+		},
+	}
+	evidence, err := parseEvidence(args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(evidence.PhaseMsgs) != 2 || evidence.PhaseMsgs[0].GetViewNumber() != 10 || len(evidence.Blocks) != 0 {
+		t.Errorf("unexpected evidence %v", evidence)
+	}
+}
+
+func TestVerifyEvidenceInvalid(t *testing.T) {
+	pc := &PluggableConsensus{}
+	evidences := []*cons_base.DoubleSignEvidence{
+		&cons_base.DoubleSignEvidence{},
+		&cons_base.DoubleSignEvidence{
+			PhaseMsgs: []*pb.ChainedBftPhaseMessage{&pb.ChainedBftPhaseMessage{}},
+		},
+		&cons_base.DoubleSignEvidence{
+			PhaseMsgs: []*pb.ChainedBftPhaseMessage{&pb.ChainedBftPhaseMessage{}, &pb.ChainedBftPhaseMessage{}},
+			Blocks:    []*pb.InternalBlock{&pb.InternalBlock{}, &pb.InternalBlock{}},
+		},
+	}
+	for i, evidence := range evidences {
+		if _, err := pc.verifyEvidence(evidence, nil); err != bft_utils.ErrInvalidEvidence {
+			t.Errorf("evidence %d expect ErrInvalidEvidence, got %v", i, err)
+		}
+	}
+}
+
+func TestCurrentSlashable(t *testing.T) {
+	pc := &PluggableConsensus{
+		cons: []*StepConsensus{
+			&StepConsensus{StartHeight: 0, Conn: &fakeSwitchCons{name: ConsensusTypeSingle}},
+		},
+	}
+	if _, _, err := pc.currentSlashable(100); err != ErrNotSlashable {
+		t.Errorf("expect ErrNotSlashable, got %v", err)
+	}
+}
+
+func TestRunSlashNotActive(t *testing.T) {
+	plugClear()
+	defer plugClear()
+	pc := plugPrepare(t)
+	// 创世块未配置slash分叉高度, 双签证据不会被接受
+	pc.context = &contract.TxContext{
+		Block: &pb.InternalBlock{Height: 100},
+	}
+	desc := &contract.TxDesc{
+		Method: slashMethod,
+		Args:   map[string]interface{}{},
+		Tx:     &pb.Transaction{Txid: []byte("slash")},
+	}
+	if err := pc.Run(desc); err != ErrSlashNotActive {
+		t.Errorf("expect ErrSlashNotActive, got %v", err)
+	}
+}
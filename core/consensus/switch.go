@@ -0,0 +1,126 @@
+package consensus
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	cons_base "github.com/xuperchain/xuperchain/core/consensus/base"
+)
+
+const (
+	// inheritValidatorsKey 共识升级配置中的继承开关, 为"true"时新共识沿用切换高度上旧共识的验证人集合
+	inheritValidatorsKey = "inherit_validators"
+	// switchStateKey is the key of SwitchState in extParams
+	switchStateKey = "switch_state"
+)
+
+var (
+	// ErrNotSwitchable the outgoing consensus can't hand over its validators
+	ErrNotSwitchable = errors.New("current consensus doesn't support inheriting validators")
+	// ErrNoValidators no validators could be inherited from the outgoing consensus
+	ErrNoValidators = errors.New("no validators to inherit from current consensus")
+	// ErrValidatorsNumber the number of inherited validators doesn't fit the incoming consensus
+	ErrValidatorsNumber = errors.New("number of inherited validators doesn't fit the consensus")
+)
+
+// needInheritValidators return whether the consensus config asks to inherit validators
+func needInheritValidators(consConf map[string]interface{}) bool {
+	switch v := consConf[inheritValidatorsKey].(type) {
+	case string:
+		inherit, _ := strconv.ParseBool(v)
+		return inherit
+	case bool:
+		return v
+	}
+	return false
+}
+
+// getSwitchState get the state of the current consensus at switch height
+func (pc *PluggableConsensus) getSwitchState(height int64) (*cons_base.SwitchState, error) {
+	if len(pc.cons) == 0 {
+		return nil, ErrNotSwitchable
+	}
+	sc, ok := pc.cons[len(pc.cons)-1].Conn.(cons_base.SwitchableInterface)
+	if !ok {
+		return nil, ErrNotSwitchable
+	}
+	return sc.GetSwitchState(height)
+}
+
+// seedConsensusConfig make the config of incoming consensus with the inherited validators,
+// the original consensus config is not changed
+func seedConsensusConfig(name string, consConf map[string]interface{},
+	validators []*cons_base.CandidateInfo) (map[string]interface{}, error) {
+	seeded := make(map[string]interface{}, len(consConf))
+	for k, v := range consConf {
+		seeded[k] = v
+	}
+	if name == ConsensusTypePow {
+		// pow没有验证人集合, 无需继承
+		return seeded, nil
+	}
+	if len(validators) == 0 {
+		return nil, ErrNoValidators
+	}
+
+	switch name {
+	case ConsensusTypeSingle:
+		if len(validators) != 1 {
+			return nil, ErrValidatorsNumber
+		}
+		seeded["miner"] = validators[0].Address
+	case ConsensusTypeXpoa:
+		proposers := make([]interface{}, 0, len(validators))
+		for _, v := range validators {
+			proposers = append(proposers, map[string]interface{}{
+				"address": v.Address,
+				"neturl":  v.PeerAddr,
+			})
+		}
+		seeded["init_proposer"] = proposers
+	case ConsensusTypeTdpos:
+		if num, ok := seeded["proposer_num"].(string); ok {
+			if num != strconv.Itoa(len(validators)) {
+				return nil, ErrValidatorsNumber
+			}
+		} else {
+			seeded["proposer_num"] = strconv.Itoa(len(validators))
+		}
+		addrs := make([]interface{}, 0, len(validators))
+		neturls := make([]interface{}, 0, len(validators))
+		for _, v := range validators {
+			addrs = append(addrs, v.Address)
+			if v.PeerAddr != "" {
+				neturls = append(neturls, v.PeerAddr)
+			}
+		}
+		seeded["init_proposer"] = map[string]interface{}{"1": addrs}
+		delete(seeded, "init_proposer_neturl")
+		if len(neturls) == len(validators) {
+			seeded["init_proposer_neturl"] = map[string]interface{}{"1": neturls}
+		}
+	default:
+		return nil, fmt.Errorf("consensus %s doesn't support inheriting validators", name)
+	}
+	return seeded, nil
+}
+
+// inheritValidators seed the incoming consensus config and extParams with the state of current consensus
+func (pc *PluggableConsensus) inheritValidators(name string, height int64, consConf map[string]interface{},
+	extParams map[string]interface{}) (map[string]interface{}, error) {
+	state, err := pc.getSwitchState(height)
+	if err != nil {
+		pc.xlog.Warn("get switch state failed", "height", height, "error", err)
+		return nil, err
+	}
+	seeded, err := seedConsensusConfig(name, consConf, state.Validators)
+	if err != nil {
+		pc.xlog.Warn("seed consensus config failed", "name", name, "height", height, "error", err)
+		return nil, err
+	}
+	extParams[switchStateKey] = state
+	pc.xlog.Info("inherit validators from current consensus", "name", name, "height", height,
+		"validators", len(state.Validators), "enableBFT", state.EnableBFT)
+	return seeded, nil
+}
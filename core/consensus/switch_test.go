@@ -0,0 +1,420 @@
+package consensus
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	log "github.com/xuperchain/log15"
+
+	cons_base "github.com/xuperchain/xuperchain/core/consensus/base"
+	"github.com/xuperchain/xuperchain/core/consensus/tdpos"
+	"github.com/xuperchain/xuperchain/core/consensus/xpoa"
+	p2p_base "github.com/xuperchain/xuperchain/core/p2p/base"
+	"github.com/xuperchain/xuperchain/core/pb"
+)
+
+// fakeSwitchCons is the outgoing consensus which hands over the given state
+type fakeSwitchCons struct {
+	cons_base.ConsensusInterface
+	name  string
+	state *cons_base.SwitchState
+}
+
+func (fc *fakeSwitchCons) Type() string {
+	return fc.name
+}
+
+func (fc *fakeSwitchCons) GetSwitchState(height int64) (*cons_base.SwitchState, error) {
+	return fc.state, nil
+}
+
+// makeSwitchStates return the state handed over by each type of consensus
+func makeSwitchStates() map[string]*cons_base.SwitchState {
+	qc := &pb.QuorumCert{ProposalId: []byte("tip"), ViewNumber: 100}
+	return map[string]*cons_base.SwitchState{
+		ConsensusTypePow: &cons_base.SwitchState{},
+		ConsensusTypeSingle: &cons_base.SwitchState{
+			Validators: []*cons_base.CandidateInfo{
+				&cons_base.CandidateInfo{Address: BobAddress},
+			},
+		},
+		ConsensusTypeXpoa: &cons_base.SwitchState{
+			Validators: []*cons_base.CandidateInfo{
+				&cons_base.CandidateInfo{Address: BobAddress, PeerAddr: "/ip4/127.0.0.1/tcp/47101/p2p/bob"},
+				&cons_base.CandidateInfo{Address: AliceAddress, PeerAddr: "/ip4/127.0.0.1/tcp/47102/p2p/alice"},
+				&cons_base.CandidateInfo{Address: minerAddress, PeerAddr: "/ip4/127.0.0.1/tcp/47103/p2p/miner"},
+			},
+			EnableBFT:      true,
+			BFTStartHeight: 10,
+			GenerateQC:     qc,
+		},
+		ConsensusTypeTdpos: &cons_base.SwitchState{
+			Validators: []*cons_base.CandidateInfo{
+				&cons_base.CandidateInfo{Address: BobAddress},
+				&cons_base.CandidateInfo{Address: AliceAddress},
+				&cons_base.CandidateInfo{Address: minerAddress},
+			},
+			EnableBFT:      true,
+			BFTStartHeight: 20,
+			GenerateQC:     qc,
+		},
+	}
+}
+
+// makeSwitchConfs return the consensus config of each type without validators
+func makeSwitchConfs() map[string]map[string]interface{} {
+	return map[string]map[string]interface{}{
+		ConsensusTypePow: map[string]interface{}{
+			"defaultTarget":      "19",
+			inheritValidatorsKey: "true",
+		},
+		ConsensusTypeSingle: map[string]interface{}{
+			"period":             "3000",
+			inheritValidatorsKey: "true",
+		},
+		ConsensusTypeXpoa: map[string]interface{}{
+			"period":             "3000",
+			"block_num":          "10",
+			"contract_name":      "xpoa_validates",
+			"method_name":        "get_validates",
+			inheritValidatorsKey: "true",
+		},
+		ConsensusTypeTdpos: map[string]interface{}{
+			"period":               "3000",
+			"alternate_interval":   "6000",
+			"term_interval":        "9000",
+			"block_num":            "20",
+			"vote_unit_price":      "1",
+			"init_proposer_neturl": map[string]interface{}{"1": []interface{}{"stale"}},
+			inheritValidatorsKey:   true,
+		},
+	}
+}
+
+func checkSeededConf(t *testing.T, name string, conf map[string]interface{}, state *cons_base.SwitchState) {
+	switch name {
+	case ConsensusTypePow:
+		if _, ok := conf["init_proposer"]; ok {
+			t.Errorf("pow should not be seeded, conf %v", conf)
+		}
+	case ConsensusTypeSingle:
+		if conf["miner"] != state.Validators[0].Address {
+			t.Errorf("unexpected single miner %v", conf["miner"])
+		}
+	case ConsensusTypeXpoa:
+		proposers, ok := conf["init_proposer"].([]interface{})
+		if !ok || len(proposers) != len(state.Validators) {
+			t.Fatalf("unexpected xpoa init_proposer %v", conf["init_proposer"])
+		}
+		for i, p := range proposers {
+			proposer := p.(map[string]interface{})
+			if proposer["address"] != state.Validators[i].Address || proposer["neturl"] != state.Validators[i].PeerAddr {
+				t.Errorf("unexpected xpoa proposer %d %v", i, proposer)
+			}
+		}
+	case ConsensusTypeTdpos:
+		addrs, ok := conf["init_proposer"].(map[string]interface{})["1"].([]interface{})
+		if !ok || len(addrs) != len(state.Validators) {
+			t.Fatalf("unexpected tdpos init_proposer %v", conf["init_proposer"])
+		}
+		for i, addr := range addrs {
+			if addr != state.Validators[i].Address {
+				t.Errorf("unexpected tdpos proposer %d %v", i, addr)
+			}
+		}
+		if conf["proposer_num"] != "3" && conf["proposer_num"] != "1" {
+			t.Errorf("unexpected tdpos proposer_num %v", conf["proposer_num"])
+		}
+		// 只有全部验证人都有neturl时才设置init_proposer_neturl
+		neturls, hasNeturl := conf["init_proposer_neturl"]
+		if state.Validators[0].PeerAddr == "" && hasNeturl {
+			t.Errorf("stale init_proposer_neturl should be removed, got %v", neturls)
+		}
+		if state.Validators[0].PeerAddr != "" &&
+			len(neturls.(map[string]interface{})["1"].([]interface{})) != len(state.Validators) {
+			t.Errorf("unexpected tdpos init_proposer_neturl %v", neturls)
+		}
+	}
+}
+
+func TestSwitchConsensusInheritValidators(t *testing.T) {
+	xlog := log.New("module", "consensus")
+	xlog.SetHandler(log.StreamHandler(os.Stderr, log.LogfmtFormat()))
+	types := []string{ConsensusTypePow, ConsensusTypeSingle, ConsensusTypeXpoa, ConsensusTypeTdpos}
+	states := makeSwitchStates()
+	for _, from := range types {
+		for _, to := range types {
+			pc := &PluggableConsensus{
+				xlog: xlog,
+				cons: []*StepConsensus{
+					&StepConsensus{Conn: &fakeSwitchCons{name: from, state: states[from]}},
+				},
+			}
+			consConf := makeSwitchConfs()[to]
+			if !needInheritValidators(consConf) {
+				t.Fatalf("%s->%s should inherit validators", from, to)
+			}
+			extParams := make(map[string]interface{})
+			seeded, err := pc.inheritValidators(to, 100, consConf, extParams)
+
+			var expectErr error
+			if to != ConsensusTypePow && from == ConsensusTypePow {
+				expectErr = ErrNoValidators
+			} else if to == ConsensusTypeSingle && len(states[from].Validators) != 1 {
+				expectErr = ErrValidatorsNumber
+			}
+			if err != expectErr {
+				t.Fatalf("%s->%s expect error %v, got %v", from, to, expectErr, err)
+			}
+			if err != nil {
+				continue
+			}
+			if extParams[switchStateKey] != states[from] {
+				t.Errorf("%s->%s switch state not passed", from, to)
+			}
+			if _, ok := consConf["init_proposer"]; ok {
+				t.Errorf("%s->%s origin config should not be changed", from, to)
+			}
+			checkSeededConf(t, to, seeded, states[from])
+		}
+	}
+}
+
+func TestSwitchConsensusProposerNum(t *testing.T) {
+	validators := makeSwitchStates()[ConsensusTypeTdpos].Validators
+	consConf := makeSwitchConfs()[ConsensusTypeTdpos]
+	consConf["proposer_num"] = "2"
+	if _, err := seedConsensusConfig(ConsensusTypeTdpos, consConf, validators); err != ErrValidatorsNumber {
+		t.Errorf("expect ErrValidatorsNumber, got %v", err)
+	}
+	consConf["proposer_num"] = "3"
+	if _, err := seedConsensusConfig(ConsensusTypeTdpos, consConf, validators); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSwitchConsensusNotSwitchable(t *testing.T) {
+	pc := &PluggableConsensus{
+		cons: []*StepConsensus{
+			&StepConsensus{Conn: nil},
+		},
+	}
+	if _, err := pc.getSwitchState(100); err != ErrNotSwitchable {
+		t.Errorf("expect ErrNotSwitchable, got %v", err)
+	}
+	if needInheritValidators(map[string]interface{}{inheritValidatorsKey: "false"}) ||
+		needInheritValidators(map[string]interface{}{}) {
+		t.Error("inherit_validators should be off by default")
+	}
+}
+
+func TestSwitchStateQCMatchTip(t *testing.T) {
+	state := makeSwitchStates()[ConsensusTypeXpoa]
+	if !state.QCMatchTip([]byte("tip")) || state.QCMatchTip([]byte("other")) {
+		t.Error("QCMatchTip should match the generateQC")
+	}
+	state.EnableBFT = false
+	if state.QCMatchTip([]byte("tip")) {
+		t.Error("QCMatchTip should be false without bft")
+	}
+	var nilState *cons_base.SwitchState
+	if nilState.QCMatchTip([]byte("tip")) {
+		t.Error("QCMatchTip should be false for nil state")
+	}
+}
+
+// makeSwitchExtParams return the extParams of tdpos and xpoa like newUpdateConsensus
+func makeSwitchExtParams(pc *PluggableConsensus, height int64) map[string]interface{} {
+	return map[string]interface{}{
+		"crypto_client": pc.cryptoClient,
+		"bcname":        pc.bcname,
+		"ledger":        pc.ledger,
+		"utxovm":        pc.utxoVM,
+		"timestamp":     time.Now().UnixNano(),
+		"p2psvr":        pc.p2psvr,
+		"height":        height,
+	}
+}
+
+// TestSwitchConsensusTdposXpoa switch between the real tdpos and xpoa and check the state handed over
+func TestSwitchConsensusTdposXpoa(t *testing.T) {
+	plugClear()
+	defer plugClear()
+	pc := plugPrepareWithGensisBlock(t)
+	pc.p2psvr = &p2p_base.MockP2pServer{}
+	xlog := log.New("module", "consensus")
+	xlog.SetHandler(log.StreamHandler(os.Stderr, log.LogfmtFormat()))
+	pc.xlog = xlog
+
+	// 初始共识为tdpos, 在高度1切换到xpoa
+	addrs := []interface{}{BobAddress, AliceAddress, "akf7qunmeaqb51Wu418d6TyPKp4jdLdpV"}
+	neturls := []interface{}{
+		"/ip4/127.0.0.1/tcp/47101/p2p/bob",
+		"/ip4/127.0.0.1/tcp/47102/p2p/alice",
+		"/ip4/127.0.0.1/tcp/47103/p2p/carol",
+	}
+	tdposConf := makeSwitchConfs()[ConsensusTypeTdpos]
+	tdposConf["proposer_num"] = "3"
+	tdposConf["init_proposer"] = map[string]interface{}{"1": addrs}
+	tdposConf["init_proposer_neturl"] = map[string]interface{}{"1": neturls}
+	tp := &tdpos.TDpos{}
+	tp.Init()
+	if err := tp.Configure(xlog, pc.cfg, tdposConf, makeSwitchExtParams(pc, 0)); err != nil {
+		t.Fatal(err)
+	}
+	pc.cons = []*StepConsensus{&StepConsensus{Conn: tp}}
+
+	height := int64(1)
+	tdposState, err := tp.GetSwitchState(height)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tdposState.Validators) != len(addrs) || tdposState.EnableBFT {
+		t.Fatalf("unexpected tdpos switch state %v", tdposState)
+	}
+	for i, v := range tdposState.Validators {
+		if v.Address != addrs[i] || v.PeerAddr != neturls[i] {
+			t.Errorf("unexpected tdpos validator %d %v", i, v)
+		}
+	}
+	extParams := makeSwitchExtParams(pc, height)
+	xpoaConf, err := pc.inheritValidators(ConsensusTypeXpoa, height, makeSwitchConfs()[ConsensusTypeXpoa], extParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSeededConf(t, ConsensusTypeXpoa, xpoaConf, tdposState)
+	xp := &xpoa.XPoa{}
+	if err := xp.Configure(xlog, pc.cfg, xpoaConf, extParams); err != nil {
+		t.Fatal(err)
+	}
+	pc.cons = append(pc.cons, &StepConsensus{StartHeight: height, Conn: xp})
+
+	// xpoa在高度2切换回tdpos, 验证人集合保持不变
+	height = 2
+	xpoaState, err := xp.GetSwitchState(height)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(xpoaState.Validators) != len(addrs) || xpoaState.EnableBFT {
+		t.Fatalf("unexpected xpoa switch state %v", xpoaState)
+	}
+	for i, v := range xpoaState.Validators {
+		if v.Address != addrs[i] || v.PeerAddr != neturls[i] {
+			t.Errorf("unexpected xpoa validator %d %v", i, v)
+		}
+	}
+	extParams = makeSwitchExtParams(pc, height)
+	tdposConf, err = pc.inheritValidators(ConsensusTypeTdpos, height, makeSwitchConfs()[ConsensusTypeTdpos], extParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSeededConf(t, ConsensusTypeTdpos, tdposConf, xpoaState)
+	if _, ok := extParams[switchStateKey].(*cons_base.SwitchState); !ok {
+		t.Errorf("xpoa switch state not passed")
+	}
+	tp = &tdpos.TDpos{}
+	tp.Init()
+	if err := tp.Configure(xlog, pc.cfg, tdposConf, extParams); err != nil {
+		t.Fatal(err)
+	}
+	state, err := tp.GetSwitchState(height)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, v := range state.Validators {
+		if v.Address != addrs[i] || v.PeerAddr != neturls[i] {
+			t.Errorf("unexpected validator %d %v after switching back to tdpos", i, v)
+		}
+	}
+}
+
+// TestSwitchConsensusTdposXpoaBFT switch between the real tdpos and xpoa with chained-bft enabled,
+// the QC chain on the tip block and the start height of chained-bft are handed over
+func TestSwitchConsensusTdposXpoaBFT(t *testing.T) {
+	plugClear()
+	defer plugClear()
+	pc := plugPrepareWithGensisBlock(t)
+	pc.p2psvr = &p2p_base.MockP2pServer{}
+	xlog := log.New("module", "consensus")
+	xlog.SetHandler(log.StreamHandler(os.Stderr, log.LogfmtFormat()))
+	pc.xlog = xlog
+	tipBlockid := pc.ledger.GetMeta().GetTipBlockid()
+
+	// 初始共识为开启bft的tdpos, 在下一个高度切换到开启bft的xpoa
+	addrs := []interface{}{BobAddress, AliceAddress, "akf7qunmeaqb51Wu418d6TyPKp4jdLdpV"}
+	neturls := []interface{}{
+		"/ip4/127.0.0.1/tcp/47101/p2p/bob",
+		"/ip4/127.0.0.1/tcp/47102/p2p/alice",
+		"/ip4/127.0.0.1/tcp/47103/p2p/carol",
+	}
+	tdposConf := makeSwitchConfs()[ConsensusTypeTdpos]
+	tdposConf["proposer_num"] = "3"
+	tdposConf["init_proposer"] = map[string]interface{}{"1": addrs}
+	tdposConf["init_proposer_neturl"] = map[string]interface{}{"1": neturls}
+	tdposConf["bft_config"] = map[string]interface{}{}
+	tp := &tdpos.TDpos{}
+	tp.Init()
+	if err := tp.Configure(xlog, pc.cfg, tdposConf, makeSwitchExtParams(pc, 0)); err != nil {
+		t.Fatal(err)
+	}
+	defer tp.Stop()
+	pc.cons = []*StepConsensus{&StepConsensus{Conn: tp}}
+
+	height := pc.ledger.GetMeta().GetTrunkHeight() + 1
+	tdposState, err := tp.GetSwitchState(height)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !tdposState.EnableBFT || tdposState.BFTStartHeight != 0 || !tdposState.QCMatchTip(tipBlockid) {
+		t.Fatalf("unexpected tdpos switch state %v", tdposState)
+	}
+	extParams := makeSwitchExtParams(pc, height)
+	xpoaConf, err := pc.inheritValidators(ConsensusTypeXpoa, height, makeSwitchConfs()[ConsensusTypeXpoa], extParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	xpoaConf["bft_config"] = map[string]interface{}{}
+	xp := &xpoa.XPoa{}
+	if err := xp.Configure(xlog, pc.cfg, xpoaConf, extParams); err != nil {
+		t.Fatal(err)
+	}
+	defer xp.Stop()
+	pc.cons = append(pc.cons, &StepConsensus{StartHeight: height, Conn: xp})
+
+	// xpoa沿用tdpos的QC链和bft起始高度, 而不是从切换高度重新开始
+	xpoaState, err := xp.GetSwitchState(height)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !xpoaState.EnableBFT || xpoaState.BFTStartHeight != tdposState.BFTStartHeight {
+		t.Fatalf("unexpected xpoa switch state %v", xpoaState)
+	}
+	if xpoaState.GenerateQC != tdposState.GenerateQC || xpoaState.LockedQC != tdposState.LockedQC {
+		t.Errorf("xpoa should inherit the QC chain of tdpos, got %v", xpoaState.GenerateQC)
+	}
+
+	// xpoa再切换回开启bft的tdpos
+	extParams = makeSwitchExtParams(pc, height)
+	tdposConf, err = pc.inheritValidators(ConsensusTypeTdpos, height, makeSwitchConfs()[ConsensusTypeTdpos], extParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tdposConf["bft_config"] = map[string]interface{}{}
+	tp = &tdpos.TDpos{}
+	tp.Init()
+	if err := tp.Configure(xlog, pc.cfg, tdposConf, extParams); err != nil {
+		t.Fatal(err)
+	}
+	defer tp.Stop()
+	state, err := tp.GetSwitchState(height)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !state.QCMatchTip(tipBlockid) || state.BFTStartHeight != xpoaState.BFTStartHeight {
+		t.Fatalf("unexpected switch state %v after switching back to tdpos", state)
+	}
+	if state.GenerateQC != xpoaState.GenerateQC {
+		t.Errorf("tdpos should inherit the QC chain of xpoa, got %v", state.GenerateQC)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/xuperchain/xuperchain/core/common"
 	cons_base "github.com/xuperchain/xuperchain/core/consensus/base"
 	"github.com/xuperchain/xuperchain/core/contract"
+	bls_sign "github.com/xuperchain/xuperchain/core/crypto/bls"
 	"github.com/xuperchain/xuperchain/core/pb"
 )
 
@@ -39,12 +40,12 @@ func (tp *TDpos) minerScheduling(timestamp int64) (term int64, pos int64, blockP
 	return
 }
 
-// 调度产生的矿工与自身进行进行比较
-func (tp *TDpos) isProposer(term int64, pos int64, address []byte) bool {
+// 调度产生的矿工与自身进行进行比较, preHash为待出块的前一个区块, 用于vrf选举模式下确定出块顺序
+func (tp *TDpos) isProposer(term int64, pos int64, address []byte, preHash []byte) bool {
 	if term == 0 {
 		return false
 	}
-	proposers := tp.getTermProposer(term)
+	proposers := tp.getScheduledProposers(term, preHash)
 	tp.log.Trace("TDpos getTermProposer result", "term", term, "proposers", proposers)
 	if proposers == nil {
 		tp.log.Warn("TDpos getTermProposer error", "term", term)
@@ -80,7 +81,7 @@ func (tp *TDpos) getProposer(term int64, pos int64) (string, error) {
 }
 
 // getNextProposer return the next block proposer of given term,pos
-func (tp *TDpos) getNextProposer(term int64, pos int64, blockPos int64) (string, error) {
+func (tp *TDpos) getNextProposer(term int64, pos int64, blockPos int64, preHash []byte) (string, error) {
 	if term == 0 {
 		if len(tp.config.initProposer[1]) <= 0 {
 			tp.log.Warn("TDpos getTermProposer error, no proposer in term 1")
@@ -89,7 +90,7 @@ func (tp *TDpos) getNextProposer(term int64, pos int64, blockPos int64) (string,
 		return tp.config.initProposer[1][0].Address, nil
 	}
 
-	proposers := tp.getTermProposer(term)
+	proposers := tp.getScheduledProposers(term, preHash)
 	tp.log.Trace("TDpos getTermProposer result", "term", term, "proposers", proposers)
 	if proposers == nil {
 		tp.log.Warn("TDpos getTermProposer error", "term", term)
@@ -98,7 +99,7 @@ func (tp *TDpos) getNextProposer(term int64, pos int64, blockPos int64) (string,
 
 	// current proposer is the last proposer of this term
 	if pos >= int64(len(proposers)) {
-		proposers := tp.getTermProposer(term + 1)
+		proposers := tp.getScheduledProposers(term+1, preHash)
 		if proposers == nil {
 			tp.log.Warn("TDpos getTermProposer error", "term", term+1)
 			return "", errors.New("no proposer found")
@@ -123,6 +124,9 @@ func (tp *TDpos) getTermProposer(term int64) []*cons_base.CandidateInfo {
 	if term == 1 {
 		return tp.config.initProposer[1]
 	}
+	if tp.lightMode {
+		return tp.getTermProposerLight(term)
+	}
 	key := GenTermCheckKey(tp.version, term)
 	val, err := tp.utxoVM.GetFromTable(nil, []byte(key))
 	if err != nil && common.NormalizedKVError(err) != common.ErrKVNotFound {
@@ -170,6 +174,71 @@ func (tp *TDpos) getTermProposer(term int64) []*cons_base.CandidateInfo {
 
 }
 
+// getTermProposerLight 轻节点从账本最新区块向前查找区块头中公布的term轮及之前最近一轮的验证者名单,
+// 轻节点逐个确认区块头, 校验区块时账本最新区块即为其前一个区块
+func (tp *TDpos) getTermProposerLight(term int64) []*cons_base.CandidateInfo {
+	blockid := tp.ledger.GetMeta().GetTipBlockid()
+	for len(blockid) > 0 {
+		header, err := tp.headerValidators.Find(blockid)
+		if err != nil {
+			tp.log.Warn("TDpos getTermProposerLight find header error", "term", term, "error", err)
+			return nil
+		}
+		if header == nil {
+			break
+		}
+		tv := &termValidators{}
+		if err := json.Unmarshal(header.GetNextValidators(), tv); err != nil {
+			tp.log.Warn("TDpos getTermProposerLight unmarshal error", "term", term, "error", err)
+			return nil
+		}
+		if tv.Term <= term {
+			return tv.Proposers
+		}
+		blockid = header.GetPreHash()
+	}
+	return tp.config.initProposer[1]
+}
+
+// encodeTermValidators 编码区块头中公布的term轮验证者名单
+func encodeTermValidators(term int64, proposers []*cons_base.CandidateInfo) ([]byte, error) {
+	return json.Marshal(&termValidators{
+		Term:      term,
+		Proposers: proposers,
+	})
+}
+
+// makeNextValidators 矿工在本轮需要检票时计算下一轮的验证者名单并公布在区块头中, 无需检票时返回nil
+func (tp *TDpos) makeNextValidators(term int64) ([]byte, error) {
+	key := GenTermCheckKey(tp.version, term+1)
+	val, err := tp.utxoVM.GetFromTable(nil, []byte(key))
+	if val != nil || common.NormalizedKVError(err) != common.ErrKVNotFound {
+		return nil, nil
+	}
+	proposers, err := tp.calTermProposer(tp.version, term+1)
+	if err != nil {
+		return nil, err
+	}
+	if proposers == nil {
+		return nil, errors.New("no proposer found")
+	}
+	return encodeTermValidators(term+1, proposers)
+}
+
+// hasCheckValidaterTx 区块中是否包含检票交易
+func hasCheckValidaterTx(block *pb.InternalBlock) bool {
+	for _, tx := range block.GetTransactions() {
+		if !tx.GetAutogen() {
+			continue
+		}
+		desc, err := contract.Parse(string(tx.GetDesc()))
+		if err == nil && desc.Module == "tdpos" && desc.Method == checkvValidaterMethod {
+			return true
+		}
+	}
+	return false
+}
+
 // 生成当前轮的验证者名单
 func (tp *TDpos) genTermProposer() ([]*cons_base.CandidateInfo, error) {
 	//var res []string
@@ -395,6 +464,18 @@ func (tp *TDpos) validateNominateCandidate(desc *contract.TxDesc) (*cons_base.Ca
 		}
 	}
 
+	// process candidate BLS public key, which is optional
+	if desc.Args["bls_public_key"] != nil {
+		blsPublicKey, ok := desc.Args["bls_public_key"].(string)
+		if !ok {
+			return nil, "", errors.New("validateNominateCandidate bls_public_key should be string")
+		}
+		if _, err := bls_sign.ParsePublicKeyJSON([]byte(blsPublicKey)); err != nil {
+			return nil, "", errors.New("validateNominateCandidate bls_public_key invalid")
+		}
+		canInfo.BlsPublicKey = blsPublicKey
+	}
+
 	return canInfo, fromAddr, nil
 }
 
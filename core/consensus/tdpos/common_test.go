@@ -339,7 +339,7 @@ func TestTermProposerBasic(t *testing.T) {
 	t.Log("term 100 ", strList)
 
 	// test for isProposer
-	isProposer := tdpos.isProposer(2, 2, []byte("f3prTg9itaZY6m48wXXikXdcxiByW7zgk"))
+	isProposer := tdpos.isProposer(2, 2, []byte("f3prTg9itaZY6m48wXXikXdcxiByW7zgk"), nil)
 	if isProposer != false {
 		t.Error("expect false, but got ", isProposer)
 	}
@@ -1,6 +1,7 @@
 package tdpos
 
 import (
+	"bytes"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -155,6 +156,10 @@ func (tp *TDpos) runNominateCandidate(desc *contract.TxDesc, block *pb.InternalB
 		return err
 	}
 	candidate := canInfo.Address
+	if tp.getSlashRecord(candidate) != nil {
+		tp.log.Warn("runNominateCandidate candidate has been slashed", "candidate", candidate)
+		return ErrCandidateSlashed
+	}
 	keyConNom := GenCandidateNominateKey(candidate)
 	keyCanBal := GenCandidateBallotsKey(candidate)
 	keyCanInfo := GenCandidateInfoKey(candidate)
@@ -231,6 +236,12 @@ func (tp *TDpos) runRevokeCandidate(desc *contract.TxDesc, block *pb.InternalBlo
 		return err
 	}
 
+	// 被惩罚的候选人押金被冻结, 不允许撤销
+	if tp.getSlashRecord(candidate.Address) != nil {
+		tp.log.Warn("runRevokeCandidate candidate has been slashed", "candidate", candidate.Address)
+		return ErrCandidateSlashed
+	}
+
 	keyRevoke := GenRevokeKey(txNom)
 	if _, ok := tp.revokeCache.Load(txNom); ok {
 		tp.log.Warn("runRevokeCandidate error", "error", "revoke repeated")
@@ -304,10 +315,39 @@ func (tp *TDpos) runCheckValidater(desc *contract.TxDesc, block *pb.InternalBloc
 	key := GenTermCheckKey(version, term)
 	_, err = tp.utxoVM.GetFromTable(nil, []byte(key))
 	if common.NormalizedKVError(err) != common.ErrKVNotFound {
+		// 已检票的轮次不再公布验证者名单
+		if err == nil && len(block.GetNextValidators()) > 0 {
+			return ErrNextValidatorsMismatch
+		}
 		return err
 	}
+	proposers, err := tp.calTermProposer(version, term)
+	if proposers != nil {
+		if tp.ledger.StateRootEnabled() {
+			expect, err := encodeTermValidators(term, proposers)
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(block.GetNextValidators(), expect) {
+				tp.log.Warn("runCheckValidater next validators mismatch", "term", term,
+					"expect", string(expect), "got", string(block.GetNextValidators()))
+				return ErrNextValidatorsMismatch
+			}
+		}
+		proposersJSON, _ := json.Marshal(proposers)
+		tp.log.Info("runCheckValidater", "key", key, "proposersJson", proposersJSON, "proposers", proposers)
+		tp.context.UtxoBatch.Put([]byte(key), proposersJSON)
+		tp.triggerProposerChanged(proposers)
+		return nil
+	}
+	tp.log.Warn("runCheckValidater error")
+	return errors.New("runCheckValidater error")
+}
+
+// calTermProposer 计算term轮的验证者名单, 没有检出足够的候选人时沿用之前轮次的名单
+func (tp *TDpos) calTermProposer(version int64, term int64) ([]*cons_base.CandidateInfo, error) {
 	proposers, err := tp.genTermProposer()
-	tp.log.Trace("runCheckValidater", "proposers", proposers, "err", err)
+	tp.log.Trace("calTermProposer", "proposers", proposers, "err", err)
 	if err == ErrProposerNotEnough {
 		// 没有检出足够的候选人, 则往前回溯, 使用上一轮的候选人代替
 		for i := term - 1; i >= 1; i-- {
@@ -324,17 +364,10 @@ func (tp *TDpos) runCheckValidater(desc *contract.TxDesc, block *pb.InternalBloc
 				}
 			}
 		}
-		tp.log.Trace("runCheckValidater from previous OK")
-	}
-	if proposers != nil {
-		proposersJSON, _ := json.Marshal(proposers)
-		tp.log.Info("runCheckValidater", "key", key, "proposersJson", proposersJSON, "proposers", proposers)
-		tp.context.UtxoBatch.Put([]byte(key), proposersJSON)
-		tp.triggerProposerChanged(proposers)
-		return nil
+		tp.log.Trace("calTermProposer from previous OK")
+		return proposers, nil
 	}
-	tp.log.Warn("runCheckValidater error")
-	return errors.New("runCheckValidater error")
+	return proposers, err
 }
 
 // triggerProposerChanged triggers a ProposerChanged event
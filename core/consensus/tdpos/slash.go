@@ -0,0 +1,161 @@
+package tdpos
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+
+	"github.com/xuperchain/xuperchain/core/common"
+	cons_base "github.com/xuperchain/xuperchain/core/consensus/base"
+	"github.com/xuperchain/xuperchain/core/pb"
+)
+
+var (
+	// ErrNotValidator the offender is neither a candidate nor a proposer of current term
+	ErrNotValidator = errors.New("the offender is not candidate or proposer of tdpos")
+	// ErrCandidateSlashed the candidate has been slashed for double-signing
+	ErrCandidateSlashed = errors.New("the candidate has been slashed")
+)
+
+// slashedCandidate 被惩罚候选人在惩罚前的状态, 便于回滚时恢复
+type slashedCandidate struct {
+	NominateTxid string                   `json:"nominate_txid"`
+	Ballots      int64                    `json:"ballots"`
+	Candidate    *cons_base.CandidateInfo `json:"candidate"`
+}
+
+// GenSlashCandidateKey generate slashed candidate key
+func GenSlashCandidateKey(address string) string {
+	return pb.ConsTDposPrefix + "_candidate_slash_" + address
+}
+
+// getSlashRecord return the slash record of address, nil if not slashed
+func (tp *TDpos) getSlashRecord(address string) *cons_base.SlashRecord {
+	val, err := tp.utxoVM.GetFromTable(nil, []byte(cons_base.GenSlashKey(address)))
+	if err != nil {
+		return nil
+	}
+	record := &cons_base.SlashRecord{}
+	if err := json.Unmarshal(val, record); err != nil {
+		tp.log.Warn("TDpos getSlashRecord unmarshal error", "address", address, "error", err)
+		return nil
+	}
+	return record
+}
+
+// isSlashedBefore return whether the address has been slashed before height
+func (tp *TDpos) isSlashedBefore(address string, height int64) bool {
+	record := tp.getSlashRecord(address)
+	return record != nil && record.Height < height
+}
+
+// Slash is the specific implementation of SlashableInterface,
+// the offender is removed from candidates and the deposit of nomination can't be revoked any more,
+// proposers of current term are kept and the offender's blocks are rejected after slashing
+func (tp *TDpos) Slash(record *cons_base.SlashRecord) error {
+	offender := record.Offender
+	term := tp.context.Block.GetCurTerm()
+	if term < 1 {
+		term = 1
+	}
+	inTerm := false
+	for _, proposer := range tp.getTermProposer(term) {
+		if proposer.Address == offender {
+			inTerm = true
+			break
+		}
+	}
+	if !tp.inCandidate(offender) {
+		if !inTerm {
+			return ErrNotValidator
+		}
+		return nil
+	}
+
+	keyConNom := GenCandidateNominateKey(offender)
+	keyCanBal := GenCandidateBallotsKey(offender)
+	keyCanInfo := GenCandidateInfoKey(offender)
+	nomTxid, err := tp.utxoVM.GetFromTable(nil, []byte(keyConNom))
+	if err != nil {
+		tp.log.Warn("TDpos Slash get nominate txid error", "offender", offender, "error", err)
+		return err
+	}
+	slashed := &slashedCandidate{
+		NominateTxid: hex.EncodeToString(nomTxid),
+	}
+	if val, err := tp.utxoVM.GetFromTable(nil, []byte(keyCanInfo)); err == nil {
+		json.Unmarshal(val, &slashed.Candidate)
+	}
+	if val, ok := tp.candidateBallotsCache.Load(keyCanBal); ok {
+		slashed.Ballots = val.(*candidateBallotsValue).ballots
+	} else if bal, ok := tp.candidateBallots.Load(keyCanBal); ok {
+		slashed.Ballots = bal.(int64)
+	}
+
+	// 提名时冻结的押金不再允许撤销
+	nomTx, err := tp.ledger.QueryTransaction(nomTxid)
+	if err != nil {
+		tp.log.Warn("TDpos Slash query nominate tx error", "offender", offender, "error", err)
+		return err
+	}
+	deposit, err := calAmount(nomTx)
+	if err != nil {
+		return err
+	}
+	record.FrozenDeposit = deposit.String()
+
+	slashedValue, err := json.Marshal(slashed)
+	if err != nil {
+		return err
+	}
+	tp.context.UtxoBatch.Delete([]byte(keyConNom))
+	tp.context.UtxoBatch.Delete([]byte(keyCanInfo))
+	tp.context.UtxoBatch.Put([]byte(GenSlashCandidateKey(offender)), slashedValue)
+	tp.candidateBallotsCache.Store(keyCanBal, &candidateBallotsValue{
+		ballots: slashed.Ballots,
+		isDel:   true,
+	})
+	tp.log.Info("TDpos Slash candidate", "offender", offender, "ballots", slashed.Ballots,
+		"deposit", record.FrozenDeposit)
+	return nil
+}
+
+// BlockSlot is the specific implementation of SlashableInterface, the slot is the turn of proposer in a term
+func (tp *TDpos) BlockSlot(block *pb.InternalBlock) int64 {
+	term, pos, _ := tp.minerScheduling(block.GetTimestamp())
+	return term*tp.config.proposerNum + pos
+}
+
+// RollbackSlash is the specific implementation of SlashableInterface
+func (tp *TDpos) RollbackSlash(record *cons_base.SlashRecord) error {
+	offender := record.Offender
+	keySlash := GenSlashCandidateKey(offender)
+	val, err := tp.utxoVM.GetFromTable(nil, []byte(keySlash))
+	if common.NormalizedKVError(err) == common.ErrKVNotFound {
+		// 惩罚时不在候选人池中, 无需恢复
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	slashed := &slashedCandidate{}
+	if err := json.Unmarshal(val, slashed); err != nil {
+		return err
+	}
+	nomTxid, err := hex.DecodeString(slashed.NominateTxid)
+	if err != nil {
+		return err
+	}
+	canInfoValue, err := json.Marshal(slashed.Candidate)
+	if err != nil {
+		return err
+	}
+	tp.context.UtxoBatch.Put([]byte(GenCandidateNominateKey(offender)), nomTxid)
+	tp.context.UtxoBatch.Put([]byte(GenCandidateInfoKey(offender)), canInfoValue)
+	tp.context.UtxoBatch.Delete([]byte(keySlash))
+	tp.candidateBallotsCache.Store(GenCandidateBallotsKey(offender), &candidateBallotsValue{
+		ballots: slashed.Ballots,
+		isDel:   false,
+	})
+	return nil
+}
@@ -0,0 +1,96 @@
+package tdpos
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	cons_base "github.com/xuperchain/xuperchain/core/consensus/base"
+	"github.com/xuperchain/xuperchain/core/contract"
+)
+
+func TestGenSlashCandidateKey(t *testing.T) {
+	res := GenSlashCandidateKey("addr1")
+	if res != "D_candidate_slash_addr1" {
+		t.Error("GenSlashCandidateKey error")
+	}
+}
+
+func TestSlash(t *testing.T) {
+	candidate := "f3prTg9itaZY6m48wXXikXdcxiByW7zgk"
+	desc := &contract.TxDesc{
+		Module: "tdpos",
+		Method: "nominate_candidate",
+		Args: map[string]interface{}{
+			"candidate": candidate,
+		},
+	}
+	strDesc, _ := json.Marshal(desc)
+
+	U, L, tdpos := commonWork(t)
+	txCons, block := makeTxWithDesc(strDesc, U, L, t)
+
+	tdpos.context = &contract.TxContext{Block: block}
+	tdpos.context.UtxoBatch = tdpos.utxoVM.NewBatch()
+	canInfo, _ := json.Marshal(&cons_base.CandidateInfo{Address: candidate})
+	tdpos.context.UtxoBatch.Put([]byte(GenCandidateNominateKey(candidate)), txCons.Txid)
+	tdpos.context.UtxoBatch.Put([]byte(GenCandidateInfoKey(candidate)), canInfo)
+	tdpos.context.UtxoBatch.Write()
+	tdpos.candidateBallots.Store(GenCandidateBallotsKey(candidate), int64(10))
+
+	if err := tdpos.Slash(&cons_base.SlashRecord{Offender: BobAddress}); err != ErrNotValidator {
+		t.Error("Slash not validator error", err)
+	}
+
+	tdpos.context.UtxoBatch = tdpos.utxoVM.NewBatch()
+	record := &cons_base.SlashRecord{
+		Offender: candidate,
+		Height:   block.Height,
+		Txid:     fmt.Sprintf("%x", txCons.Txid),
+	}
+	if err := tdpos.Slash(record); err != nil {
+		t.Fatal("Slash error", err)
+	}
+	if record.FrozenDeposit == "" {
+		t.Error("Slash should fill the frozen deposit")
+	}
+	recordJSON, _ := json.Marshal(record)
+	tdpos.context.UtxoBatch.Put([]byte(cons_base.GenSlashKey(candidate)), recordJSON)
+	tdpos.context.UtxoBatch.Write()
+	if val, ok := tdpos.candidateBallotsCache.Load(GenCandidateBallotsKey(candidate)); !ok ||
+		!val.(*candidateBallotsValue).isDel || val.(*candidateBallotsValue).ballots != 10 {
+		t.Error("Slash should remove the candidate", val)
+	}
+	if val, _ := tdpos.utxoVM.GetFromTable(nil, []byte(GenCandidateNominateKey(candidate))); val != nil {
+		t.Error("Slash should delete the nominate key")
+	}
+	if !tdpos.isSlashedBefore(candidate, block.Height+1) || tdpos.isSlashedBefore(candidate, block.Height) {
+		t.Error("isSlashedBefore error")
+	}
+
+	// 被惩罚的候选人不能撤销提名
+	revokeDesc := &contract.TxDesc{
+		Module: "tdpos",
+		Method: "revoke_candidate",
+		Tx:     txCons,
+		Args: map[string]interface{}{
+			"txid": fmt.Sprintf("%x", txCons.Txid),
+		},
+	}
+	if err := tdpos.runRevokeCandidate(revokeDesc, block); err == nil {
+		t.Error("runRevokeCandidate slashed candidate error", err)
+	}
+
+	tdpos.context.UtxoBatch = tdpos.utxoVM.NewBatch()
+	if err := tdpos.RollbackSlash(record); err != nil {
+		t.Fatal("RollbackSlash error", err)
+	}
+	tdpos.context.UtxoBatch.Write()
+	if val, ok := tdpos.candidateBallotsCache.Load(GenCandidateBallotsKey(candidate)); !ok ||
+		val.(*candidateBallotsValue).isDel {
+		t.Error("RollbackSlash should restore the candidate", val)
+	}
+	if val, _ := tdpos.utxoVM.GetFromTable(nil, []byte(GenCandidateNominateKey(candidate))); string(val) != string(txCons.Txid) {
+		t.Error("RollbackSlash should restore the nominate key")
+	}
+}
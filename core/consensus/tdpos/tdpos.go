@@ -23,6 +23,7 @@ import (
 	cons_base "github.com/xuperchain/xuperchain/core/consensus/base"
 	bft "github.com/xuperchain/xuperchain/core/consensus/common/chainedbft"
 	bft_config "github.com/xuperchain/xuperchain/core/consensus/common/chainedbft/config"
+	bft_utils "github.com/xuperchain/xuperchain/core/consensus/common/chainedbft/utils"
 	"github.com/xuperchain/xuperchain/core/contract"
 	"github.com/xuperchain/xuperchain/core/ledger"
 	p2p_base "github.com/xuperchain/xuperchain/core/p2p/base"
@@ -109,10 +110,29 @@ func (tp *TDpos) Configure(xlog log.Logger, cfg *config.NodeConfig, consCfg map[
 		return errors.New("invalid type of heights")
 	}
 
+	if switchState, ok := extParams["switch_state"].(*cons_base.SwitchState); ok {
+		tp.switchState = switchState
+	}
+
+	tp.lightMode = cfg.NodeMode == config.NodeModeLight
+	tp.headerValidators = cons_base.NewHeaderValidators(tp.ledger.QueryBlockHeader)
+
 	if err = tp.buildConfigs(xlog, nil, consCfg); err != nil {
 		return err
 	}
 
+	if tp.vrfEnabled() {
+		if err = tp.initVrf(cfg); err != nil {
+			xlog.Warn("init vrf election failed!", "error", err)
+			return err
+		}
+	}
+
+	tp.bftStartHeight = tp.height
+	if tp.config.enableBFT && tp.switchState != nil && tp.switchState.EnableBFT {
+		tp.bftStartHeight = tp.switchState.BFTStartHeight
+	}
+
 	if err = tp.initBFT(cfg); err != nil {
 		xlog.Warn("init chained-bft failed!", "error", err)
 		return err
@@ -289,6 +309,30 @@ func (tp *TDpos) buildConfigs(xlog log.Logger, cfg *config.NodeConfig, consCfg m
 		}
 	}
 
+	// if have init_proposer_bls_public_key, votes of chained-bft can be signed with BLS
+	if _, ok := consCfg["init_proposer_bls_public_key"]; ok {
+		proposerKeys, ok := consCfg["init_proposer_bls_public_key"].(map[string]interface{})
+		if !ok {
+			return errors.New("TDpos init error, init_proposer_bls_public_key should be map")
+		}
+		proposerKeys1, ok := proposerKeys["1"].([]interface{})
+		if !ok || int64(len(proposerKeys1)) != tp.config.proposerNum {
+			return errors.New("TDpos init error, Proposer BLS public key number should be equal to proposerNum")
+		}
+		for idx, v := range proposerKeys1 {
+			tp.config.initProposer[1][idx].BlsPublicKey, _ = v.(string)
+		}
+	}
+
+	// read config of election_mode, proposers are shuffled by VRF output in vrf mode
+	tp.config.electionMode = electionModeDefault
+	if electionMode, ok := consCfg["election_mode"].(string); ok && electionMode != "" {
+		if electionMode != electionModeDefault && electionMode != electionModeVrf {
+			return errors.New("TDpos init error, invalid election_mode " + electionMode)
+		}
+		tp.config.electionMode = electionMode
+	}
+
 	// parse bft related config
 	tp.config.enableBFT = false
 	if bftConfData, ok := consCfg["bft_config"].(map[string]interface{}); ok {
@@ -386,7 +430,7 @@ Again:
 	}
 
 	// master check
-	if tp.isProposer(term, pos, tp.address) {
+	if tp.isProposer(term, pos, tp.address, tp.ledger.GetMeta().GetTipBlockid()) {
 		tp.log.Trace("CompeteMaster now xterm infos", "term", term, "pos", pos, "blockPos", blockPos, "un2", un2,
 			"master", true, "height", tp.ledger.GetMeta().TrunkHeight+1, "origin height", height)
 		tp.curBlockNum = blockPos
@@ -438,7 +482,7 @@ func (tp *TDpos) notifyNewView() error {
 	t2 := time.Now()
 	un2 := t2.UnixNano()
 	term, pos, blockPos := tp.minerScheduling(un2)
-	nextProposer, err := tp.getNextProposer(term, pos, blockPos)
+	nextProposer, err := tp.getNextProposer(term, pos, blockPos, meta.GetTipBlockid())
 	if err != nil {
 		return err
 	}
@@ -446,6 +490,73 @@ func (tp *TDpos) notifyNewView() error {
 	return tp.bftPaceMaker.NextNewView(meta.TrunkHeight+1, nextProposer, proposer)
 }
 
+// electViewProposer is the ProposerElection of hotstuff pacemaker, view is entered by the TimeoutCert of view-1
+func (tp *TDpos) electViewProposer(view int64) (string, error) {
+	tipBlock, err := tp.ledger.QueryBlock(tp.ledger.GetMeta().GetTipBlockid())
+	if err != nil {
+		return "", err
+	}
+	_, proposer, err := tp.viewProposer(tipBlock, view-1)
+	return proposer, err
+}
+
+// viewProposer return the term and the proposer rotated by the TimeoutCert of tcView on preBlock,
+// the proposer is rotated from the one scheduled at the timestamp of preBlock in the schedule order of the term
+func (tp *TDpos) viewProposer(preBlock *pb.InternalBlock, tcView int64) (int64, string, error) {
+	rounds, err := bft_utils.TimeoutRounds(tcView, preBlock)
+	if err != nil {
+		return 0, "", err
+	}
+	term, pos, _ := tp.minerScheduling(preBlock.GetTimestamp())
+	if term == 0 {
+		term, pos = 1, 0
+	}
+	proposers := tp.getScheduledProposers(term, preBlock.GetBlockid())
+	if len(proposers) == 0 {
+		return 0, "", errors.New("no proposer found")
+	}
+	return term, proposers[(pos+rounds)%int64(len(proposers))].Address, nil
+}
+
+// rotatedTimeoutCert return the highest TimeoutCert and its term if this node is the proposer rotated by it
+func (tp *TDpos) rotatedTimeoutCert() (*pb.TimeoutCert, int64) {
+	if !tp.config.enableBFT {
+		return nil, 0
+	}
+	tc := tp.bftPaceMaker.GetChainedBFT().GetHighTC()
+	if tc == nil {
+		return nil, 0
+	}
+	tipBlock, err := tp.ledger.QueryBlock(tp.ledger.GetMeta().GetTipBlockid())
+	if err != nil {
+		return nil, 0
+	}
+	term, proposer, err := tp.viewProposer(tipBlock, tc.GetViewNumber())
+	if err != nil || term != tp.curTerm || proposer != string(tp.address) {
+		return nil, 0
+	}
+	return tc, term
+}
+
+// checkRotatedProposer check the block proposed by the proposer rotated by the TimeoutCert it carries
+func (tp *TDpos) checkRotatedProposer(in *pb.InternalBlock, preBlock *pb.InternalBlock) error {
+	if !tp.config.enableBFT {
+		return errors.New("timeout cert without bft")
+	}
+	tc := in.GetTimeoutCert()
+	if ok, err := tp.bftPaceMaker.GetChainedBFT().IsTimeoutCertValidate(tc); !ok {
+		return fmt.Errorf("invalid timeout cert, err=%v", err)
+	}
+	term, proposer, err := tp.viewProposer(preBlock, tc.GetViewNumber())
+	if err != nil {
+		return err
+	}
+	if in.GetCurTerm() != term || string(in.GetProposer()) != proposer {
+		return fmt.Errorf("proposer mismatch, expect %s of term %d", proposer, term)
+	}
+	return nil
+}
+
 func (tp *TDpos) notifyTermChanged(term int64) error {
 	if !tp.config.enableBFT {
 		// BFT not enabled, continue
@@ -467,6 +578,17 @@ func (tp *TDpos) CheckMinerMatch(header *pb.Header, in *pb.InternalBlock) (bool,
 		tp.log.Info("TDpos CheckMinerMatch VerifyBlock not ok")
 		return ok, err
 	}
+	// 区块头中公布的验证者名单需对应区块中的检票交易, 名单内容在执行检票交易时校验
+	if len(in.GetNextValidators()) > 0 && (!tp.ledger.StateRootEnabled() || !tp.lightMode && !hasCheckValidaterTx(in)) {
+		tp.log.Warn("TDpos CheckMinerMatch unexpected next validators", "logid", header.Logid)
+		return false, nil
+	}
+	// 被惩罚的矿工之后产出的块不再接受
+	if ledger.ForkActive(tp.ledger.GetForkHeights().Slash, in.GetHeight()) &&
+		tp.isSlashedBefore(string(in.Proposer), in.GetHeight()) {
+		tp.log.Warn("TDpos CheckMinerMatch proposer has been slashed", "proposer", string(in.Proposer))
+		return false, nil
+	}
 
 	// 2 验证bft相关信息
 	if tp.config.enableBFT && !tp.isFirstblock(in.GetHeight()) {
@@ -488,7 +610,22 @@ func (tp *TDpos) CheckMinerMatch(header *pb.Header, in *pb.InternalBlock) (bool,
 	tp.log.Trace("CheckMinerMatch", "preBlock.CurTerm", preBlock.CurTerm, "in.CurTerm", in.CurTerm, " in.Proposer",
 		string(in.Proposer), "blockid", fmt.Sprintf("%x", in.Blockid))
 	term, pos, _ := tp.minerScheduling(in.Timestamp)
-	if tp.isProposer(term, pos, in.Proposer) {
+	// vrf选举模式下每个区块需携带矿工基于前一个区块生成的vrf证明
+	if tp.vrfEnabled() {
+		if err := tp.verifyVrfProof(in); err != nil {
+			tp.log.Warn("CheckMinerMatch failed, verify vrf proof error", "logid", header.Logid, "error", err)
+			return false, nil
+		}
+	}
+	// 携带超时证书的区块由超时后轮换的出块人产出, 不再校验出块时间
+	if in.GetTimeoutCert() != nil {
+		if err := tp.checkRotatedProposer(in, preBlock); err != nil {
+			tp.log.Warn("CheckMinerMatch failed, check rotated proposer error", "logid", header.Logid, "error", err)
+			return false, nil
+		}
+		return true, nil
+	}
+	if tp.isProposer(term, pos, in.Proposer, in.PreHash) {
 		// curTermProposerProduceNumCache is not thread safe, lock before use it.
 		tp.mutex.Lock()
 		defer tp.mutex.Unlock()
@@ -535,10 +672,13 @@ func (tp *TDpos) ProcessBeforeMiner(timestamp int64) (map[string]interface{}, bo
 	}
 	res := make(map[string]interface{})
 	term, pos, blockPos := tp.minerScheduling(timestamp)
-	if term != tp.curTerm || blockPos > tp.config.blockNum || pos >= tp.config.proposerNum {
+	// 本节点被超时证书轮换为出块人时, 代替超时的出块人出块
+	if tc, tcTerm := tp.rotatedTimeoutCert(); tc != nil {
+		term = tcTerm
+		res["timeout_cert"] = tc
+	} else if term != tp.curTerm || blockPos > tp.config.blockNum || pos >= tp.config.proposerNum {
 		return res, false
-	}
-	if !tp.isProposer(term, pos, tp.address) {
+	} else if !tp.isProposer(term, pos, tp.address, tp.ledger.GetMeta().GetTipBlockid()) {
 		tp.log.Warn("ProcessBeforeMiner prepare too long, omit!")
 		return nil, false
 	}
@@ -575,6 +715,26 @@ func (tp *TDpos) ProcessBeforeMiner(timestamp int64) (map[string]interface{}, bo
 		}
 	}
 
+	if tp.vrfEnabled() {
+		proof, err := tp.makeVrfProof(tp.utxoVM.GetLatestBlockid())
+		if err != nil {
+			tp.log.Warn("ProcessBeforeMiner make vrf proof failed", "error", err)
+			return nil, false
+		}
+		res["vrf_proof"] = proof
+	}
+
+	if tp.ledger.StateRootEnabled() {
+		nextValidators, err := tp.makeNextValidators(term)
+		if err != nil {
+			tp.log.Warn("ProcessBeforeMiner make next validators failed", "error", err)
+			return nil, false
+		}
+		if nextValidators != nil {
+			res["next_validators"] = nextValidators
+		}
+	}
+
 	res["type"] = TYPE
 	res["curTerm"] = term
 	res["curBlockNum"] = blockPos
@@ -837,6 +997,10 @@ func (tp *TDpos) initBFT(cfg *config.NodeConfig) error {
 			ViewNumber: block.GetHeight(),
 		}
 		qc[0] = block.GetJustify()
+		// 继承旧共识的QC链, 保证切换后的第一个块仍能携带有效的Justify
+		if tp.switchState.QCMatchTip(blockid) {
+			qc[2], qc[1], qc[0] = tp.switchState.ProposalQC, tp.switchState.GenerateQC, tp.switchState.LockedQC
+		}
 	}
 	term, _, _ := tp.minerScheduling(time.Now().UnixNano())
 	proposers := tp.getTermProposer(term)
@@ -859,7 +1023,7 @@ func (tp *TDpos) initBFT(cfg *config.NodeConfig) error {
 		return err
 	}
 
-	paceMaker, err := bft.NewDefaultPaceMaker(tp.bcname, tp.height, meta.TrunkHeight,
+	paceMaker, err := bft.NewDefaultPaceMaker(tp.bcname, tp.bftStartHeight, meta.TrunkHeight,
 		string(tp.address), cbft, tp.log, tp, tp.ledger)
 	if err != nil {
 		if err != nil {
@@ -868,12 +1032,46 @@ func (tp *TDpos) initBFT(cfg *config.NodeConfig) error {
 		}
 	}
 	tp.bftPaceMaker = paceMaker
-	bridge.SetPaceMaker(paceMaker)
+	if tp.config.bftConfig.Pacemaker == bft_config.PacemakerHotstuff {
+		hotstuffPaceMaker, err := bft.NewHotstuffPaceMaker(paceMaker, tp.config.bftConfig)
+		if err != nil {
+			tp.log.Warn("initBFT: create HotstuffPaceMaker failed", "error", err)
+			return err
+		}
+		hotstuffPaceMaker.SetProposerElection(tp.electViewProposer)
+		tp.bftPaceMaker = hotstuffPaceMaker
+	}
+	bridge.SetPaceMaker(tp.bftPaceMaker)
 	return tp.bftPaceMaker.Start()
 }
 
+// GetSwitchState is the specific implementation of SwitchableInterface,
+// validators are the proposers of the term which the block before switch height belongs to
+func (tp *TDpos) GetSwitchState(height int64) (*cons_base.SwitchState, error) {
+	term := int64(1)
+	if height > 1 {
+		preBlock, err := tp.ledger.QueryBlockByHeight(height - 1)
+		if err != nil {
+			tp.log.Warn("GetSwitchState query block error", "height", height-1, "error", err)
+			return nil, err
+		}
+		if preBlock.GetCurTerm() > 0 {
+			term = preBlock.GetCurTerm()
+		}
+	}
+	state := &cons_base.SwitchState{
+		Validators: tp.getTermProposer(term),
+		EnableBFT:  tp.config.enableBFT,
+	}
+	if tp.config.enableBFT {
+		state.BFTStartHeight = tp.bftStartHeight
+		state.ProposalQC, state.GenerateQC, state.LockedQC = tp.bftPaceMaker.GetChainedBFT().GetQcStatus()
+	}
+	return state, nil
+}
+
 func (tp *TDpos) isFirstblock(targetHeight int64) bool {
-	consStartHeight := tp.height
+	consStartHeight := tp.bftStartHeight
 	consStartHeight++
 	tp.log.Debug("isFirstblock check", "consStartHeight", consStartHeight,
 		"targetHeight", targetHeight)
@@ -1,12 +1,14 @@
 package tdpos
 
 import (
+	"crypto/ecdsa"
 	"errors"
 	"math/big"
 	"sync"
 
 	crypto_base "github.com/xuperchain/crypto/client/service/base"
 	log "github.com/xuperchain/log15"
+	"github.com/xuperchain/xuperchain/core/common"
 	cons_base "github.com/xuperchain/xuperchain/core/consensus/base"
 	bft "github.com/xuperchain/xuperchain/core/consensus/common/chainedbft"
 	bft_config "github.com/xuperchain/xuperchain/core/consensus/common/chainedbft/config"
@@ -21,6 +23,8 @@ var (
 	ErrProposerNotEnough = errors.New("Term publish proposer num less than config")
 	// ErrProposeBlockMoreThanConfig propose block more than config
 	ErrProposeBlockMoreThanConfig = errors.New("Propose block more than config num error")
+	// ErrNextValidatorsMismatch next validators in block header not match the check result
+	ErrNextValidatorsMismatch = errors.New("Next validators in block header not match")
 )
 
 const (
@@ -84,6 +88,24 @@ type TDpos struct {
 	p2psvr       p2p_base.P2PServer
 	// 变更候选人生效高度，0表示当前立即生效，1表示下个区块生效，以此类推
 	effectiveDelay int64
+	// chained-bft起始高度, 继承旧共识的chained-bft时沿用旧共识的起始高度
+	bftStartHeight int64
+	// 共识切换时从旧共识继承的状态, 为空表示不继承
+	switchState *cons_base.SwitchState
+	// 矿工私钥, 仅在vrf选举模式下用于生成区块的vrf证明
+	privateKey *ecdsa.PrivateKey
+	// 每轮出块顺序的随机种子缓存, key: term_preHash, value: seed
+	termSeedCache *common.LRUCache
+	// 轻节点模式下不执行交易, 验证者名单从区块头中公布的变更获取
+	lightMode bool
+	// 从区块头中查找最近一次公布的验证者名单
+	headerValidators *cons_base.HeaderValidators
+}
+
+// termValidators 检票区块在区块头中公布的下一轮验证者名单
+type termValidators struct {
+	Term      int64                      `json:"term"`
+	Proposers []*cons_base.CandidateInfo `json:"proposers"`
 }
 
 // tdpos 共识机制的配置
@@ -105,6 +127,8 @@ type tDposConfig struct {
 	// is proposers' netURL needed for nomination and tdpos config
 	// this is read from config need_neturl
 	needNetURL bool
+	// 出块顺序的选举方式, default|vrf
+	electionMode string
 
 	// BFT related config
 	enableBFT bool
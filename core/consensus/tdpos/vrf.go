@@ -0,0 +1,225 @@
+package tdpos
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sort"
+
+	"github.com/xuperchain/xuperchain/core/common"
+	"github.com/xuperchain/xuperchain/core/common/config"
+	cons_base "github.com/xuperchain/xuperchain/core/consensus/base"
+	"github.com/xuperchain/xuperchain/core/crypto/vrf"
+	"github.com/xuperchain/xuperchain/core/pb"
+)
+
+const (
+	// electionModeDefault 候选人按得票排序轮流出块
+	electionModeDefault = "default"
+	// electionModeVrf 每轮的出块顺序由上一轮最后一个区块的VRF输出决定
+	electionModeVrf = "vrf"
+
+	// termSeedCacheSize 按区块缓存所在轮的种子, 需覆盖各分支最近的区块
+	termSeedCacheSize = 1024
+)
+
+var (
+	// ErrInvalidVrfProof the vrf proof of block is missing or invalid
+	ErrInvalidVrfProof = errors.New("invalid vrf proof of block")
+	// ErrTermSeedNotFound the last block of previous term is not found
+	ErrTermSeedNotFound = errors.New("last block of previous term not found")
+)
+
+// vrfEnabled return whether the proposer order is elected by VRF
+func (tp *TDpos) vrfEnabled() bool {
+	return tp.config.electionMode == electionModeVrf
+}
+
+// initVrf load the private key of miner which is used to generate the vrf proof
+func (tp *TDpos) initVrf(cfg *config.NodeConfig) error {
+	skpath := cfg.Miner.Keypath + "/private.key"
+	skJSON, err := ioutil.ReadFile(skpath)
+	if err != nil {
+		tp.log.Warn("load private key error", "path", skpath)
+		return err
+	}
+	sk, err := tp.cryptoClient.GetEcdsaPrivateKeyFromJsonStr(string(skJSON))
+	if err != nil {
+		tp.log.Warn("parse private key failed", "path", skpath)
+		return err
+	}
+	tp.privateKey = sk
+	tp.termSeedCache = common.NewLRUCache(termSeedCacheSize)
+	return nil
+}
+
+// blockRandomness return the VRF output of block. Blocks without vrf proof, such as the genesis block
+// and blocks before tdpos takes effect, use the genesis blockid, which is fixed when the chain is created,
+// otherwise the proposer of the block before the first VRF term could grind its blockid to bias the order
+func (tp *TDpos) blockRandomness(block *pb.InternalBlock) ([]byte, error) {
+	if len(block.GetVrfProof()) == 0 {
+		return tp.ledger.GetMeta().GetRootBlockid(), nil
+	}
+	pk, err := tp.cryptoClient.GetEcdsaPublicKeyFromJsonStr(string(block.GetPubkey()))
+	if err != nil {
+		return nil, err
+	}
+	return vrf.ProofToHash(pk.Curve, block.GetVrfProof())
+}
+
+// vrfAlpha return the VRF input of the block after preBlock: randomness(preBlock) || height
+func (tp *TDpos) vrfAlpha(preBlock *pb.InternalBlock) ([]byte, error) {
+	randomness, err := tp.blockRandomness(preBlock)
+	if err != nil {
+		return nil, err
+	}
+	height := make([]byte, 8)
+	binary.BigEndian.PutUint64(height, uint64(preBlock.GetHeight()+1))
+	return append(append([]byte{}, randomness...), height...), nil
+}
+
+// makeVrfProof generate the vrf proof of the block after preHash
+func (tp *TDpos) makeVrfProof(preHash []byte) ([]byte, error) {
+	if tp.privateKey == nil {
+		return nil, ErrInvalidVrfProof
+	}
+	preBlock, err := tp.ledger.QueryBlock(preHash)
+	if err != nil {
+		return nil, err
+	}
+	alpha, err := tp.vrfAlpha(preBlock)
+	if err != nil {
+		return nil, err
+	}
+	return vrf.Prove(tp.privateKey, alpha)
+}
+
+// verifyVrfProof verify the vrf proof of block with the public key of proposer
+func (tp *TDpos) verifyVrfProof(block *pb.InternalBlock) error {
+	if len(block.GetVrfProof()) == 0 {
+		return ErrInvalidVrfProof
+	}
+	pk, err := tp.cryptoClient.GetEcdsaPublicKeyFromJsonStr(string(block.GetPubkey()))
+	if err != nil {
+		return err
+	}
+	preBlock, err := tp.ledger.QueryBlock(block.GetPreHash())
+	if err != nil {
+		return err
+	}
+	alpha, err := tp.vrfAlpha(preBlock)
+	if err != nil {
+		return err
+	}
+	if _, err := vrf.Verify(pk, alpha, block.GetVrfProof()); err != nil {
+		return ErrInvalidVrfProof
+	}
+	return nil
+}
+
+// termSeedEntry is the seed of term on the branch ending at a block of the term
+type termSeedEntry struct {
+	term int64
+	seed []byte
+}
+
+// getTermSeed return the seed of term on the branch of preHash,
+// which is the VRF output of the last block before the term.
+// The VRF output can't be ground, but the proposer of the last block knows the seed before broadcasting
+// the block, it can withhold the block to make the seed fall back to the previous block. The bias is a choice
+// between two seeds at the cost of its block, which is accepted as the proposers are elected by votes
+func (tp *TDpos) getTermSeed(term int64, preHash []byte) ([]byte, error) {
+	// 每个矿工在一轮中最多出blockNum+1个块, 超过该范围仍未找到说明分支非法
+	maxSteps := tp.config.proposerNum*(tp.config.blockNum+1) + 1
+	// 种子按区块缓存, 新区块只需回溯到已缓存的前一个区块
+	var visited [][]byte
+	blockid := preHash
+	for i := int64(0); i <= maxSteps; i++ {
+		if seed, ok := tp.cachedTermSeed(term, blockid); ok {
+			tp.cacheTermSeed(term, visited, seed)
+			return seed, nil
+		}
+		block, err := tp.ledger.QueryBlock(blockid)
+		if err != nil {
+			return nil, err
+		}
+		if block.GetCurTerm() < term || len(block.GetPreHash()) == 0 {
+			seed, err := tp.blockRandomness(block)
+			if err != nil {
+				return nil, err
+			}
+			tp.cacheTermSeed(term, visited, seed)
+			return seed, nil
+		}
+		visited = append(visited, blockid)
+		blockid = block.GetPreHash()
+	}
+	return nil, ErrTermSeedNotFound
+}
+
+// cachedTermSeed return the cached seed of term on the branch of blockid
+func (tp *TDpos) cachedTermSeed(term int64, blockid []byte) ([]byte, bool) {
+	if tp.termSeedCache == nil {
+		return nil, false
+	}
+	v, ok := tp.termSeedCache.Get(string(blockid))
+	if !ok {
+		return nil, false
+	}
+	entry := v.(*termSeedEntry)
+	if entry.term != term {
+		return nil, false
+	}
+	return entry.seed, true
+}
+
+// cacheTermSeed cache the seed of term for blocks of the term
+func (tp *TDpos) cacheTermSeed(term int64, blockids [][]byte, seed []byte) {
+	if tp.termSeedCache == nil {
+		return
+	}
+	for _, blockid := range blockids {
+		tp.termSeedCache.Add(string(blockid), &termSeedEntry{term: term, seed: seed})
+	}
+}
+
+// getScheduledProposers return the proposers of term in the order of producing blocks,
+// in vrf election mode, proposers after term 1 are shuffled by the seed of term
+func (tp *TDpos) getScheduledProposers(term int64, preHash []byte) []*cons_base.CandidateInfo {
+	proposers := tp.getTermProposer(term)
+	if !tp.vrfEnabled() || term <= 1 || proposers == nil {
+		return proposers
+	}
+	seed, err := tp.getTermSeed(term, preHash)
+	if err != nil {
+		tp.log.Warn("TDpos getTermSeed error", "term", term, "preHash", fmt.Sprintf("%x", preHash), "error", err)
+		return nil
+	}
+	return shuffleProposers(proposers, seed)
+}
+
+// shuffleProposers sort proposers by H(seed || address)
+func shuffleProposers(proposers []*cons_base.CandidateInfo, seed []byte) []*cons_base.CandidateInfo {
+	type weighted struct {
+		proposer *cons_base.CandidateInfo
+		weight   []byte
+	}
+	list := make([]weighted, len(proposers))
+	for i, p := range proposers {
+		h := sha256.New()
+		h.Write(seed)
+		h.Write([]byte(p.Address))
+		list[i] = weighted{proposer: p, weight: h.Sum(nil)}
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		return bytes.Compare(list[i].weight, list[j].weight) < 0
+	})
+	res := make([]*cons_base.CandidateInfo, len(list))
+	for i, v := range list {
+		res[i] = v.proposer
+	}
+	return res
+}
@@ -0,0 +1,122 @@
+package tdpos
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	log "github.com/xuperchain/log15"
+	"github.com/xuperchain/xuperchain/core/common"
+	cons_base "github.com/xuperchain/xuperchain/core/consensus/base"
+	crypto_client "github.com/xuperchain/xuperchain/core/crypto/client"
+	"github.com/xuperchain/xuperchain/core/pb"
+)
+
+func makeVrfConsensus(t *testing.T) (*TDpos, *fakeBlockChainHolder) {
+	holder := prepareBlockchain()
+	cryptoClient, err := crypto_client.CreateCryptoClient(crypto_client.CryptoTypeDefault)
+	if err != nil {
+		t.Fatal(err)
+	}
+	xlog := log.New("module", "consensus")
+	xlog.SetHandler(log.StreamHandler(os.Stderr, log.LogfmtFormat()))
+	tdpos := &TDpos{
+		log:          xlog,
+		ledger:       holder.Ledger,
+		utxoVM:       holder.UtxoVM,
+		cryptoClient: cryptoClient,
+		privateKey:   holder.PrivateKey,
+	}
+	tdpos.config.electionMode = electionModeVrf
+	tdpos.config.proposerNum = 1
+	tdpos.config.blockNum = 20
+	return tdpos, holder
+}
+
+func TestVrfProof(t *testing.T) {
+	tdpos, holder := makeVrfConsensus(t)
+	proof, err := tdpos.makeVrfProof(holder.B2.Blockid)
+	if err != nil {
+		t.Fatal("makeVrfProof error", err)
+	}
+	block := &pb.InternalBlock{
+		PreHash:  holder.B2.Blockid,
+		Height:   holder.B2.Height + 1,
+		Pubkey:   []byte(bobPubkey),
+		VrfProof: proof,
+	}
+	if err := tdpos.verifyVrfProof(block); err != nil {
+		t.Fatal("verifyVrfProof error", err)
+	}
+	if randomness, err := tdpos.blockRandomness(block); err != nil || bytes.Equal(randomness, holder.B0.Blockid) {
+		t.Fatal("randomness of block with vrf proof should be the vrf output", err)
+	}
+	// 证明与前一个区块绑定
+	block.PreHash = holder.B1.Blockid
+	if err := tdpos.verifyVrfProof(block); err != ErrInvalidVrfProof {
+		t.Fatal("vrf proof on another pre block should not pass", err)
+	}
+	block.VrfProof = nil
+	if err := tdpos.verifyVrfProof(block); err != ErrInvalidVrfProof {
+		t.Fatal("block without vrf proof should not pass", err)
+	}
+}
+
+func TestTermSeed(t *testing.T) {
+	tdpos, holder := makeVrfConsensus(t)
+	// 区块没有vrf证明, 首个VRF轮次的种子固定为创世区块id, 与区块内容无关
+	seed, err := tdpos.getTermSeed(3, holder.B2.Blockid)
+	if err != nil || !bytes.Equal(seed, holder.B0.Blockid) {
+		t.Fatal("seed of term 3 should be the genesis blockid", err)
+	}
+	seed, err = tdpos.getTermSeed(2, holder.B2.Blockid)
+	if err != nil || !bytes.Equal(seed, holder.B0.Blockid) {
+		t.Fatal("seed of term 2 should be the genesis blockid", err)
+	}
+}
+
+func TestTermSeedCache(t *testing.T) {
+	tdpos, holder := makeVrfConsensus(t)
+	tdpos.termSeedCache = common.NewLRUCache(termSeedCacheSize)
+	if _, err := tdpos.getTermSeed(2, holder.B2.Blockid); err != nil {
+		t.Fatal("getTermSeed error", err)
+	}
+	// 种子按区块缓存, 与所求的轮次一起校验
+	seed, ok := tdpos.cachedTermSeed(2, holder.B2.Blockid)
+	if !ok || !bytes.Equal(seed, holder.B0.Blockid) {
+		t.Fatal("seed of term 2 should be cached by block 2")
+	}
+	if _, ok := tdpos.cachedTermSeed(3, holder.B2.Blockid); ok {
+		t.Fatal("seed of other term should not hit the cache")
+	}
+	// 命中缓存后无需再回溯区块
+	tdpos.cacheTermSeed(2, [][]byte{holder.B2.Blockid}, []byte("cached"))
+	seed, err := tdpos.getTermSeed(2, holder.B2.Blockid)
+	if err != nil || !bytes.Equal(seed, []byte("cached")) {
+		t.Fatal("getTermSeed should use the cached seed", err)
+	}
+}
+
+func TestShuffleProposers(t *testing.T) {
+	proposers := []*cons_base.CandidateInfo{
+		{Address: "a"}, {Address: "b"}, {Address: "c"}, {Address: "d"},
+	}
+	res1 := shuffleProposers(proposers, []byte("seed1"))
+	res2 := shuffleProposers(proposers, []byte("seed1"))
+	if len(res1) != len(proposers) {
+		t.Fatal("shuffle should keep all proposers")
+	}
+	seen := map[string]bool{}
+	for i := range res1 {
+		if res1[i] != res2[i] {
+			t.Fatal("shuffle with the same seed should be deterministic")
+		}
+		seen[res1[i].Address] = true
+	}
+	if len(seen) != len(proposers) {
+		t.Fatal("shuffle result should be a permutation")
+	}
+	if proposers[0].Address != "a" {
+		t.Fatal("shuffle should not modify the input")
+	}
+}
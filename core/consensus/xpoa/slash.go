@@ -0,0 +1,160 @@
+package xpoa
+
+import (
+	"encoding/json"
+	"errors"
+
+	cons_base "github.com/xuperchain/xuperchain/core/consensus/base"
+	"github.com/xuperchain/xuperchain/core/ledger"
+	"github.com/xuperchain/xuperchain/core/pb"
+)
+
+var (
+	// ErrNotValidator the offender is not in the validates
+	ErrNotValidator = errors.New("the offender is not in xpoa validates")
+	// ErrLastValidator the offender is the last validator which can't be removed
+	ErrLastValidator = errors.New("the last xpoa validator can't be slashed")
+)
+
+// getValidatesByHeight return validates based on block of height after slashed validators removed
+func (xpoa *XPoa) getValidatesByHeight(height int64) ([]*cons_base.CandidateInfo, error) {
+	if height <= 0 {
+		return xpoa.xpoaConf.initProposers, nil
+	}
+	block, err := xpoa.ledger.QueryBlockByHeight(height)
+	if err != nil {
+		xpoa.lg.Warn("xpoa getValidatesByHeight query block error", "height", height, "error", err)
+		return nil, err
+	}
+	validates, _, err := xpoa.getValidatesByBlockId(block.GetBlockid())
+	if err != nil {
+		return nil, err
+	}
+	if validates == nil {
+		return xpoa.proposerInfos, nil
+	}
+	return xpoa.filterSlashed(validates, height), nil
+}
+
+// getValidatesOfBlock return validates based on block after slashed validators removed, used to announce validates changes
+func (xpoa *XPoa) getValidatesOfBlock(block *pb.InternalBlock) ([]*cons_base.CandidateInfo, error) {
+	if block.GetHeight() <= 0 {
+		return xpoa.xpoaConf.initProposers, nil
+	}
+	validates, _, err := xpoa.getValidatesByBlockId(block.GetBlockid())
+	if err != nil {
+		return nil, err
+	}
+	if validates == nil {
+		return nil, ErrUpdateValidates
+	}
+	return xpoa.filterSlashed(validates, block.GetHeight()), nil
+}
+
+// makeNextValidators return validates of the pre block in json if they are changed from the block before,
+// which is announced in the header of the next block, nil is returned if not changed.
+// Validates of block at height h take effect 3 blocks later, so light nodes can follow them by headers
+func (xpoa *XPoa) makeNextValidators(preHash []byte) ([]byte, error) {
+	preBlock, err := xpoa.ledger.QueryBlockHeader(preHash)
+	if err != nil {
+		return nil, err
+	}
+	cur, err := xpoa.getValidatesOfBlock(preBlock)
+	if err != nil {
+		return nil, err
+	}
+	prev := xpoa.xpoaConf.initProposers
+	if preBlock.GetHeight() > 0 {
+		prePreBlock, err := xpoa.ledger.QueryBlockHeader(preBlock.GetPreHash())
+		if err != nil {
+			return nil, err
+		}
+		if prev, err = xpoa.getValidatesOfBlock(prePreBlock); err != nil {
+			return nil, err
+		}
+	}
+	if cons_base.CandidateInfoEqual(cur, prev) {
+		return nil, nil
+	}
+	return json.Marshal(cur)
+}
+
+// getValidatesLight return validates based on block of height for light nodes,
+// which is the latest validates announced at or before the next block
+func (xpoa *XPoa) getValidatesLight(height int64) ([]*cons_base.CandidateInfo, error) {
+	block, err := xpoa.ledger.QueryBlockHeaderByHeight(height + 1)
+	if err != nil {
+		return nil, err
+	}
+	header, err := xpoa.headerValidators.Find(block.GetBlockid())
+	if err != nil {
+		return nil, err
+	}
+	if header == nil {
+		return xpoa.xpoaConf.initProposers, nil
+	}
+	validates := []*cons_base.CandidateInfo{}
+	if err := json.Unmarshal(header.GetNextValidators(), &validates); err != nil {
+		return nil, err
+	}
+	return validates, nil
+}
+
+// filterSlashed remove validators slashed before or at height, the validates is kept if all of them are slashed
+func (xpoa *XPoa) filterSlashed(validates []*cons_base.CandidateInfo, height int64) []*cons_base.CandidateInfo {
+	if !ledger.ForkActive(xpoa.ledger.GetForkHeights().Slash, height) {
+		return validates
+	}
+	res := make([]*cons_base.CandidateInfo, 0, len(validates))
+	for _, v := range validates {
+		val, err := xpoa.utxoVM.GetFromTable(nil, []byte(cons_base.GenSlashKey(v.Address)))
+		if err == nil {
+			record := &cons_base.SlashRecord{}
+			if json.Unmarshal(val, record) == nil && record.Height <= height {
+				xpoa.lg.Debug("xpoa filter slashed validator", "address", v.Address, "slashHeight", record.Height)
+				continue
+			}
+		}
+		res = append(res, v)
+	}
+	if len(res) == 0 {
+		return validates
+	}
+	return res
+}
+
+// Slash is the specific implementation of SlashableInterface,
+// the offender is removed from validates 3 blocks later which follows the rule of validates changing,
+// xpoa validators have no deposit to freeze
+func (xpoa *XPoa) Slash(record *cons_base.SlashRecord) error {
+	validates, err := xpoa.getValidatesByHeight(record.Height - 3)
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, v := range validates {
+		if v.Address == record.Offender {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return ErrNotValidator
+	}
+	if len(validates) <= 1 {
+		return ErrLastValidator
+	}
+	xpoa.lg.Info("xpoa slash validator", "offender", record.Offender, "height", record.Height)
+	return nil
+}
+
+// BlockSlot is the specific implementation of SlashableInterface, the slot is the turn of proposer
+func (xpoa *XPoa) BlockSlot(block *pb.InternalBlock) int64 {
+	return block.GetTimestamp() / (xpoa.xpoaConf.period * xpoa.xpoaConf.blockNum)
+}
+
+// RollbackSlash is the specific implementation of SlashableInterface,
+// nothing to do since the slash record is removed by PluggableConsensus
+func (xpoa *XPoa) RollbackSlash(record *cons_base.SlashRecord) error {
+	return nil
+}
@@ -45,6 +45,14 @@ type XPoa struct {
 	bftPaceMaker bft.PacemakerInterface
 	// 变更候选人生效高度，0表示当前立即生效，1表示下个区块生效，以此类推
 	effectiveDelay int64
+	// chained-bft起始高度, 继承旧共识的chained-bft时沿用旧共识的起始高度
+	bftStartHeight int64
+	// 共识切换时从旧共识继承的状态, 为空表示不继承
+	switchState *cons_base.SwitchState
+	// 轻节点模式下不执行交易, 验证集合从区块头中公布的变更获取
+	lightMode bool
+	// 从区块头中查找最近一次公布的验证集合
+	headerValidators *cons_base.HeaderValidators
 }
 
 // Config xpoa共识机制的配置
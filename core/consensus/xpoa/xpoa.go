@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"strconv"
 	"sync"
@@ -15,11 +16,12 @@ import (
 	log "github.com/xuperchain/log15"
 	"github.com/xuperchain/xuperchain/core/common"
 	"github.com/xuperchain/xuperchain/core/common/config"
+	"github.com/xuperchain/xuperchain/core/common/events"
 	"github.com/xuperchain/xuperchain/core/consensus/base"
 	cons_base "github.com/xuperchain/xuperchain/core/consensus/base"
 	bft "github.com/xuperchain/xuperchain/core/consensus/common/chainedbft"
 	bft_config "github.com/xuperchain/xuperchain/core/consensus/common/chainedbft/config"
-	"github.com/xuperchain/xuperchain/core/global"
+	bft_utils "github.com/xuperchain/xuperchain/core/consensus/common/chainedbft/utils"
 	"github.com/xuperchain/xuperchain/core/ledger"
 	p2p_base "github.com/xuperchain/xuperchain/core/p2p/base"
 	"github.com/xuperchain/xuperchain/core/pb"
@@ -74,12 +76,20 @@ func (xpoa *XPoa) Configure(xlog log.Logger, cfg *config.NodeConfig, consCfg map
 		return err
 	}
 
+	xpoa.lightMode = cfg.NodeMode == config.NodeModeLight
+	xpoa.headerValidators = cons_base.NewHeaderValidators(xpoa.ledger.QueryBlockHeader)
+
 	err = xpoa.buildXPoaConfig(consCfg)
 	if err != nil {
 		xpoa.lg.Warn("xpoa buildXPoaConfig error", "error", err.Error())
 		return err
 	}
 
+	xpoa.bftStartHeight = xpoa.startHeight
+	if xpoa.enableBFT && xpoa.switchState != nil && xpoa.switchState.EnableBFT {
+		xpoa.bftStartHeight = xpoa.switchState.BFTStartHeight
+	}
+
 	cryptoClient, ok := extParams["crypto_client"].(crypto_base.CryptoClient)
 	if !ok {
 		return errors.New("invalid type of crypto_client")
@@ -128,6 +138,9 @@ func (xpoa *XPoa) buildExtParams(extParams map[string]interface{}) error {
 	} else {
 		return errors.New("invalid type of timestamp")
 	}
+	if switchState, ok := extParams["switch_state"].(*cons_base.SwitchState); ok {
+		xpoa.switchState = switchState
+	}
 	return nil
 }
 
@@ -208,6 +221,10 @@ func (xpoa *XPoa) buildXPoaConfig(consCfg map[string]interface{}) error {
 			}
 			proposer.Address = p["address"].(string)
 			proposer.PeerAddr = p["neturl"].(string)
+			// bls_public_key is optional, it's needed while votes of chained-bft are signed with BLS
+			if blsPublicKey, ok := p["bls_public_key"].(string); ok {
+				proposer.BlsPublicKey = blsPublicKey
+			}
 			xpoa.xpoaConf.initProposers = append(xpoa.xpoaConf.initProposers, proposer)
 		}
 	} else {
@@ -216,10 +233,10 @@ func (xpoa *XPoa) buildXPoaConfig(consCfg map[string]interface{}) error {
 
 	// bft enable
 	xpoa.enableBFT = false
-	if _, ok := consCfg["bft_config"].(map[string]interface{}); ok {
+	if bftConfData, ok := consCfg["bft_config"].(map[string]interface{}); ok {
 		// if bft_config is not empty, enable bft
 		xpoa.enableBFT = true
-		xpoa.xpoaConf.bftConfig = &bft_config.Config{}
+		xpoa.xpoaConf.bftConfig = bft_config.MakeConfig(bftConfData)
 	}
 	return nil
 }
@@ -266,6 +283,10 @@ func (xpoa *XPoa) initBFT(cfg *config.NodeConfig, cryptoClient crypto_base.Crypt
 			ViewNumber: block.GetHeight(),
 		}
 		qc[0] = block.GetJustify()
+		// 继承旧共识的QC链, 保证切换后的第一个块仍能携带有效的Justify
+		if xpoa.switchState.QCMatchTip(blockid) {
+			qc[2], qc[1], qc[0] = xpoa.switchState.ProposalQC, xpoa.switchState.GenerateQC, xpoa.switchState.LockedQC
+		}
 	}
 
 	cbft, err := bft.NewChainedBft(
@@ -287,7 +308,7 @@ func (xpoa *XPoa) initBFT(cfg *config.NodeConfig, cryptoClient crypto_base.Crypt
 		return err
 	}
 
-	paceMaker, err := bft.NewDefaultPaceMaker(xpoa.bcname, xpoa.startHeight, meta.TrunkHeight,
+	paceMaker, err := bft.NewDefaultPaceMaker(xpoa.bcname, xpoa.bftStartHeight, meta.TrunkHeight,
 		string(xpoa.address), cbft, xpoa.lg, xpoa, xpoa.ledger)
 	if err != nil {
 		if err != nil {
@@ -297,7 +318,16 @@ func (xpoa *XPoa) initBFT(cfg *config.NodeConfig, cryptoClient crypto_base.Crypt
 	}
 
 	xpoa.bftPaceMaker = paceMaker
-	bridge.SetPaceMaker(paceMaker)
+	if xpoa.xpoaConf.bftConfig.Pacemaker == bft_config.PacemakerHotstuff {
+		hotstuffPaceMaker, err := bft.NewHotstuffPaceMaker(paceMaker, xpoa.xpoaConf.bftConfig)
+		if err != nil {
+			xpoa.lg.Warn("initBFT: create HotstuffPaceMaker failed", "error", err)
+			return err
+		}
+		hotstuffPaceMaker.SetProposerElection(xpoa.electViewProposer)
+		xpoa.bftPaceMaker = hotstuffPaceMaker
+	}
+	bridge.SetPaceMaker(xpoa.bftPaceMaker)
 	return xpoa.bftPaceMaker.Start()
 }
 
@@ -406,29 +436,64 @@ func (xpoa *XPoa) updateValidates(height int64) (bool, error) {
 		return true, nil
 	}
 
-	block, err := xpoa.ledger.QueryBlockByHeight(height)
-	if err != nil {
-		xpoa.lg.Error("xpoa.getCurrentValidates", "getBlock", err)
-		return false, err
-	}
-	validates, ok, err := xpoa.getValidatesByBlockId(block.GetBlockid())
-	if err != nil {
-		return ok, err
+	var validates []*cons_base.CandidateInfo
+	if xpoa.lightMode {
+		var err error
+		if validates, err = xpoa.getValidatesLight(height); err != nil {
+			xpoa.lg.Error("xpoa.getValidatesLight", "height", height, "error", err)
+			return false, err
+		}
+	} else {
+		block, err := xpoa.ledger.QueryBlockByHeight(height)
+		if err != nil {
+			xpoa.lg.Error("xpoa.getCurrentValidates", "getBlock", err)
+			return false, err
+		}
+		var ok bool
+		validates, ok, err = xpoa.getValidatesByBlockId(block.GetBlockid())
+		if err != nil {
+			return ok, err
+		}
+		if validates != nil {
+			validates = xpoa.filterSlashed(validates, height)
+		}
 	}
 	if validates != nil && !base.CandidateInfoEqual(xpoa.proposerInfos, validates) {
 		err := xpoa.bftPaceMaker.UpdateValidatorSet(validates)
 		if err != nil {
 			return false, ErrUpdateValidates
 		}
+		curMiners := xpoa.GetCoreMiners()
 		xpoa.proposerInfos = validates
-		xpoa.lg.Debug("Xpoa updateValidates Successfully", "base on", global.F(block.GetBlockid()))
+		xpoa.lg.Debug("Xpoa updateValidates Successfully", "height", height)
 		for _, v := range xpoa.proposerInfos {
 			xpoa.lg.Debug("updateValidates", "curValidates", v.Address)
 		}
+		xpoa.triggerValidatorsChanged(curMiners)
 	}
 	return true, nil
 }
 
+// triggerValidatorsChanged triggers a ValidatorsChanged event
+func (xpoa *XPoa) triggerValidatorsChanged(curMiners []*cons_base.MinerInfo) {
+	em := &events.EventMessage{
+		BcName:   xpoa.bcname,
+		Type:     events.ValidatorsChanged,
+		Priority: 0,
+		Sender:   xpoa,
+		Message: &cons_base.MinersChangedEvent{
+			BcName:        xpoa.bcname,
+			CurrentMiners: curMiners,
+			NextMiners:    xpoa.GetCoreMiners(),
+		},
+	}
+	eb := events.GetEventBus()
+	_, err := eb.FireEventAsync(em)
+	if err != nil {
+		xpoa.lg.Warn("triggerValidatorsChanged fire event failed", "error", err)
+	}
+}
+
 // minerScheduling return current term, pos, blockPos from last changed
 func (xpoa *XPoa) minerScheduling(timestamp int64, length int64) (term int64, pos int64, blockPos int64) {
 	// 每一轮的时间
@@ -455,6 +520,81 @@ func (xpoa *XPoa) getProposer(nextTime int64, proposerInfos []*cons_base.Candida
 	return proposerInfos[pos], nil
 }
 
+// electViewProposer is the ProposerElection of hotstuff pacemaker, view is entered by the TimeoutCert of view-1
+func (xpoa *XPoa) electViewProposer(view int64) (string, error) {
+	tipBlock, err := xpoa.ledger.QueryBlock(xpoa.ledger.GetMeta().GetTipBlockid())
+	if err != nil {
+		return "", err
+	}
+	return xpoa.viewProposer(tipBlock, view-1)
+}
+
+// viewProposer return the proposer rotated by the TimeoutCert of tcView on preBlock, the proposer is rotated
+// from the one scheduled at the timestamp of preBlock in the order of validates of the next block
+func (xpoa *XPoa) viewProposer(preBlock *pb.InternalBlock, tcView int64) (string, error) {
+	rounds, err := bft_utils.TimeoutRounds(tcView, preBlock)
+	if err != nil {
+		return "", err
+	}
+	var proposers []*cons_base.CandidateInfo
+	if xpoa.lightMode {
+		proposers, err = xpoa.getValidatesLight(preBlock.GetHeight() + 1 - 3)
+	} else {
+		proposers, err = xpoa.getValidatesByHeight(preBlock.GetHeight() + 1 - 3)
+	}
+	if err != nil {
+		return "", err
+	}
+	if len(proposers) == 0 {
+		return "", errors.New("xpoa proposer infos is empty")
+	}
+	_, pos, _ := xpoa.minerScheduling(preBlock.GetTimestamp(), int64(len(proposers)))
+	return proposers[(pos+rounds)%int64(len(proposers))].Address, nil
+}
+
+// rotatedTimeoutCert return the highest TimeoutCert if this node is the proposer rotated by it
+func (xpoa *XPoa) rotatedTimeoutCert() *pb.TimeoutCert {
+	if !xpoa.enableBFT {
+		return nil
+	}
+	tc := xpoa.bftPaceMaker.GetChainedBFT().GetHighTC()
+	if tc == nil {
+		return nil
+	}
+	tipBlock, err := xpoa.ledger.QueryBlock(xpoa.ledger.GetMeta().GetTipBlockid())
+	if err != nil {
+		return nil
+	}
+	proposer, err := xpoa.viewProposer(tipBlock, tc.GetViewNumber())
+	if err != nil || proposer != xpoa.address {
+		return nil
+	}
+	return tc
+}
+
+// checkRotatedProposer check the block proposed by the proposer rotated by the TimeoutCert it carries
+func (xpoa *XPoa) checkRotatedProposer(in *pb.InternalBlock) error {
+	if !xpoa.enableBFT {
+		return errors.New("timeout cert without bft")
+	}
+	tc := in.GetTimeoutCert()
+	if ok, err := xpoa.bftPaceMaker.GetChainedBFT().IsTimeoutCertValidate(tc); !ok {
+		return fmt.Errorf("invalid timeout cert, err=%v", err)
+	}
+	preBlock, err := xpoa.ledger.QueryBlock(in.GetPreHash())
+	if err != nil {
+		return err
+	}
+	proposer, err := xpoa.viewProposer(preBlock, tc.GetViewNumber())
+	if err != nil {
+		return err
+	}
+	if string(in.GetProposer()) != proposer {
+		return fmt.Errorf("proposer mismatch, expect %s", proposer)
+	}
+	return nil
+}
+
 // getProposerWithTime get proposer with timestamp
 // 注意：这里的time需要是一个同步的时间戳
 func (xpoa *XPoa) getProposerWithTime(timestamp int64, height int64) (string, error) {
@@ -508,6 +648,19 @@ func (xpoa *XPoa) CheckMinerMatch(header *pb.Header, in *pb.InternalBlock) (bool
 		return ok, err
 	}
 
+	// 区块头中公布的验证集合变更, 全节点依据前一个区块重新计算并校验
+	if len(in.GetNextValidators()) > 0 && !xpoa.ledger.StateRootEnabled() {
+		xpoa.lg.Warn("CheckMinerMatch unexpected next validators", "logid", header.Logid)
+		return false, nil
+	}
+	if xpoa.ledger.StateRootEnabled() && !xpoa.lightMode {
+		expect, err := xpoa.makeNextValidators(in.GetPreHash())
+		if err != nil || !bytes.Equal(expect, in.GetNextValidators()) {
+			xpoa.lg.Warn("CheckMinerMatch next validators mismatch", "logid", header.Logid, "error", err)
+			return false, nil
+		}
+	}
+
 	// 验证矿工身份
 	// get current validates from model
 	proposer, err := xpoa.getProposerWithTime(in.GetTimestamp(), in.GetHeight())
@@ -526,6 +679,14 @@ func (xpoa *XPoa) CheckMinerMatch(header *pb.Header, in *pb.InternalBlock) (bool
 			return false, nil
 		}
 	}
+	// 携带超时证书的区块由超时后轮换的出块人产出, 不再校验出块时间
+	if in.GetTimeoutCert() != nil {
+		if err := xpoa.checkRotatedProposer(in); err != nil {
+			xpoa.lg.Warn("CheckMinerMatch check rotated proposer error", "logid", header.Logid, "error", err)
+			return false, nil
+		}
+		return true, nil
+	}
 	return bytes.Equal(in.GetProposer(), []byte(proposer)), nil
 }
 
@@ -543,10 +704,12 @@ func (xpoa *XPoa) ProcessBeforeMiner(timestamp int64) (map[string]interface{}, b
 
 	res := make(map[string]interface{})
 	_, pos, blockPos := xpoa.minerScheduling(timestamp, int64(len(xpoa.proposerInfos)))
-	if blockPos > xpoa.xpoaConf.blockNum || int(pos) >= len(xpoa.proposerInfos) {
+	// 本节点被超时证书轮换为出块人时, 代替超时的出块人出块
+	if tc := xpoa.rotatedTimeoutCert(); tc != nil {
+		res["timeout_cert"] = tc
+	} else if blockPos > xpoa.xpoaConf.blockNum || int(pos) >= len(xpoa.proposerInfos) {
 		return res, false
-	}
-	if !xpoa.isProposer(pos, xpoa.address) {
+	} else if !xpoa.isProposer(pos, xpoa.address) {
 		xpoa.lg.Warn("ProcessBeforeMiner prepare too long, omit!")
 		return nil, false
 	}
@@ -556,7 +719,7 @@ func (xpoa *XPoa) ProcessBeforeMiner(timestamp int64) (map[string]interface{}, b
 			if ok, _ := xpoa.bftPaceMaker.IsLastViewConfirmed(); !ok {
 				if len(xpoa.proposerInfos) == 1 {
 					res["quorum_cert"] = nil
-					return res, true
+					return res, xpoa.setNextValidators(res)
 				}
 				xpoa.lg.Warn("ProcessBeforeMiner last block not confirmed, walk to previous block")
 				lastBlockid := xpoa.ledger.GetMeta().GetTipBlockid()
@@ -583,10 +746,29 @@ func (xpoa *XPoa) ProcessBeforeMiner(timestamp int64) (map[string]interface{}, b
 			res["quorum_cert"] = qc
 		}
 	}
+	if !xpoa.setNextValidators(res) {
+		return nil, false
+	}
 	xpoa.lg.Trace("ProcessBeforeMiner", "res", res)
 	return res, true
 }
 
+// setNextValidators 在ProcessBeforeMiner结果中设置待出区块需要公布的验证集合变更
+func (xpoa *XPoa) setNextValidators(res map[string]interface{}) bool {
+	if !xpoa.ledger.StateRootEnabled() {
+		return true
+	}
+	nextValidators, err := xpoa.makeNextValidators(xpoa.ledger.GetMeta().GetTipBlockid())
+	if err != nil {
+		xpoa.lg.Warn("ProcessBeforeMiner make next validators failed", "error", err)
+		return false
+	}
+	if nextValidators != nil {
+		res["next_validators"] = nextValidators
+	}
+	return true
+}
+
 func (xpoa *XPoa) checkNextProposerChanged(height int64) ([]*cons_base.CandidateInfo, bool) {
 	validateBlock, err := xpoa.ledger.QueryBlockByHeight(height)
 	if err != nil {
@@ -737,7 +919,25 @@ func (xpoa *XPoa) GetStatus() *cons_base.ConsensusStatus {
 
 // isFirstBlock return whether is the first after validates has changed
 func (xpoa *XPoa) isFirstBlock(BlockHeight int64) bool {
-	xpoa.lg.Debug("isFirstBlock check", "consStartHeight", xpoa.startHeight+1,
+	xpoa.lg.Debug("isFirstBlock check", "consStartHeight", xpoa.bftStartHeight+1,
 		"targetHeight", BlockHeight)
-	return xpoa.startHeight+1 == BlockHeight
+	return xpoa.bftStartHeight+1 == BlockHeight
+}
+
+// GetSwitchState is the specific implementation of SwitchableInterface,
+// validators follow the same rule as CompeteMaster which is based on block of height-3
+func (xpoa *XPoa) GetSwitchState(height int64) (*cons_base.SwitchState, error) {
+	validators, err := xpoa.getValidatesByHeight(height - 3)
+	if err != nil {
+		return nil, err
+	}
+	state := &cons_base.SwitchState{
+		Validators: validators,
+		EnableBFT:  xpoa.enableBFT,
+	}
+	if xpoa.enableBFT {
+		state.BFTStartHeight = xpoa.bftStartHeight
+		state.ProposalQC, state.GenerateQC, state.LockedQC = xpoa.bftPaceMaker.GetChainedBFT().GetQcStatus()
+	}
+	return state, nil
 }
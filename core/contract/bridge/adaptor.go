@@ -31,6 +31,14 @@ type vmContextImpl struct {
 }
 
 func (v *vmContextImpl) Invoke(method string, args map[string][]byte) (*contract.Response, error) {
+	resp, err := v.invoke(method, args)
+	if v.ctx.Trace != nil {
+		v.ctx.traceInvoke(method, args, resp, err)
+	}
+	return resp, err
+}
+
+func (v *vmContextImpl) invoke(method string, args map[string][]byte) (*contract.Response, error) {
 	if !v.ctx.CanInitialize && method == initMethod {
 		return nil, errors.New("invalid contract method " + method)
 	}
@@ -113,6 +121,8 @@ func (v *vmImpl) NewContext(ctxCfg *contract.ContextConfig) (contract.Context, e
 	ctx.Initiator = ctxCfg.Initiator
 	ctx.AuthRequire = ctxCfg.AuthRequire
 	ctx.ResourceLimits = ctxCfg.ResourceLimits
+	ctx.EvmResourceLimits = ctxCfg.EvmResourceLimits
+	ctx.EthGateway = ctxCfg.EthGateway
 	ctx.CanInitialize = ctxCfg.CanInitialize
 	ctx.Core = ctxCfg.Core
 	ctx.TransferAmount = ctxCfg.TransferAmount
@@ -122,6 +132,13 @@ func (v *vmImpl) NewContext(ctxCfg *contract.ContextConfig) (contract.Context, e
 		ctx.ContractSet[ctx.ContractName] = true
 	}
 	ctx.Logger = v.xbridge.debugLogger.New("contract", ctx.ContractName, "ctxid", ctx.ID)
+	if ctxCfg.Trace != nil {
+		ctx.Trace = ctxCfg.Trace
+		ctx.Trace.Module = v.name
+		ctx.Trace.Contract = ctxCfg.ContractName
+		ctx.Trace.TransferAmount = ctxCfg.TransferAmount
+		ctx.Trace.ResourceLimits = ctxCfg.ResourceLimits
+	}
 	release := func() {
 		v.ctxmgr.DestroyContext(ctx)
 	}
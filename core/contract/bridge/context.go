@@ -51,6 +51,15 @@ type Context struct {
 
 	// Write by contract
 	Output *pb.Response
+
+	// Trace records the call if the context is being traced
+	Trace *contract.CallTrace
+
+	// EvmResourceLimits whether the gas, memory and disk of EVM contracts are limited by ResourceLimits
+	EvmResourceLimits bool
+
+	// EthGateway whether the EVM events not defined in abi are saved as raw logs
+	EthGateway bool
 }
 
 // DiskUsed returns the bytes written to xmodel
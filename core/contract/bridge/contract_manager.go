@@ -61,6 +61,10 @@ func (c *contractManager) DeployContract(contextConfig *contract.ContextConfig,
 		abiBuf := args["contract_abi"]
 		store.Put("contract", contractAbiKey(contractName), abiBuf)
 	}
+	err = putContractEvents(contextConfig, contractName, &desc, args)
+	if err != nil {
+		return nil, contract.Limits{}, err
+	}
 
 	contractType, err := getContractType(&desc)
 	if err != nil {
@@ -76,6 +80,9 @@ func (c *contractManager) DeployContract(contextConfig *contract.ContextConfig,
 		ContractName:   contractName,
 		Method:         "initialize",
 		ResourceLimits: contextConfig.ResourceLimits,
+
+		EvmResourceLimits: contextConfig.EvmResourceLimits,
+		EthGateway:        contextConfig.EthGateway,
 	}, cp)
 	if err != nil {
 		creator.RemoveCache(contractName)
@@ -142,6 +149,10 @@ func (c *contractManager) UpgradeContract(contextConfig *contract.ContextConfig,
 	store := contextConfig.XMCache
 	store.Put("contract", ContractCodeDescKey(contractName), descbuf)
 	store.Put("contract", contractCodeKey(contractName), code)
+	err = putContractEvents(contextConfig, contractName, desc, args)
+	if err != nil {
+		return nil, contract.Limits{}, err
+	}
 
 	cp := newCodeProvider(store)
 
@@ -171,6 +182,22 @@ func (c *contractManager) UpgradeContract(contextConfig *contract.ContextConfig,
 		}, nil
 }
 
+// putContractEvents saves the indexed keys of events declared by non evm contract in contract_events,
+// the declaration is replaced when upgrading with it. contract_events is ignored before the
+// contract_events fork, like the nodes before it do
+func putContractEvents(contextConfig *contract.ContextConfig, contractName string, desc *pb.WasmCodeDesc, args map[string][]byte) error {
+	eventsBuf := args["contract_events"]
+	if !contextConfig.ContractEvents || desc.ContractType == string(TypeEvm) || len(eventsBuf) == 0 {
+		return nil
+	}
+	// 格式为 {"事件名": ["字段名", ...]}
+	var events map[string][]string
+	if err := json.Unmarshal(eventsBuf, &events); err != nil {
+		return fmt.Errorf("bad contract events:%s", err)
+	}
+	return contextConfig.XMCache.Put("contract", contractEventsKey(contractName), eventsBuf)
+}
+
 func modelCacheDiskUsed(cache *xmodel.XMCache) int64 {
 	size := int64(0)
 	_, wset, _ := cache.GetRWSets()
@@ -193,6 +220,10 @@ func contractAbiKey(contractName string) []byte {
 	return []byte(contractName + "." + "abi")
 }
 
+func contractEventsKey(contractName string) []byte {
+	return []byte(contractName + "." + "events")
+}
+
 func getContractType(desc *pb.WasmCodeDesc) (ContractType, error) {
 	switch desc.ContractType {
 	case "", "wasm":
@@ -39,6 +39,17 @@ func TestContractAbiKey(t *testing.T) {
 	}
 }
 
+func TestContractEventsKey(t *testing.T) {
+	contractName := "test1234"
+	contractEventsKeyStr := "test1234.events"
+
+	contractEventsKeyBytes := contractEventsKey(contractName)
+
+	if string(contractEventsKeyBytes) != contractEventsKeyStr {
+		t.Errorf("expect %s got %s", contractEventsKeyStr, string(contractEventsKeyBytes))
+	}
+}
+
 func TestGetContractType(t *testing.T) {
 	descpb := new(pb.WasmCodeDesc)
 	descpb.ContractType = string(TypeEvm)
@@ -133,6 +133,11 @@ func (c *SyscallService) Transfer(ctx context.Context, in *pb.TransferRequest) (
 		return nil, errors.New("empty to address")
 	}
 	err := nctx.Cache.Transfer(nctx.ContractName, in.GetTo(), amount)
+	nctx.TraceSyscall(&contract.SyscallTrace{
+		Name:   "Transfer",
+		To:     in.GetTo(),
+		Amount: in.GetAmount(),
+	}, err)
 	if err != nil {
 		return nil, err
 	}
@@ -146,6 +151,24 @@ func (c *SyscallService) ContractCall(ctx context.Context, in *pb.ContractCallRe
 	if !ok {
 		return nil, fmt.Errorf("bad ctx id:%d", in.Header.Ctxid)
 	}
+	if nctx.Trace == nil {
+		return c.contractCall(nctx, in, nil)
+	}
+	// 被调用合约的执行过程记录在该系统调用中
+	trace := &contract.CallTrace{
+		Module:   in.GetModule(),
+		Contract: in.GetContract(),
+		Method:   in.GetMethod(),
+	}
+	resp, err := c.contractCall(nctx, in, trace)
+	nctx.TraceSyscall(&contract.SyscallTrace{
+		Name: "ContractCall",
+		Call: trace,
+	}, err)
+	return resp, err
+}
+
+func (c *SyscallService) contractCall(nctx *Context, in *pb.ContractCallRequest, trace *contract.CallTrace) (*pb.ContractCallResponse, error) {
 	if nctx.ContractSet[in.GetContract()] {
 		return nil, errors.New("recursive contract call not permitted")
 	}
@@ -183,6 +206,10 @@ func (c *SyscallService) ContractCall(ctx context.Context, in *pb.ContractCallRe
 		Core:           nctx.Core,
 		ResourceLimits: *limits,
 		ContractSet:    nctx.ContractSet,
+		Trace:          trace,
+
+		EvmResourceLimits: nctx.EvmResourceLimits,
+		EthGateway:        nctx.EthGateway,
 	}
 	vctx, err := vm.NewContext(cfg)
 	if err != nil {
@@ -234,6 +261,11 @@ func (c *SyscallService) CrossContractQuery(ctx context.Context, in *pb.CrossCon
 
 	// CrossQuery cross query from other chain
 	contractResponse, err := nctx.Cache.CrossQuery(crossQueryRequest, crossQueryMeta)
+	nctx.TraceSyscall(&contract.SyscallTrace{
+		Name:  "CrossContractQuery",
+		URI:   in.GetUri(),
+		Value: contractResponse.GetBody(),
+	}, err)
 	return &pb.CrossContractQueryResponse{
 		Response: &pb.Response{
 			Status:  contractResponse.GetStatus(),
@@ -254,6 +286,12 @@ func (c *SyscallService) PutObject(ctx context.Context, in *pb.PutRequest) (*pb.
 	}
 
 	err := nctx.Cache.Put(nctx.ContractName, in.Key, in.Value)
+	nctx.TraceSyscall(&contract.SyscallTrace{
+		Name:   "PutObject",
+		Bucket: nctx.ContractName,
+		Key:    in.Key,
+		Value:  in.Value,
+	}, err)
 	if err != nil {
 		return nil, err
 	}
@@ -269,6 +307,12 @@ func (c *SyscallService) GetObject(ctx context.Context, in *pb.GetRequest) (*pb.
 	}
 
 	value, err := nctx.Cache.Get(nctx.ContractName, in.Key)
+	nctx.TraceSyscall(&contract.SyscallTrace{
+		Name:   "GetObject",
+		Bucket: nctx.ContractName,
+		Key:    in.Key,
+		Value:  value.GetPureData().GetValue(),
+	}, err)
 	if err != nil {
 		return nil, err
 	}
@@ -285,6 +329,11 @@ func (c *SyscallService) DeleteObject(ctx context.Context, in *pb.DeleteRequest)
 	}
 
 	err := nctx.Cache.Del(nctx.ContractName, in.Key)
+	nctx.TraceSyscall(&contract.SyscallTrace{
+		Name:   "DeleteObject",
+		Bucket: nctx.ContractName,
+		Key:    in.Key,
+	}, err)
 	if err != nil {
 		return nil, err
 	}
@@ -394,6 +443,11 @@ func (c *SyscallService) EmitEvent(ctx context.Context, in *pb.EmitEventRequest)
 	}
 	nctx.Events = append(nctx.Events, event)
 	nctx.Cache.AddEvent(event)
+	nctx.TraceSyscall(&contract.SyscallTrace{
+		Name:  "EmitEvent",
+		Event: in.GetName(),
+		Value: in.GetBody(),
+	}, nil)
 	return &pb.EmitEventResponse{}, nil
 }
 
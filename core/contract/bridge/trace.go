@@ -0,0 +1,33 @@
+package bridge
+
+import (
+	"github.com/xuperchain/xuperchain/core/contract"
+)
+
+// TraceSyscall records the syscall made by contract if the context is being traced
+func (c *Context) TraceSyscall(trace *contract.SyscallTrace, err error) {
+	if c.Trace == nil {
+		return
+	}
+	if err != nil {
+		trace.Error = err.Error()
+	}
+	c.Trace.Syscalls = append(c.Trace.Syscalls, trace)
+}
+
+// traceInvoke records the args and the result of contract call
+func (c *Context) traceInvoke(method string, args map[string][]byte, resp *contract.Response, err error) {
+	c.Trace.Method = method
+	c.Trace.Args = make(map[string]contract.TraceBytes, len(args))
+	for k, v := range args {
+		c.Trace.Args[k] = v
+	}
+	c.Trace.ResourceUsed = c.ResourceUsed()
+	if err != nil {
+		c.Trace.Error = err.Error()
+		return
+	}
+	c.Trace.Status = resp.Status
+	c.Trace.Message = resp.Message
+	c.Trace.Body = resp.Body
+}
@@ -1,6 +1,7 @@
 package abi
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/hyperledger/burrow/execution/evm/abi"
@@ -64,6 +65,46 @@ func (a *ABI) encodeMethod(method *abi.FunctionSpec, args map[string]interface{}
 	return out, err
 }
 
+// EventArg is a decoded argument of contract event
+type EventArg struct {
+	Name    string
+	Type    string
+	Indexed bool
+	Value   string
+}
+
+// DecodeEvent decodes the body of event emitted by evm contract.
+// The body is the json array of event arguments in the order of ABI inputs,
+// strings are returned as is, other values are returned as json text.
+func (a *ABI) DecodeEvent(eventName string, body []byte) ([]EventArg, error) {
+	event, ok := a.spec.EventsByName[eventName]
+	if !ok {
+		return nil, fmt.Errorf("event %s not found", eventName)
+	}
+	var values []json.RawMessage
+	if err := json.Unmarshal(body, &values); err != nil {
+		return nil, err
+	}
+	if len(values) != len(event.Inputs) {
+		return nil, fmt.Errorf("event %s expect %d args, got %d", eventName, len(event.Inputs), len(values))
+	}
+
+	args := make([]EventArg, 0, len(values))
+	for i, input := range event.Inputs {
+		var value string
+		if err := json.Unmarshal(values[i], &value); err != nil {
+			value = string(values[i])
+		}
+		args = append(args, EventArg{
+			Name:    input.Name,
+			Type:    input.EVM.GetSignature(),
+			Indexed: input.Indexed,
+			Value:   value,
+		})
+	}
+	return args, nil
+}
+
 //func decodeHex(str string) []byte {
 //	var buf []byte
 //	n, err := fmt.Sscanf(str, "0x%x", &buf)
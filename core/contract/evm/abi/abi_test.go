@@ -37,3 +37,35 @@ func TestNewAbi(t *testing.T) {
 	// [0 2 103 164]
 	fmt.Printf("%v\n", input2)
 }
+
+func TestDecodeEvent(t *testing.T) {
+	abiBuf := []byte(`[{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},` +
+		`{"indexed":false,"name":"value","type":"uint256"},{"indexed":false,"name":"memo","type":"string"}],` +
+		`"name":"Transfer","type":"event"}]`)
+	enc, err := New(abiBuf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	args, err := enc.DecodeEvent("Transfer", []byte(`["B4E4AC2A2DF1FC1BD2D9D6B4AE44B0F5F6C6E6C0",100,"hello"]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := []EventArg{
+		{Name: "from", Type: "address", Indexed: true, Value: "B4E4AC2A2DF1FC1BD2D9D6B4AE44B0F5F6C6E6C0"},
+		{Name: "value", Type: "uint256", Value: "100"},
+		{Name: "memo", Type: "string", Value: "hello"},
+	}
+	for i := range expect {
+		if args[i] != expect[i] {
+			t.Fatalf("arg %d expect %v got %v", i, expect[i], args[i])
+		}
+	}
+
+	if _, err := enc.DecodeEvent("Approval", []byte(`[]`)); err == nil {
+		t.Fatal("expect error for unknown event")
+	}
+	if _, err := enc.DecodeEvent("Transfer", []byte(`[100]`)); err == nil {
+		t.Fatal("expect error for bad args")
+	}
+}
@@ -28,29 +28,31 @@ const (
 )
 
 type evmCreator struct {
-	vm *evm.EVM
 }
 
 func newEvmCreator(config *bridge.InstanceCreatorConfig) (bridge.InstanceCreator, error) {
-	opt := evm.Options{}
-	opt.DebugOpcodes = true
-	vm := evm.New(opt)
-	return &evmCreator{
-		vm: vm,
-	}, nil
+	return &evmCreator{}, nil
 }
 
 // CreateInstance instances an evm virtual machine instance which can run a single contract call
 func (e *evmCreator) CreateInstance(ctx *bridge.Context, cp bridge.ContractCodeProvider) (bridge.Instance, error) {
 	state := newStateManager(ctx)
 	blockState := newBlockStateManager(ctx)
-	return &evmInstance{
-		vm:         e.vm,
+	instance := &evmInstance{
 		ctx:        ctx,
 		state:      state,
 		blockState: blockState,
 		cp:         cp,
-	}, nil
+	}
+	// 每个实例使用独立的虚拟机, 以便按实例统计内存的使用
+	opt := evm.Options{}
+	opt.DebugOpcodes = true
+	// 内存只在evm_resource分叉之后计费, 之前的交易没有内存限制
+	if ctx.EvmResourceLimits {
+		opt.MemoryProvider = instance.newMemory
+	}
+	instance.vm = evm.New(opt)
+	return instance, nil
 }
 
 func (e *evmCreator) RemoveCache(name string) {
@@ -65,6 +67,7 @@ type evmInstance struct {
 	code       []byte
 	abi        []byte
 	gasUsed    uint64
+	memoryUsed uint64
 }
 
 func (e *evmInstance) Exec() error {
@@ -101,7 +104,7 @@ func (e *evmInstance) Exec() error {
 		return err
 	}
 
-	gas := uint64(contract.MaxLimits.Cpu)
+	gas := e.gasLimit()
 
 	// 如果客户端已经将参数进行了 abi 编码，那么此处不需要再进行编码，而且返回的结果也不需要 abi 解码。否则此处需要将参数 abi 编码同时将结果 abi 解码。
 	needDecodeResp := false
@@ -133,8 +136,9 @@ func (e *evmInstance) Exec() error {
 		Gas:      &gas,
 	}
 	out, err := e.vm.Execute(e.state, e.blockState, e, params, e.code)
+	e.gasUsed = e.gasLimit() - *params.Gas
 	if err != nil {
-		return err
+		return e.execError(err)
 	}
 
 	if needDecodeResp {
@@ -145,8 +149,6 @@ func (e *evmInstance) Exec() error {
 		}
 	}
 
-	e.gasUsed = uint64(contract.MaxLimits.Cpu) - *params.Gas
-
 	e.ctx.Output = &pb.Response{
 		Status: 200,
 		Body:   out,
@@ -155,8 +157,10 @@ func (e *evmInstance) Exec() error {
 }
 
 func (e *evmInstance) ResourceUsed() contract.Limits {
+	// 磁盘的使用由 bridge.Context 根据读写集统计
 	return contract.Limits{
-		Cpu: int64(e.gasUsed),
+		Cpu:    int64(e.gasUsed),
+		Memory: int64(e.memoryUsed),
 	}
 }
 
@@ -180,16 +184,22 @@ func (e *evmInstance) Log(log *exec.LogEvent) error {
 	if err != nil {
 		return err
 	}
-	event, err := unpackEventFromAbi(contractAbiByte, contractName, log)
+	event, err := unpackEventFromAbi(contractAbiByte, contractName, log, e.ctx.EthGateway)
 	if err != nil {
 		return err
 	}
 	e.ctx.Events = append(e.ctx.Events, event)
 	e.ctx.Cache.AddEvent(event)
+	e.ctx.TraceSyscall(&contract.SyscallTrace{
+		Name:  "EmitEvent",
+		Event: event.Name,
+		Value: event.Body,
+	}, nil)
 	return nil
 }
 
-func unpackEventFromAbi(abiByte []byte, contractName string, log *exec.LogEvent) (*xchainpb.ContractEvent, error) {
+// unpackEventFromAbi decode the log by abi, the events not defined in abi are saved as raw logs if rawLog is true
+func unpackEventFromAbi(abiByte []byte, contractName string, log *exec.LogEvent, rawLog bool) (*xchainpb.ContractEvent, error) {
 	var eventID abi.EventID
 	copy(eventID[:], log.GetTopic(0).Bytes())
 	spec, err := abi.ReadSpec(abiByte)
@@ -197,9 +207,13 @@ func unpackEventFromAbi(abiByte []byte, contractName string, log *exec.LogEvent)
 		return nil, err
 	}
 	eventSpec, ok := spec.EventsByID[eventID]
-	if !ok {
+	if !rawLog && !ok {
 		return nil, fmt.Errorf("The Event By ID Not Found ")
 	}
+	if !ok || len(log.Topics) == 0 {
+		// abi 中未定义的事件以原始日志的形式保存
+		return newRawLogEvent(contractName, log)
+	}
 	vals := abi.GetPackingTypes(eventSpec.Inputs)
 	if err := abi.UnpackEvent(eventSpec, log.Topics, log.Data, vals...); err != nil {
 		return nil, err
@@ -241,7 +255,7 @@ func (e *evmInstance) deployContract() error {
 		return err
 	}
 
-	gas := uint64(contract.MaxLimits.Cpu)
+	gas := e.gasLimit()
 
 	input := []byte{}
 	jsonEncoded, ok := e.ctx.Args[evmParamJSONEncoded]
@@ -265,8 +279,9 @@ func (e *evmInstance) deployContract() error {
 		Gas:      &gas,
 	}
 	contractCode, err := e.vm.Execute(e.state, e.blockState, e, params, input)
+	e.gasUsed = e.gasLimit() - *params.Gas
 	if err != nil {
-		return err
+		return e.execError(err)
 	}
 
 	key := evmCodeKey(e.ctx.ContractName)
@@ -275,8 +290,6 @@ func (e *evmInstance) deployContract() error {
 		return err
 	}
 
-	e.gasUsed = uint64(contract.MaxLimits.Cpu) - *params.Gas
-
 	e.ctx.Output = &pb.Response{
 		Status: 200,
 	}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/execution/evm/abi"
 	"github.com/hyperledger/burrow/execution/exec"
 
@@ -128,9 +129,34 @@ func TestUnpackEventFromAbi(t *testing.T) {
 	log.Topics = topics
 	log.Data = data
 
-	event, err := unpackEventFromAbi([]byte(abiJson), contractName, log)
+	event, err := unpackEventFromAbi([]byte(abiJson), contractName, log, false)
 	if err != nil {
 		t.Error(err)
 	}
 	fmt.Printf("%+v\n", event)
 }
+
+func TestUnpackRawLogEvent(t *testing.T) {
+	log := &exec.LogEvent{
+		Topics: []binary.Word256{binary.LeftPadWord256([]byte{1}), binary.LeftPadWord256([]byte{2})},
+		Data:   []byte{0xab, 0xcd},
+	}
+	// eth_gateway生效前abi中未定义的事件仍然报错
+	if _, err := unpackEventFromAbi([]byte("[]"), "contractName", log, false); err == nil {
+		t.Error("expect error unpacking event not in abi before eth_gateway fork")
+	}
+	event, err := unpackEventFromAbi([]byte("[]"), "contractName", log, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.Name != RawLogEventName || event.Contract != "contractName" {
+		t.Errorf("expect raw log event got %v", event)
+	}
+	rawLog, err := ParseRawLog(event)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rawLog.Topics) != 2 || rawLog.Topics[1] != hex.EncodeToString(log.Topics[1].Bytes()) || rawLog.Data != "abcd" {
+		t.Errorf("unexpected raw log %v", rawLog)
+	}
+}
@@ -0,0 +1,49 @@
+package evm
+
+import (
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/hyperledger/burrow/execution/exec"
+
+	xchainpb "github.com/xuperchain/xuperchain/core/pb"
+)
+
+// RawLogEventName is the name of contract event which keeps the raw evm log,
+// it's used for the events which are not defined in the abi of contract.
+// The name can not conflict with solidity events since `$` is not allowed in identifiers
+const RawLogEventName = "$log"
+
+// RawLog is the body of raw log event, topics and data are hex encoded
+type RawLog struct {
+	Topics []string `json:"topics"`
+	Data   string   `json:"data"`
+}
+
+func newRawLogEvent(contractName string, log *exec.LogEvent) (*xchainpb.ContractEvent, error) {
+	rawLog := &RawLog{
+		Topics: make([]string, 0, len(log.Topics)),
+		Data:   hex.EncodeToString(log.Data),
+	}
+	for _, topic := range log.Topics {
+		rawLog.Topics = append(rawLog.Topics, hex.EncodeToString(topic.Bytes()))
+	}
+	body, err := json.Marshal(rawLog)
+	if err != nil {
+		return nil, err
+	}
+	return &xchainpb.ContractEvent{
+		Contract: contractName,
+		Name:     RawLogEventName,
+		Body:     body,
+	}, nil
+}
+
+// ParseRawLog parse the body of raw log event
+func ParseRawLog(event *xchainpb.ContractEvent) (*RawLog, error) {
+	rawLog := new(RawLog)
+	if err := json.Unmarshal(event.GetBody(), rawLog); err != nil {
+		return nil, err
+	}
+	return rawLog, nil
+}
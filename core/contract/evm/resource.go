@@ -0,0 +1,116 @@
+package evm
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm"
+
+	"github.com/xuperchain/xuperchain/core/contract"
+	"github.com/xuperchain/xuperchain/core/contract/bridge"
+)
+
+const (
+	// evmWordSize 内存按照32字节的字扩展, 与以太坊内存扩展的计费方式一致
+	evmWordSize = 32
+	// outOfResourceStatus is the status of contract response while gas or memory is exhausted
+	outOfResourceStatus = 500
+)
+
+// gasLimit return the gas budget of the instance which comes from the cpu limit of invoke request,
+// the budget is contract.MaxLimits before the evm_resource fork
+func (e *evmInstance) gasLimit() uint64 {
+	if !e.ctx.EvmResourceLimits {
+		return uint64(contract.MaxLimits.Cpu)
+	}
+	if e.ctx.ResourceLimits.Cpu <= 0 {
+		return 0
+	}
+	return uint64(e.ctx.ResourceLimits.Cpu)
+}
+
+// newMemory is the memory provider of evm, every call frame gets a new memory
+// which charges the memory limit of the instance as it expands
+func (e *evmInstance) newMemory(errSink errors.Sink) evm.Memory {
+	return &meteredMemory{
+		Memory:   evm.DefaultDynamicMemoryProvider(errSink),
+		errSink:  errSink,
+		instance: e,
+	}
+}
+
+// useMemory charge size bytes of memory to the instance, nothing is charged if the limit is exceeded
+func (e *evmInstance) useMemory(size uint64) error {
+	limit := e.ctx.ResourceLimits.Memory
+	if limit < 0 || size > uint64(limit) || e.memoryUsed > uint64(limit)-size {
+		return errors.Errorf(errors.Codes.MemoryOutOfBounds, "out of memory limit, used %d, require %d, limit %d",
+			e.memoryUsed, size, limit)
+	}
+	e.memoryUsed += size
+	return nil
+}
+
+// execError convert the error of evm execution to contract error,
+// the partial resource used before the error is kept in instance
+func (e *evmInstance) execError(err error) error {
+	switch errors.GetCode(err) {
+	case errors.Codes.InsufficientGas:
+		return &bridge.ContractError{
+			Status:  outOfResourceStatus,
+			Message: fmt.Sprintf("out of gas, used %d, limit %d", e.gasUsed, e.gasLimit()),
+		}
+	case errors.Codes.MemoryOutOfBounds:
+		return &bridge.ContractError{
+			Status:  outOfResourceStatus,
+			Message: err.Error(),
+		}
+	}
+	return err
+}
+
+// meteredMemory records the active size of memory, which is the highest word touched by Read or Write.
+// The underlying memory is kept unchanged so that MSIZE is the same as before
+type meteredMemory struct {
+	evm.Memory
+	errSink  errors.Sink
+	instance *evmInstance
+	size     uint64
+}
+
+func (m *meteredMemory) Read(offset, length *big.Int) []byte {
+	if !m.expand(offset, length) {
+		return nil
+	}
+	return m.Memory.Read(offset, length)
+}
+
+func (m *meteredMemory) Write(offset *big.Int, value []byte) {
+	if !m.expand(offset, big.NewInt(int64(len(value)))) {
+		return
+	}
+	m.Memory.Write(offset, value)
+}
+
+// expand charge the memory expansion of accessing [offset, offset+length),
+// false is returned if the memory limit is exceeded
+func (m *meteredMemory) expand(offset, length *big.Int) bool {
+	if length.Sign() == 0 {
+		return true
+	}
+	end := new(big.Int).Add(offset, length)
+	if !end.IsUint64() || end.Uint64() > ^uint64(0)-evmWordSize {
+		// 越界的访问由底层内存报错
+		return true
+	}
+	size := (end.Uint64() + evmWordSize - 1) / evmWordSize * evmWordSize
+	if size <= m.size {
+		return true
+	}
+	if err := m.instance.useMemory(size - m.size); err != nil {
+		m.errSink.PushError(err)
+		return false
+	}
+	m.size = size
+	return true
+}
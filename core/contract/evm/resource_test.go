@@ -0,0 +1,146 @@
+package evm
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/hyperledger/burrow/execution/errors"
+
+	"github.com/xuperchain/xuperchain/core/contract"
+	"github.com/xuperchain/xuperchain/core/contract/bridge"
+	"github.com/xuperchain/xuperchain/core/pb"
+)
+
+type testErrSink struct {
+	err error
+}
+
+func (s *testErrSink) PushError(err error) bool {
+	if err != nil && s.err == nil {
+		s.err = err
+	}
+	return err != nil
+}
+
+func TestMeteredMemory(t *testing.T) {
+	instance := &evmInstance{
+		ctx: &bridge.Context{
+			ResourceLimits:    contract.Limits{Cpu: 100, Memory: 128},
+			EvmResourceLimits: true,
+		},
+	}
+	if instance.gasLimit() != 100 {
+		t.Errorf("expect gas limit 100 got %d", instance.gasLimit())
+	}
+	sink := &testErrSink{}
+	mem := instance.newMemory(sink)
+
+	mem.Write(big.NewInt(0), make([]byte, 33))
+	if instance.memoryUsed != 64 {
+		t.Errorf("expect 64 bytes used got %d", instance.memoryUsed)
+	}
+	// 访问已扩展的内存不再计费
+	mem.Read(big.NewInt(32), big.NewInt(32))
+	mem.Read(big.NewInt(1000), big.NewInt(0))
+	if instance.memoryUsed != 64 || sink.err != nil {
+		t.Errorf("expect 64 bytes used got %d, err %v", instance.memoryUsed, sink.err)
+	}
+
+	// 每个调用帧的内存单独计费
+	mem2 := instance.newMemory(sink)
+	mem2.Write(big.NewInt(32), []byte{1})
+	if instance.memoryUsed != 128 || sink.err != nil {
+		t.Errorf("expect 128 bytes used got %d, err %v", instance.memoryUsed, sink.err)
+	}
+
+	if out := mem.Read(big.NewInt(64), big.NewInt(1)); out != nil {
+		t.Error("read beyond memory limit should fail")
+	}
+	if errors.GetCode(sink.err) != errors.Codes.MemoryOutOfBounds {
+		t.Errorf("expect memory out of bounds got %v", sink.err)
+	}
+	if _, ok := instance.execError(sink.err).(*bridge.ContractError); !ok {
+		t.Error("out of memory should be contract error")
+	}
+	used := instance.ResourceUsed()
+	if used.Memory != int64(instance.memoryUsed) {
+		t.Errorf("expect memory used %d got %d", instance.memoryUsed, used.Memory)
+	}
+}
+
+func TestOutOfGasError(t *testing.T) {
+	instance := &evmInstance{
+		ctx: &bridge.Context{
+			ResourceLimits: contract.Limits{Cpu: 100},
+		},
+		gasUsed: 100,
+	}
+	err := instance.execError(errors.Codes.InsufficientGas)
+	cerr, ok := err.(*bridge.ContractError)
+	if !ok || cerr.Status != outOfResourceStatus {
+		t.Errorf("out of gas should be contract error, got %v", err)
+	}
+	if used := instance.ResourceUsed(); used.Cpu != 100 {
+		t.Errorf("expect partial cpu used 100 got %d", used.Cpu)
+	}
+	other := errors.Codes.InvalidJumpDest
+	if instance.execError(other) != other {
+		t.Error("other errors should be returned as they are")
+	}
+}
+
+// testCodeProvider provides the same code for all contracts
+type testCodeProvider struct {
+	code []byte
+}
+
+func (p *testCodeProvider) GetContractCodeDesc(name string) (*pb.WasmCodeDesc, error) {
+	return &pb.WasmCodeDesc{}, nil
+}
+
+func (p *testCodeProvider) GetContractCode(name string) ([]byte, error) {
+	return p.code, nil
+}
+
+func (p *testCodeProvider) GetContractAbi(name string) ([]byte, error) {
+	return nil, nil
+}
+
+func TestLegacyEvmTx(t *testing.T) {
+	// PUSH1 0x2a PUSH1 0 MSTORE PUSH1 0x20 PUSH1 0 RETURN
+	code, _ := hex.DecodeString("602a60005260206000f3")
+	// evm_resource分叉之前的交易只有cpu限制, 内存限制为0
+	limits := contract.Limits{Cpu: 1000}
+	for _, enabled := range []bool{false, true} {
+		creator, _ := newEvmCreator(nil)
+		ctx := &bridge.Context{
+			ContractName:      "storagedata11",
+			Method:            "get",
+			Initiator:         "dpzuVdosQrF2kmzumhVeFQZa1aYcdgFpN",
+			Args:              map[string][]byte{},
+			ResourceLimits:    limits,
+			EvmResourceLimits: enabled,
+		}
+		instance, err := creator.CreateInstance(ctx, &testCodeProvider{code: code})
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = instance.Exec()
+		if enabled {
+			if _, ok := err.(*bridge.ContractError); !ok {
+				t.Errorf("expect out of memory with zero memory limit, got %v", err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("legacy tx should be verified, got %v", err)
+		}
+		if body := ctx.Output.GetBody(); len(body) != 32 || body[31] != 0x2a {
+			t.Errorf("unexpected output %x", body)
+		}
+		if used := instance.ResourceUsed(); used.Memory != 0 || used.Exceed(limits) {
+			t.Errorf("legacy tx should be within its limits, used %v", used)
+		}
+	}
+}
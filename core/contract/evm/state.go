@@ -10,11 +10,16 @@ import (
 	"github.com/hyperledger/burrow/permission"
 
 	"github.com/xuperchain/xuperchain/core/common/log"
+	"github.com/xuperchain/xuperchain/core/contract"
 	"github.com/xuperchain/xuperchain/core/contract/bridge"
 )
 
 type stateManager struct {
 	ctx *bridge.Context
+	// diskUsed is the upper bound of disk used, which is the disk used before the first write
+	// plus the size of all writes, the exact usage is computed only when it exceeds the limit
+	diskUsed int64
+	written  bool
 }
 
 func newStateManager(ctx *bridge.Context) *stateManager {
@@ -60,6 +65,12 @@ func (s *stateManager) GetStorage(address crypto.Address, key binary.Word256) ([
 		return nil, nil
 	}
 	v, err := s.ctx.Cache.Get(contractName, key.Bytes())
+	s.ctx.TraceSyscall(&contract.SyscallTrace{
+		Name:   "GetObject",
+		Bucket: contractName,
+		Key:    key.Bytes(),
+		Value:  v.GetPureData().GetValue(),
+	}, err)
 	if err != nil {
 		return binary.Zero256.Bytes(), nil
 	}
@@ -84,7 +95,32 @@ func (s *stateManager) SetStorage(address crypto.Address, key binary.Word256, va
 	if err != nil {
 		return err
 	}
-	return s.ctx.Cache.Put(contractName, key.Bytes(), value)
+	if s.ctx.EvmResourceLimits && !s.written {
+		s.diskUsed = s.ctx.DiskUsed()
+		s.written = true
+	}
+	err = s.ctx.Cache.Put(contractName, key.Bytes(), value)
+	s.ctx.TraceSyscall(&contract.SyscallTrace{
+		Name:   "PutObject",
+		Bucket: contractName,
+		Key:    key.Bytes(),
+		Value:  value,
+	}, err)
+	if err != nil {
+		return err
+	}
+	// 磁盘只在evm_resource分叉之后按写入计费, 之前由bridge根据读写集检查
+	if !s.ctx.EvmResourceLimits {
+		return nil
+	}
+	s.diskUsed += int64(len(key.Bytes()) + len(value))
+	if s.diskUsed > s.ctx.ResourceLimits.Disk {
+		s.diskUsed = s.ctx.DiskUsed()
+		if s.diskUsed > s.ctx.ResourceLimits.Disk {
+			return bridge.ErrOutOfDiskLimit
+		}
+	}
+	return nil
 }
 
 // Transfer native token
@@ -105,7 +141,13 @@ func (s *stateManager) Transfer(from, to crypto.Address, amount *big.Int) error
 		return err
 	}
 
-	return s.ctx.Cache.Transfer(fromAddr, toAddr, amount)
+	err = s.ctx.Cache.Transfer(fromAddr, toAddr, amount)
+	s.ctx.TraceSyscall(&contract.SyscallTrace{
+		Name:   "Transfer",
+		To:     toAddr,
+		Amount: amount.String(),
+	}, err)
+	return err
 }
 
 type blockStateManager struct {
@@ -32,6 +32,13 @@ func validACL(acl *pb.Acl) error {
 	// permission model check
 	if permissionModel := acl.GetPm(); permissionModel != nil {
 		permissionRule := permissionModel.GetRule()
+		// SIGN_TIMELOCK wraps another rule, so check the time lock and then the wrapped rule
+		if permissionRule == pb.PermissionRule_SIGN_TIMELOCK {
+			if err := validTimeLock(acl.GetTimeLock()); err != nil {
+				return err
+			}
+			permissionRule = acl.GetTimeLock().GetRule()
+		}
 		akSets := acl.GetAkSets()
 		aksWeight := acl.GetAksWeight()
 		if akSets == nil && aksWeight == nil {
@@ -51,6 +58,22 @@ func validACL(acl *pb.Acl) error {
 			} else {
 				return fmt.Errorf("valid acl failed, akSets is nil")
 			}
+		} else if permissionRule == pb.PermissionRule_SIGN_RATE {
+			if aksWeight == nil || len(aksWeight) == 0 || len(aksWeight) > utils.GetAkLimit() {
+				return fmt.Errorf("valid acl failed, aksWeight is empty or size of aksWeight is very big")
+			}
+			// the accept value of SIGN_RATE is the rate of signed members, should be in (0, 1]
+			acceptValue := permissionModel.GetAcceptValue()
+			if acceptValue <= 0 || acceptValue > 1 {
+				return fmt.Errorf("valid acl failed, acceptValue of SIGN_RATE should be in (0, 1]")
+			}
+		} else if permissionRule == pb.PermissionRule_SIGN_SUM {
+			if aksWeight == nil || len(aksWeight) == 0 || len(aksWeight) > utils.GetAkLimit() {
+				return fmt.Errorf("valid acl failed, aksWeight is empty or size of aksWeight is very big")
+			}
+			if permissionModel.GetAcceptValue() <= 0 {
+				return fmt.Errorf("valid acl failed, acceptValue of SIGN_SUM should be positive")
+			}
 		} else {
 			return fmt.Errorf("valid acl failed, permission model is not found")
 		}
@@ -61,6 +84,27 @@ func validACL(acl *pb.Acl) error {
 	return nil
 }
 
+func validTimeLock(timeLock *pb.TimeLock) error {
+	if timeLock == nil {
+		return fmt.Errorf("valid acl failed, timeLock is required by SIGN_TIMELOCK")
+	}
+	rule := timeLock.GetRule()
+	if rule != pb.PermissionRule_SIGN_THRESHOLD && rule != pb.PermissionRule_SIGN_AKSET {
+		return fmt.Errorf("valid acl failed, only SIGN_THRESHOLD and SIGN_AKSET can be wrapped by timeLock")
+	}
+	if timeLock.GetNotBeforeHeight() < 0 || timeLock.GetNotAfterHeight() < 0 ||
+		timeLock.GetNotBeforeTimestamp() < 0 || timeLock.GetNotAfterTimestamp() < 0 {
+		return fmt.Errorf("valid acl failed, bounds of timeLock should not be negative")
+	}
+	if timeLock.GetNotAfterHeight() > 0 && timeLock.GetNotBeforeHeight() > timeLock.GetNotAfterHeight() {
+		return fmt.Errorf("valid acl failed, notBeforeHeight is greater than notAfterHeight")
+	}
+	if timeLock.GetNotAfterTimestamp() > 0 && timeLock.GetNotBeforeTimestamp() > timeLock.GetNotAfterTimestamp() {
+		return fmt.Errorf("valid acl failed, notBeforeTimestamp is greater than notAfterTimestamp")
+	}
+	return nil
+}
+
 // Invoke NewAccount method implementation
 func (na *NewAccountMethod) Invoke(ctx *KContext, args map[string][]byte) (*contract.Response, error) {
 	if ctx.ResourceLimit.XFee < ctx.NewAccountResourceAmount {
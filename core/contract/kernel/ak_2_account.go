@@ -88,9 +88,13 @@ func update(ctx *KContext, aclJSON []byte, accountName string, method string) er
 	akSets := acl.GetAkSets()
 	aksWeight := acl.GetAksWeight()
 	permissionRule := acl.GetPm().GetRule()
+	if permissionRule == pb.PermissionRule_SIGN_TIMELOCK {
+		// members of a time locked ACL are defined by the wrapped rule
+		permissionRule = acl.GetTimeLock().GetRule()
+	}
 
 	switch permissionRule {
-	case pb.PermissionRule_SIGN_THRESHOLD:
+	case pb.PermissionRule_SIGN_THRESHOLD, pb.PermissionRule_SIGN_RATE, pb.PermissionRule_SIGN_SUM:
 		return updateForThreshold(ctx, aksWeight, accountName, method)
 	case pb.PermissionRule_SIGN_AKSET:
 		return updateForAKSet(ctx, akSets, accountName, method)
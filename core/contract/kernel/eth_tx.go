@@ -0,0 +1,156 @@
+package kernel
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/xuperchain/xuperchain/core/contract"
+	"github.com/xuperchain/xuperchain/core/gateway/eth/ethtx"
+	"github.com/xuperchain/xuperchain/core/xmodel"
+)
+
+const (
+	// EthTxBucket records the ethereum transactions mapped by the eth gateway, keyed by the ethereum transaction hash,
+	// the version of record is the xuper transaction
+	EthTxBucket = "XCEthTx"
+	// EthNonceBucket records the next nonce of ethereum transactions, keyed by the hex encoded sender
+	EthNonceBucket = "XCEthNonce"
+	// EthAccountBucket binds the ethereum address to the xuper address which is allowed to record
+	// its transactions, keyed by the hex encoded ethereum address
+	EthAccountBucket = "XCEthAccount"
+)
+
+var (
+	// ErrEthTxExist is returned when recording an ethereum transaction already on chain
+	ErrEthTxExist = errors.New("ethereum transaction already exists")
+	// ErrEthSenderMismatch is returned when the sender of ethereum transaction is not bound to the initiator
+	ErrEthSenderMismatch = errors.New("ethereum sender is not bound to the initiator")
+	// ErrEthGatewayDisabled is returned before the eth_gateway fork height in genesis
+	ErrEthGatewayDisabled = errors.New("ethereum transaction is not supported at current height")
+)
+
+// ethTxMethods record the ethereum transactions on chain so that a signed ethereum transaction
+// can not be replayed. The sender is recovered from the signed raw transaction, and the transaction
+// can only be recorded by the xuper address which the sender has bound by BindEthAddress
+type ethTxMethods struct {
+}
+
+// EthTxRecord is the value of ethereum transaction in EthTxBucket
+type EthTxRecord struct {
+	// From is the hex encoded ethereum address signing the transaction
+	From  string `json:"from"`
+	Nonce uint64 `json:"nonce"`
+}
+
+func getEthNonce(ctx *KContext, from string) (uint64, error) {
+	data, err := ctx.ModelCache.Get(EthNonceBucket, []byte(from))
+	if err == xmodel.ErrNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(string(data.GetPureData().GetValue()), 10, 64)
+}
+
+// BindEthAddress binds the ethereum address signing the initiator by personal_sign to the initiator,
+// a later binding of the same ethereum address overrides the former one
+func (m *ethTxMethods) BindEthAddress(ctx *KContext, args map[string][]byte) (*contract.Response, error) {
+	if !ctx.ContextConfig.EthGateway {
+		return nil, ErrEthGatewayDisabled
+	}
+	signer, err := ethtx.MessageSigner([]byte(ctx.Initiator), args["signature"])
+	if err != nil {
+		return nil, err
+	}
+	from := hex.EncodeToString(signer)
+	if err := ctx.ModelCache.Put(EthAccountBucket, []byte(from), []byte(ctx.Initiator)); err != nil {
+		return nil, err
+	}
+	return &contract.Response{
+		Status: contract.StatusOK,
+		Body:   []byte(from),
+	}, nil
+}
+
+// RecordEthTx records the signed ethereum transaction of raw and increases the nonce of its sender,
+// the transaction is rejected if the sender is not bound to the initiator, the hash exists
+// or the nonce is not the next nonce
+func (m *ethTxMethods) RecordEthTx(ctx *KContext, args map[string][]byte) (*contract.Response, error) {
+	if !ctx.ContextConfig.EthGateway {
+		return nil, ErrEthGatewayDisabled
+	}
+	tx, err := ethtx.Decode(args["raw"])
+	if err != nil {
+		return nil, err
+	}
+	sender, err := tx.Sender(tx.ChainID())
+	if err != nil {
+		return nil, err
+	}
+	from := hex.EncodeToString(sender)
+	bound, err := ctx.ModelCache.Get(EthAccountBucket, []byte(from))
+	if err != nil && err != xmodel.ErrNotFound {
+		return nil, err
+	}
+	if err == xmodel.ErrNotFound || string(bound.GetPureData().GetValue()) != ctx.Initiator {
+		return nil, ErrEthSenderMismatch
+	}
+
+	hash := tx.Hash()
+	_, err = ctx.ModelCache.Get(EthTxBucket, hash)
+	if err == nil {
+		return nil, ErrEthTxExist
+	}
+	if err != xmodel.ErrNotFound {
+		return nil, err
+	}
+	expect, err := getEthNonce(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	if tx.Nonce != expect {
+		return nil, fmt.Errorf("nonce %d mismatch, expect %d", tx.Nonce, expect)
+	}
+
+	record, err := json.Marshal(&EthTxRecord{
+		From:  from,
+		Nonce: tx.Nonce,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.ModelCache.Put(EthTxBucket, hash, record); err != nil {
+		return nil, err
+	}
+	next := []byte(strconv.FormatUint(tx.Nonce+1, 10))
+	if err := ctx.ModelCache.Put(EthNonceBucket, []byte(from), next); err != nil {
+		return nil, err
+	}
+	return &contract.Response{
+		Status: contract.StatusOK,
+		Body:   next,
+	}, nil
+}
+
+// EthTxNonce returns the next nonce of from, nothing is saved
+func (m *ethTxMethods) EthTxNonce(ctx *KContext, args map[string][]byte) (*contract.Response, error) {
+	if !ctx.ContextConfig.EthGateway {
+		return nil, ErrEthGatewayDisabled
+	}
+	from := string(args["from"])
+	if from == "" {
+		return nil, errors.New("from is nil")
+	}
+	nonce, err := getEthNonce(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	return &contract.Response{
+		Status: contract.StatusOK,
+		Body:   []byte(strconv.FormatUint(nonce, 10)),
+	}, nil
+}
@@ -0,0 +1,122 @@
+package kernel
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+
+	"github.com/xuperchain/xuperchain/core/contract"
+	"github.com/xuperchain/xuperchain/core/gateway/eth/ethtx"
+	"github.com/xuperchain/xuperchain/core/xmodel"
+	xmodel_pb "github.com/xuperchain/xuperchain/core/xmodel/pb"
+)
+
+// 私钥0x4646...46对应的以太坊地址
+const testEthFrom = "9d8a62f656a8d1615c1294fd71e9cfb3e4855a4f"
+
+var testEthKey, _ = btcec.PrivKeyFromBytes(btcec.S256(), []byte{
+	0x46, 0x46, 0x46, 0x46, 0x46, 0x46, 0x46, 0x46, 0x46, 0x46, 0x46, 0x46, 0x46, 0x46, 0x46, 0x46,
+	0x46, 0x46, 0x46, 0x46, 0x46, 0x46, 0x46, 0x46, 0x46, 0x46, 0x46, 0x46, 0x46, 0x46, 0x46, 0x46,
+})
+
+// signEthTx return the raw transfer of nonce signed with EIP-155
+func signEthTx(t *testing.T, nonce uint64) []byte {
+	chainID := big.NewInt(1337)
+	tx := &ethtx.Transaction{
+		Nonce:    nonce,
+		GasPrice: new(big.Int),
+		Gas:      21000,
+		To:       make([]byte, ethtx.AddressLength),
+		Value:    big.NewInt(1),
+	}
+	sig, err := btcec.SignCompact(btcec.S256(), testEthKey, tx.SigningHash(chainID), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := new(big.Int).Lsh(chainID, 1)
+	v.Add(v, big.NewInt(int64(sig[0]-27+35)))
+	return ethtx.EncodeList(
+		ethtx.EncodeUint64(tx.Nonce),
+		ethtx.EncodeBigInt(tx.GasPrice),
+		ethtx.EncodeUint64(tx.Gas),
+		ethtx.EncodeBytes(tx.To),
+		ethtx.EncodeBigInt(tx.Value),
+		ethtx.EncodeBytes(nil),
+		ethtx.EncodeBigInt(v),
+		ethtx.EncodeBigInt(new(big.Int).SetBytes(sig[1:33])),
+		ethtx.EncodeBigInt(new(big.Int).SetBytes(sig[33:])),
+	)
+}
+
+func newEthTxContext(initiator string, raws ...[]byte) *KContext {
+	// 绑定关系, 交易哈希和nonce均不存在, 读集中为空版本
+	vdatas := []*xmodel_pb.VersionedData{
+		{PureData: &xmodel_pb.PureData{Bucket: EthAccountBucket, Key: []byte(testEthFrom)}},
+		{PureData: &xmodel_pb.PureData{Bucket: EthNonceBucket, Key: []byte(testEthFrom)}},
+	}
+	for _, raw := range raws {
+		vdatas = append(vdatas, &xmodel_pb.VersionedData{
+			PureData: &xmodel_pb.PureData{Bucket: EthTxBucket, Key: ethtx.Keccak256(raw)},
+		})
+	}
+	return &KContext{
+		ModelCache:    xmodel.NewXModelCacheWithInputs(vdatas, nil, nil),
+		Initiator:     initiator,
+		ResourceLimit: contract.MaxLimits,
+		ContextConfig: &contract.ContextConfig{EthGateway: true},
+	}
+}
+
+func TestEthTxMethods(t *testing.T) {
+	raw0, raw1 := signEthTx(t, 0), signEthTx(t, 1)
+	m := &ethTxMethods{}
+	ctx := newEthTxContext("alice", raw0, raw1)
+	ctx.ContextConfig.EthGateway = false
+	if _, err := m.RecordEthTx(ctx, map[string][]byte{"raw": raw0}); err != ErrEthGatewayDisabled {
+		t.Errorf("expect ErrEthGatewayDisabled before eth_gateway fork, got %v", err)
+	}
+	ctx.ContextConfig.EthGateway = true
+	if _, err := m.RecordEthTx(ctx, map[string][]byte{"raw": raw0}); err != ErrEthSenderMismatch {
+		t.Errorf("expect ErrEthSenderMismatch recording before binding, got %v", err)
+	}
+
+	compact, _ := btcec.SignCompact(btcec.S256(), testEthKey, ethtx.MessageHash([]byte("alice")), false)
+	signature := append(append([]byte{}, compact[1:]...), compact[0])
+	resp, err := m.BindEthAddress(ctx, map[string][]byte{"signature": signature})
+	if err != nil || string(resp.Body) != testEthFrom {
+		t.Fatalf("bind eth address error, resp %v, err %v", resp, err)
+	}
+
+	if _, err := m.RecordEthTx(ctx, map[string][]byte{"raw": raw1}); err == nil {
+		t.Error("expect error recording a future nonce")
+	}
+	resp, err = m.RecordEthTx(ctx, map[string][]byte{"raw": raw0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(resp.Body) != "1" {
+		t.Errorf("expect next nonce 1, got %s", resp.Body)
+	}
+	if _, err := m.RecordEthTx(ctx, map[string][]byte{"raw": raw0}); err != ErrEthTxExist {
+		t.Errorf("expect ErrEthTxExist replaying the transaction, got %v", err)
+	}
+	resp, err = m.EthTxNonce(ctx, map[string][]byte{"from": []byte(testEthFrom)})
+	if err != nil || string(resp.Body) != "1" {
+		t.Errorf("expect nonce 1, got %v %v", resp, err)
+	}
+	if _, err := m.RecordEthTx(ctx, map[string][]byte{"raw": raw1[1:]}); err == nil {
+		t.Error("expect error recording a bad transaction")
+	}
+
+	// 绑定到alice的地址不能由bob记录
+	ctx.Initiator = "bob"
+	if _, err := m.RecordEthTx(ctx, map[string][]byte{"raw": raw1}); err != ErrEthSenderMismatch {
+		t.Errorf("expect ErrEthSenderMismatch recording by other initiator, got %v", err)
+	}
+	// 签名的是alice, bob无法用它绑定该地址
+	if resp, err := m.BindEthAddress(ctx, map[string][]byte{"signature": signature}); err == nil &&
+		string(resp.Body) == testEthFrom {
+		t.Error("expect the signature of alice not binding the address to bob")
+	}
+}
@@ -346,6 +346,7 @@ func (k *Kernel) validateUpdateGasPrice(desc *contract.TxDesc, name string) (*pb
 		MemRate:  result.MemRate,
 		DiskRate: result.DiskRate,
 		XfeeRate: result.XfeeRate,
+		RentRate: result.RentRate,
 	}, nil
 }
 
@@ -852,13 +853,21 @@ func (k *Kernel) runUpdateGasPrice(desc *contract.TxDesc) error {
 	if oldParams.GetCpuRate() != originalGasPrice.GetCpuRate() ||
 		oldParams.GetMemRate() != originalGasPrice.GetMemRate() ||
 		oldParams.GetDiskRate() != originalGasPrice.GetDiskRate() ||
-		oldParams.GetXfeeRate() != originalGasPrice.GetXfeeRate() {
+		oldParams.GetXfeeRate() != originalGasPrice.GetXfeeRate() ||
+		oldParams.GetRentRate() != originalGasPrice.GetRentRate() {
 		return fmt.Errorf("old_gas_price values are not equal to the current node")
 	}
 	newGasPrice, err := k.validateUpdateGasPrice(desc, "new_gas_price")
 	if err != nil {
 		return err
 	}
+	if k.context.LedgerObj != nil {
+		if cfg := k.context.LedgerObj.GetStorageRent(); cfg != nil {
+			if err := cfg.CheckGasPrice(newGasPrice); err != nil {
+				return err
+			}
+		}
+	}
 	k.log.Info("update gas price", "params", newGasPrice)
 	err = k.context.UtxoMeta.UpdateGasPrice(newGasPrice, k.context.UtxoBatch)
 	return err
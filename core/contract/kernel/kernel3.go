@@ -47,6 +47,8 @@ func NewKernel(xbridge *bridge.XBridge) (*XuperKernel, error) {
 	contractMethods := &contractMethods{
 		xbridge: xbridge,
 	}
+	storageRentMethods := &storageRentMethods{}
+	ethTxMethods := &ethTxMethods{}
 	return &XuperKernel{
 		methods: map[string]Method{
 			"Get":           &GetMethod{},
@@ -55,6 +57,16 @@ func NewKernel(xbridge *bridge.XBridge) (*XuperKernel, error) {
 			"SetMethodAcl":  &SetMethodACLMethod{},
 			"Deploy":        MethodFunc(contractMethods.Deploy),
 			"Upgrade":       MethodFunc(contractMethods.Upgrade),
+			// storage rent of contract data
+			"StorageRent":       MethodFunc(storageRentMethods.StorageRent),
+			"SettleStorageRent": MethodFunc(storageRentMethods.SettleStorageRent),
+			"PayStorageRent":    MethodFunc(storageRentMethods.PayStorageRent),
+			"ArchiveStorage":    MethodFunc(storageRentMethods.ArchiveStorage),
+			"RestoreStorage":    MethodFunc(storageRentMethods.RestoreStorage),
+			// replay protection of ethereum transactions mapped by eth gateway
+			"BindEthAddress": MethodFunc(ethTxMethods.BindEthAddress),
+			"RecordEthTx":    MethodFunc(ethTxMethods.RecordEthTx),
+			"EthTxNonce":     MethodFunc(ethTxMethods.EthTxNonce),
 		},
 	}, nil
 }
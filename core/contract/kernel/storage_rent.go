@@ -0,0 +1,267 @@
+package kernel
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/xuperchain/xuperchain/core/contract"
+	"github.com/xuperchain/xuperchain/core/xmodel"
+)
+
+var (
+	// ErrStorageRentDisabled is returned when calling storage rent methods on chains without storage rent
+	ErrStorageRentDisabled = errors.New("storage rent is disabled")
+)
+
+// storageRentMethods manage the storage rent of contracts, the rent is settled up to the height
+// given by caller, which must not be after the block the tx is in, to make the result deterministic.
+// The rent is not charged automatically by blocks, it's charged only when SettleStorageRent
+// or PayStorageRent is called, see xmodel.StorageRent
+type storageRentMethods struct {
+}
+
+// StorageRentStatus is the response of StorageRent method
+type StorageRentStatus struct {
+	*xmodel.StorageRent
+	ContractName string `json:"contract_name"`
+	Height       int64  `json:"height"`
+	Archivable   bool   `json:"archivable"`
+}
+
+func parseInt64Arg(args map[string][]byte, name string) (int64, error) {
+	value := args[name]
+	if value == nil {
+		return 0, fmt.Errorf("%s is nil", name)
+	}
+	n, err := strconv.ParseInt(string(value), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("bad %s:%s", name, value)
+	}
+	if n < 0 {
+		return 0, fmt.Errorf("%s is negative", name)
+	}
+	return n, nil
+}
+
+// settle loads the rent record of contract_name and settles it up to the height argument
+func (s *storageRentMethods) settle(ctx *KContext, args map[string][]byte) (string, *xmodel.StorageRent, error) {
+	rentCtx := ctx.ContextConfig.StorageRent
+	if rentCtx == nil {
+		return "", nil, ErrStorageRentDisabled
+	}
+	contractName := string(args["contract_name"])
+	if contractName == "" {
+		return "", nil, errors.New("contract_name is nil")
+	}
+	height, err := parseInt64Arg(args, "height")
+	if err != nil {
+		return "", nil, err
+	}
+	if height == 0 || height > rentCtx.Height {
+		return "", nil, fmt.Errorf("height %d is not in (0, %d]", height, rentCtx.Height)
+	}
+	rent, err := ctx.ModelCache.GetStorageRent(contractName)
+	if err != nil {
+		return "", nil, err
+	}
+	if rent.IsArchived() {
+		return contractName, rent, nil
+	}
+	_, err = rent.Settle(height, &rentCtx.StorageRentConfig, rentCtx.GasPrice.GetRentRate())
+	if err != nil {
+		return "", nil, err
+	}
+	return contractName, rent, nil
+}
+
+// pay charges amount of gas from the tx as xfee
+func (s *storageRentMethods) pay(ctx *KContext, amount int64) error {
+	xfeeRate := ctx.ContextConfig.StorageRent.GasPrice.GetXfeeRate()
+	if xfeeRate > 0 && amount > math.MaxInt64/xfeeRate {
+		return fmt.Errorf("amount %d overflows", amount)
+	}
+	ctx.AddXFeeUsed(amount * xfeeRate)
+	return nil
+}
+
+// StorageRent returns the rent record of contract settled up to height, nothing is saved
+func (s *storageRentMethods) StorageRent(ctx *KContext, args map[string][]byte) (*contract.Response, error) {
+	contractName, rent, err := s.settle(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	height, _ := parseInt64Arg(args, "height")
+	status := &StorageRentStatus{
+		StorageRent:  rent,
+		ContractName: contractName,
+		Height:       height,
+		Archivable:   rent.Archivable(height, &ctx.ContextConfig.StorageRent.StorageRentConfig),
+	}
+	body, err := json.Marshal(status)
+	if err != nil {
+		return nil, err
+	}
+	return &contract.Response{
+		Status: contract.StatusOK,
+		Body:   body,
+	}, nil
+}
+
+// SettleStorageRent settles the rent of contract up to height, anyone can settle the rent of any contract
+func (s *storageRentMethods) SettleStorageRent(ctx *KContext, args map[string][]byte) (*contract.Response, error) {
+	contractName, rent, err := s.settle(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	if rent.IsArchived() {
+		return nil, xmodel.ErrStorageArchived
+	}
+	err = ctx.ModelCache.PutStorageRent(contractName, rent)
+	if err != nil {
+		return nil, err
+	}
+	return &contract.Response{
+		Status: contract.StatusOK,
+		Body:   []byte(strconv.FormatInt(rent.Balance, 10)),
+	}, nil
+}
+
+// PayStorageRent settles the rent of contract up to height and pays amount of gas to its balance
+func (s *storageRentMethods) PayStorageRent(ctx *KContext, args map[string][]byte) (*contract.Response, error) {
+	contractName, rent, err := s.settle(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	if rent.IsArchived() {
+		return nil, fmt.Errorf("%s, restore it instead", xmodel.ErrStorageArchived)
+	}
+	amount, err := parseInt64Arg(args, "amount")
+	if err != nil {
+		return nil, err
+	}
+	err = s.pay(ctx, amount)
+	if err != nil {
+		return nil, err
+	}
+	rent.Pay(amount)
+	err = ctx.ModelCache.PutStorageRent(contractName, rent)
+	if err != nil {
+		return nil, err
+	}
+	return &contract.Response{
+		Status: contract.StatusOK,
+		Body:   []byte(strconv.FormatInt(rent.Balance, 10)),
+	}, nil
+}
+
+// archiveTxid returns the tx of the last archived chunk of contract
+func (s *storageRentMethods) archiveTxid(ctx *KContext, contractName string, rent *xmodel.StorageRent) ([]byte, error) {
+	if len(rent.ArchiveTxid) != 0 {
+		return rent.ArchiveTxid, nil
+	}
+	// 租金记录的版本即为最后一次归档的交易
+	vd, err := ctx.ModelCache.Get(xmodel.StorageRentBucket, []byte(contractName))
+	if err != nil {
+		return nil, err
+	}
+	return vd.GetRefTxid(), nil
+}
+
+// ArchiveStorage archives a chunk of the data of contract which is in arrears for the grace period,
+// the chunk is moved to the transient bucket of the tx and only the hash chain of chunks is kept in xmodel.
+// A large contract is archived by calling it repeatedly until no data is left
+func (s *storageRentMethods) ArchiveStorage(ctx *KContext, args map[string][]byte) (*contract.Response, error) {
+	contractName, rent, err := s.settle(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	height, _ := parseInt64Arg(args, "height")
+	cfg := &ctx.ContextConfig.StorageRent.StorageRentConfig
+	if !rent.Archivable(height, cfg) {
+		return nil, fmt.Errorf("contract %s can not be archived at height %d", contractName, height)
+	}
+	var prevTxid []byte
+	if rent.IsArchived() {
+		prevTxid, err = s.archiveTxid(ctx, contractName, rent)
+		if err != nil {
+			return nil, err
+		}
+	}
+	archiveHash, err := ctx.ModelCache.ArchiveStorage(contractName, rent.Bytes, cfg.ArchiveChunkBytes, rent.ArchiveHash, prevTxid)
+	if err != nil {
+		return nil, err
+	}
+	rent.ArchiveHash = archiveHash
+	rent.ArchiveTxid = nil
+	err = ctx.ModelCache.PutStorageRent(contractName, rent)
+	if err != nil {
+		return nil, err
+	}
+	return &contract.Response{
+		Status: contract.StatusOK,
+		Body:   []byte(fmt.Sprintf("%x", archiveHash)),
+	}, nil
+}
+
+// RestoreStorage restores the last archived chunk of contract by paying the arrears, the chunk
+// is read from the archive argument, or the tx archived it if it's not given. The chunks are restored
+// in the reverse order, the contract is restored when the first chunk is restored
+func (s *storageRentMethods) RestoreStorage(ctx *KContext, args map[string][]byte) (*contract.Response, error) {
+	contractName, rent, err := s.settle(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	if !rent.IsArchived() {
+		return nil, xmodel.ErrStorageNotArchived
+	}
+	amount, err := parseInt64Arg(args, "amount")
+	if err != nil {
+		return nil, err
+	}
+	if amount+rent.Balance < 0 {
+		return nil, fmt.Errorf("amount %d is less than the arrears %d", amount, -rent.Balance)
+	}
+
+	archive, ok := args["archive"]
+	if !ok {
+		txid, err := s.archiveTxid(ctx, contractName, rent)
+		if err != nil {
+			return nil, err
+		}
+		tx, err := ctx.ContextConfig.Core.QueryTransaction(txid)
+		if err != nil {
+			return nil, fmt.Errorf("query archive tx %x error: %s", txid, err)
+		}
+		archive = xmodel.ParseStorageArchive(tx, contractName)
+	}
+	chunk, err := ctx.ModelCache.RestoreStorage(contractName, archive, rent.ArchiveHash)
+	if err != nil {
+		return nil, err
+	}
+	err = s.pay(ctx, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	rent.ArchiveHash = chunk.PrevHash
+	rent.ArchiveTxid = chunk.PrevTxid
+	if !rent.IsArchived() {
+		height, _ := parseInt64Arg(args, "height")
+		rent.ArchiveHash = nil
+		rent.ArchiveTxid = nil
+		rent.SettledHeight = height
+		rent.PeakBytes = rent.Bytes
+	}
+	rent.Pay(amount)
+	err = ctx.ModelCache.PutStorageRent(contractName, rent)
+	if err != nil {
+		return nil, err
+	}
+	return &contract.Response{
+		Status: contract.StatusOK,
+		Body:   []byte(strconv.FormatInt(rent.Balance, 10)),
+	}, nil
+}
@@ -0,0 +1,156 @@
+package kernel
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/xuperchain/xuperchain/core/contract"
+	"github.com/xuperchain/xuperchain/core/ledger"
+	"github.com/xuperchain/xuperchain/core/pb"
+	"github.com/xuperchain/xuperchain/core/permission/acl/utils"
+	"github.com/xuperchain/xuperchain/core/xmodel"
+	xmodel_pb "github.com/xuperchain/xuperchain/core/xmodel/pb"
+)
+
+func newStorageRentContext(t *testing.T, rent *xmodel.StorageRent, datas map[string]string) *KContext {
+	rentValue, err := json.Marshal(rent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vdatas := []*xmodel_pb.VersionedData{
+		{
+			RefTxid:  []byte("tx0"),
+			PureData: &xmodel_pb.PureData{Bucket: utils.GetContract2AccountBucket(), Key: []byte("counter"), Value: []byte("XC1111111111111111@xuper")},
+		},
+		{
+			RefTxid:  []byte("tx0"),
+			PureData: &xmodel_pb.PureData{Bucket: xmodel.StorageRentBucket, Key: []byte("counter"), Value: rentValue},
+		},
+	}
+	for k, v := range datas {
+		vdatas = append(vdatas, &xmodel_pb.VersionedData{
+			RefTxid:  []byte("tx0"),
+			PureData: &xmodel_pb.PureData{Bucket: "counter", Key: []byte(k), Value: []byte(v)},
+		})
+	}
+	return &KContext{
+		ModelCache:    xmodel.NewXModelCacheWithInputs(vdatas, nil, nil),
+		ResourceLimit: contract.MaxLimits,
+		ContextConfig: &contract.ContextConfig{
+			BCName: "xuper",
+			StorageRent: &contract.StorageRentContext{
+				StorageRentConfig: ledger.StorageRentConfig{
+					Period:            10,
+					GracePeriod:       10,
+					ArchiveChunkBytes: 4,
+				},
+				GasPrice: &pb.GasPrice{XfeeRate: 1, RentRate: 2},
+				Height:   100,
+			},
+		},
+	}
+}
+
+func TestStorageRentMethods(t *testing.T) {
+	s := &storageRentMethods{}
+	rent := &xmodel.StorageRent{
+		Bytes:         12,
+		PeakBytes:     12,
+		SettledHeight: 50,
+	}
+	datas := map[string]string{"k1": "v1", "k2": "value2"}
+
+	ctx := newStorageRentContext(t, rent, datas)
+	args := map[string][]byte{
+		"contract_name": []byte("counter"),
+		"height":        []byte("101"),
+		"amount":        []byte("10"),
+	}
+	if _, err := s.PayStorageRent(ctx, args); err == nil {
+		t.Error("expect error settling after the block height")
+	}
+	args["height"] = []byte("70")
+	resp, err := s.PayStorageRent(ctx, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 2 periods, 6 gas per period
+	if string(resp.Body) != "-2" || ctx.ResourceUsed().XFee != 10 {
+		t.Errorf("unexpected balance %s, xfee %d", resp.Body, ctx.ResourceUsed().XFee)
+	}
+
+	ctx = newStorageRentContext(t, rent, datas)
+	args["height"] = []byte("69")
+	if _, err := s.ArchiveStorage(ctx, args); err == nil {
+		t.Error("expect error archiving in the grace period")
+	}
+	ctx = newStorageRentContext(t, rent, datas)
+	args["height"] = []byte("90")
+	chunk1 := archiveStorage(t, s, ctx, args)
+	archived, _ := ctx.ModelCache.GetStorageRent("counter")
+	if !archived.IsArchived() || archived.Balance != -24 || archived.Bytes != 8 {
+		t.Fatalf("unexpected rent %+v", archived)
+	}
+	// 继续归档剩余的数据
+	ctx = newStorageRentContext(t, archived, map[string]string{"k2": "value2"})
+	chunk2 := archiveStorage(t, s, ctx, args)
+	archived, _ = ctx.ModelCache.GetStorageRent("counter")
+	if archived.Bytes != 0 {
+		t.Fatalf("unexpected rent %+v", archived)
+	}
+	ctx = newStorageRentContext(t, archived, nil)
+	if _, err := s.ArchiveStorage(ctx, args); err == nil {
+		t.Error("expect error archiving without data left")
+	}
+
+	ctx = newStorageRentContext(t, archived, nil)
+	args["archive"] = chunk1
+	args["amount"] = []byte("30")
+	if _, err := s.RestoreStorage(ctx, args); err == nil {
+		t.Error("expect error restoring the chunks out of order")
+	}
+	args["archive"] = chunk2
+	args["amount"] = []byte("23")
+	if _, err := s.RestoreStorage(ctx, args); err == nil {
+		t.Error("expect error paying less than the arrears")
+	}
+	args["amount"] = []byte("30")
+	if _, err := s.RestoreStorage(ctx, args); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.ModelCache.WriteStorageUsage(); err != nil {
+		t.Fatal(err)
+	}
+	restored, _ := ctx.ModelCache.GetStorageRent("counter")
+	if !restored.IsArchived() || string(restored.ArchiveTxid) != "tx0" || restored.Balance != 6 || restored.Bytes != 8 {
+		t.Fatalf("unexpected rent %+v", restored)
+	}
+
+	ctx = newStorageRentContext(t, restored, map[string]string{"k2": "value2"})
+	args["archive"] = chunk1
+	args["amount"] = []byte("0")
+	if _, err := s.RestoreStorage(ctx, args); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.ModelCache.WriteStorageUsage(); err != nil {
+		t.Fatal(err)
+	}
+	restored, _ = ctx.ModelCache.GetStorageRent("counter")
+	if restored.IsArchived() || restored.Balance != 6 || restored.Bytes != 12 || restored.SettledHeight != 90 {
+		t.Errorf("unexpected rent %+v", restored)
+	}
+}
+
+// archiveStorage archives a chunk of counter and returns the chunk
+func archiveStorage(t *testing.T, s *storageRentMethods, ctx *KContext, args map[string][]byte) []byte {
+	if _, err := s.ArchiveStorage(ctx, args); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.ModelCache.WriteStorageUsage(); err != nil {
+		t.Fatal(err)
+	}
+	_, writeSet, _ := ctx.ModelCache.GetRWSets()
+	return xmodel.ParseStorageArchive(&pb.Transaction{
+		TxOutputsExt: xmodel.GetTxOutputs(writeSet),
+	}, "counter")
+}
@@ -347,6 +347,7 @@ func (prp *Proposal) fillOldState(desc []byte) ([]byte, error) {
 		gasPriceMap["mem_rate"] = gasPrice.GetMemRate()
 		gasPriceMap["disk_rate"] = gasPrice.GetDiskRate()
 		gasPriceMap["xfee_rate"] = gasPrice.GetXfeeRate()
+		gasPriceMap["rent_rate"] = gasPrice.GetRentRate()
 
 		descObj.Args["old_gas_price"] = gasPrice
 	default:
@@ -0,0 +1,16 @@
+package contract
+
+import (
+	"github.com/xuperchain/xuperchain/core/ledger"
+	"github.com/xuperchain/xuperchain/core/pb"
+)
+
+// StorageRentContext is the storage rent of xmodel data which the contracts are executed with
+type StorageRentContext struct {
+	ledger.StorageRentConfig
+	// GasPrice is the gas price of chain, the rent is charged by its rent rate
+	GasPrice *pb.GasPrice
+	// Height is the height of the block which the tx is executed in,
+	// the rent can't be settled after it
+	Height int64
+}
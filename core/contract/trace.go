@@ -0,0 +1,111 @@
+package contract
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/xuperchain/xuperchain/core/xmodel"
+	xmodel_pb "github.com/xuperchain/xuperchain/core/xmodel/pb"
+)
+
+// TraceBytes is shown as string if it's printable, otherwise as 0x prefixed hex
+type TraceBytes []byte
+
+// MarshalJSON implements json.Marshaler
+func (b TraceBytes) MarshalJSON() ([]byte, error) {
+	if isPrintable(b) {
+		return json.Marshal(string(b))
+	}
+	return json.Marshal("0x" + hex.EncodeToString(b))
+}
+
+func isPrintable(b []byte) bool {
+	if !utf8.Valid(b) {
+		return false
+	}
+	for _, r := range string(b) {
+		if !unicode.IsPrint(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// CallTrace is a frame of the contract call tree, the syscalls made by the contract
+// are recorded in order and the frame of sub contract call is nested in its syscall
+type CallTrace struct {
+	Module         string                `json:"module"`
+	Contract       string                `json:"contract"`
+	Method         string                `json:"method"`
+	Args           map[string]TraceBytes `json:"args,omitempty"`
+	TransferAmount string                `json:"transfer_amount,omitempty"`
+	ResourceLimits Limits                `json:"resource_limits"`
+	// ResourceUsed includes the resource used by sub contract calls
+	ResourceUsed Limits          `json:"resource_used"`
+	Status       int             `json:"status,omitempty"`
+	Message      string          `json:"message,omitempty"`
+	Body         TraceBytes      `json:"body,omitempty"`
+	Error        string          `json:"error,omitempty"`
+	Syscalls     []*SyscallTrace `json:"syscalls,omitempty"`
+}
+
+// SyscallTrace is a syscall made by contract, only the fields related to the syscall are set
+type SyscallTrace struct {
+	Name   string     `json:"name"`
+	Bucket string     `json:"bucket,omitempty"`
+	Key    TraceBytes `json:"key,omitempty"`
+	Value  TraceBytes `json:"value,omitempty"`
+	To     string     `json:"to,omitempty"`
+	Amount string     `json:"amount,omitempty"`
+	URI    string     `json:"uri,omitempty"`
+	Event  string     `json:"event,omitempty"`
+	Error  string     `json:"error,omitempty"`
+	// Call is the frame of ContractCall
+	Call *CallTrace `json:"call,omitempty"`
+}
+
+// TraceRead is an entry of the read set
+type TraceRead struct {
+	Bucket  string     `json:"bucket"`
+	Key     TraceBytes `json:"key"`
+	Version string     `json:"version,omitempty"`
+}
+
+// TraceWrite is an entry of the write set
+type TraceWrite struct {
+	Bucket string     `json:"bucket"`
+	Key    TraceBytes `json:"key"`
+	Value  TraceBytes `json:"value"`
+}
+
+// TxTrace is the trace of contract requests in a tx
+type TxTrace struct {
+	Txid     string        `json:"txid,omitempty"`
+	Calls    []*CallTrace  `json:"calls"`
+	ReadSet  []*TraceRead  `json:"read_set"`
+	WriteSet []*TraceWrite `json:"write_set"`
+	// Error is the error which stops the execution
+	Error string `json:"error,omitempty"`
+}
+
+// SetRWSets fill the read set and write set of trace
+func (t *TxTrace) SetRWSets(inputs []*xmodel_pb.VersionedData, outputs []*xmodel_pb.PureData) {
+	t.ReadSet = make([]*TraceRead, 0, len(inputs))
+	for _, in := range inputs {
+		t.ReadSet = append(t.ReadSet, &TraceRead{
+			Bucket:  in.GetPureData().GetBucket(),
+			Key:     in.GetPureData().GetKey(),
+			Version: xmodel.GetVersion(in),
+		})
+	}
+	t.WriteSet = make([]*TraceWrite, 0, len(outputs))
+	for _, out := range outputs {
+		t.WriteSet = append(t.WriteSet, &TraceWrite{
+			Bucket: out.GetBucket(),
+			Key:    out.GetKey(),
+			Value:  out.GetValue(),
+		})
+	}
+}
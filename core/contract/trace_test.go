@@ -0,0 +1,50 @@
+package contract
+
+import (
+	"encoding/json"
+	"testing"
+
+	xmodel_pb "github.com/xuperchain/xuperchain/core/xmodel/pb"
+)
+
+func TestTraceBytesMarshal(t *testing.T) {
+	testCases := []struct {
+		value  TraceBytes
+		expect string
+	}{
+		{TraceBytes("counter"), `"counter"`},
+		{TraceBytes("中文"), `"中文"`},
+		{TraceBytes{0x01, 0xff}, `"0x01ff"`},
+		{TraceBytes("line\n"), `"0x6c696e650a"`},
+	}
+	for _, tc := range testCases {
+		buf, err := json.Marshal(tc.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(buf) != tc.expect {
+			t.Errorf("expect %s, got %s", tc.expect, buf)
+		}
+	}
+}
+
+func TestTxTraceSetRWSets(t *testing.T) {
+	trace := &TxTrace{}
+	inputs := []*xmodel_pb.VersionedData{
+		{
+			PureData:  &xmodel_pb.PureData{Bucket: "counter", Key: []byte("k1")},
+			RefTxid:   []byte{0xab},
+			RefOffset: 1,
+		},
+	}
+	outputs := []*xmodel_pb.PureData{
+		{Bucket: "counter", Key: []byte("k1"), Value: []byte("1")},
+	}
+	trace.SetRWSets(inputs, outputs)
+	if len(trace.ReadSet) != 1 || trace.ReadSet[0].Version != "ab_1" {
+		t.Fatalf("unexpected read set %+v", trace.ReadSet)
+	}
+	if len(trace.WriteSet) != 1 || string(trace.WriteSet[0].Value) != "1" {
+		t.Fatalf("unexpected write set %+v", trace.WriteSet)
+	}
+}
@@ -97,6 +97,21 @@ type ContextConfig struct {
 
 	// ContractCodeFromCache control whether fetch contract code from XMCache
 	ContractCodeFromCache bool
+
+	// Trace records the call and its syscalls if not nil, set by tracing
+	Trace *CallTrace
+
+	// StorageRent is the storage rent of xmodel data, nil if storage rent is disabled
+	StorageRent *StorageRentContext
+
+	// EvmResourceLimits whether the gas, memory and disk of EVM contracts are limited by ResourceLimits
+	EvmResourceLimits bool
+
+	// EthGateway whether the ethereum transactions can be recorded and EVM raw logs are saved
+	EthGateway bool
+
+	// ContractEvents whether the events declaration of non evm contracts is saved when deploying or upgrading
+	ContractEvents bool
 }
 
 // VirtualMachine define virtual machine interface
@@ -128,7 +128,7 @@ func (x *xvmCreator) CreateInstance(ctx *bridge.Context, cp bridge.ContractCodeP
 		return nil, err
 	}
 
-	return createInstance(ctx, code, x.config.SyscallService)
+	return createInstance(ctx, code, x.config.SyscallService, nil)
 }
 
 func (x *xvmCreator) RemoveCache(contractName string) {
@@ -15,10 +15,13 @@ import (
 	gowasm "github.com/xuperchain/xuperchain/core/xvm/runtime/go"
 )
 
-func createInstance(ctx *bridge.Context, code *contractCode, syscall *bridge.SyscallService) (bridge.Instance, error) {
+// createInstance creates an instance of contract, hook is only used by the code of ixvm-debug driver
+func createInstance(ctx *bridge.Context, code *contractCode, syscall *bridge.SyscallService,
+	hook exec.DebugHook) (bridge.Instance, error) {
 	log.Info("instance resource limit", "limits", ctx.ResourceLimits)
 	execCtx, err := code.ExecCode.NewContext(&exec.ContextConfig{
-		GasLimit: ctx.ResourceLimits.Cpu,
+		GasLimit:  ctx.ResourceLimits.Cpu,
+		DebugHook: hook,
 	})
 	if err != nil {
 		log.Error("create contract context error", "error", err, "contract", ctx.ContractName)
@@ -36,13 +36,16 @@ func (x *xvmInterpCreator) makeExecCode(codepath string) (exec.Code, error) {
 	if err != nil {
 		return nil, err
 	}
-	resolver := exec.NewMultiResolver(
+	return exec.NewInterpCode(codebuf, newInterpResolver(x.config.SyscallService))
+}
+
+func newInterpResolver(syscall *bridge.SyscallService) exec.Resolver {
+	return exec.NewMultiResolver(
 		gowasm.NewResolver(),
 		emscripten.NewResolver(),
-		newSyscallResolver(x.config.SyscallService),
+		newSyscallResolver(syscall),
 		builtinResolver,
 	)
-	return exec.NewInterpCode(codebuf, resolver)
 }
 
 func (x *xvmInterpCreator) CreateInstance(ctx *bridge.Context, cp bridge.ContractCodeProvider) (bridge.Instance, error) {
@@ -50,7 +53,7 @@ func (x *xvmInterpCreator) CreateInstance(ctx *bridge.Context, cp bridge.Contrac
 	if err != nil {
 		return nil, err
 	}
-	return createInstance(ctx, code, x.config.SyscallService)
+	return createInstance(ctx, code, x.config.SyscallService, nil)
 }
 
 func (x *xvmInterpCreator) RemoveCache(contractName string) {
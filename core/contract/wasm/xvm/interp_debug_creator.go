@@ -0,0 +1,102 @@
+package xvm
+
+import (
+	"errors"
+	"io/ioutil"
+	"sync"
+
+	"github.com/xuperchain/xuperchain/core/contract/bridge"
+	"github.com/xuperchain/xuperchain/core/xvm/debug/instrument"
+	"github.com/xuperchain/xuperchain/core/xvm/exec"
+)
+
+// DebugDriver is the interpreter driver with debugger attached, which is used by xdev
+const DebugDriver = "ixvm-debug"
+
+// DebugVMConfig is the VMConfig of DebugDriver
+type DebugVMConfig struct {
+	// NewHook returns the debug hook of a new contract instance, info is the debug
+	// information of contract code. The instance is not debugged if nil is returned
+	NewHook func(contractName string, info *instrument.Info) exec.DebugHook
+}
+
+// DriverName implements bridge.VMConfig
+func (d *DebugVMConfig) DriverName() string {
+	return DebugDriver
+}
+
+// IsEnable implements bridge.VMConfig
+func (d *DebugVMConfig) IsEnable() bool {
+	return true
+}
+
+type xvmDebugCreator struct {
+	cm      *codeManager
+	config  bridge.InstanceCreatorConfig
+	newHook func(contractName string, info *instrument.Info) exec.DebugHook
+
+	mutex sync.Mutex
+	infos map[exec.Code]*instrument.Info
+}
+
+func newXVMDebugCreator(creatorConfig *bridge.InstanceCreatorConfig) (bridge.InstanceCreator, error) {
+	vmconfig, ok := creatorConfig.VMConfig.(*DebugVMConfig)
+	if !ok {
+		return nil, errors.New("ixvm-debug driver needs DebugVMConfig")
+	}
+	creator := &xvmDebugCreator{
+		config:  *creatorConfig,
+		newHook: vmconfig.NewHook,
+		infos:   make(map[exec.Code]*instrument.Info),
+	}
+	var err error
+	creator.cm, err = newCodeManager(creator.config.Basedir,
+		creator.compileCode, creator.makeExecCode)
+	if err != nil {
+		return nil, err
+	}
+	return creator, nil
+}
+
+func (x *xvmDebugCreator) compileCode(buf []byte, outputPath string) error {
+	return ioutil.WriteFile(outputPath, buf, 0600)
+}
+
+func (x *xvmDebugCreator) makeExecCode(codepath string) (exec.Code, error) {
+	codebuf, err := ioutil.ReadFile(codepath)
+	if err != nil {
+		return nil, err
+	}
+	code, info, err := exec.NewInterpDebugCode(codebuf, newInterpResolver(x.config.SyscallService))
+	if err != nil {
+		return nil, err
+	}
+	x.mutex.Lock()
+	x.infos[code] = info
+	x.mutex.Unlock()
+	return code, nil
+}
+
+func (x *xvmDebugCreator) CreateInstance(ctx *bridge.Context, cp bridge.ContractCodeProvider) (bridge.Instance, error) {
+	code, err := x.cm.GetExecCode(ctx.ContractName, cp)
+	if err != nil {
+		return nil, err
+	}
+	x.mutex.Lock()
+	info := x.infos[code.ExecCode]
+	x.mutex.Unlock()
+
+	var hook exec.DebugHook
+	if info != nil && x.newHook != nil {
+		hook = x.newHook(ctx.ContractName, info)
+	}
+	return createInstance(ctx, code, x.config.SyscallService, hook)
+}
+
+func (x *xvmDebugCreator) RemoveCache(contractName string) {
+	x.cm.RemoveCode(contractName)
+}
+
+func init() {
+	bridge.Register(bridge.TypeWasm, DebugDriver, newXVMDebugCreator)
+}
@@ -0,0 +1,115 @@
+package xchaincore
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/xuperchain/xuperchain/core/ledger"
+	"github.com/xuperchain/xuperchain/core/pb"
+)
+
+// ShortTxIDLen is the number of txid bytes used as short txid
+const ShortTxIDLen = 8
+
+var (
+	// ErrCompactBlockInvalid the compact block is malformed
+	ErrCompactBlockInvalid = errors.New("invalid compact block")
+	// ErrCompactBlockMismatch the rebuilt block doesn't match the merkle root of compact block
+	ErrCompactBlockMismatch = errors.New("rebuilt block mismatch merkle root")
+)
+
+// ShortTxID return the short txid of txid
+func ShortTxID(txid []byte) uint64 {
+	buf := make([]byte, ShortTxIDLen)
+	copy(buf, txid)
+	return binary.LittleEndian.Uint64(buf)
+}
+
+// NewCompactBlock make compact block from block, coinbase and autogen txs are prefilled
+// since they never go through the unconfirmed pool of receivers
+func NewCompactBlock(bcname string, block *pb.InternalBlock) *pb.CompactBlock {
+	header := proto.Clone(block).(*pb.InternalBlock)
+	header.Transactions = nil
+	header.MerkleTree = nil
+	cb := &pb.CompactBlock{
+		Bcname:     bcname,
+		Blockid:    block.Blockid,
+		Block:      header,
+		ShortTxids: make([]uint64, 0, len(block.Transactions)),
+	}
+	for i, tx := range block.Transactions {
+		cb.ShortTxids = append(cb.ShortTxids, ShortTxID(tx.Txid))
+		if tx.Coinbase || tx.Autogen {
+			cb.PrefilledTxs = append(cb.PrefilledTxs, &pb.PrefilledTx{Index: int32(i), Tx: tx})
+		}
+	}
+	return cb
+}
+
+// rebuildCompactBlock fill txs of compact block from prefilled txs and the unconfirmed txs,
+// return the txs in block order and indexes of the missing ones
+func rebuildCompactBlock(cb *pb.CompactBlock, unconfirmedTxs []*pb.Transaction) ([]*pb.Transaction, []int32, error) {
+	if cb.GetBlock() == nil || int(cb.GetBlock().GetTxCount()) != len(cb.GetShortTxids()) {
+		return nil, nil, ErrCompactBlockInvalid
+	}
+	txs := make([]*pb.Transaction, len(cb.ShortTxids))
+	for _, ptx := range cb.PrefilledTxs {
+		if ptx.Index < 0 || int(ptx.Index) >= len(txs) || ptx.Tx == nil {
+			return nil, nil, ErrCompactBlockInvalid
+		}
+		txs[ptx.Index] = ptx.Tx
+	}
+
+	// 短id冲突的交易无法确定是哪一笔, 当作缺失交易处理
+	pool := make(map[uint64]*pb.Transaction, len(unconfirmedTxs))
+	conflicts := make(map[uint64]bool)
+	for _, tx := range unconfirmedTxs {
+		sid := ShortTxID(tx.Txid)
+		if old, ok := pool[sid]; ok && !bytes.Equal(old.Txid, tx.Txid) {
+			conflicts[sid] = true
+		}
+		pool[sid] = tx
+	}
+	var missing []int32
+	for i, sid := range cb.ShortTxids {
+		if txs[i] != nil {
+			continue
+		}
+		if tx, ok := pool[sid]; ok && !conflicts[sid] {
+			txs[i] = tx
+			continue
+		}
+		missing = append(missing, int32(i))
+	}
+	return txs, missing, nil
+}
+
+// fillCompactBlock fill the missing txs got from peer and make the full block,
+// the merkle root is checked to make sure the rebuilt block is the same as miner's
+func fillCompactBlock(cb *pb.CompactBlock, txs []*pb.Transaction, missing []int32, missingTxs []*pb.Transaction) (*pb.InternalBlock, error) {
+	if len(missing) != len(missingTxs) {
+		return nil, ErrCompactBlockMismatch
+	}
+	for i, index := range missing {
+		tx := missingTxs[i]
+		if tx == nil || ShortTxID(tx.Txid) != cb.ShortTxids[index] {
+			return nil, ErrCompactBlockMismatch
+		}
+		txs[index] = tx
+	}
+	merkleTree := ledger.MakeMerkleTree(txs)
+	var merkleRoot []byte
+	if len(merkleTree) > 0 {
+		merkleRoot = merkleTree[len(merkleTree)-1]
+	}
+	if !bytes.Equal(merkleRoot, cb.GetBlock().GetMerkleRoot()) {
+		return nil, ErrCompactBlockMismatch
+	}
+	block := proto.Clone(cb.Block).(*pb.InternalBlock)
+	block.Transactions = txs
+	block.MerkleTree = merkleTree
+	return block, nil
+}
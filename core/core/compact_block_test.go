@@ -0,0 +1,83 @@
+package xchaincore
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/xuperchain/xuperchain/core/ledger"
+	"github.com/xuperchain/xuperchain/core/pb"
+)
+
+func makeCompactTestBlock(txCount int) *pb.InternalBlock {
+	block := &pb.InternalBlock{
+		Version: ledger.BlockVersion,
+		Height:  10,
+		TxCount: int32(txCount),
+	}
+	for i := 0; i < txCount; i++ {
+		txid := sha256.Sum256([]byte(fmt.Sprintf("tx%d", i)))
+		block.Transactions = append(block.Transactions, &pb.Transaction{
+			Txid:     txid[:],
+			Coinbase: i == 0,
+		})
+	}
+	block.MerkleTree = ledger.MakeMerkleTree(block.Transactions)
+	block.MerkleRoot = block.MerkleTree[len(block.MerkleTree)-1]
+	block.Blockid, _ = ledger.MakeBlockID(block)
+	return block
+}
+
+func TestCompactBlockRebuild(t *testing.T) {
+	block := makeCompactTestBlock(5)
+	cb := NewCompactBlock("xuper", block)
+	if cb.Block.Transactions != nil || cb.Block.MerkleTree != nil || len(block.Transactions) != 5 {
+		t.Fatal("compact block should not contain txs and origin block should not be changed")
+	}
+	if len(cb.ShortTxids) != 5 || len(cb.PrefilledTxs) != 1 || cb.PrefilledTxs[0].Index != 0 {
+		t.Fatalf("unexpected compact block %v", cb)
+	}
+
+	// 本地缺少第2和第4笔交易, 另有一笔不在块中的交易
+	other := sha256.Sum256([]byte("other"))
+	unconfirmed := []*pb.Transaction{
+		block.Transactions[3], block.Transactions[1], {Txid: other[:]},
+	}
+	txs, missing, err := rebuildCompactBlock(cb, unconfirmed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(missing) != 2 || missing[0] != 2 || missing[1] != 4 {
+		t.Fatalf("unexpected missing %v", missing)
+	}
+	if _, err := fillCompactBlock(cb, txs, missing, []*pb.Transaction{block.Transactions[4], block.Transactions[2]}); err != ErrCompactBlockMismatch {
+		t.Fatalf("expect mismatch error got %v", err)
+	}
+	rebuilt, err := fillCompactBlock(cb, txs, missing, []*pb.Transaction{block.Transactions[2], block.Transactions[4]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	blockid, _ := ledger.MakeBlockID(rebuilt)
+	if string(blockid) != string(block.Blockid) || ledger.VerifyMerkle(rebuilt) != nil {
+		t.Fatal("rebuilt block mismatch")
+	}
+}
+
+func TestCompactBlockShortIDConflict(t *testing.T) {
+	block := makeCompactTestBlock(2)
+	cb := NewCompactBlock("xuper", block)
+	fake := append([]byte{}, block.Transactions[1].Txid[:ShortTxIDLen]...)
+	fake = append(fake, []byte("conflict")...)
+	_, missing, err := rebuildCompactBlock(cb, []*pb.Transaction{block.Transactions[1], {Txid: fake}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(missing) != 1 || missing[0] != 1 {
+		t.Fatalf("conflicted tx should be missing, got %v", missing)
+	}
+
+	cb.ShortTxids = cb.ShortTxids[:1]
+	if _, _, err := rebuildCompactBlock(cb, nil); err != ErrCompactBlockInvalid {
+		t.Fatalf("expect invalid error got %v", err)
+	}
+}
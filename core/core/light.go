@@ -0,0 +1,173 @@
+package xchaincore
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/xuperchain/xuperchain/core/global"
+	"github.com/xuperchain/xuperchain/core/ledger"
+	"github.com/xuperchain/xuperchain/core/pb"
+)
+
+var (
+	// ErrNoValidProof is returned when no full peer returns a valid proof to light node
+	ErrNoValidProof = errors.New("no valid proof from full peers")
+	// ErrLightNoStateRoot is returned when running LIGHT mode on a chain without state root
+	ErrLightNoStateRoot = errors.New("LIGHT mode requires state_root enabled in genesis")
+)
+
+// lightHeader removes the body of block, blockid does not depend on the removed fields
+func lightHeader(block *pb.InternalBlock) *pb.InternalBlock {
+	block.Transactions = nil
+	block.MerkleTree = nil
+	return block
+}
+
+// parseUtxoKey parses txid and offset from the utxo key without address prefix
+func parseUtxoKey(key []byte) ([]byte, int32, error) {
+	fields := strings.Split(string(key), "_")
+	if len(fields) != 2 {
+		return nil, 0, errors.New("invalid utxo key")
+	}
+	txid, err := hex.DecodeString(fields[0])
+	if err != nil {
+		return nil, 0, err
+	}
+	offset, err := strconv.ParseInt(fields[1], 10, 32)
+	if err != nil {
+		return nil, 0, err
+	}
+	return txid, int32(offset), nil
+}
+
+// sendHeader confirms the header of block and its missing ancestors in LIGHT mode,
+// the headers are verified by consensus rules instead of executing transactions
+func (xc *XChainCore) sendHeader(in *pb.Block, hd *global.XContext) error {
+	xc.mutex.Lock()
+	defer xc.mutex.Unlock()
+	if xc.Ledger.ExistBlock(in.Blockid) {
+		xc.log.Debug("Block is exist", "logid", in.Header.Logid, "cost", hd.Timer.Print())
+		return ErrBlockExist
+	}
+	if in.Block.Height <= xc.Ledger.GetMeta().TrunkHeight-3 {
+		xc.log.Warn("refuse short chain of blocks", "remote", in.Block.Height, "local", xc.Ledger.GetMeta().TrunkHeight)
+		return ErrServiceRefused
+	}
+	in.Block = lightHeader(in.Block)
+	if err := xc.Ledger.SavePendingBlock(in); err != nil {
+		xc.log.Warn("Save Pending Block error! ", "logid", in.Header.Logid, "blockid", global.F(in.Blockid))
+		return ErrCannotSyncBlock
+	}
+	// 向前找到本地已有的区块, 缺少的区块头从其他节点获取
+	headers := []*pb.InternalBlock{in.Block}
+	for preHash := in.Block.PreHash; !xc.Ledger.ExistBlock(preHash); {
+		pre, _ := xc.Ledger.GetPendingBlock(preHash)
+		if pre == nil {
+			pre = xc.BroadCastGetBlock(&pb.BlockID{Header: in.Header, Bcname: in.Bcname, Blockid: preHash, NeedContent: true})
+			if pre == nil || pre.Block == nil || !bytes.Equal(pre.Block.Blockid, preHash) {
+				xc.log.Warn("Can't Get a Block", "logid", in.Header.Logid, "blockid", global.F(preHash))
+				return ErrCannotSyncBlock
+			}
+			pre.Block = lightHeader(pre.Block)
+			if err := xc.Ledger.SavePendingBlock(pre); err != nil {
+				return ErrCannotSyncBlock
+			}
+		}
+		headers = append(headers, pre.Block)
+		preHash = pre.Block.PreHash
+	}
+	for i := len(headers) - 1; i >= 0; i-- {
+		// 校验区块签名, 矿工身份以及Justify中的QuorumCert签名
+		if ok, _ := xc.con.CheckMinerMatch(in.Header, headers[i]); !ok {
+			xc.log.Warn("refused a connection because of check miner error", "logid", in.Header.Logid,
+				"blockid", global.F(headers[i].Blockid))
+			return ErrServiceRefused
+		}
+		cs := xc.Ledger.ConfirmBlock(headers[i], false)
+		if !cs.Succ {
+			xc.log.Warn("confirm header error", "logid", in.Header.Logid, "error", cs.Error)
+			return ErrConfirmBlock
+		}
+	}
+	xc.con.ProcessConfirmBlock(in.Block)
+	xc.log.Debug("confirm headers in light mode", "logid", in.Header.Logid, "count", len(headers),
+		"height", in.Block.Height, "cost", hd.Timer.Print())
+	return nil
+}
+
+// queryTxLight queries the tx from full peers and verifies its merkle proof against local headers
+func (xc *XChainCore) queryTxLight(in *pb.TxStatus, out *pb.TxStatus) *pb.TxStatus {
+	proof, err := xc.getTxProofFromPeers(in.Txid)
+	if err != nil {
+		xc.log.Debug("Query Transaction from full peers error", "logid", in.Header.Logid, "Txid", global.F(in.Txid), "error", err)
+		out.Status = pb.TransactionStatus_NOEXIST
+		return out
+	}
+	ib, err := xc.Ledger.QueryBlockHeader(proof.Block.Blockid)
+	if err != nil {
+		out.Header.Error = pb.XChainErrorEnum_UNKNOW_ERROR
+		return out
+	}
+	out.Tx = proof.Tx
+	out.Status = pb.TransactionStatus_CONFIRM
+	out.Distance = xc.Ledger.GetMeta().TrunkHeight - ib.Height
+	return out
+}
+
+// verifyTxProof checks the tx proof got from peers, the block of proof must be in local trunk
+func (xc *XChainCore) verifyTxProof(txid []byte, proof *pb.TxProof) error {
+	if proof == nil || proof.Block == nil || proof.Tx == nil || !bytes.Equal(proof.Tx.Txid, txid) {
+		return ledger.ErrInvalidProof
+	}
+	if err := ledger.VerifyTxProof(proof); err != nil {
+		return err
+	}
+	ib, err := xc.Ledger.QueryBlockHeader(proof.Block.Blockid)
+	if err != nil {
+		return err
+	}
+	if !ib.InTrunk {
+		return ledger.ErrInvalidProof
+	}
+	return nil
+}
+
+// getBalanceLight sums the utxos of addr got from full peers, the utxos are verified as a whole by the state proof,
+// so that a peer can't omit any utxo
+func (xc *XChainCore) getBalanceLight(addr string) (string, error) {
+	res, err := xc.getUtxoProofFromPeers(addr)
+	if err != nil {
+		return "", err
+	}
+	balance := big.NewInt(0)
+	for _, proof := range res.Proofs {
+		balance.Add(balance, big.NewInt(0).SetBytes(proof.Amount))
+	}
+	return balance.String(), nil
+}
+
+// verifyUtxoProof checks the utxos got from peers are all the utxos of addr in the state tree of local header,
+// it returns the height of the block which the proof is made at
+func (xc *XChainCore) verifyUtxoProof(addr string, res *pb.UtxoProofResponse) (int64, error) {
+	if res.GetBlock() == nil || res.Address != addr {
+		return 0, ledger.ErrInvalidProof
+	}
+	ib, err := xc.Ledger.QueryBlockHeader(res.Block.Blockid)
+	if err != nil {
+		return 0, err
+	}
+	if !ib.InTrunk {
+		return 0, ledger.ErrInvalidProof
+	}
+	if len(ib.StateRoot) == 0 {
+		return 0, ledger.ErrStateRootDisabled
+	}
+	if err := ledger.VerifyUtxoSetProof(ib.StateRoot, res); err != nil {
+		return 0, err
+	}
+	return ib.Height, nil
+}
@@ -0,0 +1,38 @@
+package xchaincore
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/xuperchain/xuperchain/core/ledger"
+)
+
+func TestLightHeader(t *testing.T) {
+	block := makeCompactTestBlock(3)
+	blockid := block.Blockid
+	header := lightHeader(block)
+	if header.Transactions != nil || header.MerkleTree != nil {
+		t.Fatal("light header should not contain body")
+	}
+	id, err := ledger.MakeBlockID(header)
+	if err != nil || !bytes.Equal(id, blockid) {
+		t.Fatal("blockid of light header should not be changed", err)
+	}
+}
+
+func TestParseUtxoKey(t *testing.T) {
+	txid := []byte("0123456789abcdef")
+	key := []byte(fmt.Sprintf("%s_%d", hex.EncodeToString(txid), 3))
+	refTxid, offset, err := parseUtxoKey(key)
+	if err != nil || !bytes.Equal(refTxid, txid) || offset != 3 {
+		t.Fatal("parse utxo key error", err, refTxid, offset)
+	}
+	invalidKeys := []string{"", "abc", "zz_1", hex.EncodeToString(txid) + "_x", "a_b_c"}
+	for _, k := range invalidKeys {
+		if _, _, err := parseUtxoKey([]byte(k)); err == nil {
+			t.Fatal("expect error for invalid key", k)
+		}
+	}
+}
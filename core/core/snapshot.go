@@ -0,0 +1,44 @@
+package xchaincore
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/xuperchain/xuperchain/core/global"
+	"github.com/xuperchain/xuperchain/core/kv/kvdb"
+	"github.com/xuperchain/xuperchain/core/snapshot"
+)
+
+// exportSnapshot exports the state at the irreversible block height into a sub directory of path,
+// mining and block syncing are paused until the state is restored.
+func (xc *XChainCore) exportSnapshot(path string) error {
+	xc.mutex.Lock()
+	defer xc.mutex.Unlock()
+
+	height := xc.Utxovm.GetIrreversibleBlockHeight()
+	block, err := xc.Ledger.QueryBlockByHeight(height)
+	if err != nil {
+		xc.log.Warn("exportSnapshot query irreversible block error", "height", height, "err", err)
+		return err
+	}
+	dir := filepath.Join(path, fmt.Sprintf("%s-%d", xc.bcname, height))
+	var manifest *snapshot.Manifest
+	err = xc.Utxovm.ExportState(block.Blockid, func(stateDB kvdb.Database) error {
+		var exportErr error
+		manifest, exportErr = snapshot.Export(&snapshot.ExportOptions{
+			Dir:         dir,
+			Bcname:      xc.bcname,
+			Ledger:      xc.Ledger,
+			StateDB:     stateDB,
+			Block:       block,
+			GenesisPath: filepath.Join(xc.datapath, "xuper.json"),
+		})
+		return exportErr
+	})
+	if err != nil {
+		return err
+	}
+	xc.log.Info("export snapshot success", "dir", dir, "height", manifest.Height,
+		"blockid", manifest.Blockid, "utxovm", global.F(xc.Utxovm.GetLatestBlockid()))
+	return nil
+}
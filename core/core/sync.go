@@ -17,10 +17,16 @@ const (
 	MaxSyncTimes = 5
 	// MaxSleepMilSecond ...
 	MaxSleepMilSecond = 500
+	// MaxSyncHeaders is the max number of block headers in one GET_BLOCK_HEADERS response
+	MaxSyncHeaders = 1000
 )
 
 // SyncBlocks sync block while start to miner
 func (xc *XChainCore) SyncBlocks() {
+	// 落后较多时先用流水线方式追赶, 剩下的少量区块和分叉仍由下面的逻辑处理
+	if err := xc.syncMgr.syncFromPeers(); err != nil {
+		xc.log.Warn("pipelined sync blocks failed", "error", err)
+	}
 	hd := &global.XContext{Timer: global.NewXTimer()}
 	for i := 0; i < MaxSyncTimes; i++ {
 		xc.log.Trace("sync blocks", "blockname", xc.bcname, "try times", i)
@@ -48,22 +54,10 @@ func (xc *XChainCore) SyncBlocks() {
 
 // syncForOnce sync block from peer nodes for one times
 func (xc *XChainCore) syncForOnce() (*pb.BCStatus, bool) {
-	bcs := &pb.BCStatus{Bcname: xc.bcname}
-	bcsBuf, _ := proto.Marshal(bcs)
-	msg, err := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, xc.bcname, "", xuper_p2p.XuperMessage_GET_BLOCKCHAINSTATUS, bcsBuf, xuper_p2p.XuperMessage_NONE)
-	if err != nil {
-		xc.log.Warn("syncForOnce error", "error", err)
-		return nil, false
-	}
 	filters := []p2p_base.FilterStrategy{p2p_base.NearestBucketStrategy}
-	whiteList := xc.groupChain.GetAllowedPeersWithBcname(xc.bcname)
-	opts := []p2p_base.MessageOption{
-		p2p_base.WithFilters(filters),
-		p2p_base.WithBcName(xc.bcname),
-		p2p_base.WithWhiteList(whiteList),
-	}
-	hbcs, err := xc.P2pSvr.SendMessageWithResponse(context.Background(), msg, opts...)
+	hbcs, err := xc.getPeersBlockChainStatus(filters)
 	if err != nil {
+		xc.log.Warn("syncForOnce error", "error", err)
 		return nil, false
 	}
 	hbc := countGetBlockChainStatus(hbcs)
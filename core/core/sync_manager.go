@@ -0,0 +1,380 @@
+package xchaincore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/xuperchain/xuperchain/core/common/config"
+	"github.com/xuperchain/xuperchain/core/global"
+	"github.com/xuperchain/xuperchain/core/ledger"
+	p2p_base "github.com/xuperchain/xuperchain/core/p2p/base"
+	xuper_p2p "github.com/xuperchain/xuperchain/core/p2p/pb"
+	"github.com/xuperchain/xuperchain/core/pb"
+)
+
+var (
+	// ErrSyncForked is returned when the headers got from peers do not link to the local chain
+	ErrSyncForked = errors.New("headers from peers are not linked to local chain")
+	// ErrSyncHeader is returned when a header got from peers is invalid
+	ErrSyncHeader = errors.New("invalid block header from peers")
+)
+
+// syncPeer is a peer used to download blocks
+type syncPeer struct {
+	id     string
+	height int64
+}
+
+// syncManager 流水线方式同步落后的区块:
+// 先从一个节点顺序拉取区块头并校验链接关系, 再由多个协程从不同节点并行下载区块体并校验,
+// 最后按高度顺序存入pending表, 每凑够一批调用SendBlock确认
+type syncManager struct {
+	xc  *XChainCore
+	opt config.SyncOption
+	// running ensures only one pipeline at the same time
+	running int32
+
+	mutex  sync.RWMutex
+	status *pb.SyncStatus
+}
+
+func newSyncManager(xc *XChainCore, opt config.SyncOption) *syncManager {
+	if opt.MaxPeers <= 0 {
+		opt.MaxPeers = 1
+	}
+	if opt.Workers <= 0 {
+		opt.Workers = 1
+	}
+	if opt.HeaderBatchSize <= 0 || opt.HeaderBatchSize > MaxSyncHeaders {
+		opt.HeaderBatchSize = MaxSyncHeaders
+	}
+	if opt.Window < opt.HeaderBatchSize {
+		opt.Window = opt.HeaderBatchSize
+	}
+	return &syncManager{
+		xc:  xc,
+		opt: opt,
+	}
+}
+
+// Status returns the progress of the latest pipelined sync, nil if never started
+func (sm *syncManager) Status() *pb.SyncStatus {
+	if sm == nil {
+		return nil
+	}
+	sm.mutex.RLock()
+	defer sm.mutex.RUnlock()
+	if sm.status == nil {
+		return nil
+	}
+	return proto.Clone(sm.status).(*pb.SyncStatus)
+}
+
+func (sm *syncManager) updateStatus(fn func(status *pb.SyncStatus)) {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+	fn(sm.status)
+}
+
+// syncFromPeers catches up with peers until the local height is behind them no more than the threshold
+func (sm *syncManager) syncFromPeers() error {
+	if !atomic.CompareAndSwapInt32(&sm.running, 0, 1) {
+		return nil
+	}
+	defer atomic.StoreInt32(&sm.running, 0)
+	filters := []p2p_base.FilterStrategy{p2p_base.NearestBucketStrategy}
+	if sm.xc.NeedCoreConnection() {
+		filters = append(filters, p2p_base.CorePeersStrategy)
+	}
+	for {
+		res, err := sm.xc.getPeersBlockChainStatus(filters)
+		if err != nil {
+			return err
+		}
+		localHeight := sm.xc.Ledger.GetMeta().TrunkHeight
+		peers, target := selectSyncPeers(res, localHeight, sm.opt.MaxPeers)
+		if len(peers) == 0 || target-localHeight <= sm.opt.Threshold {
+			return nil
+		}
+		if err := sm.run(peers, target); err != nil {
+			return err
+		}
+	}
+}
+
+// selectSyncPeers chooses the highest peers and returns the height all of them have reached
+func selectSyncPeers(res []*xuper_p2p.XuperMessage, localHeight int64, maxPeers int) ([]syncPeer, int64) {
+	peers := make([]syncPeer, 0, len(res))
+	seen := make(map[string]bool, len(res))
+	for _, msg := range res {
+		if msg.GetHeader().GetErrorType() != xuper_p2p.XuperMessage_SUCCESS {
+			continue
+		}
+		from := msg.GetHeader().GetFrom()
+		if from == "" || seen[from] {
+			continue
+		}
+		bcStatus := &pb.BCStatus{}
+		if err := proto.Unmarshal(msg.GetData().GetMsgInfo(), bcStatus); err != nil {
+			continue
+		}
+		height := bcStatus.GetMeta().GetTrunkHeight()
+		if height <= localHeight {
+			continue
+		}
+		seen[from] = true
+		peers = append(peers, syncPeer{id: from, height: height})
+	}
+	if len(peers) == 0 {
+		return nil, localHeight
+	}
+	sort.SliceStable(peers, func(i, j int) bool {
+		return peers[i].height > peers[j].height
+	})
+	if len(peers) > maxPeers {
+		peers = peers[:maxPeers]
+	}
+	return peers, peers[len(peers)-1].height
+}
+
+// verifySyncHeader checks the header is the next one of pre
+func verifySyncHeader(pre, header *pb.InternalBlock) error {
+	if header.GetHeight() != pre.GetHeight()+1 {
+		return fmt.Errorf("%s, expect height %d got %d", ErrSyncHeader, pre.GetHeight()+1, header.GetHeight())
+	}
+	if !bytes.Equal(header.PreHash, pre.Blockid) {
+		return ErrSyncForked
+	}
+	blockid, err := ledger.MakeBlockID(header)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(blockid, header.Blockid) {
+		return fmt.Errorf("%s, blockid mismatch at height %d", ErrSyncHeader, header.Height)
+	}
+	return nil
+}
+
+// run syncs blocks from the local tip to target
+func (sm *syncManager) run(peers []syncPeer, target int64) error {
+	xc := sm.xc
+	tip, err := xc.Ledger.QueryBlockHeader(xc.Ledger.GetMeta().TipBlockid)
+	if err != nil {
+		return err
+	}
+	peerIDs := make([]string, 0, len(peers))
+	for _, peer := range peers {
+		peerIDs = append(peerIDs, peer.id)
+	}
+	sm.mutex.Lock()
+	sm.status = &pb.SyncStatus{
+		Syncing:       true,
+		StartHeight:   tip.Height + 1,
+		CurrentHeight: tip.Height,
+		TargetHeight:  target,
+		Peers:         peerIDs,
+	}
+	sm.mutex.Unlock()
+	defer sm.updateStatus(func(status *pb.SyncStatus) {
+		status.Syncing = false
+	})
+	xc.log.Info("start pipelined sync", "from", tip.Height+1, "target", target, "peers", peerIDs)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var (
+		once     sync.Once
+		firstErr error
+		wg       sync.WaitGroup
+	)
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	// window限制已下载但还未存入pending表的区块个数
+	window := make(chan struct{}, sm.opt.Window)
+	headerCh := make(chan *pb.InternalBlock, sm.opt.Workers)
+	blockCh := make(chan *pb.Block, sm.opt.Workers)
+	wg.Add(1 + sm.opt.Workers)
+	go func() {
+		defer wg.Done()
+		if err := sm.fetchHeaders(ctx, peers, tip, target, window, headerCh); err != nil {
+			fail(err)
+		}
+	}()
+	for i := 0; i < sm.opt.Workers; i++ {
+		go func() {
+			defer wg.Done()
+			if err := sm.downloadBlocks(ctx, peers, headerCh, blockCh); err != nil {
+				fail(err)
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(blockCh)
+	}()
+
+	// 区块可能乱序到达, 按高度顺序存入pending表后分批确认
+	pending := make(map[int64]*pb.Block)
+	next, confirmed := tip.Height+1, tip.Height
+	var last *pb.Block
+	for block := range blockCh {
+		if ctx.Err() != nil {
+			continue
+		}
+		pending[block.Block.Height] = block
+		for b, ok := pending[next]; ok; b, ok = pending[next] {
+			delete(pending, next)
+			if err := xc.Ledger.SavePendingBlock(b); err != nil {
+				fail(err)
+				break
+			}
+			<-window
+			last = b
+			next++
+		}
+		if ctx.Err() == nil && last != nil && last.Block.Height-confirmed >= sm.opt.HeaderBatchSize {
+			if err := sm.confirm(last); err != nil {
+				fail(err)
+				continue
+			}
+			confirmed = last.Block.Height
+		}
+	}
+	if firstErr == nil && last != nil && last.Block.Height > confirmed {
+		if err := sm.confirm(last); err != nil {
+			fail(err)
+		}
+	}
+	if firstErr != nil {
+		xc.log.Warn("pipelined sync stopped", "height", xc.Ledger.GetMeta().TrunkHeight, "error", firstErr)
+		return firstErr
+	}
+	xc.log.Info("pipelined sync finished", "height", xc.Ledger.GetMeta().TrunkHeight)
+	return nil
+}
+
+// confirm confirms the saved pending blocks up to block in order of height
+func (sm *syncManager) confirm(block *pb.Block) error {
+	hd := &global.XContext{Timer: global.NewXTimer()}
+	err := sm.xc.SendBlock(block, hd)
+	if err != nil && err != ErrBlockExist {
+		return err
+	}
+	sm.updateStatus(func(status *pb.SyncStatus) {
+		status.CurrentHeight = block.Block.Height
+	})
+	sm.xc.log.Debug("pipelined sync confirm blocks", "height", block.Block.Height, "cost", hd.Timer.Print())
+	return nil
+}
+
+// fetchHeaders fetches headers after pre up to target and sends them to out in order
+func (sm *syncManager) fetchHeaders(ctx context.Context, peers []syncPeer, pre *pb.InternalBlock, target int64,
+	window chan struct{}, out chan<- *pb.InternalBlock) error {
+	defer close(out)
+	for round := 0; pre.Height < target; round++ {
+		size := target - pre.Height
+		if size > sm.opt.HeaderBatchSize {
+			size = sm.opt.HeaderBatchSize
+		}
+		headers, err := sm.fetchHeaderBatch(ctx, peers, round, pre.Height+1, size)
+		if err != nil {
+			return err
+		}
+		for _, header := range headers {
+			if err := verifySyncHeader(pre, header); err != nil {
+				return err
+			}
+			select {
+			case window <- struct{}{}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			select {
+			case out <- header:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			pre = header
+		}
+	}
+	return nil
+}
+
+// fetchHeaderBatch tries the peers in turn until one of them returns the headers
+func (sm *syncManager) fetchHeaderBatch(ctx context.Context, peers []syncPeer, round int, height, size int64) ([]*pb.InternalBlock, error) {
+	for i := 0; i < len(peers); i++ {
+		peer := peers[(round+i)%len(peers)]
+		headers, err := sm.xc.getBlockHeadersFromPeer(ctx, peer.id, height, size)
+		if err == nil && len(headers) > 0 {
+			if int64(len(headers)) > size {
+				headers = headers[:size]
+			}
+			return headers, nil
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		sm.xc.log.Warn("pipelined sync fetch headers failed", "peer", peer.id, "height", height, "error", err)
+	}
+	return nil, ErrCannotSyncBlock
+}
+
+// downloadBlocks downloads and verifies the bodies of headers from in
+func (sm *syncManager) downloadBlocks(ctx context.Context, peers []syncPeer, in <-chan *pb.InternalBlock, out chan<- *pb.Block) error {
+	for header := range in {
+		block, err := sm.downloadBlock(ctx, peers, header)
+		if err != nil {
+			return err
+		}
+		select {
+		case out <- block:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+func (sm *syncManager) downloadBlock(ctx context.Context, peers []syncPeer, header *pb.InternalBlock) (*pb.Block, error) {
+	if sm.xc.nodeMode == config.NodeModeLight {
+		// 轻节点只同步区块头
+		return &pb.Block{Header: global.GHeader(), Bcname: sm.xc.bcname, Blockid: header.Blockid, Block: header}, nil
+	}
+	for i := 0; i < len(peers); i++ {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		// 不同高度的区块分散到不同节点下载
+		peer := peers[(int(header.Height)+i)%len(peers)]
+		block, err := sm.xc.getBlockFromPeer(ctx, header.Blockid, peer.id)
+		if err != nil {
+			continue
+		}
+		if !bytes.Equal(block.GetBlock().GetBlockid(), header.Blockid) {
+			sm.xc.log.Warn("pipelined sync got unexpected block", "peer", peer.id, "height", header.Height)
+			sm.xc.P2pSvr.ReportPeer(peer.id, p2p_base.PeerEventInvalidBlock)
+			continue
+		}
+		if ok, err := sm.xc.Ledger.VerifyBlock(block.Block, block.GetHeader().GetLogid()); !ok {
+			sm.xc.log.Warn("pipelined sync verify block failed", "peer", peer.id, "height", header.Height, "error", err)
+			sm.xc.P2pSvr.ReportPeer(peer.id, p2p_base.PeerEventInvalidBlock)
+			continue
+		}
+		block.Header = global.GHeader()
+		block.Bcname = sm.xc.bcname
+		return block, nil
+	}
+	return nil, ErrCannotSyncBlock
+}
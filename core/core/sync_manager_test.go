@@ -0,0 +1,76 @@
+package xchaincore
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/xuperchain/xuperchain/core/ledger"
+	p2p_base "github.com/xuperchain/xuperchain/core/p2p/base"
+	xuper_p2p "github.com/xuperchain/xuperchain/core/p2p/pb"
+	"github.com/xuperchain/xuperchain/core/pb"
+)
+
+func makeStatusMsg(t *testing.T, from string, height int64, errType xuper_p2p.XuperMessage_ErrorType) *xuper_p2p.XuperMessage {
+	buf, _ := proto.Marshal(&pb.BCStatus{Bcname: "xuper", Meta: &pb.LedgerMeta{TrunkHeight: height}})
+	msg, err := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, "xuper", "",
+		xuper_p2p.XuperMessage_GET_BLOCKCHAINSTATUS_RES, buf, errType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg.Header.From = from
+	return msg
+}
+
+func TestSelectSyncPeers(t *testing.T) {
+	res := []*xuper_p2p.XuperMessage{
+		makeStatusMsg(t, "a", 1000, xuper_p2p.XuperMessage_SUCCESS),
+		makeStatusMsg(t, "b", 900, xuper_p2p.XuperMessage_SUCCESS),
+		makeStatusMsg(t, "c", 1200, xuper_p2p.XuperMessage_SUCCESS),
+		makeStatusMsg(t, "c", 1300, xuper_p2p.XuperMessage_SUCCESS),
+		makeStatusMsg(t, "d", 5000, xuper_p2p.XuperMessage_GET_BLOCKCHAIN_ERROR),
+		makeStatusMsg(t, "e", 50, xuper_p2p.XuperMessage_SUCCESS),
+	}
+	peers, target := selectSyncPeers(res, 100, 2)
+	if len(peers) != 2 || peers[0].id != "c" || peers[1].id != "a" {
+		t.Fatalf("unexpected peers %v", peers)
+	}
+	if target != 1000 {
+		t.Fatalf("expect target 1000 got %d", target)
+	}
+	peers, target = selectSyncPeers(res, 100, 10)
+	if len(peers) != 3 || target != 900 {
+		t.Fatalf("unexpected peers %v target %d", peers, target)
+	}
+	if peers, _ = selectSyncPeers(res, 2000, 10); len(peers) != 0 {
+		t.Fatalf("expect no peers got %v", peers)
+	}
+}
+
+func TestVerifySyncHeader(t *testing.T) {
+	makeHeader := func(height int64, preHash []byte) *pb.InternalBlock {
+		header := &pb.InternalBlock{
+			Version:    ledger.BlockVersion,
+			Height:     height,
+			PreHash:    preHash,
+			MerkleRoot: []byte("merkle"),
+		}
+		header.Blockid, _ = ledger.MakeBlockID(header)
+		return header
+	}
+	pre := makeHeader(10, []byte("pre"))
+	header := makeHeader(11, pre.Blockid)
+	if err := verifySyncHeader(pre, header); err != nil {
+		t.Fatal(err)
+	}
+	if err := verifySyncHeader(pre, makeHeader(11, []byte("other"))); err != ErrSyncForked {
+		t.Fatalf("expect ErrSyncForked got %v", err)
+	}
+	if err := verifySyncHeader(pre, makeHeader(12, pre.Blockid)); err == nil {
+		t.Fatal("expect error of height")
+	}
+	header.Nonce = 1
+	if err := verifySyncHeader(pre, header); err == nil {
+		t.Fatal("expect error of blockid")
+	}
+}
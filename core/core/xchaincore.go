@@ -26,6 +26,7 @@ import (
 	"github.com/xuperchain/xuperchain/core/consensus"
 	cons_base "github.com/xuperchain/xuperchain/core/consensus/base"
 	"github.com/xuperchain/xuperchain/core/consensus/tdpos"
+	"github.com/xuperchain/xuperchain/core/contract"
 	"github.com/xuperchain/xuperchain/core/contract/bridge"
 	"github.com/xuperchain/xuperchain/core/contract/kernel"
 	"github.com/xuperchain/xuperchain/core/contract/proposal"
@@ -37,6 +38,7 @@ import (
 	xuper_p2p "github.com/xuperchain/xuperchain/core/p2p/pb"
 	"github.com/xuperchain/xuperchain/core/pb"
 	"github.com/xuperchain/xuperchain/core/utxo"
+	"github.com/xuperchain/xuperchain/core/xmodel"
 )
 
 var (
@@ -109,6 +111,11 @@ type XChainCore struct {
 	txidCacheExpiredTime time.Duration
 	enableCompress       bool
 	pruneOption          config.PruneOption
+	snapshotOption       config.SnapshotOption
+	datapath             string
+
+	// syncMgr syncs blocks in pipeline when far behind peers
+	syncMgr *syncManager
 
 	// cache for duplicate block message
 	msgCache           *common.LRUCache
@@ -149,11 +156,14 @@ func (xc *XChainCore) Init(bcname string, xlog log.Logger, cfg *config.NodeConfi
 	xc.txidCache = cache.New(xc.txidCacheExpiredTime, TxidCacheGcTime)
 	xc.enableCompress = cfg.EnableCompress
 	xc.pruneOption = cfg.Prune
+	xc.snapshotOption = cfg.Snapshot
+	xc.syncMgr = newSyncManager(xc, cfg.Sync)
 	xc.msgCache = common.NewLRUCache(DefaultMessageCacheSize)
 	xc.blockBroadcaseMode = cfg.BlockBroadcaseMode
 	ledger.MemCacheSize = cfg.DBCache.MemCacheSize
 	ledger.FileHandlersCacheSize = cfg.DBCache.FdCacheSize
 	datapath := cfg.Datapath + "/" + bcname
+	xc.datapath = datapath
 	datapathOthers := []string{}
 	for _, dpo := range cfg.DatapathOthers {
 		datapathOthers = append(datapathOthers, dpo+"/"+bcname)
@@ -244,6 +254,9 @@ func (xc *XChainCore) Init(bcname string, xlog log.Logger, cfg *config.NodeConfi
 		xc.log.Warn("OpenLedger error", "bc", xc.bcname, "datapath", datapath, "dataPathOhters", datapathOthers)
 		return err
 	}
+	if xc.nodeMode == config.NodeModeLight {
+		xc.Ledger.SetHeaderOnly()
+	}
 
 	publicKeyStr, err := cryptoClient.GetEcdsaPublicKeyJsonFormatStr(xc.privateKey)
 	if err != nil {
@@ -277,6 +290,11 @@ func (xc *XChainCore) Init(bcname string, xlog log.Logger, cfg *config.NodeConfi
 		xc.log.Warn("GenesisBlock nil")
 		return errors.New("Genesis Block is nil")
 	}
+	// 轻节点只校验区块头, 依赖状态根证明账户和验证者集合
+	if xc.nodeMode == config.NodeModeLight && !xc.Ledger.StateRootEnabled() {
+		xc.log.Warn("LIGHT mode requires state root", "bc", xc.bcname)
+		return ErrLightNoStateRoot
+	}
 	xc.award = gBlk.GetConfig().Award
 	gCon, err := gBlk.GetConfig().GetGenesisConsensus()
 	if err != nil {
@@ -388,6 +406,11 @@ func (xc *XChainCore) SendBlock(in *pb.Block, hd *global.XContext) error {
 		return ErrServiceRefused
 	}
 
+	// LIGHT模式只确认区块头
+	if xc.nodeMode == config.NodeModeLight {
+		return xc.sendHeader(in, hd)
+	}
+
 	xc.mutex.Lock()
 	defer xc.mutex.Unlock()
 
@@ -595,6 +618,9 @@ func (xc *XChainCore) doMiner() {
 	var curTerm, curBlockNum int64
 	var targetBits int32
 	qc := (*pb.QuorumCert)(nil)
+	var vrfProof []byte
+	var nextValidators []byte
+	tc := (*pb.TimeoutCert)(nil)
 	data, ok := xc.con.ProcessBeforeMiner(xc.Ledger.GetMeta().TrunkHeight+1, t.UnixNano())
 	minerTimer.Mark("ProcessBeforeMiner")
 	if ok {
@@ -608,6 +634,15 @@ func (xc *XChainCore) doMiner() {
 					if qci, ok := data["quorum_cert"].(*pb.QuorumCert); ok {
 						qc = qci
 					}
+					if proof, ok := data["vrf_proof"].([]byte); ok {
+						vrfProof = proof
+					}
+					if validators, ok := data["next_validators"].([]byte); ok {
+						nextValidators = validators
+					}
+					if tci, ok := data["timeout_cert"].(*pb.TimeoutCert); ok {
+						tc = tci
+					}
 				case consensus.ConsensusTypePow:
 					xc.log.Trace("Minning pow ProcessBeforeMiner!")
 					targetBits = data["targetBits"].(int32)
@@ -616,6 +651,12 @@ func (xc *XChainCore) doMiner() {
 					if qci, ok := data["quorum_cert"].(*pb.QuorumCert); ok {
 						qc = qci
 					}
+					if validators, ok := data["next_validators"].([]byte); ok {
+						nextValidators = validators
+					}
+					if tci, ok := data["timeout_cert"].(*pb.TimeoutCert); ok {
+						tc = tci
+					}
 				}
 			}
 		}
@@ -649,6 +690,8 @@ func (xc *XChainCore) doMiner() {
 		xc.log.Warn("[Minning] fail to get unconfirmedtx")
 		return
 	}
+	// ACL依赖区块高度和时间的交易按待出区块重新鉴权
+	txsUnconf = xc.Utxovm.FilterTxsAtBlock(txsUnconf, xc.Ledger.GetMeta().TrunkHeight+1, t.UnixNano())
 	for _, ucTx := range txsUnconf {
 		accumulatedTxSize += proto.Size(ucTx)
 		if accumulatedTxSize > txSizeTotalLimit {
@@ -663,6 +706,8 @@ func (xc *XChainCore) doMiner() {
 		xc.log.Warn("[Minning] format fake block error", "logid")
 		return
 	}
+	// 预执行检票交易时校验区块头中公布的验证者名单
+	fakeBlock.NextValidators = nextValidators
 	//2. pre-execute the contract
 	freshBatch = xc.Utxovm.NewBatch()
 	if txs, _, err = xc.Utxovm.TxOfRunningContractGenerate(txs, fakeBlock, freshBatch, true); err != nil {
@@ -679,7 +724,7 @@ func (xc *XChainCore) doMiner() {
 	txs = append(txs, awardtx)
 	freshBlock, err = xc.Ledger.FormatMinerBlock(txs, xc.address, xc.privateKey,
 		t.UnixNano(), curTerm, curBlockNum, xc.Utxovm.GetLatestBlockid(), targetBits,
-		xc.Utxovm.GetTotal(), qc, fakeBlock.FailedTxs, xc.Ledger.GetMeta().TrunkHeight+1)
+		xc.Utxovm.GetTotal(), qc, fakeBlock.FailedTxs, xc.Ledger.GetMeta().TrunkHeight+1, vrfProof, nextValidators, tc)
 	if err != nil {
 		xc.log.Warn("[Minning] format block error", "logid", header.Logid, "err", err)
 		return
@@ -724,12 +769,16 @@ func (xc *XChainCore) doMiner() {
 		//     相邻节点在没有相同块的情况下通过GetBlock主动获取块数据。
 		//  3. Mixed_BroadCast_Mode是指出块节点将新块用Full_BroadCast_Mode模式广播，
 		//     其他节点使用Interactive_BroadCast_Mode
+		//  4. Compact_BroadCast_Mode是指广播区块头和交易短id，接收节点从未确认交易中重建区块
 		// broadcast block in Full_BroadCast_Mode since it's the original miner
 		block := &pb.Block{
 			Bcname:  xc.bcname,
 			Blockid: freshBlock.Blockid,
 		}
-		if xc.blockBroadcaseMode == 1 {
+		if xc.blockBroadcaseMode == 3 {
+			// send block header and short txids in Compact_BroadCast_Mode
+			xc.broadcastCompactBlock(freshBlock)
+		} else if xc.blockBroadcaseMode == 1 {
 			// send block id in Interactive_BroadCast_Mode
 			msgInfo, _ := proto.Marshal(block)
 			msg, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion1, xc.bcname, "", xuper_p2p.XuperMessage_NEW_BLOCKID, msgInfo, xuper_p2p.XuperMessage_NONE)
@@ -774,10 +823,10 @@ func (xc *XChainCore) doMiner() {
 
 // Miner start to miner
 func (xc *XChainCore) Miner() int {
-	// 1 强制walk到最新状态
+	// 1 强制walk到最新状态, LIGHT模式没有交易, 不需要walk
 	ledgerLastID := xc.Ledger.GetMeta().TipBlockid
 	utxovmLastID := xc.Utxovm.GetLatestBlockid()
-	if !bytes.Equal(ledgerLastID, utxovmLastID) {
+	if xc.nodeMode != config.NodeModeLight && !bytes.Equal(ledgerLastID, utxovmLastID) {
 		xc.log.Warn("ledger last blockid is not equal utxovm last id")
 		xc.Utxovm.Walk(ledgerLastID, false)
 	}
@@ -812,10 +861,18 @@ func (xc *XChainCore) Miner() int {
 			syscall.Kill(syscall.Getpid(), syscall.SIGINT)
 			return -1
 		}
+		// 状态快照导出入口
+		if xc.snapshotOption.Switch && xc.snapshotOption.Bcname == xc.bcname {
+			if err := xc.exportSnapshot(xc.snapshotOption.Path); err != nil {
+				xc.log.Warn("export snapshot failed", "err", err)
+			}
+			xc.snapshotOption.Switch = false
+		}
 		b, s := xc.con.CompeteMaster(xc.Ledger.GetMeta().TrunkHeight + 1)
 		xc.log.Debug("competemaster", "blockchain", xc.bcname, "master", b, "needSync", s, "compete height", xc.Ledger.GetMeta().TrunkHeight+1)
 		xc.updateIsCoreMiner()
-		if b {
+		// LIGHT模式只跟随区块头, 不参与出块
+		if b && xc.nodeMode != config.NodeModeLight {
 			// todo 首次切换为矿工时SyncBlcok, Bug: 可能会导致第一次出块失败
 			if s {
 				xc.SyncBlocks()
@@ -945,6 +1002,9 @@ func (xc *XChainCore) QueryTx(in *pb.TxStatus) *pb.TxStatus {
 		xc.log.Debug("refused a connection a function call QueryTx", "logid", in.Header.Logid)
 		return out
 	}
+	if xc.nodeMode == config.NodeModeLight {
+		return xc.queryTxLight(in, out)
+	}
 
 	t, err := xc.Ledger.QueryTransaction(out.Txid)
 	if err != nil {
@@ -1016,6 +1076,11 @@ func (xc *XChainCore) GetBlock(in *pb.BlockID) *pb.Block {
 		}
 	} else {
 		xc.log.Debug("debug needcontent", "logid", in.Header.Logid, "needcontent", in.NeedContent)
+		if in.NeedContent && xc.nodeMode == config.NodeModeLight && ib.Height > 0 {
+			// 轻节点没有区块体
+			out.Header.Error = pb.XChainErrorEnum_SERVICE_REFUSED_ERROR
+			return out
+		}
 		if in.NeedContent {
 			out.Block = ib
 		}
@@ -1057,6 +1122,7 @@ func (xc *XChainCore) GetBlockChainStatus(in *pb.BCStatus, viewOption pb.ViewOpt
 	}
 	if viewOption == pb.ViewOption_NONE {
 		out.Block = ib
+		out.SyncStatus = xc.syncMgr.Status()
 	}
 	if viewOption == pb.ViewOption_NONE || viewOption == pb.ViewOption_BRANCHINFO {
 		// fetch all branches info
@@ -1184,6 +1250,9 @@ func (xc *XChainCore) GetBalance(addr string) (string, error) {
 	if xc.Status() != global.Normal {
 		return "", ErrNotReady
 	}
+	if xc.nodeMode == config.NodeModeLight {
+		return xc.getBalanceLight(addr)
+	}
 	bint, err := xc.Utxovm.GetBalance(addr)
 	if err != nil {
 		return "", err
@@ -1347,6 +1416,58 @@ func (xc *XChainCore) PreExec(req *pb.InvokeRPCRequest, hd *global.XContext) (*p
 	return xc.Utxovm.PreExec(req, hd)
 }
 
+// TraceTx re-execute the contract requests of tx and return the call tree
+func (xc *XChainCore) TraceTx(in *pb.TraceTxRequest) *pb.TraceResponse {
+	out := &pb.TraceResponse{Header: in.Header, Bcname: in.Bcname}
+	out.Header.Error = pb.XChainErrorEnum_SUCCESS
+	if xc.Status() != global.Normal || xc.nodeMode == config.NodeModeLight {
+		out.Header.Error = pb.XChainErrorEnum_CONNECT_REFUSE
+		xc.log.Debug("refused a connection a function call TraceTx", "logid", in.Header.Logid)
+		return out
+	}
+	trace, err := xc.Utxovm.TraceTx(in.Txid)
+	if err == ledger.ErrTxNotFound || err == utxo.ErrTxNotFound {
+		out.Header.Error = pb.XChainErrorEnum_TX_NOT_FOUND_ERROR
+		return out
+	}
+	if err != nil {
+		xc.log.Warn("TraceTx error", "logid", in.Header.Logid, "txid", global.F(in.Txid), "error", err)
+		out.Header.Error = pb.XChainErrorEnum_UNKNOW_ERROR
+		return out
+	}
+	xc.setTrace(out, trace)
+	return out
+}
+
+// TracePreExec pre-execute the contract requests and return the call tree
+func (xc *XChainCore) TracePreExec(in *pb.InvokeRPCRequest) *pb.TraceResponse {
+	out := &pb.TraceResponse{Header: in.Header, Bcname: in.Bcname}
+	out.Header.Error = pb.XChainErrorEnum_SUCCESS
+	if xc.Status() != global.Normal || xc.nodeMode == config.NodeModeLight {
+		out.Header.Error = pb.XChainErrorEnum_CONNECT_REFUSE
+		xc.log.Debug("refused a connection a function call TracePreExec", "logid", in.Header.Logid)
+		return out
+	}
+	trace, err := xc.Utxovm.TracePreExec(in)
+	if err != nil {
+		xc.log.Warn("TracePreExec error", "logid", in.Header.Logid, "error", err)
+		out.Header.Error = pb.XChainErrorEnum_UNKNOW_ERROR
+		return out
+	}
+	xc.setTrace(out, trace)
+	return out
+}
+
+func (xc *XChainCore) setTrace(out *pb.TraceResponse, trace *contract.TxTrace) {
+	buf, err := json.Marshal(trace)
+	if err != nil {
+		xc.log.Warn("marshal trace error", "logid", out.Header.Logid, "error", err)
+		out.Header.Error = pb.XChainErrorEnum_UNKNOW_ERROR
+		return
+	}
+	out.Trace = string(buf)
+}
+
 // IsCoreMiner return true if current node is one of the current core miners
 // Note that is could be a little delay since it updated at each CompeteMaster.
 func (xc *XChainCore) IsCoreMiner() bool {
@@ -1393,6 +1514,200 @@ func (xc *XChainCore) GetBlockByHeight(in *pb.BlockHeight) *pb.Block {
 	return out
 }
 
+// GetBlockHeaders get the trunk block headers from the given height, used by header-first sync
+func (xc *XChainCore) GetBlockHeaders(in *pb.BlockHeadersRequest) *pb.BlockHeadersResponse {
+	out := &pb.BlockHeadersResponse{Header: global.GHeader(), Bcname: in.Bcname}
+	out.Header.Error = pb.XChainErrorEnum_SUCCESS
+	if xc.Status() != global.Normal {
+		out.Header.Error = pb.XChainErrorEnum_CONNECT_REFUSE
+		xc.log.Debug("refused a connection a function call GetBlockHeaders")
+		return out
+	}
+	size := in.Size
+	if size <= 0 || size > MaxSyncHeaders {
+		size = MaxSyncHeaders
+	}
+	end := in.Height + size
+	if trunkHeight := xc.Ledger.GetMeta().TrunkHeight; end > trunkHeight+1 {
+		end = trunkHeight + 1
+	}
+	for height := in.Height; height < end; height++ {
+		header, err := xc.Ledger.QueryBlockHeaderByHeight(height)
+		if err != nil {
+			// 主干可能正在切换, 只返回已经查到的部分
+			xc.log.Debug("GetBlockHeaders query header error", "height", height, "error", err)
+			break
+		}
+		// 同步时不需要merkle树, 减少传输的数据量
+		header.MerkleTree = nil
+		out.Blocks = append(out.Blocks, header)
+	}
+	return out
+}
+
+// GetBlockTxs get the txs of block by indexes, used by compact block relay
+func (xc *XChainCore) GetBlockTxs(in *pb.BlockTxsRequest) *pb.BlockTxsResponse {
+	out := &pb.BlockTxsResponse{Header: global.GHeader(), Bcname: in.Bcname, Blockid: in.Blockid}
+	out.Header.Error = pb.XChainErrorEnum_SUCCESS
+	if xc.Status() != global.Normal {
+		out.Header.Error = pb.XChainErrorEnum_CONNECT_REFUSE
+		xc.log.Debug("refused a connection a function call GetBlockTxs")
+		return out
+	}
+	block, err := xc.Ledger.QueryBlock(in.Blockid)
+	if err != nil {
+		xc.log.Debug("GetBlockTxs query block error", "blockid", global.F(in.Blockid), "error", err)
+		out.Header.Error = pb.XChainErrorEnum_UNKNOW_ERROR
+		return out
+	}
+	for _, index := range in.Indexes {
+		if index < 0 || int(index) >= len(block.Transactions) {
+			out.Header.Error = pb.XChainErrorEnum_VALIDATE_ERROR
+			out.Txs = nil
+			return out
+		}
+		out.Txs = append(out.Txs, block.Transactions[index])
+	}
+	return out
+}
+
+// GetTxProof get the merkle proof of a confirmed tx in trunk
+func (xc *XChainCore) GetTxProof(in *pb.TxProofRequest) *pb.TxProofResponse {
+	out := &pb.TxProofResponse{Header: in.Header, Bcname: in.Bcname}
+	out.Header.Error = pb.XChainErrorEnum_SUCCESS
+	if xc.Status() != global.Normal {
+		out.Header.Error = pb.XChainErrorEnum_CONNECT_REFUSE
+		xc.log.Debug("refused a connection a function call GetTxProof", "logid", in.Header.Logid)
+		return out
+	}
+	tx, err := xc.Ledger.QueryTransaction(in.Txid)
+	if err != nil {
+		xc.log.Debug("GetTxProof query tx error", "logid", in.Header.Logid, "txid", global.F(in.Txid), "error", err)
+		out.Header.Error = pb.XChainErrorEnum_TX_NOT_FOUND_ERROR
+		return out
+	}
+	block, err := xc.Ledger.QueryBlock(tx.Blockid)
+	if err != nil {
+		xc.log.Warn("GetTxProof query block error", "logid", in.Header.Logid, "blockid", global.F(tx.Blockid), "error", err)
+		out.Header.Error = pb.XChainErrorEnum_UNKNOW_ERROR
+		return out
+	}
+	if !block.InTrunk {
+		// 分支上的交易没有意义
+		out.Header.Error = pb.XChainErrorEnum_TX_NOT_FOUND_ERROR
+		return out
+	}
+	out.Proof, err = ledger.MakeTxProof(block, tx)
+	if err != nil {
+		xc.log.Warn("GetTxProof make proof error", "logid", in.Header.Logid, "txid", global.F(in.Txid), "error", err)
+		out.Header.Error = pb.XChainErrorEnum_UNKNOW_ERROR
+	}
+	return out
+}
+
+// GetStateProof get the merkle proof of a xmodel key in the state tree of the trunk block at height
+func (xc *XChainCore) GetStateProof(in *pb.StateProofRequest) *pb.StateProofResponse {
+	out := &pb.StateProofResponse{Header: in.Header, Bcname: in.Bcname}
+	out.Header.Error = pb.XChainErrorEnum_SUCCESS
+	if xc.Status() != global.Normal {
+		out.Header.Error = pb.XChainErrorEnum_CONNECT_REFUSE
+		xc.log.Debug("refused a connection a function call GetStateProof", "logid", in.Header.Logid)
+		return out
+	}
+	height := in.Height
+	if height < 0 {
+		height = xc.Ledger.GetMeta().TrunkHeight
+	}
+	block, err := xc.Ledger.QueryBlockByHeight(height)
+	if err != nil {
+		xc.log.Debug("GetStateProof query block error", "logid", in.Header.Logid, "height", height, "error", err)
+		out.Header.Error = pb.XChainErrorEnum_UNKNOW_ERROR
+		return out
+	}
+	reader, err := xc.Utxovm.GetSnapShotWithBlock(block.Blockid)
+	if err != nil {
+		xc.log.Warn("GetStateProof create snapshot error", "logid", in.Header.Logid, "height", height, "error", err)
+		out.Header.Error = pb.XChainErrorEnum_UNKNOW_ERROR
+		return out
+	}
+	vd, err := reader.Get(in.Bucket, in.Key)
+	if err != nil {
+		xc.log.Warn("GetStateProof get value error", "logid", in.Header.Logid, "bucket", in.Bucket, "error", err)
+		out.Header.Error = pb.XChainErrorEnum_UNKNOW_ERROR
+		return out
+	}
+	version := xmodel.GetVersion(vd)
+	if bytes.Equal(vd.PureData.GetValue(), []byte(xmodel.DelFlag)) {
+		version = ""
+	}
+	out.Proof, err = xc.Ledger.MakeStateProof(block, in.Bucket, in.Key, version, vd.PureData.GetValue())
+	if err == ledger.ErrStateRootDisabled {
+		out.Header.Error = pb.XChainErrorEnum_SERVICE_REFUSED_ERROR
+	} else if err != nil {
+		xc.log.Warn("GetStateProof make proof error", "logid", in.Header.Logid, "bucket", in.Bucket, "error", err)
+		out.Header.Error = pb.XChainErrorEnum_UNKNOW_ERROR
+	}
+	return out
+}
+
+// GetUtxoProof get all the utxos of address with the proof in the state tree of the latest block, used by light nodes
+func (xc *XChainCore) GetUtxoProof(in *pb.UtxoProofRequest) *pb.UtxoProofResponse {
+	out := &pb.UtxoProofResponse{Header: in.Header, Bcname: in.Bcname, Address: in.Address}
+	out.Header.Error = pb.XChainErrorEnum_SUCCESS
+	if xc.Status() != global.Normal || xc.nodeMode == config.NodeModeLight {
+		out.Header.Error = pb.XChainErrorEnum_CONNECT_REFUSE
+		xc.log.Debug("refused a connection a function call GetUtxoProof", "logid", in.Header.Logid)
+		return out
+	}
+	// 确认区块时持有写锁, 保证utxo表与最新区块一致
+	xc.mutex.RLock()
+	defer xc.mutex.RUnlock()
+	block, err := xc.Ledger.QueryBlockHeader(xc.Utxovm.GetLatestBlockid())
+	if err != nil {
+		xc.log.Warn("GetUtxoProof query block error", "logid", in.Header.Logid, "error", err)
+		out.Header.Error = pb.XChainErrorEnum_UNKNOW_ERROR
+		return out
+	}
+	addrPrefix := fmt.Sprintf("%s%s_", pb.UTXOTablePrefix, in.Address)
+	it := xc.Utxovm.ScanWithPrefix([]byte(addrPrefix))
+	defer it.Release()
+	for it.Next() {
+		txid, offset, err := parseUtxoKey(it.Key()[len(addrPrefix):])
+		if err != nil {
+			xc.log.Warn("GetUtxoProof parse utxo key error", "logid", in.Header.Logid, "key", string(it.Key()))
+			out.Header.Error = pb.XChainErrorEnum_UNKNOW_ERROR
+			return out
+		}
+		item := &utxo.UtxoItem{}
+		if err := item.Loads(it.Value()); err != nil {
+			out.Header.Error = pb.XChainErrorEnum_UNKNOW_ERROR
+			return out
+		}
+		out.Proofs = append(out.Proofs, &pb.UtxoProof{
+			RefTxid:      txid,
+			RefOffset:    offset,
+			Amount:       item.Amount.Bytes(),
+			FrozenHeight: item.FrozenHeight,
+		})
+	}
+	if it.Error() != nil {
+		out.Header.Error = pb.XChainErrorEnum_UNKNOW_ERROR
+		return out
+	}
+	err = xc.Ledger.MakeUtxoSetProof(block, out)
+	if err == ledger.ErrStateRootDisabled {
+		out.Header.Error = pb.XChainErrorEnum_SERVICE_REFUSED_ERROR
+		return out
+	} else if err != nil {
+		xc.log.Warn("GetUtxoProof make proof error", "logid", in.Header.Logid, "address", in.Address, "error", err)
+		out.Header.Error = pb.XChainErrorEnum_UNKNOW_ERROR
+		return out
+	}
+	block.MerkleTree = nil
+	out.Block = block
+	return out
+}
+
 // GetAccountContractsStatus query account contracts
 func (xc *XChainCore) GetAccountContractsStatus(account string, needContent bool) ([]*pb.ContractStatus, error) {
 	res := []*pb.ContractStatus{}
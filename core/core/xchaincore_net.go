@@ -6,6 +6,7 @@ import (
 	"errors"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/xuperchain/xuperchain/core/common/config"
 	"github.com/xuperchain/xuperchain/core/global"
 	p2p_base "github.com/xuperchain/xuperchain/core/p2p/base"
 	xuper_p2p "github.com/xuperchain/xuperchain/core/p2p/pb"
@@ -109,6 +110,69 @@ func (xc *XChainCore) getBlockFromPeer(ctx context.Context, blockid []byte, remo
 	return nil, errors.New("get block failed, no block data")
 }
 
+// getBlockHeadersFromPeer get trunk block headers in [height, height+size) from given peer
+func (xc *XChainCore) getBlockHeadersFromPeer(ctx context.Context, remotePid string, height, size int64) ([]*pb.InternalBlock, error) {
+	in := &pb.BlockHeadersRequest{
+		Header: &pb.Header{
+			Logid: global.Glogid(),
+		},
+		Bcname: xc.bcname,
+		Height: height,
+		Size:   size,
+	}
+	msgbuf, err := proto.Marshal(in)
+	if err != nil {
+		xc.log.Warn("getBlockHeadersFromPeer Marshal msg error", "error", err)
+		return nil, err
+	}
+	msg, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, xc.bcname, "",
+		xuper_p2p.XuperMessage_GET_BLOCK_HEADERS, msgbuf, xuper_p2p.XuperMessage_NONE)
+	whiteList := xc.groupChain.GetAllowedPeersWithBcname(xc.bcname)
+	opts := []p2p_base.MessageOption{
+		p2p_base.WithBcName(xc.bcname),
+		p2p_base.WithTargetPeerIDs([]string{remotePid}),
+		p2p_base.WithWhiteList(whiteList),
+	}
+	res, err := xc.P2pSvr.SendMessageWithResponse(ctx, msg, opts...)
+	if err != nil || len(res) < 1 {
+		return nil, errors.New("get block headers failed")
+	}
+	for _, v := range res {
+		if v.GetHeader().GetErrorType() != xuper_p2p.XuperMessage_SUCCESS {
+			continue
+		}
+		buf, err := p2p_base.Uncompress(v)
+		if buf == nil || err != nil {
+			xc.log.Warn("getBlockHeadersFromPeer xuper_p2p Uncompress error", "error", err)
+			continue
+		}
+		out := &pb.BlockHeadersResponse{}
+		if err := proto.Unmarshal(buf, out); err != nil {
+			xc.log.Warn("getBlockHeadersFromPeer unmarshal error", "error", err)
+			continue
+		}
+		return out.GetBlocks(), nil
+	}
+	return nil, errors.New("get block headers failed, no header data")
+}
+
+// getPeersBlockChainStatus get the blockchain status of peers chosen by filters
+func (xc *XChainCore) getPeersBlockChainStatus(filters []p2p_base.FilterStrategy) ([]*xuper_p2p.XuperMessage, error) {
+	bcs := &pb.BCStatus{Bcname: xc.bcname}
+	bcsBuf, _ := proto.Marshal(bcs)
+	msg, err := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, xc.bcname, "", xuper_p2p.XuperMessage_GET_BLOCKCHAINSTATUS, bcsBuf, xuper_p2p.XuperMessage_NONE)
+	if err != nil {
+		return nil, err
+	}
+	whiteList := xc.groupChain.GetAllowedPeersWithBcname(xc.bcname)
+	opts := []p2p_base.MessageOption{
+		p2p_base.WithFilters(filters),
+		p2p_base.WithBcName(xc.bcname),
+		p2p_base.WithWhiteList(whiteList),
+	}
+	return xc.P2pSvr.SendMessageWithResponse(context.Background(), msg, opts...)
+}
+
 // handleNewBlockID handle signal of New_BlockID
 func (xc *XChainCore) handleNewBlockID(ctx context.Context, blockid []byte, remotePid string) (*pb.Block, error) {
 	if len(blockid) == 0 || remotePid == "" {
@@ -150,3 +214,237 @@ func (xc *XChainCore) handleNewBlockID(ctx context.Context, blockid []byte, remo
 		"blockid", bidPretty, "peerid", remotePid)
 	return nil, nil
 }
+
+// broadcastCompactBlock broadcast block header with short txids in Compact_BroadCast_Mode
+func (xc *XChainCore) broadcastCompactBlock(block *pb.InternalBlock) {
+	cb := NewCompactBlock(xc.bcname, block)
+	msgInfo, err := proto.Marshal(cb)
+	if err != nil {
+		xc.log.Warn("broadcastCompactBlock Marshal msg error", "error", err)
+		return
+	}
+	msg, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion1, xc.bcname, "", xuper_p2p.XuperMessage_NEW_COMPACT_BLOCK, msgInfo, xuper_p2p.XuperMessage_NONE)
+	filters := []p2p_base.FilterStrategy{p2p_base.DefaultStrategy}
+	if xc.NeedCoreConnection() {
+		filters = append(filters, p2p_base.CorePeersStrategy)
+	}
+	whiteList := xc.groupChain.GetAllowedPeersWithBcname(xc.bcname)
+	opts := []p2p_base.MessageOption{
+		p2p_base.WithFilters(filters),
+		p2p_base.WithBcName(xc.bcname),
+		p2p_base.WithWhiteList(whiteList),
+	}
+	xc.P2pSvr.SendMessage(context.Background(), msg, opts...)
+}
+
+// handleNewCompactBlock rebuild block from compact block and unconfirmed txs,
+// only the missing txs are got from remote peer
+func (xc *XChainCore) handleNewCompactBlock(ctx context.Context, cb *pb.CompactBlock, remotePid string) (*pb.Block, error) {
+	blockid := cb.GetBlockid()
+	if len(blockid) == 0 || remotePid == "" || cb.GetBlock() == nil {
+		xc.log.Warn("handleNewCompactBlock: blockid or remotePid cannot be nil", "remotePid", remotePid)
+		return nil, ErrCompactBlockInvalid
+	}
+
+	// dup check in message cache and ledger
+	bidPretty := hex.EncodeToString(blockid)
+	if _, exist := xc.msgCache.Get(bidPretty); exist {
+		xc.log.Info("Received compact block but it's in cache, ignore it", "blockid", bidPretty, "peerid", remotePid)
+		return nil, nil
+	}
+	if xc.Ledger.ExistBlock(blockid) {
+		xc.log.Info("Received compact block but it's in ledger, ignore it", "blockid", bidPretty, "peerid", remotePid)
+		return nil, nil
+	}
+	xc.msgCache.Add(bidPretty, remotePid)
+
+	if xc.nodeMode == config.NodeModeLight {
+		// 轻节点只需要区块头, 无需重建交易
+		return &pb.Block{Header: global.GHeader(), Bcname: xc.bcname, Blockid: blockid, Block: cb.GetBlock()}, nil
+	}
+	block, err := xc.rebuildBlockFromCompact(ctx, cb, remotePid)
+	if err != nil {
+		// 重建失败时退化为获取完整区块
+		xc.log.Warn("handleNewCompactBlock rebuild block failed, get full block instead",
+			"blockid", bidPretty, "peerid", remotePid, "error", err)
+		fullBlock, err := xc.getBlockFromPeer(ctx, blockid, remotePid)
+		if err != nil {
+			xc.msgCache.Del(bidPretty)
+			return nil, err
+		}
+		return fullBlock, nil
+	}
+	return &pb.Block{
+		Header:  global.GHeader(),
+		Bcname:  xc.bcname,
+		Blockid: blockid,
+		Block:   block,
+	}, nil
+}
+
+// rebuildBlockFromCompact rebuild block from unconfirmed txs and the missing txs got from remote peer
+func (xc *XChainCore) rebuildBlockFromCompact(ctx context.Context, cb *pb.CompactBlock, remotePid string) (*pb.InternalBlock, error) {
+	unconfirmedTxs, err := xc.Utxovm.GetUnconfirmedTx(false)
+	if err != nil {
+		return nil, err
+	}
+	txs, missing, err := rebuildCompactBlock(cb, unconfirmedTxs)
+	if err != nil {
+		return nil, err
+	}
+	var missingTxs []*pb.Transaction
+	if len(missing) > 0 {
+		missingTxs, err = xc.getBlockTxsFromPeer(ctx, cb.GetBlockid(), missing, remotePid)
+		if err != nil {
+			return nil, err
+		}
+	}
+	xc.log.Debug("rebuild compact block", "blockid", global.F(cb.GetBlockid()),
+		"txCount", len(txs), "missing", len(missing))
+	return fillCompactBlock(cb, txs, missing, missingTxs)
+}
+
+// getBlockTxsFromPeer get the txs of block by indexes from given peer
+func (xc *XChainCore) getBlockTxsFromPeer(ctx context.Context, blockid []byte, indexes []int32, remotePid string) ([]*pb.Transaction, error) {
+	in := &pb.BlockTxsRequest{
+		Header: &pb.Header{
+			Logid: global.Glogid(),
+		},
+		Bcname:  xc.bcname,
+		Blockid: blockid,
+		Indexes: indexes,
+	}
+	msgbuf, err := proto.Marshal(in)
+	if err != nil {
+		xc.log.Warn("getBlockTxsFromPeer Marshal msg error", "error", err)
+		return nil, err
+	}
+	msg, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, xc.bcname, "",
+		xuper_p2p.XuperMessage_GET_BLOCK_TXS, msgbuf, xuper_p2p.XuperMessage_NONE)
+	whiteList := xc.groupChain.GetAllowedPeersWithBcname(xc.bcname)
+	opts := []p2p_base.MessageOption{
+		p2p_base.WithBcName(xc.bcname),
+		p2p_base.WithTargetPeerIDs([]string{remotePid}),
+		p2p_base.WithWhiteList(whiteList),
+	}
+	res, err := xc.P2pSvr.SendMessageWithResponse(ctx, msg, opts...)
+	if err != nil || len(res) < 1 {
+		return nil, errors.New("get block txs failed")
+	}
+	for _, v := range res {
+		if v.GetHeader().GetErrorType() != xuper_p2p.XuperMessage_SUCCESS {
+			continue
+		}
+		buf, err := p2p_base.Uncompress(v)
+		if buf == nil || err != nil {
+			xc.log.Warn("getBlockTxsFromPeer xuper_p2p Uncompress error", "error", err)
+			continue
+		}
+		out := &pb.BlockTxsResponse{}
+		if err := proto.Unmarshal(buf, out); err != nil {
+			xc.log.Warn("getBlockTxsFromPeer unmarshal error", "error", err)
+			continue
+		}
+		return out.GetTxs(), nil
+	}
+	return nil, errors.New("get block txs failed, no tx data")
+}
+
+// sendToFullPeers sends the request of light node to peers and returns the uncompressed successful responses
+func (xc *XChainCore) sendToFullPeers(msgType xuper_p2p.XuperMessage_MessageType, in proto.Message) ([][]byte, error) {
+	msgbuf, err := proto.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+	msg, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, xc.bcname, "", msgType, msgbuf, xuper_p2p.XuperMessage_NONE)
+	filters := []p2p_base.FilterStrategy{p2p_base.NearestBucketStrategy}
+	if xc.NeedCoreConnection() {
+		filters = append(filters, p2p_base.CorePeersStrategy)
+	}
+	whiteList := xc.groupChain.GetAllowedPeersWithBcname(xc.bcname)
+	opts := []p2p_base.MessageOption{
+		p2p_base.WithFilters(filters),
+		p2p_base.WithBcName(xc.bcname),
+		p2p_base.WithWhiteList(whiteList),
+	}
+	res, err := xc.P2pSvr.SendMessageWithResponse(context.Background(), msg, opts...)
+	if err != nil {
+		return nil, err
+	}
+	var bufs [][]byte
+	for _, v := range res {
+		if v.GetHeader().GetErrorType() != xuper_p2p.XuperMessage_SUCCESS {
+			continue
+		}
+		buf, err := p2p_base.Uncompress(v)
+		if buf == nil || err != nil {
+			xc.log.Warn("sendToFullPeers xuper_p2p Uncompress error", "type", msgType, "error", err)
+			continue
+		}
+		bufs = append(bufs, buf)
+	}
+	return bufs, nil
+}
+
+// getTxProofFromPeers gets the tx with its merkle proof from full peers, returns the first valid one
+func (xc *XChainCore) getTxProofFromPeers(txid []byte) (*pb.TxProof, error) {
+	in := &pb.TxProofRequest{
+		Header: &pb.Header{
+			Logid: global.Glogid(),
+		},
+		Bcname: xc.bcname,
+		Txid:   txid,
+	}
+	bufs, err := xc.sendToFullPeers(xuper_p2p.XuperMessage_GET_TX_PROOF, in)
+	if err != nil {
+		return nil, err
+	}
+	for _, buf := range bufs {
+		out := &pb.TxProofResponse{}
+		if err := proto.Unmarshal(buf, out); err != nil {
+			continue
+		}
+		if err := xc.verifyTxProof(txid, out.GetProof()); err != nil {
+			xc.log.Debug("getTxProofFromPeers verify proof error", "txid", global.F(txid), "error", err)
+			continue
+		}
+		return out.GetProof(), nil
+	}
+	return nil, ErrNoValidProof
+}
+
+// getUtxoProofFromPeers gets the utxos of addr with state proofs from full peers,
+// returns the valid one made at the highest block
+func (xc *XChainCore) getUtxoProofFromPeers(addr string) (*pb.UtxoProofResponse, error) {
+	in := &pb.UtxoProofRequest{
+		Header: &pb.Header{
+			Logid: global.Glogid(),
+		},
+		Bcname:  xc.bcname,
+		Address: addr,
+	}
+	bufs, err := xc.sendToFullPeers(xuper_p2p.XuperMessage_GET_UTXO_PROOF, in)
+	if err != nil {
+		return nil, err
+	}
+	var best *pb.UtxoProofResponse
+	bestHeight := int64(-1)
+	for _, buf := range bufs {
+		out := &pb.UtxoProofResponse{}
+		if err := proto.Unmarshal(buf, out); err != nil {
+			continue
+		}
+		height, err := xc.verifyUtxoProof(addr, out)
+		if err != nil {
+			xc.log.Debug("getUtxoProofFromPeers verify proof error", "address", addr, "error", err)
+			continue
+		}
+		if height > bestHeight {
+			best, bestHeight = out, height
+		}
+	}
+	if best == nil {
+		return nil, ErrNoValidProof
+	}
+	return best, nil
+}
@@ -21,7 +21,9 @@ type XChainMG struct {
 	Cfg    *config.NodeConfig
 	P2pSvr p2p_base.P2PServer
 	// msgChan is the message subscribe from net
-	msgChan    chan *xuper_p2p.XuperMessage
+	msgChan chan *xuper_p2p.XuperMessage
+	// txMsgChan is the tx message subscribe from net, handled by a bounded number of workers
+	txMsgChan  chan *xuper_p2p.XuperMessage
 	chains     *sync.Map
 	rootKernel *kernel.Kernel
 	datapath   string
@@ -45,6 +47,7 @@ func (xm *XChainMG) Init(log log.Logger, cfg *config.NodeConfig,
 	xm.Cfg = cfg
 	xm.P2pSvr = p2pV2
 	xm.msgChan = make(chan *xuper_p2p.XuperMessage, 50000)
+	xm.txMsgChan = make(chan *xuper_p2p.XuperMessage, 50000)
 
 	xm.Speed = probe.NewSpeedCalc("sum")
 	xm.Quit = make(chan struct{})
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"runtime"
 
 	"github.com/golang/protobuf/proto"
 
@@ -18,7 +19,7 @@ import (
 // RegisterSubscriber register p2p_base msg type
 func (xm *XChainMG) RegisterSubscriber() error {
 	xm.Log.Trace("Start to Register Subscriber")
-	if _, err := xm.P2pSvr.Register(xm.P2pSvr.NewSubscriber(xm.msgChan, xuper_p2p.XuperMessage_POSTTX, nil, "", xm.Log)); err != nil {
+	if _, err := xm.P2pSvr.Register(xm.P2pSvr.NewSubscriber(xm.txMsgChan, xuper_p2p.XuperMessage_POSTTX, nil, "", xm.Log)); err != nil {
 		return err
 	}
 
@@ -26,7 +27,7 @@ func (xm *XChainMG) RegisterSubscriber() error {
 		return err
 	}
 
-	if _, err := xm.P2pSvr.Register(xm.P2pSvr.NewSubscriber(xm.msgChan, xuper_p2p.XuperMessage_BATCHPOSTTX, nil, "", xm.Log)); err != nil {
+	if _, err := xm.P2pSvr.Register(xm.P2pSvr.NewSubscriber(xm.txMsgChan, xuper_p2p.XuperMessage_BATCHPOSTTX, nil, "", xm.Log)); err != nil {
 		return err
 	}
 
@@ -34,6 +35,10 @@ func (xm *XChainMG) RegisterSubscriber() error {
 		return err
 	}
 
+	if _, err := xm.P2pSvr.Register(xm.P2pSvr.NewSubscriber(xm.msgChan, xuper_p2p.XuperMessage_NEW_COMPACT_BLOCK, nil, "", xm.Log)); err != nil {
+		return err
+	}
+
 	if _, err := xm.P2pSvr.Register(xm.P2pSvr.NewSubscriber(nil, xuper_p2p.XuperMessage_GET_BLOCK, xm.handleGetBlock, "", xm.Log)); err != nil {
 		return err
 	}
@@ -42,6 +47,22 @@ func (xm *XChainMG) RegisterSubscriber() error {
 		return err
 	}
 
+	if _, err := xm.P2pSvr.Register(xm.P2pSvr.NewSubscriber(nil, xuper_p2p.XuperMessage_GET_BLOCK_HEADERS, xm.handleGetBlockHeaders, "", xm.Log)); err != nil {
+		return err
+	}
+
+	if _, err := xm.P2pSvr.Register(xm.P2pSvr.NewSubscriber(nil, xuper_p2p.XuperMessage_GET_BLOCK_TXS, xm.handleGetBlockTxs, "", xm.Log)); err != nil {
+		return err
+	}
+
+	if _, err := xm.P2pSvr.Register(xm.P2pSvr.NewSubscriber(nil, xuper_p2p.XuperMessage_GET_TX_PROOF, xm.handleGetTxProof, "", xm.Log)); err != nil {
+		return err
+	}
+
+	if _, err := xm.P2pSvr.Register(xm.P2pSvr.NewSubscriber(nil, xuper_p2p.XuperMessage_GET_UTXO_PROOF, xm.handleGetUtxoProof, "", xm.Log)); err != nil {
+		return err
+	}
+
 	if _, err := xm.P2pSvr.Register(xm.P2pSvr.NewSubscriber(nil, xuper_p2p.XuperMessage_CONFIRM_BLOCKCHAINSTATUS, xm.handleConfirmBlockChainStatus, "", xm.Log)); err != nil {
 		return err
 	}
@@ -57,6 +78,10 @@ func (xm *XChainMG) RegisterSubscriber() error {
 // StartLoop dispatch msg received
 func (xm *XChainMG) StartLoop() {
 	xm.Log.Info("XchainMg start loop to process net msg")
+	// 交易消息量大且校验耗CPU, 由固定数量的协程处理, 避免大量交易挤占区块消息的处理
+	for i := 0; i < runtime.NumCPU(); i++ {
+		go xm.txMsgLoop()
+	}
 	for {
 		select {
 		case msg := <-xm.msgChan:
@@ -67,8 +92,20 @@ func (xm *XChainMG) StartLoop() {
 	}
 }
 
+// txMsgLoop handle tx msg received one by one
+func (xm *XChainMG) txMsgLoop() {
+	for {
+		select {
+		case msg := <-xm.txMsgChan:
+			xm.Log.Trace("XchainMG get tx msg", "logid", msg.GetHeader().GetLogid(), "msgType", msg.GetHeader().GetType(), "checksum", msg.GetHeader().GetDataCheckSum(), "from", msg.GetHeader().GetFrom())
+			xm.handleReceivedMsg(msg)
+		}
+	}
+}
+
 func (xm *XChainMG) handleReceivedMsg(msg *xuper_p2p.XuperMessage) {
 	bcname := msg.GetHeader().GetBcname()
+	// From是直接发送该消息的对端节点(由p2p层按连接身份设置), 转发的消息校验的是转发节点
 	from := msg.GetHeader().GetFrom()
 	if !xm.IsPeerInGroupChain(bcname, from) {
 		xm.Log.Warn("remote node ip is not in white list, refuse it")
@@ -77,7 +114,8 @@ func (xm *XChainMG) handleReceivedMsg(msg *xuper_p2p.XuperMessage) {
 	// check msg type
 	msgType := msg.GetHeader().GetType()
 	if msgType != xuper_p2p.XuperMessage_POSTTX && msgType != xuper_p2p.XuperMessage_SENDBLOCK && msgType !=
-		xuper_p2p.XuperMessage_BATCHPOSTTX && msgType != xuper_p2p.XuperMessage_NEW_BLOCKID {
+		xuper_p2p.XuperMessage_BATCHPOSTTX && msgType != xuper_p2p.XuperMessage_NEW_BLOCKID &&
+		msgType != xuper_p2p.XuperMessage_NEW_COMPACT_BLOCK {
 		xm.Log.Warn("Received msg cannot handled!", "logid", msg.GetHeader().GetLogid())
 		return
 	}
@@ -98,6 +136,8 @@ func (xm *XChainMG) handleReceivedMsg(msg *xuper_p2p.XuperMessage) {
 		xm.handleBatchPostTx(msg)
 	case xuper_p2p.XuperMessage_NEW_BLOCKID:
 		xm.handleNewBlockID(msg)
+	case xuper_p2p.XuperMessage_NEW_COMPACT_BLOCK:
+		xm.handleNewCompactBlock(msg)
 	}
 }
 
@@ -111,12 +151,14 @@ func (xm *XChainMG) handlePostTx(msg *xuper_p2p.XuperMessage) {
 	txStatusBuf, err := p2p_base.Uncompress(msg)
 	if txStatusBuf == nil || err != nil {
 		xm.Log.Error("handlePostTx xuper_p2p uncompressed error", "error", err)
+		xm.P2pSvr.ReportPeer(msg.GetHeader().GetFrom(), p2p_base.PeerEventInvalidTx)
 		return
 	}
 	// Unmarshal msg
 	err = proto.Unmarshal(txStatusBuf, txStatus)
 	if err != nil {
 		xm.Log.Error("handlePostTx Unmarshal msg to tx error", "logid", msg.GetHeader().GetLogid())
+		xm.P2pSvr.ReportPeer(msg.GetHeader().GetFrom(), p2p_base.PeerEventInvalidTx)
 		return
 	}
 
@@ -124,7 +166,11 @@ func (xm *XChainMG) handlePostTx(msg *xuper_p2p.XuperMessage) {
 	if txStatus.Header == nil {
 		txStatus.Header = global.GHeader()
 	}
-	if _, needRepost, _ := xm.ProcessTx(txStatus); needRepost {
+	out, needRepost, err := xm.ProcessTx(txStatus)
+	if isInvalidTxError(out, err) {
+		xm.P2pSvr.ReportPeer(msg.GetHeader().GetFrom(), p2p_base.PeerEventInvalidTx)
+	}
+	if needRepost {
 		whiteList := bc.groupChain.GetAllowedPeersWithBcname(msg.GetHeader().GetBcname())
 		opts := []p2p_base.MessageOption{
 			p2p_base.WithFilters([]p2p_base.FilterStrategy{p2p_base.DefaultStrategy}),
@@ -136,6 +182,17 @@ func (xm *XChainMG) handlePostTx(msg *xuper_p2p.XuperMessage) {
 	return
 }
 
+// isInvalidTxError check whether the tx is refused because of itself, rather than the state of local node.
+// Only malformed txs and invalid signatures are certain to be faults of sender, other verification errors
+// such as missing utxos or conflicting versions may be caused by the local state, so they are not punished
+func isInvalidTxError(out *pb.CommonReply, err error) bool {
+	switch err {
+	case ErrTxNil, ErrTxInvalid, ErrBlockChainNameEmpty:
+		return true
+	}
+	return out.GetHeader().GetError() == pb.XChainErrorEnum_TX_SIGN_ERROR
+}
+
 // ProcessTx process tx, move from server/server.go
 func (xm *XChainMG) ProcessTx(in *pb.TxStatus) (*pb.CommonReply, bool, error) {
 	out := &pb.CommonReply{Header: &pb.Header{Logid: in.Header.Logid}}
@@ -164,6 +221,11 @@ func (xm *XChainMG) ProcessTx(in *pb.TxStatus) (*pb.CommonReply, bool, error) {
 		xm.Log.Warn("PostTx NodeMode is FAST_SYNC, refused!")
 		return out, false, nil
 	}
+	if bc.GetNodeMode() == config.NodeModeLight {
+		out.Header.Error = pb.XChainErrorEnum_CONNECT_REFUSE // 拒绝
+		xm.Log.Warn("PostTx NodeMode is LIGHT, refused!")
+		return out, false, nil
+	}
 	out, needRepost := bc.PostTx(in, hd)
 	return out, needRepost, nil
 }
@@ -200,6 +262,10 @@ func (xm *XChainMG) HandleSendBlock(msg *xuper_p2p.XuperMessage) {
 			return
 		}
 		xm.Log.Error("HandleSendBlock ProcessBlock error", "error", err.Error())
+		// 只在处理失败时校验区块, 避免正常路径重复验签
+		if err == ErrInvalidBlock || !xm.verifyReceivedBlock(bc, block) {
+			xm.P2pSvr.ReportPeer(msg.GetHeader().GetFrom(), p2p_base.PeerEventInvalidBlock)
+		}
 		return
 	}
 
@@ -217,6 +283,9 @@ func (xm *XChainMG) HandleSendBlock(msg *xuper_p2p.XuperMessage) {
 			p2p_base.WithWhiteList(whiteList),
 		}
 		go xm.P2pSvr.SendMessage(context.Background(), msg, opts...)
+	} else if xm.Cfg.BlockBroadcaseMode == 3 {
+		// send block header and short txids in Compact_BroadCast_Mode
+		go bc.broadcastCompactBlock(block.Block)
 	} else {
 		// send block id in Interactive_BroadCast_Mode or Mixed_BroadCast_Mode
 		// we could use Interactive_BroadCast_Mode to avoid duplicate messages
@@ -245,6 +314,15 @@ func (xm *XChainMG) HandleSendBlock(msg *xuper_p2p.XuperMessage) {
 	return
 }
 
+// verifyReceivedBlock check the blockid and signature of a block received from network
+func (xm *XChainMG) verifyReceivedBlock(bc *XChainCore, block *pb.Block) bool {
+	if validateSendBlock(block) != nil {
+		return false
+	}
+	ok, _ := bc.Ledger.VerifyBlock(block.Block, block.GetHeader().GetLogid())
+	return ok
+}
+
 // ProcessBlock process block
 func (xm *XChainMG) ProcessBlock(block *pb.Block) error {
 	bc := xm.Get(block.GetBcname())
@@ -393,6 +471,54 @@ func (xm *XChainMG) handleGetBlock(ctx context.Context, msg *xuper_p2p.XuperMess
 	return res, err
 }
 
+// 处理getBlockHeaders消息回调函数
+func (xm *XChainMG) handleGetBlockHeaders(ctx context.Context, msg *xuper_p2p.XuperMessage) (*xuper_p2p.XuperMessage, error) {
+	bcname := msg.GetHeader().GetBcname()
+	logid := msg.GetHeader().GetLogid()
+	from := msg.GetHeader().GetFrom()
+	if !xm.IsPeerInGroupChain(bcname, from) {
+		xm.Log.Warn("remote node ip is not in white list, refuse it")
+		res, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, bcname, logid,
+			xuper_p2p.XuperMessage_GET_BLOCK_HEADERS_RES, []byte("unknown"), xuper_p2p.XuperMessage_CHECK_SUM_ERROR)
+		return res, errors.New("remote node ip is not in white list, refuse it")
+	}
+	xm.Log.Trace("Start to handleGetBlockHeaders", "bcname", bcname, "logid", logid)
+	if !p2p_base.VerifyDataCheckSum(msg) {
+		xm.Log.Warn("handleGetBlockHeaders verify msg error", "log_id", logid)
+		res, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, bcname, logid,
+			xuper_p2p.XuperMessage_GET_BLOCK_HEADERS_RES, nil, xuper_p2p.XuperMessage_CHECK_SUM_ERROR)
+		return res, errors.New("verify msg error")
+	}
+	in := &pb.BlockHeadersRequest{}
+	if err := proto.Unmarshal(msg.GetData().GetMsgInfo(), in); err != nil {
+		xm.Log.Error("handleGetBlockHeaders unmarshal msg error", "error", err.Error())
+		res, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, bcname, logid,
+			xuper_p2p.XuperMessage_GET_BLOCK_HEADERS_RES, nil, xuper_p2p.XuperMessage_UNMARSHAL_MSG_BODY_ERROR)
+		return res, errors.New("unmarshal msg error")
+	}
+	bc := xm.Get(bcname)
+	if bc == nil {
+		xm.Log.Error("handleGetBlockHeaders Get blockchain error", "error", "blockchain not exit", "bcname", bcname)
+		res, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, bcname, logid,
+			xuper_p2p.XuperMessage_GET_BLOCK_HEADERS_RES, nil, xuper_p2p.XuperMessage_BLOCKCHAIN_NOTEXIST)
+		return res, errors.New("blockChain not exit")
+	}
+	out := bc.GetBlockHeaders(in)
+	if out.GetHeader().GetError() != pb.XChainErrorEnum_SUCCESS {
+		xm.Log.Error("handleGetBlockHeaders GetBlockHeaders error", "error", out.GetHeader().GetError())
+		res, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, bcname, logid,
+			xuper_p2p.XuperMessage_GET_BLOCK_HEADERS_RES, nil, xuper_p2p.XuperMessage_GET_BLOCK_ERROR)
+		return res, errors.New("getBlockHeaders error")
+	}
+	resBuf, _ := proto.Marshal(out)
+	res, err := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, bcname, logid,
+		xuper_p2p.XuperMessage_GET_BLOCK_HEADERS_RES, resBuf, xuper_p2p.XuperMessage_SUCCESS)
+	if xm.enableCompress {
+		res = p2p_base.Compress(res)
+	}
+	return res, err
+}
+
 // 处理getBlockChainStatus消息回调函数
 func (xm *XChainMG) handleGetBlockChainStatus(ctx context.Context, msg *xuper_p2p.XuperMessage) (*xuper_p2p.XuperMessage, error) {
 	bcname := msg.GetHeader().GetBcname()
@@ -568,3 +694,210 @@ func (xm *XChainMG) handleNewBlockID(msg *xuper_p2p.XuperMessage) {
 	}
 	go xm.P2pSvr.SendMessage(context.Background(), msg, opts...)
 }
+
+// handleNewCompactBlock handle NEW_COMPACT_BLOCK message
+func (xm *XChainMG) handleNewCompactBlock(msg *xuper_p2p.XuperMessage) {
+	xm.Log.Trace("Start to handleNewCompactBlock", "logid", msg.GetHeader().GetLogid())
+	cb := &pb.CompactBlock{}
+	cbBuf, err := p2p_base.Uncompress(msg)
+	if err != nil || cbBuf == nil {
+		xm.Log.Error("handleNewCompactBlock uncompressed error", "error", err, "logid", msg.GetHeader().GetLogid())
+		return
+	}
+	if err := proto.Unmarshal(cbBuf, cb); err != nil {
+		xm.Log.Warn("handleNewCompactBlock received unknown message", "error", err, "logid", msg.GetHeader().GetLogid())
+		return
+	}
+
+	bcname := cb.GetBcname()
+	bc := xm.Get(bcname)
+	if bc == nil {
+		xm.Log.Warn("handleNewCompactBlock get bc is nil", "logid", msg.GetHeader().GetLogid())
+		return
+	}
+	blockRes, err := bc.handleNewCompactBlock(context.Background(), cb, msg.GetHeader().GetFrom())
+	if err != nil {
+		xm.Log.Warn("handleNewCompactBlock process message failed", "error", err, "logid", msg.GetHeader().GetLogid())
+		return
+	}
+	if blockRes == nil {
+		xm.Log.Trace("handleNewCompactBlock may received this block before", "blockid", global.F(cb.GetBlockid()),
+			"logid", msg.GetHeader().GetLogid())
+		return
+	}
+
+	if err := xm.ProcessBlock(blockRes); err != nil {
+		if err == ErrBlockExist {
+			xm.Log.Debug("handleNewCompactBlock: ProcessBlock block exists")
+			return
+		}
+		xm.Log.Error("handleNewCompactBlock ProcessBlock error", "error", err.Error())
+		if err == ErrInvalidBlock || !xm.verifyReceivedBlock(bc, blockRes) {
+			xm.P2pSvr.ReportPeer(msg.GetHeader().GetFrom(), p2p_base.PeerEventInvalidBlock)
+		}
+		return
+	}
+
+	// the block is in local ledger now, so peers could get missing txs from us
+	filters := []p2p_base.FilterStrategy{p2p_base.DefaultStrategy}
+	if bc.NeedCoreConnection() {
+		filters = append(filters, p2p_base.CorePeersStrategy)
+	}
+	whiteList := bc.groupChain.GetAllowedPeersWithBcname(bc.bcname)
+	opts := []p2p_base.MessageOption{
+		p2p_base.WithFilters(filters),
+		p2p_base.WithBcName(bcname),
+		p2p_base.WithWhiteList(whiteList),
+	}
+	go xm.P2pSvr.SendMessage(context.Background(), msg, opts...)
+}
+
+// handleGetBlockTxs handle GET_BLOCK_TXS message, return the txs of block by indexes
+func (xm *XChainMG) handleGetBlockTxs(ctx context.Context, msg *xuper_p2p.XuperMessage) (*xuper_p2p.XuperMessage, error) {
+	bcname := msg.GetHeader().GetBcname()
+	logid := msg.GetHeader().GetLogid()
+	from := msg.GetHeader().GetFrom()
+	if !xm.IsPeerInGroupChain(bcname, from) {
+		xm.Log.Warn("remote node ip is not in white list, refuse it")
+		res, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, bcname, logid,
+			xuper_p2p.XuperMessage_GET_BLOCK_TXS_RES, []byte("unknown"), xuper_p2p.XuperMessage_CHECK_SUM_ERROR)
+		return res, errors.New("remote node ip is not in white list, refuse it")
+	}
+	xm.Log.Trace("Start to handleGetBlockTxs", "bcname", bcname, "logid", logid)
+	if !p2p_base.VerifyDataCheckSum(msg) {
+		xm.Log.Warn("handleGetBlockTxs verify msg error", "log_id", logid)
+		res, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, bcname, logid,
+			xuper_p2p.XuperMessage_GET_BLOCK_TXS_RES, nil, xuper_p2p.XuperMessage_CHECK_SUM_ERROR)
+		return res, errors.New("verify msg error")
+	}
+	in := &pb.BlockTxsRequest{}
+	if err := proto.Unmarshal(msg.GetData().GetMsgInfo(), in); err != nil {
+		xm.Log.Error("handleGetBlockTxs unmarshal msg error", "error", err.Error())
+		res, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, bcname, logid,
+			xuper_p2p.XuperMessage_GET_BLOCK_TXS_RES, nil, xuper_p2p.XuperMessage_UNMARSHAL_MSG_BODY_ERROR)
+		return res, errors.New("unmarshal msg error")
+	}
+	bc := xm.Get(bcname)
+	if bc == nil {
+		xm.Log.Error("handleGetBlockTxs Get blockchain error", "error", "blockchain not exit", "bcname", bcname)
+		res, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, bcname, logid,
+			xuper_p2p.XuperMessage_GET_BLOCK_TXS_RES, nil, xuper_p2p.XuperMessage_BLOCKCHAIN_NOTEXIST)
+		return res, errors.New("blockChain not exit")
+	}
+	out := bc.GetBlockTxs(in)
+	if out.GetHeader().GetError() != pb.XChainErrorEnum_SUCCESS {
+		xm.Log.Error("handleGetBlockTxs GetBlockTxs error", "error", out.GetHeader().GetError())
+		res, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, bcname, logid,
+			xuper_p2p.XuperMessage_GET_BLOCK_TXS_RES, nil, xuper_p2p.XuperMessage_GET_BLOCK_ERROR)
+		return res, errors.New("getBlockTxs error")
+	}
+	resBuf, _ := proto.Marshal(out)
+	res, err := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, bcname, logid,
+		xuper_p2p.XuperMessage_GET_BLOCK_TXS_RES, resBuf, xuper_p2p.XuperMessage_SUCCESS)
+	if xm.enableCompress {
+		res = p2p_base.Compress(res)
+	}
+	return res, err
+}
+
+// handleGetTxProof handle GET_TX_PROOF message, return the tx with its merkle proof
+func (xm *XChainMG) handleGetTxProof(ctx context.Context, msg *xuper_p2p.XuperMessage) (*xuper_p2p.XuperMessage, error) {
+	bcname := msg.GetHeader().GetBcname()
+	logid := msg.GetHeader().GetLogid()
+	from := msg.GetHeader().GetFrom()
+	if !xm.IsPeerInGroupChain(bcname, from) {
+		xm.Log.Warn("remote node ip is not in white list, refuse it")
+		res, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, bcname, logid,
+			xuper_p2p.XuperMessage_GET_TX_PROOF_RES, []byte("unknown"), xuper_p2p.XuperMessage_CHECK_SUM_ERROR)
+		return res, errors.New("remote node ip is not in white list, refuse it")
+	}
+	xm.Log.Trace("Start to handleGetTxProof", "bcname", bcname, "logid", logid)
+	if !p2p_base.VerifyDataCheckSum(msg) {
+		xm.Log.Warn("handleGetTxProof verify msg error", "log_id", logid)
+		res, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, bcname, logid,
+			xuper_p2p.XuperMessage_GET_TX_PROOF_RES, nil, xuper_p2p.XuperMessage_CHECK_SUM_ERROR)
+		return res, errors.New("verify msg error")
+	}
+	in := &pb.TxProofRequest{}
+	if err := proto.Unmarshal(msg.GetData().GetMsgInfo(), in); err != nil {
+		xm.Log.Error("handleGetTxProof unmarshal msg error", "error", err.Error())
+		res, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, bcname, logid,
+			xuper_p2p.XuperMessage_GET_TX_PROOF_RES, nil, xuper_p2p.XuperMessage_UNMARSHAL_MSG_BODY_ERROR)
+		return res, errors.New("unmarshal msg error")
+	}
+	bc := xm.Get(bcname)
+	if bc == nil {
+		xm.Log.Error("handleGetTxProof Get blockchain error", "error", "blockchain not exit", "bcname", bcname)
+		res, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, bcname, logid,
+			xuper_p2p.XuperMessage_GET_TX_PROOF_RES, nil, xuper_p2p.XuperMessage_BLOCKCHAIN_NOTEXIST)
+		return res, errors.New("blockChain not exit")
+	}
+	if in.Header == nil {
+		in.Header = global.GHeader()
+	}
+	out := bc.GetTxProof(in)
+	if out.GetHeader().GetError() != pb.XChainErrorEnum_SUCCESS {
+		xm.Log.Debug("handleGetTxProof GetTxProof error", "error", out.GetHeader().GetError())
+		res, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, bcname, logid,
+			xuper_p2p.XuperMessage_GET_TX_PROOF_RES, nil, xuper_p2p.XuperMessage_UNKNOW_ERROR)
+		return res, errors.New("getTxProof error")
+	}
+	resBuf, _ := proto.Marshal(out)
+	res, err := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, bcname, logid,
+		xuper_p2p.XuperMessage_GET_TX_PROOF_RES, resBuf, xuper_p2p.XuperMessage_SUCCESS)
+	if xm.enableCompress {
+		res = p2p_base.Compress(res)
+	}
+	return res, err
+}
+
+// handleGetUtxoProof handle GET_UTXO_PROOF message, return the utxos of address with state proofs
+func (xm *XChainMG) handleGetUtxoProof(ctx context.Context, msg *xuper_p2p.XuperMessage) (*xuper_p2p.XuperMessage, error) {
+	bcname := msg.GetHeader().GetBcname()
+	logid := msg.GetHeader().GetLogid()
+	from := msg.GetHeader().GetFrom()
+	if !xm.IsPeerInGroupChain(bcname, from) {
+		xm.Log.Warn("remote node ip is not in white list, refuse it")
+		res, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, bcname, logid,
+			xuper_p2p.XuperMessage_GET_UTXO_PROOF_RES, []byte("unknown"), xuper_p2p.XuperMessage_CHECK_SUM_ERROR)
+		return res, errors.New("remote node ip is not in white list, refuse it")
+	}
+	xm.Log.Trace("Start to handleGetUtxoProof", "bcname", bcname, "logid", logid)
+	if !p2p_base.VerifyDataCheckSum(msg) {
+		xm.Log.Warn("handleGetUtxoProof verify msg error", "log_id", logid)
+		res, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, bcname, logid,
+			xuper_p2p.XuperMessage_GET_UTXO_PROOF_RES, nil, xuper_p2p.XuperMessage_CHECK_SUM_ERROR)
+		return res, errors.New("verify msg error")
+	}
+	in := &pb.UtxoProofRequest{}
+	if err := proto.Unmarshal(msg.GetData().GetMsgInfo(), in); err != nil {
+		xm.Log.Error("handleGetUtxoProof unmarshal msg error", "error", err.Error())
+		res, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, bcname, logid,
+			xuper_p2p.XuperMessage_GET_UTXO_PROOF_RES, nil, xuper_p2p.XuperMessage_UNMARSHAL_MSG_BODY_ERROR)
+		return res, errors.New("unmarshal msg error")
+	}
+	bc := xm.Get(bcname)
+	if bc == nil {
+		xm.Log.Error("handleGetUtxoProof Get blockchain error", "error", "blockchain not exit", "bcname", bcname)
+		res, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, bcname, logid,
+			xuper_p2p.XuperMessage_GET_UTXO_PROOF_RES, nil, xuper_p2p.XuperMessage_BLOCKCHAIN_NOTEXIST)
+		return res, errors.New("blockChain not exit")
+	}
+	if in.Header == nil {
+		in.Header = global.GHeader()
+	}
+	out := bc.GetUtxoProof(in)
+	if out.GetHeader().GetError() != pb.XChainErrorEnum_SUCCESS {
+		xm.Log.Debug("handleGetUtxoProof GetUtxoProof error", "error", out.GetHeader().GetError())
+		res, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, bcname, logid,
+			xuper_p2p.XuperMessage_GET_UTXO_PROOF_RES, nil, xuper_p2p.XuperMessage_UNKNOW_ERROR)
+		return res, errors.New("getUtxoProof error")
+	}
+	resBuf, _ := proto.Marshal(out)
+	res, err := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, bcname, logid,
+		xuper_p2p.XuperMessage_GET_UTXO_PROOF_RES, resBuf, xuper_p2p.XuperMessage_SUCCESS)
+	if xm.enableCompress {
+		res = p2p_base.Compress(res)
+	}
+	return res, err
+}
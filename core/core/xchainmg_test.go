@@ -374,7 +374,7 @@ func TestXChainMgBasic(t *testing.T) {
 	t.Log("msg info ", msgInfo)
 	msgTmp, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion1, "xuper", "123456", xuper_p2p.XuperMessage_POSTTX,
 		msgInfo, xuper_p2p.XuperMessage_NONE)
-	xcmg.msgChan <- msgTmp
+	xcmg.txMsgChan <- msgTmp
 	//batch BatchPostTx
 	batchTxs := &pb.BatchTxs{
 		Header: &pb.Header{},
@@ -384,7 +384,7 @@ func TestXChainMgBasic(t *testing.T) {
 	msgInfos, _ := proto.Marshal(batchTxs)
 	msgsTmp, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion1, "xuper", "123457", xuper_p2p.XuperMessage_BATCHPOSTTX,
 		msgInfos, xuper_p2p.XuperMessage_NONE)
-	xcmg.msgChan <- msgsTmp
+	xcmg.txMsgChan <- msgsTmp
 	sendBlockMsgInfo, _ := proto.Marshal(globalBlock)
 	sendBlockMsgTmp, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion1, "xuper", "123458", xuper_p2p.XuperMessage_SENDBLOCK,
 		sendBlockMsgInfo, xuper_p2p.XuperMessage_NONE)
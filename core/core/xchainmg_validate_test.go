@@ -1,6 +1,7 @@
 package xchaincore
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -83,3 +84,29 @@ func TestValidateSendBlock(t *testing.T) {
 		}
 	}
 }
+
+func TestIsInvalidTxError(t *testing.T) {
+	reply := func(e pb.XChainErrorEnum) *pb.CommonReply {
+		return &pb.CommonReply{Header: &pb.Header{Error: e}}
+	}
+	if !isInvalidTxError(reply(pb.XChainErrorEnum_VALIDATE_ERROR), ErrTxInvalid) {
+		t.Error("malformed tx should be invalid")
+	}
+	if !isInvalidTxError(reply(pb.XChainErrorEnum_TX_SIGN_ERROR), nil) {
+		t.Error("tx of invalid signature should be invalid")
+	}
+	// 与本地状态相关的错误不惩罚发送方
+	for _, e := range []pb.XChainErrorEnum{
+		pb.XChainErrorEnum_SUCCESS,
+		pb.XChainErrorEnum_TX_VERIFICATION_ERROR,
+		pb.XChainErrorEnum_UTXOVM_NOT_FOUND_ERROR,
+		pb.XChainErrorEnum_CONNECT_REFUSE,
+	} {
+		if isInvalidTxError(reply(e), nil) {
+			t.Errorf("%s should not be invalid", e)
+		}
+	}
+	if isInvalidTxError(nil, errors.New("rpc error")) {
+		t.Error("other errors should not be invalid")
+	}
+}
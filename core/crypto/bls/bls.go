@@ -0,0 +1,268 @@
+// Package bls implements BLS signatures on BLS12-381, signatures are in G1 and public keys are in G2.
+// Signatures of the same message can be aggregated into one signature, the aggregation is weighted by
+// coefficients derived from all public keys, so that no proof of possession is needed against rogue key attack.
+package bls
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/consensys/gurvy/bls381"
+
+	"github.com/xuperchain/xuperchain/core/crypto/config"
+	"github.com/xuperchain/xuperchain/core/crypto/hash"
+)
+
+const (
+	// PublicKeyLength is the byte length of public key
+	PublicKeyLength = 4 * fpLength
+	// SignatureLength is the byte length of signature
+	SignatureLength = fpLength
+
+	// 不同用途使用不同的域分隔标签, 签名的标签按RFC 9380的建议包含hash_to_curve的suite
+	dstSign   = "XCHAIN-V01-CS01-with-BLS12381G1_XMD:SHA-256_SSWU_RO_"
+	dstKeyGen = "XCHAIN-BLS12381-KEYGEN"
+	dstCoef   = "XCHAIN-BLS12381-AGG"
+)
+
+var (
+	// ErrInvalidPrivateKey is returned while the private key is zero or not less than the group order
+	ErrInvalidPrivateKey = errors.New("invalid BLS private key")
+	// ErrInvalidSignature is returned while the signature can not be decoded
+	ErrInvalidSignature = errors.New("invalid BLS signature")
+	// ErrInvalidPublicKey is returned while the public key can not be decoded
+	ErrInvalidPublicKey = errors.New("invalid BLS public key")
+	// ErrAggregateParams is returned while public keys and signatures to aggregate are not matched
+	ErrAggregateParams = errors.New("number of public keys and signatures not match")
+)
+
+// PrivateKey is the BLS private key
+type PrivateKey struct {
+	x *big.Int
+	PublicKey
+}
+
+// PublicKey is the BLS public key, a point in G2
+type PublicKey struct {
+	p bls381.G2Affine
+}
+
+// PublicKeyJSON is the json format of public key, which is used as public key of XuperSign
+type PublicKeyJSON struct {
+	Curvname string
+	P        []byte
+}
+
+// GenerateKey generate a random private key
+func GenerateKey(r io.Reader) (*PrivateKey, error) {
+	if r == nil {
+		r = rand.Reader
+	}
+	seed := make([]byte, 64)
+	if _, err := io.ReadFull(r, seed); err != nil {
+		return nil, err
+	}
+	return NewPrivateKeyFromSeed(seed)
+}
+
+// NewPrivateKeyFromSeed derive the private key from seed deterministically
+func NewPrivateKeyFromSeed(seed []byte) (*PrivateKey, error) {
+	h := sha512.New()
+	h.Write([]byte(dstKeyGen))
+	h.Write(seed)
+	x := new(big.Int).SetBytes(h.Sum(nil))
+	return NewPrivateKey(x.Mod(x, frModulus).Bytes())
+}
+
+// NewPrivateKeyFromEcdsa derive the private key from ecdsa private key,
+// so that the account can use BLS signature without another key file
+func NewPrivateKeyFromEcdsa(k *ecdsa.PrivateKey) (*PrivateKey, error) {
+	if k == nil || k.D == nil {
+		return nil, ErrInvalidPrivateKey
+	}
+	return NewPrivateKeyFromSeed(k.D.Bytes())
+}
+
+// NewPrivateKey create private key from big-endian bytes
+func NewPrivateKey(data []byte) (*PrivateKey, error) {
+	x := new(big.Int).SetBytes(data)
+	if x.Sign() == 0 || x.Cmp(frModulus) >= 0 {
+		return nil, ErrInvalidPrivateKey
+	}
+	k := &PrivateKey{x: x}
+	var p bls381.G2Jac
+	p.ScalarMulByGen(curve, scalar(x))
+	p.ToAffineFromJac(&k.p)
+	return k, nil
+}
+
+// Bytes return the 32 bytes big-endian form of private key
+func (k *PrivateKey) Bytes() []byte {
+	out := make([]byte, 32)
+	b := k.x.Bytes()
+	copy(out[len(out)-len(b):], b)
+	return out
+}
+
+// Public return the public key of private key
+func (k *PrivateKey) Public() *PublicKey {
+	return &k.PublicKey
+}
+
+// NewPublicKey decode public key, the point is checked to be in G2
+func NewPublicKey(data []byte) (*PublicKey, error) {
+	p, err := unmarshalG2(data)
+	if err != nil {
+		return nil, ErrInvalidPublicKey
+	}
+	return &PublicKey{p: *p}, nil
+}
+
+// Bytes return the encoded public key
+func (pk *PublicKey) Bytes() []byte {
+	return marshalG2(&pk.p)
+}
+
+// MarshalJSON return the json format of public key
+func (pk *PublicKey) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&PublicKeyJSON{
+		Curvname: config.CurveBls,
+		P:        pk.Bytes(),
+	})
+}
+
+// ParsePublicKeyJSON decode public key from json format
+func ParsePublicKeyJSON(data []byte) (*PublicKey, error) {
+	pkJSON := &PublicKeyJSON{}
+	if err := json.Unmarshal(data, pkJSON); err != nil {
+		return nil, err
+	}
+	if pkJSON.Curvname != config.CurveBls {
+		return nil, ErrInvalidPublicKey
+	}
+	return NewPublicKey(pkJSON.P)
+}
+
+// IsPublicKeyJSON return whether data is the json format of BLS public key
+func IsPublicKeyJSON(data []byte) bool {
+	pkJSON := &PublicKeyJSON{}
+	return json.Unmarshal(data, pkJSON) == nil && pkJSON.Curvname == config.CurveBls
+}
+
+// GetAddressFromPublicKey return the address of public key,
+// it's in the same format as ecdsa address, with the crypto type BLS
+func GetAddressFromPublicKey(pk *PublicKey) string {
+	payload := append([]byte{byte(config.Bls)}, hash.UsingRipemd160(hash.UsingSha256(pk.Bytes()))...)
+	checkCode := hash.DoubleSha256(payload)[:4]
+	return base58.Encode(append(payload, checkCode...))
+}
+
+// Sign sign msg with private key, the signature is 48 bytes
+func Sign(k *PrivateKey, msg []byte) []byte {
+	h := hashToG1(dstSign, msg)
+	var p, sig bls381.G1Jac
+	var affine bls381.G1Affine
+	h.ToJacobian(&p)
+	sig.ScalarMul(curve, &p, scalar(k.x))
+	sig.ToAffineFromJac(&affine)
+	return marshalG1(&affine)
+}
+
+// Verify verify the signature of msg, e(sig, g2) == e(H(msg), pk)
+func Verify(pk *PublicKey, sig, msg []byte) bool {
+	s, err := unmarshalG1(sig)
+	if err != nil {
+		return false
+	}
+	return verifyPoint(&pk.p, s, msg)
+}
+
+// verifyPoint check e(-sig, g2) * e(H(msg), pk) == 1
+func verifyPoint(pk *bls381.G2Affine, sig *bls381.G1Affine, msg []byte) bool {
+	var negSig bls381.G1Affine
+	var g2 bls381.G2Affine
+	negSig.Neg(sig)
+	gen := g2Gen()
+	gen.ToAffineFromJac(&g2)
+	return pairingCheck(negSig, g2, hashToG1(dstSign, msg), *pk)
+}
+
+// coefficients return the aggregation coefficients of public keys,
+// t_i = H(i || pk_1 || ... || pk_n) truncated to 128 bits
+func coefficients(pks []*PublicKey) []*big.Int {
+	all := make([]byte, 0, len(pks)*PublicKeyLength)
+	for _, pk := range pks {
+		all = append(all, pk.Bytes()...)
+	}
+	res := make([]*big.Int, len(pks))
+	for i := range pks {
+		h := sha256.New()
+		h.Write([]byte(dstCoef))
+		idx := make([]byte, 4)
+		binary.BigEndian.PutUint32(idx, uint32(i))
+		h.Write(idx)
+		h.Write(all)
+		res[i] = new(big.Int).SetBytes(h.Sum(nil)[:16])
+	}
+	return res
+}
+
+// AggregateSignatures aggregate signatures of the same msg, sigs[i] is signed by pks[i],
+// the aggregated signature is sum(t_i * sig_i)
+func AggregateSignatures(pks []*PublicKey, sigs [][]byte) ([]byte, error) {
+	if len(pks) == 0 || len(pks) != len(sigs) {
+		return nil, ErrAggregateParams
+	}
+	coefs := coefficients(pks)
+	var agg bls381.G1Jac
+	agg.Z.SetZero()
+	for i, sig := range sigs {
+		s, err := unmarshalG1(sig)
+		if err != nil {
+			return nil, ErrInvalidSignature
+		}
+		var p, weighted bls381.G1Jac
+		s.ToJacobian(&p)
+		weighted.ScalarMul(curve, &p, scalar(coefs[i]))
+		g1Add(&agg, &weighted)
+	}
+	var affine bls381.G1Affine
+	agg.ToAffineFromJac(&affine)
+	return marshalG1(&affine), nil
+}
+
+// AggregatePublicKeys aggregate public keys into sum(t_i * pk_i)
+func AggregatePublicKeys(pks []*PublicKey) (*PublicKey, error) {
+	if len(pks) == 0 {
+		return nil, ErrAggregateParams
+	}
+	coefs := coefficients(pks)
+	var agg bls381.G2Jac
+	agg.Z.SetZero()
+	for i, pk := range pks {
+		var p, weighted bls381.G2Jac
+		pk.p.ToJacobian(&p)
+		weighted.ScalarMul(curve, &p, scalar(coefs[i]))
+		g2Add(&agg, &weighted)
+	}
+	res := &PublicKey{}
+	agg.ToAffineFromJac(&res.p)
+	return res, nil
+}
+
+// VerifyAggregatedSignature verify the aggregated signature of msg signed by all pks
+func VerifyAggregatedSignature(pks []*PublicKey, sig, msg []byte) bool {
+	apk, err := AggregatePublicKeys(pks)
+	if err != nil || apk.p.IsInfinity() {
+		return false
+	}
+	return Verify(apk, sig, msg)
+}
@@ -0,0 +1,167 @@
+package bls
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+
+	"github.com/xuperchain/xuperchain/core/crypto/account"
+	"github.com/xuperchain/xuperchain/core/crypto/config"
+)
+
+func genKeys(t *testing.T, n int) []*PrivateKey {
+	keys := make([]*PrivateKey, n)
+	for i := range keys {
+		k, err := GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys[i] = k
+	}
+	return keys
+}
+
+func TestHashToG1(t *testing.T) {
+	// RFC 9380 J.9.1 BLS12381G1_XMD:SHA-256_SSWU_RO_ 的测试向量
+	dst := "QUUX-V01-CS02-with-BLS12381G1_XMD:SHA-256_SSWU_RO_"
+	vectors := []struct {
+		msg, x, y string
+	}{
+		{
+			msg: "",
+			x:   "052926add2207b76ca4fa57a8734416c8dc95e24501772c814278700eed6d1e4e8cf62d9c09db0fac349612b759e79a1",
+			y:   "08ba738453bfed09cb546dbb0783dbb3a5f1f566ed67bb6be0e8c67e2e81a4cc68ee29813bb7994998f3eae0c9c6a265",
+		},
+		{
+			msg: "abc",
+			x:   "03567bc5ef9c690c2ab2ecdf6a96ef1c139cc0b2f284dca0a9a7943388a49a3aee664ba5379a7655d3c68900be2f6903",
+			y:   "0b9c15f3fe6e5cf4211f346271d7b01c8f3b28be689c8429c85b67af215533311f0b8dfaaa154fa6b88176c229f2885d",
+		},
+	}
+	for _, v := range vectors {
+		p := hashToG1(dst, []byte(v.msg))
+		x, y := p.X.Bytes(), p.Y.Bytes()
+		if hex.EncodeToString(x[:]) != v.x || hex.EncodeToString(y[:]) != v.y {
+			t.Fatalf("unexpected hash of %q: %x %x", v.msg, x, y)
+		}
+		if !g1InSubgroup(&p) {
+			t.Fatalf("hash of %q not in G1", v.msg)
+		}
+	}
+}
+
+func TestSignVerify(t *testing.T) {
+	k := genKeys(t, 1)[0]
+	msg := []byte("hello xuperchain")
+	sig := Sign(k, msg)
+	if len(sig) != SignatureLength {
+		t.Fatal("invalid signature length", len(sig))
+	}
+	if !Verify(k.Public(), sig, msg) {
+		t.Fatal("verify signature failed")
+	}
+	if Verify(k.Public(), sig, []byte("another msg")) {
+		t.Fatal("signature of another msg should not pass")
+	}
+	other := genKeys(t, 1)[0]
+	if Verify(other.Public(), sig, msg) {
+		t.Fatal("signature of another key should not pass")
+	}
+	// 签名编码被篡改
+	bad := append([]byte{}, sig...)
+	bad[len(bad)-1] ^= 1
+	if Verify(k.Public(), bad, msg) {
+		t.Fatal("tampered signature should not pass")
+	}
+}
+
+func TestKeyEncoding(t *testing.T) {
+	k := genKeys(t, 1)[0]
+	k2, err := NewPrivateKey(k.Bytes())
+	if err != nil || !bytes.Equal(k2.Public().Bytes(), k.Public().Bytes()) {
+		t.Fatal("decode private key failed", err)
+	}
+	pk, err := NewPublicKey(k.Public().Bytes())
+	if err != nil || !bytes.Equal(pk.Bytes(), k.Public().Bytes()) {
+		t.Fatal("decode public key failed", err)
+	}
+	bad := k.Public().Bytes()
+	bad[PublicKeyLength-1] ^= 1
+	if _, err := NewPublicKey(bad); err == nil {
+		t.Fatal("point not on curve should be refused")
+	}
+	jsonPK, err := k.Public().MarshalJSON()
+	if err != nil || !IsPublicKeyJSON(jsonPK) {
+		t.Fatal("marshal public key json failed", err)
+	}
+	pk, err = ParsePublicKeyJSON(jsonPK)
+	if err != nil || !bytes.Equal(pk.Bytes(), k.Public().Bytes()) {
+		t.Fatal("parse public key json failed", err)
+	}
+	if IsPublicKeyJSON([]byte(`{"Curvname":"P-256","X":1,"Y":2}`)) {
+		t.Fatal("ecdsa public key should not be BLS public key")
+	}
+	if _, err := NewPrivateKey(frModulus.Bytes()); err == nil {
+		t.Fatal("private key not less than group order should be refused")
+	}
+}
+
+func TestNewPrivateKeyFromEcdsa(t *testing.T) {
+	ek, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	k1, err := NewPrivateKeyFromEcdsa(ek)
+	if err != nil {
+		t.Fatal(err)
+	}
+	k2, _ := NewPrivateKeyFromEcdsa(ek)
+	if !bytes.Equal(k1.Bytes(), k2.Bytes()) {
+		t.Fatal("key derived from ecdsa key should be deterministic")
+	}
+	addr := GetAddressFromPublicKey(k1.Public())
+	ok, cryptoType := account.CheckAddressFormat(addr)
+	if !ok || cryptoType != config.Bls {
+		t.Fatal("invalid BLS address", addr, cryptoType)
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	keys := genKeys(t, 4)
+	msg := []byte("proposal id")
+	pks := make([]*PublicKey, len(keys))
+	sigs := make([][]byte, len(keys))
+	for i, k := range keys {
+		pks[i] = k.Public()
+		sigs[i] = Sign(k, msg)
+	}
+	agg, err := AggregateSignatures(pks, sigs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(agg) != SignatureLength {
+		t.Fatal("invalid aggregated signature length", len(agg))
+	}
+	if !VerifyAggregatedSignature(pks, agg, msg) {
+		t.Fatal("verify aggregated signature failed")
+	}
+	if VerifyAggregatedSignature(pks[:3], agg, msg) {
+		t.Fatal("aggregated signature should not pass with part of public keys")
+	}
+	// 公钥顺序与签名顺序不一致
+	swapped := []*PublicKey{pks[1], pks[0], pks[2], pks[3]}
+	if VerifyAggregatedSignature(swapped, agg, msg) {
+		t.Fatal("aggregated signature should not pass with reordered public keys")
+	}
+	sigs[2] = Sign(keys[2], []byte("another msg"))
+	agg, _ = AggregateSignatures(pks, sigs)
+	if VerifyAggregatedSignature(pks, agg, msg) {
+		t.Fatal("aggregated signature with wrong sign should not pass")
+	}
+	if _, err := AggregateSignatures(pks, sigs[:2]); err != ErrAggregateParams {
+		t.Fatal("expect ErrAggregateParams", err)
+	}
+}
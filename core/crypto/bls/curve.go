@@ -0,0 +1,212 @@
+package bls
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gurvy/bls381"
+	"github.com/consensys/gurvy/bls381/fp"
+	"github.com/consensys/gurvy/bls381/fr"
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+const (
+	// fpLength is the byte length of a base field element
+	fpLength = 48
+
+	// ZCash序列化格式的标志位
+	flagCompressed = 0x80
+	flagInfinity   = 0x40
+	flagLargest    = 0x20
+	flagMask       = 0xe0
+)
+
+var (
+	// ErrInvalidPoint is returned while the point is not on curve or not in the prime order subgroup
+	ErrInvalidPoint = errors.New("invalid BLS12-381 point")
+
+	curve = bls381.BLS381()
+	// fpModulus is the modulus of base field
+	fpModulus = fp.ElementModulus()
+	// frModulus is the order of G1 and G2
+	frModulus, _ = new(big.Int).SetString("52435875175126190479447740508185965837690552500527637822603658699938581184513", 10)
+	// halfFpModulus is (p-1)/2, y is the largest one of (y, -y) if y > (p-1)/2
+	halfFpModulus = new(big.Int).Rsh(fpModulus, 1)
+	// rMinusOne is used to check the order of points, r*P=0 is equivalent to (r-1)*P=-P
+	rMinusOne = new(big.Int).Sub(frModulus, big.NewInt(1))
+)
+
+// scalar converts k into the regular form element which is required by ScalarMul
+func scalar(k *big.Int) fr.Element {
+	var e fr.Element
+	e.SetBigInt(k)
+	e.FromMont()
+	return e
+}
+
+// g1Gen return the generator of G1
+func g1Gen() bls381.G1Jac {
+	var p bls381.G1Jac
+	p.ScalarMulByGen(curve, scalar(big.NewInt(1)))
+	return p
+}
+
+// g2Gen return the generator of G2
+func g2Gen() bls381.G2Jac {
+	var p bls381.G2Jac
+	p.ScalarMulByGen(curve, scalar(big.NewInt(1)))
+	return p
+}
+
+// g1Add set p = p + a, Add of gurvy does not work while p equals a
+func g1Add(p, a *bls381.G1Jac) {
+	if p.Equal(a) {
+		p.Double()
+		return
+	}
+	p.Add(curve, a)
+}
+
+// g2Add set p = p + a, Add of gurvy does not work while p equals a
+func g2Add(p, a *bls381.G2Jac) {
+	if p.Equal(a) {
+		p.Double()
+		return
+	}
+	p.Add(curve, a)
+}
+
+// isLargest return whether y is the lexicographically largest one of (y, -y)
+func isLargest(y *fp.Element) bool {
+	var v big.Int
+	y.ToBigIntRegular(&v)
+	return v.Cmp(halfFpModulus) > 0
+}
+
+// setFp set z from big-endian bytes, the value should be less than p
+func setFp(z *fp.Element, b []byte) error {
+	v := new(big.Int).SetBytes(b)
+	if v.Cmp(fpModulus) >= 0 {
+		return ErrInvalidPoint
+	}
+	z.SetBigInt(v)
+	return nil
+}
+
+// g1InSubgroup return whether p is in G1
+func g1InSubgroup(p *bls381.G1Affine) bool {
+	var j, res, neg bls381.G1Jac
+	p.ToJacobian(&j)
+	res.ScalarMul(curve, &j, scalar(rMinusOne))
+	neg.Neg(&j)
+	return res.Equal(&neg)
+}
+
+// g2InSubgroup return whether p is in G2
+func g2InSubgroup(p *bls381.G2Affine) bool {
+	var j, res, neg bls381.G2Jac
+	p.ToJacobian(&j)
+	res.ScalarMul(curve, &j, scalar(rMinusOne))
+	neg.Neg(&j)
+	return res.Equal(&neg)
+}
+
+// hashToG1 maps msg to G1 with hash_to_curve of RFC 9380, the suite is BLS12381G1_XMD:SHA-256_SSWU_RO_
+func hashToG1(dst string, msg []byte) bls381.G1Affine {
+	// 未压缩编码为 X || Y, 坐标均为小于p的大端整数
+	buf := blst.HashToG1(msg, []byte(dst)).ToAffine().Serialize()
+	var p bls381.G1Affine
+	setFp(&p.X, buf[:fpLength])
+	setFp(&p.Y, buf[fpLength:])
+	return p
+}
+
+// marshalG1 encodes p in 48 bytes compressed form
+func marshalG1(p *bls381.G1Affine) []byte {
+	out := make([]byte, fpLength)
+	if p.IsInfinity() {
+		out[0] = flagCompressed | flagInfinity
+		return out
+	}
+	copy(out, p.X.Bytes())
+	out[0] |= flagCompressed
+	if isLargest(&p.Y) {
+		out[0] |= flagLargest
+	}
+	return out
+}
+
+// unmarshalG1 decodes the compressed form of point in G1, infinity is refused
+func unmarshalG1(data []byte) (*bls381.G1Affine, error) {
+	if len(data) != fpLength || data[0]&flagCompressed == 0 || data[0]&flagInfinity != 0 {
+		return nil, ErrInvalidPoint
+	}
+	largest := data[0]&flagLargest != 0
+	buf := append([]byte{}, data...)
+	buf[0] &^= flagMask
+
+	p := &bls381.G1Affine{}
+	if err := setFp(&p.X, buf); err != nil {
+		return nil, err
+	}
+	var rhs fp.Element
+	rhs.Square(&p.X).Mul(&rhs, &p.X).Add(&rhs, &curve.B)
+	if p.Y.Sqrt(&rhs) == nil {
+		return nil, ErrInvalidPoint
+	}
+	if isLargest(&p.Y) != largest {
+		p.Y.Neg(&p.Y)
+	}
+	if !g1InSubgroup(p) {
+		return nil, ErrInvalidPoint
+	}
+	return p, nil
+}
+
+// marshalG2 encodes p in 192 bytes uncompressed form: x.A1 || x.A0 || y.A1 || y.A0
+func marshalG2(p *bls381.G2Affine) []byte {
+	out := make([]byte, 0, 4*fpLength)
+	out = append(out, p.X.A1.Bytes()...)
+	out = append(out, p.X.A0.Bytes()...)
+	out = append(out, p.Y.A1.Bytes()...)
+	out = append(out, p.Y.A0.Bytes()...)
+	return out
+}
+
+// unmarshalG2 decodes the uncompressed form of point in G2, infinity is refused
+func unmarshalG2(data []byte) (*bls381.G2Affine, error) {
+	if len(data) != 4*fpLength || data[0]&flagMask != 0 {
+		return nil, ErrInvalidPoint
+	}
+	p := &bls381.G2Affine{}
+	coords := []*fp.Element{&p.X.A1, &p.X.A0, &p.Y.A1, &p.Y.A0}
+	for i, c := range coords {
+		if err := setFp(c, data[i*fpLength:(i+1)*fpLength]); err != nil {
+			return nil, err
+		}
+	}
+	if p.IsInfinity() {
+		return nil, ErrInvalidPoint
+	}
+	// 扭曲线方程 y^2 = x^3 + 4(u+1)
+	lhs, rhs, b := p.Y, p.X, p.X
+	lhs.Square(&p.Y)
+	rhs.Square(&p.X).Mul(&rhs, &p.X)
+	b.A0.SetUint64(4)
+	b.A1.SetUint64(4)
+	rhs.Add(&rhs, &b)
+	if !lhs.Equal(&rhs) || !g2InSubgroup(p) {
+		return nil, ErrInvalidPoint
+	}
+	return p, nil
+}
+
+// pairingCheck return whether e(a1, b1) * e(a2, b2) == 1
+func pairingCheck(a1 bls381.G1Affine, b1 bls381.G2Affine, a2 bls381.G1Affine, b2 bls381.G2Affine) bool {
+	var m1, m2, one bls381.PairingResult
+	curve.MillerLoop(a1, b1, &m1)
+	curve.MillerLoop(a2, b2, &m2)
+	res := curve.FinalExponentiation(&m1, &m2)
+	one.SetOne()
+	return res.Equal(&one)
+}
@@ -0,0 +1,10 @@
+package main
+
+import (
+	"github.com/xuperchain/xuperchain/core/crypto/client/service/bls"
+)
+
+// GetInstance return the BLS12-381 client
+func GetInstance() interface{} {
+	return &bls.BlsCryptoClient{}
+}
@@ -22,6 +22,8 @@ const (
 	CryptoTypeGM = "gm"
 	// CryptoTypeSchnorr : support for Nist + Schnorr
 	CryptoTypeSchnorr = "schnorr"
+	// CryptoTypeBls : support for Nist + BLS12-381 aggregated signature
+	CryptoTypeBls = "bls"
 )
 
 // cryptoClientFactory is the factory to hold all kinds of crypto clients' instance
@@ -0,0 +1,92 @@
+package bls
+
+import (
+	"crypto/ecdsa"
+	"errors"
+
+	"github.com/xuperchain/crypto/client/service/xchain"
+
+	bls_sign "github.com/xuperchain/xuperchain/core/crypto/bls"
+)
+
+// BlsClient is the BLS12-381 part of BlsCryptoClient,
+// users get it by type assertion from the CryptoClient created with crypto type "bls"
+type BlsClient interface {
+	// 从ecdsa私钥派生BLS私钥, 账户无需额外的密钥文件
+	GetBlsPrivateKeyFromEcdsa(k *ecdsa.PrivateKey) (*bls_sign.PrivateKey, error)
+	GetBlsPublicKeyJsonFormatStr(k *bls_sign.PrivateKey) (string, error)
+	GetBlsPublicKeyFromJsonStr(keyStr string) (*bls_sign.PublicKey, error)
+	GetAddressFromBlsPublicKey(pk *bls_sign.PublicKey) (string, error)
+	BlsSign(k *bls_sign.PrivateKey, msg []byte) ([]byte, error)
+	BlsVerify(pk *bls_sign.PublicKey, sig, msg []byte) (bool, error)
+	// 聚合同一消息的多个签名, sigs[i]由pks[i]签名
+	BlsAggregateSignatures(pks []*bls_sign.PublicKey, sigs [][]byte) ([]byte, error)
+	BlsVerifyAggregatedSignature(pks []*bls_sign.PublicKey, sig, msg []byte) (bool, error)
+}
+
+// BlsCryptoClient is the crypto client with BLS12-381 aggregated signatures,
+// the other functions are the same as default xchain client
+type BlsCryptoClient struct {
+	xchain.XchainCryptoClient
+}
+
+var _ BlsClient = (*BlsCryptoClient)(nil)
+
+// GetBlsPrivateKeyFromEcdsa derive BLS private key from ecdsa private key
+func (bcc *BlsCryptoClient) GetBlsPrivateKeyFromEcdsa(k *ecdsa.PrivateKey) (*bls_sign.PrivateKey, error) {
+	return bls_sign.NewPrivateKeyFromEcdsa(k)
+}
+
+// GetBlsPublicKeyJsonFormatStr return the json format of BLS public key
+func (bcc *BlsCryptoClient) GetBlsPublicKeyJsonFormatStr(k *bls_sign.PrivateKey) (string, error) {
+	data, err := k.Public().MarshalJSON()
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// GetBlsPublicKeyFromJsonStr parse BLS public key from json format
+func (bcc *BlsCryptoClient) GetBlsPublicKeyFromJsonStr(keyStr string) (*bls_sign.PublicKey, error) {
+	return bls_sign.ParsePublicKeyJSON([]byte(keyStr))
+}
+
+// GetAddressFromBlsPublicKey return the address of BLS public key
+func (bcc *BlsCryptoClient) GetAddressFromBlsPublicKey(pk *bls_sign.PublicKey) (string, error) {
+	if pk == nil {
+		return "", bls_sign.ErrInvalidPublicKey
+	}
+	return bls_sign.GetAddressFromPublicKey(pk), nil
+}
+
+// BlsSign sign msg with BLS private key
+func (bcc *BlsCryptoClient) BlsSign(k *bls_sign.PrivateKey, msg []byte) ([]byte, error) {
+	if k == nil {
+		return nil, bls_sign.ErrInvalidPrivateKey
+	}
+	return bls_sign.Sign(k, msg), nil
+}
+
+// BlsVerify verify BLS signature of msg
+func (bcc *BlsCryptoClient) BlsVerify(pk *bls_sign.PublicKey, sig, msg []byte) (bool, error) {
+	if pk == nil {
+		return false, bls_sign.ErrInvalidPublicKey
+	}
+	if !bls_sign.Verify(pk, sig, msg) {
+		return false, errors.New("verify BLS signature failed")
+	}
+	return true, nil
+}
+
+// BlsAggregateSignatures aggregate BLS signatures of the same msg into one signature
+func (bcc *BlsCryptoClient) BlsAggregateSignatures(pks []*bls_sign.PublicKey, sigs [][]byte) ([]byte, error) {
+	return bls_sign.AggregateSignatures(pks, sigs)
+}
+
+// BlsVerifyAggregatedSignature verify the aggregated signature of msg signed by all pks
+func (bcc *BlsCryptoClient) BlsVerifyAggregatedSignature(pks []*bls_sign.PublicKey, sig, msg []byte) (bool, error) {
+	if !bls_sign.VerifyAggregatedSignature(pks, sig, msg) {
+		return false, errors.New("verify aggregated BLS signature failed")
+	}
+	return true, nil
+}
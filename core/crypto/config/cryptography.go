@@ -10,6 +10,8 @@ const (
 	Gm // = 2
 	// P-256 + schnorr
 	NistSN
+	// BLS12-381
+	Bls // = 4
 )
 
 // 定义创建账户时产生的助记词中的标记符的值，及其所对应的预留标记位的类型
@@ -30,6 +32,8 @@ const (
 	CurveGm = "SM2-P-256"
 	// Nist P256 + schnorr
 	CurveNistSN = "P-256-SN"
+	// BLS12-381配对友好曲线, 用于BLS聚合签名
+	CurveBls = "BLS12-381"
 )
 
 // IsValidCryptoType 判断是否支持的加密类型
@@ -39,6 +43,7 @@ func IsValidCryptoType(ctype byte) bool {
 	case Nist:
 	case Gm:
 	case NistSN:
+	case Bls:
 	default:
 		valid = false
 	}
@@ -0,0 +1,255 @@
+// Package vrf implements the verifiable random function ECVRF with try-and-increment hash to curve,
+// over the curves of ecdsa keys used by CryptoClient, such as NIST P-256 and SM2.
+// The proof is Gamma || c || s, Gamma is the compressed point x*H(alpha), and the output beta is the hash of Gamma.
+package vrf
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"math/big"
+)
+
+const (
+	// challengeLength is the byte length of challenge c
+	challengeLength = 16
+	// OutputLength is the byte length of VRF output
+	OutputLength = sha256.Size
+
+	suite = "XCHAIN-ECVRF-SHA256-TAI"
+)
+
+var (
+	// ErrInvalidKey is returned while the key is nil or not on curve
+	ErrInvalidKey = errors.New("invalid VRF key")
+	// ErrInvalidProof is returned while the proof can not be decoded or verified
+	ErrInvalidProof = errors.New("invalid VRF proof")
+	// ErrHashToCurve is returned while alpha can not be mapped to curve, which is almost impossible
+	ErrHashToCurve = errors.New("VRF hash to curve failed")
+)
+
+// Prove generate the VRF proof of alpha with private key
+func Prove(k *ecdsa.PrivateKey, alpha []byte) ([]byte, error) {
+	if k == nil || k.D == nil || k.Curve == nil || !k.Curve.IsOnCurve(k.X, k.Y) {
+		return nil, ErrInvalidKey
+	}
+	curve := k.Curve
+	params := curve.Params()
+	hx, hy, err := hashToCurve(&k.PublicKey, alpha)
+	if err != nil {
+		return nil, err
+	}
+	gx, gy := curve.ScalarMult(hx, hy, k.D.Bytes())
+	nonce := generateNonce(k, hx, hy)
+	ux, uy := curve.ScalarBaseMult(nonce.Bytes())
+	vx, vy := curve.ScalarMult(hx, hy, nonce.Bytes())
+	c := challenge(curve, hx, hy, gx, gy, ux, uy, vx, vy)
+
+	// s = nonce + c * x mod N
+	s := new(big.Int).Mul(c, k.D)
+	s.Add(s, nonce)
+	s.Mod(s, params.N)
+
+	proof := marshalPoint(curve, gx, gy)
+	proof = append(proof, padBytes(c.Bytes(), challengeLength)...)
+	proof = append(proof, padBytes(s.Bytes(), scalarLength(curve))...)
+	return proof, nil
+}
+
+// Verify verify the VRF proof of alpha with public key, and return the VRF output
+func Verify(pk *ecdsa.PublicKey, alpha, proof []byte) ([]byte, error) {
+	if pk == nil || pk.Curve == nil || pk.X == nil || pk.Y == nil || !pk.Curve.IsOnCurve(pk.X, pk.Y) {
+		return nil, ErrInvalidKey
+	}
+	curve := pk.Curve
+	params := curve.Params()
+	gx, gy, c, s, err := decodeProof(curve, proof)
+	if err != nil {
+		return nil, err
+	}
+	hx, hy, err := hashToCurve(pk, alpha)
+	if err != nil {
+		return nil, err
+	}
+	// U = s*G - c*Y, V = s*H - c*Gamma
+	negC := new(big.Int).Sub(params.N, c)
+	ux, uy := addPoints(curve, scalarBaseMult(curve, s), scalarMult(curve, pk.X, pk.Y, negC))
+	vx, vy := addPoints(curve, scalarMult(curve, hx, hy, s), scalarMult(curve, gx, gy, negC))
+	if challenge(curve, hx, hy, gx, gy, ux, uy, vx, vy).Cmp(c) != 0 {
+		return nil, ErrInvalidProof
+	}
+	return gammaToHash(curve, gx, gy), nil
+}
+
+// ProofToHash return the VRF output of proof without verification,
+// the proof should have been verified by Verify before
+func ProofToHash(curve elliptic.Curve, proof []byte) ([]byte, error) {
+	gx, gy, _, _, err := decodeProof(curve, proof)
+	if err != nil {
+		return nil, err
+	}
+	return gammaToHash(curve, gx, gy), nil
+}
+
+// hashToCurve maps alpha to a point with try-and-increment,
+// x = H(suite || 0x01 || pk || alpha || counter) is tried until it's the x-coordinate of a point
+func hashToCurve(pk *ecdsa.PublicKey, alpha []byte) (*big.Int, *big.Int, error) {
+	curve := pk.Curve
+	pkBytes := marshalPoint(curve, pk.X, pk.Y)
+	for counter := 0; counter < 256; counter++ {
+		h := sha256.New()
+		h.Write([]byte(suite))
+		h.Write([]byte{0x01})
+		h.Write(pkBytes)
+		h.Write(alpha)
+		h.Write([]byte{byte(counter)})
+		encoded := append([]byte{0x02}, padBytes(h.Sum(nil), coordLength(curve))...)
+		if x, y, err := unmarshalPoint(curve, encoded); err == nil {
+			return x, y, nil
+		}
+	}
+	return nil, nil, ErrHashToCurve
+}
+
+// generateNonce derive the nonce from private key and H deterministically
+func generateNonce(k *ecdsa.PrivateKey, hx, hy *big.Int) *big.Int {
+	h := sha512.New()
+	h.Write([]byte(suite))
+	h.Write(padBytes(k.D.Bytes(), scalarLength(k.Curve)))
+	h.Write(marshalPoint(k.Curve, hx, hy))
+	nonce := new(big.Int).SetBytes(h.Sum(nil))
+	nonce.Mod(nonce, new(big.Int).Sub(k.Curve.Params().N, big.NewInt(1)))
+	// nonce should be in [1, N-1]
+	return nonce.Add(nonce, big.NewInt(1))
+}
+
+// challenge return c = H(suite || 0x02 || H || Gamma || U || V) truncated to 16 bytes
+func challenge(curve elliptic.Curve, points ...*big.Int) *big.Int {
+	h := sha256.New()
+	h.Write([]byte(suite))
+	h.Write([]byte{0x02})
+	for i := 0; i+1 < len(points); i += 2 {
+		h.Write(marshalPoint(curve, points[i], points[i+1]))
+	}
+	return new(big.Int).SetBytes(h.Sum(nil)[:challengeLength])
+}
+
+// gammaToHash return beta = H(suite || 0x03 || Gamma)
+func gammaToHash(curve elliptic.Curve, gx, gy *big.Int) []byte {
+	h := sha256.New()
+	h.Write([]byte(suite))
+	h.Write([]byte{0x03})
+	h.Write(marshalPoint(curve, gx, gy))
+	return h.Sum(nil)
+}
+
+func decodeProof(curve elliptic.Curve, proof []byte) (gx, gy, c, s *big.Int, err error) {
+	pointLen := 1 + coordLength(curve)
+	if len(proof) != pointLen+challengeLength+scalarLength(curve) {
+		return nil, nil, nil, nil, ErrInvalidProof
+	}
+	gx, gy, err = unmarshalPoint(curve, proof[:pointLen])
+	if err != nil {
+		return nil, nil, nil, nil, ErrInvalidProof
+	}
+	c = new(big.Int).SetBytes(proof[pointLen : pointLen+challengeLength])
+	s = new(big.Int).SetBytes(proof[pointLen+challengeLength:])
+	if s.Cmp(curve.Params().N) >= 0 {
+		return nil, nil, nil, nil, ErrInvalidProof
+	}
+	return gx, gy, c, s, nil
+}
+
+// point is the affine coordinates, (0, 0) is the point at infinity as elliptic package does
+type point struct {
+	x, y *big.Int
+}
+
+func scalarBaseMult(curve elliptic.Curve, k *big.Int) point {
+	x, y := curve.ScalarBaseMult(k.Bytes())
+	return point{x, y}
+}
+
+func scalarMult(curve elliptic.Curve, x, y, k *big.Int) point {
+	rx, ry := curve.ScalarMult(x, y, k.Bytes())
+	return point{rx, ry}
+}
+
+// addPoints return a + b, doubling is handled explicitly since not all curves handle it in Add
+func addPoints(curve elliptic.Curve, a, b point) (*big.Int, *big.Int) {
+	if isInfinity(a) {
+		return b.x, b.y
+	}
+	if isInfinity(b) {
+		return a.x, a.y
+	}
+	if a.x.Cmp(b.x) == 0 {
+		if a.y.Cmp(b.y) == 0 {
+			return curve.Double(a.x, a.y)
+		}
+		return new(big.Int), new(big.Int)
+	}
+	return curve.Add(a.x, a.y, b.x, b.y)
+}
+
+func isInfinity(p point) bool {
+	return p.x.Sign() == 0 && p.y.Sign() == 0
+}
+
+// marshalPoint encodes point in compressed form, the point at infinity is encoded as a single zero byte
+func marshalPoint(curve elliptic.Curve, x, y *big.Int) []byte {
+	if x.Sign() == 0 && y.Sign() == 0 {
+		return []byte{0x00}
+	}
+	return append([]byte{byte(0x02 | y.Bit(0))}, padBytes(x.Bytes(), coordLength(curve))...)
+}
+
+// unmarshalPoint decodes the compressed point, y^2 = x^3 - 3x + b is assumed as curves of ecdsa keys
+func unmarshalPoint(curve elliptic.Curve, data []byte) (*big.Int, *big.Int, error) {
+	params := curve.Params()
+	if len(data) != 1+coordLength(curve) || (data[0] != 0x02 && data[0] != 0x03) {
+		return nil, nil, ErrInvalidProof
+	}
+	x := new(big.Int).SetBytes(data[1:])
+	if x.Cmp(params.P) >= 0 {
+		return nil, nil, ErrInvalidProof
+	}
+	rhs := new(big.Int).Mul(x, x)
+	rhs.Mul(rhs, x)
+	threeX := new(big.Int).Lsh(x, 1)
+	threeX.Add(threeX, x)
+	rhs.Sub(rhs, threeX)
+	rhs.Add(rhs, params.B)
+	rhs.Mod(rhs, params.P)
+	y := new(big.Int).ModSqrt(rhs, params.P)
+	if y == nil {
+		return nil, nil, ErrInvalidProof
+	}
+	if y.Bit(0) != uint(data[0]&1) {
+		y.Sub(params.P, y)
+	}
+	if !curve.IsOnCurve(x, y) {
+		return nil, nil, ErrInvalidProof
+	}
+	return x, y, nil
+}
+
+func coordLength(curve elliptic.Curve) int {
+	return (curve.Params().P.BitLen() + 7) / 8
+}
+
+func scalarLength(curve elliptic.Curve) int {
+	return (curve.Params().N.BitLen() + 7) / 8
+}
+
+// padBytes left pad b with zero to size, b is truncated to the last size bytes if it's longer
+func padBytes(b []byte, size int) []byte {
+	if len(b) >= size {
+		return b[len(b)-size:]
+	}
+	out := make([]byte, size)
+	copy(out[size-len(b):], b)
+	return out
+}
@@ -0,0 +1,70 @@
+package vrf
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+)
+
+func genKey(t *testing.T) *ecdsa.PrivateKey {
+	k, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return k
+}
+
+func TestProveVerify(t *testing.T) {
+	k := genKey(t)
+	alpha := []byte("previous vrf output")
+	proof, err := Prove(k, alpha)
+	if err != nil {
+		t.Fatal(err)
+	}
+	beta, err := Verify(&k.PublicKey, alpha, proof)
+	if err != nil || len(beta) != OutputLength {
+		t.Fatal("verify proof failed", err)
+	}
+	// 相同的输入和私钥产生相同的输出
+	proof2, _ := Prove(k, alpha)
+	beta2, err := ProofToHash(k.Curve, proof2)
+	if err != nil || !bytes.Equal(beta, beta2) {
+		t.Fatal("VRF output should be deterministic", err)
+	}
+	if _, err := Verify(&k.PublicKey, []byte("another alpha"), proof); err != ErrInvalidProof {
+		t.Fatal("proof of another alpha should not pass", err)
+	}
+	other := genKey(t)
+	if _, err := Verify(&other.PublicKey, alpha, proof); err != ErrInvalidProof {
+		t.Fatal("proof of another key should not pass", err)
+	}
+	bad := append([]byte{}, proof...)
+	bad[len(bad)-1] ^= 1
+	if _, err := Verify(&k.PublicKey, alpha, bad); err == nil {
+		t.Fatal("tampered proof should not pass")
+	}
+	if _, err := Verify(&k.PublicKey, alpha, proof[1:]); err != ErrInvalidProof {
+		t.Fatal("proof with invalid length should not pass", err)
+	}
+}
+
+func TestOutputDistinct(t *testing.T) {
+	k := genKey(t)
+	seen := map[string]bool{}
+	for i := 0; i < 16; i++ {
+		proof, err := Prove(k, []byte{byte(i)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		beta, err := Verify(&k.PublicKey, []byte{byte(i)}, proof)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if seen[string(beta)] {
+			t.Fatal("VRF output of different alpha should be distinct")
+		}
+		seen[string(beta)] = true
+	}
+}
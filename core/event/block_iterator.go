@@ -1,6 +1,7 @@
 package event
 
 import (
+	"bytes"
 	"errors"
 	"time"
 
@@ -10,13 +11,24 @@ import (
 
 var _ Iterator = (*BlockIterator)(nil)
 
-// BlockIterator wraps around ledger as a iterator style interface
+// maxRewindDepth is the max depth of fork that BlockIterator can rewind
+const maxRewindDepth = 1000
+
+// BlockIterator wraps around ledger as a iterator style interface.
+// History blocks and live blocks are fetched by the same path, and every block is checked
+// to be linked to the previous one, so there is no gap or duplicate when switching from
+// history to live blocks. When a fork happens, the iterator rewinds to the fork point and
+// returns the blocks on the new trunk again.
 type BlockIterator struct {
 	currNum    int64
 	endNum     int64
 	blockStore BlockStore
 	block      *pb.InternalBlock
 
+	// recent blockids returned by the iterator, used to find the fork point
+	recent       [][]byte
+	recentHeight int64
+
 	closed bool
 	err    error
 }
@@ -43,11 +55,61 @@ func (b *BlockIterator) Next() bool {
 		return false
 	}
 
+	// the block is not linked to the previous one, a fork happened
+	if prev := b.lastBlockid(); prev != nil && !bytes.Equal(block.GetPreHash(), prev) {
+		forkHeight, err := b.findForkHeight()
+		if err != nil {
+			b.err = err
+			return false
+		}
+		b.currNum = forkHeight + 1
+		block, err = b.fetchBlock(b.currNum)
+		if err != nil {
+			b.err = err
+			return false
+		}
+	}
+
 	b.block = block
+	b.pushRecent(block)
 	b.currNum += 1
 	return true
 }
 
+// findForkHeight returns the height of the last returned block which is still in trunk
+func (b *BlockIterator) findForkHeight() (int64, error) {
+	for len(b.recent) > 0 {
+		height := b.recentHeight + int64(len(b.recent)) - 1
+		block, err := b.blockStore.QueryBlockByHeight(height)
+		if err != nil && err != ledger.ErrBlockNotExist {
+			return 0, err
+		}
+		if err == nil && bytes.Equal(block.GetBlockid(), b.recent[len(b.recent)-1]) {
+			return height, nil
+		}
+		b.recent = b.recent[:len(b.recent)-1]
+	}
+	return 0, errors.New("fork is deeper than the blocks can be rewound")
+}
+
+func (b *BlockIterator) lastBlockid() []byte {
+	if len(b.recent) == 0 {
+		return nil
+	}
+	return b.recent[len(b.recent)-1]
+}
+
+func (b *BlockIterator) pushRecent(block *pb.InternalBlock) {
+	if len(b.recent) == 0 {
+		b.recentHeight = block.GetHeight()
+	}
+	b.recent = append(b.recent, block.GetBlockid())
+	if len(b.recent) > maxRewindDepth {
+		b.recent = b.recent[1:]
+		b.recentHeight++
+	}
+}
+
 func (b *BlockIterator) fetchBlock(num int64) (*pb.InternalBlock, error) {
 	for !b.closed {
 		// 确保utxo更新到了对应的高度
@@ -23,6 +23,13 @@ type BlockStore interface {
 	WaitBlockHeight(target int64) int64
 	// QueryBlockByHeight returns block at given height
 	QueryBlockByHeight(int64) (*pb.InternalBlock, error)
+	// QueryBlockHeader returns block header of given blockid, the block may be not in trunk
+	QueryBlockHeader(blockid []byte) (*pb.InternalBlock, error)
+	// QueryContractABI returns the ABI of evm contract, empty for contracts without ABI
+	QueryContractABI(contractName string) ([]byte, error)
+	// QueryContractEvents returns the json encoded indexed keys of events declared by non evm contract,
+	// empty for contracts without declaration
+	QueryContractEvents(contractName string) ([]byte, error)
 }
 
 type chainManager struct {
@@ -65,3 +72,19 @@ func (b *blockStore) TipBlockHeight() (int64, error) {
 	}
 	return block.GetHeight(), nil
 }
+
+func (b *blockStore) QueryContractABI(contractName string) ([]byte, error) {
+	value, err := b.UtxoVM.GetXModel().Get("contract", []byte(contractName+".abi"))
+	if err != nil {
+		return nil, err
+	}
+	return value.GetPureData().GetValue(), nil
+}
+
+func (b *blockStore) QueryContractEvents(contractName string) ([]byte, error) {
+	value, err := b.UtxoVM.GetXModel().Get("contract", []byte(contractName+".events"))
+	if err != nil {
+		return nil, err
+	}
+	return value.GetPureData().GetValue(), nil
+}
@@ -1,6 +1,7 @@
 package event
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strconv"
@@ -69,9 +70,18 @@ func (b *BlockTopic) newIterator(filter *blockFilter) (Iterator, error) {
 	if err != nil {
 		return nil, err
 	}
+	if needDecoder(filter) {
+		filter.decoder = newEventDecoder(blockStore)
+	}
 
 	var startBlockNum, endBlockNum int64
-	if filter.GetRange().GetStart() == "" {
+	if filter.GetCursor() != nil {
+		n, err := b.resumeBlockNum(blockStore, filter.GetCursor())
+		if err != nil {
+			return nil, err
+		}
+		startBlockNum = n
+	} else if filter.GetRange().GetStart() == "" {
 		n, err := blockStore.TipBlockHeight()
 		if err != nil {
 			return nil, err
@@ -99,5 +109,29 @@ func (b *BlockTopic) newIterator(filter *blockFilter) (Iterator, error) {
 	return &filteredBlockIterator{
 		biter:  biter,
 		filter: filter,
+		cursor: filter.GetCursor(),
 	}, nil
 }
+
+// resumeBlockNum returns the block number to resume from the cursor.
+// If the block of cursor is not in trunk any more, the subscription resumes from the fork point.
+func (b *BlockTopic) resumeBlockNum(blockStore BlockStore, cursor *pb.BlockCursor) (int64, error) {
+	blockid, err := hex.DecodeString(cursor.GetBlockid())
+	if err != nil {
+		return 0, fmt.Errorf("error %s when parse cursor blockid", err)
+	}
+	block, err := blockStore.QueryBlockHeader(blockid)
+	if err != nil {
+		return 0, fmt.Errorf("error %s when query cursor block", err)
+	}
+	if block.GetInTrunk() {
+		return block.GetHeight(), nil
+	}
+	for !block.GetInTrunk() {
+		block, err = blockStore.QueryBlockHeader(block.GetPreHash())
+		if err != nil {
+			return 0, fmt.Errorf("error %s when find fork point of cursor block", err)
+		}
+	}
+	return block.GetHeight() + 1, nil
+}
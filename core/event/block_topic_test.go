@@ -1,6 +1,7 @@
 package event
 
 import (
+	"bytes"
 	"encoding/hex"
 	"strconv"
 	"testing"
@@ -164,3 +165,108 @@ func TestFilterTxEvent(t *testing.T) {
 		}
 	})
 }
+
+func TestBlockTopicResumeFromCursor(t *testing.T) {
+	ledger := newMockBlockStore()
+	const N = 5
+	var blocks []*pb.InternalBlock
+	for i := 0; i < N; i++ {
+		tx1 := newTxBuilder().Tx()
+		tx2 := newTxBuilder().Tx()
+		block := newBlockBuilder().AddTx(tx1, tx2).Block()
+		blocks = append(blocks, block)
+		ledger.AppendBlock(block)
+	}
+
+	topic := NewBlockTopic(ledger)
+	newIter := func(cursor *pb.BlockCursor) Iterator {
+		iter, err := topic.NewFilterIterator(&pb.BlockFilter{
+			Range: &pb.BlockRange{
+				End: strconv.Itoa(N),
+			},
+			Cursor: cursor,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return iter
+	}
+
+	t.Run("partialBlock", func(tt *testing.T) {
+		iter := newIter(&pb.BlockCursor{
+			Blockid: hex.EncodeToString(blocks[2].GetBlockid()),
+			TxIndex: 0,
+		})
+		defer iter.Close()
+		if !iter.Next() {
+			tt.Fatal("expect block")
+		}
+		fblock := iter.Data().(*pb.FilteredBlock)
+		if fblock.GetBlockHeight() != 2 || len(fblock.GetTxs()) != 1 || fblock.GetTxs()[0].GetIndex() != 1 {
+			tt.Fatalf("expect the second tx of block 2, got %v", fblock)
+		}
+	})
+
+	t.Run("wholeBlock", func(tt *testing.T) {
+		iter := newIter(&pb.BlockCursor{
+			Blockid: hex.EncodeToString(blocks[2].GetBlockid()),
+			TxIndex: 1,
+		})
+		defer iter.Close()
+		if !iter.Next() {
+			tt.Fatal("expect block")
+		}
+		fblock := iter.Data().(*pb.FilteredBlock)
+		if fblock.GetBlockHeight() != 3 || len(fblock.GetTxs()) != 2 {
+			tt.Fatalf("expect block 3, got %v", fblock)
+		}
+	})
+
+	t.Run("forkedBlock", func(tt *testing.T) {
+		forked := newBlockBuilder().Block()
+		ledger.Fork(3, forked)
+		iter := newIter(&pb.BlockCursor{
+			Blockid: hex.EncodeToString(blocks[3].GetBlockid()),
+			TxIndex: 1,
+		})
+		defer iter.Close()
+		if !iter.Next() {
+			tt.Fatal("expect block")
+		}
+		fblock := iter.Data().(*pb.FilteredBlock)
+		if fblock.GetBlockid() != hex.EncodeToString(forked.GetBlockid()) {
+			tt.Fatalf("expect forked block, got %v", fblock)
+		}
+	})
+}
+
+func TestBlockIteratorRewindOnFork(t *testing.T) {
+	ledger := newMockBlockStore()
+	for i := 0; i < 3; i++ {
+		ledger.AppendBlock(newBlockBuilder().Block())
+	}
+
+	iter := NewBlockIterator(ledger, 0, -1)
+	defer iter.Close()
+	for i := 0; i < 3; i++ {
+		if !iter.Next() {
+			t.Fatal("expect block")
+		}
+	}
+
+	// replace block 1 and 2, and the iterator should return blocks from height 1 again
+	forked := []*pb.InternalBlock{
+		newBlockBuilder().Block(),
+		newBlockBuilder().Block(),
+		newBlockBuilder().Block(),
+	}
+	ledger.Fork(1, forked...)
+	for i, block := range forked {
+		if !iter.Next() {
+			t.Fatal("expect block")
+		}
+		if !bytes.Equal(iter.Block().GetBlockid(), block.GetBlockid()) || iter.Block().GetHeight() != int64(i+1) {
+			t.Fatalf("expect forked block at height %d, got %x", i+1, iter.Block().GetBlockid())
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package event
+
+import (
+	"encoding/hex"
+	"errors"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/xuperchain/xuperchain/core/common/events"
+	"github.com/xuperchain/xuperchain/core/pb"
+	"github.com/xuperchain/xuperchain/core/utxo"
+)
+
+var _ Topic = (*ChainReorgTopic)(nil)
+
+// ChainReorgTopic handles events of blocks undone and redone when the trunk switches
+type ChainReorgTopic struct {
+	chainmg  ChainManager
+	notifier *eventNotifier
+}
+
+// NewChainReorgTopic instances ChainReorgTopic from ChainManager
+func NewChainReorgTopic(chainmg ChainManager) *ChainReorgTopic {
+	return &ChainReorgTopic{
+		chainmg:  chainmg,
+		notifier: defaultNotifier,
+	}
+}
+
+// ParseFilter 从指定的bytes buffer反序列化topic过滤器
+// 返回的参数会作为入参传递给NewIterator的filter参数
+func (c *ChainReorgTopic) ParseFilter(buf []byte) (interface{}, error) {
+	pbfilter := new(pb.ChainReorgFilter)
+	err := proto.Unmarshal(buf, pbfilter)
+	if err != nil {
+		return nil, err
+	}
+	return pbfilter, nil
+}
+
+// MarshalEvent encode event payload returns from Iterator.Data()
+func (c *ChainReorgTopic) MarshalEvent(x interface{}) ([]byte, error) {
+	msg := x.(proto.Message)
+	return proto.Marshal(msg)
+}
+
+// NewIterator make a new Iterator base on filter
+func (c *ChainReorgTopic) NewIterator(ifilter interface{}) (Iterator, error) {
+	pbfilter, ok := ifilter.(*pb.ChainReorgFilter)
+	if !ok {
+		return nil, errors.New("bad filter type for chain reorg event")
+	}
+	if _, err := c.chainmg.GetBlockStore(pbfilter.GetBcname()); err != nil {
+		return nil, err
+	}
+	filter, err := newBlockFilter(&pb.BlockFilter{
+		Bcname:         pbfilter.GetBcname(),
+		ExcludeTx:      pbfilter.GetExcludeTx(),
+		ExcludeTxEvent: pbfilter.GetExcludeTxEvent(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	convert := func(em *events.EventMessage) (interface{}, bool) {
+		reorg, ok := em.Message.(*utxo.ChainReorgEvent)
+		if !ok {
+			return nil, false
+		}
+		return &pb.ChainReorg{
+			Bcname:     filter.GetBcname(),
+			UndoBlocks: toReorgBlocks(filter, reorg.UndoBlocks),
+			RedoBlocks: toReorgBlocks(filter, reorg.RedoBlocks),
+		}, true
+	}
+	return newNotifierIterator(c.notifier, convert, filter.GetBcname(), events.ChainReorganized), nil
+}
+
+// toReorgBlocks converts blocks to FilteredBlock with all the txs, subscribers need them to revert states
+func toReorgBlocks(filter *blockFilter, blocks []*pb.InternalBlock) []*pb.FilteredBlock {
+	fblocks := make([]*pb.FilteredBlock, 0, len(blocks))
+	for _, block := range blocks {
+		fblock := &pb.FilteredBlock{
+			Bcname:      filter.GetBcname(),
+			Blockid:     hex.EncodeToString(block.GetBlockid()),
+			BlockHeight: block.GetHeight(),
+		}
+		if !filter.GetExcludeTx() {
+			for idx, tx := range block.GetTransactions() {
+				fblock.Txs = append(fblock.Txs, &pb.FilteredTransaction{
+					Txid:   hex.EncodeToString(tx.GetTxid()),
+					Events: parseFilteredEvents(filter, tx),
+					Index:  int64(idx),
+				})
+			}
+		}
+		fblocks = append(fblocks, fblock)
+	}
+	return fblocks
+}
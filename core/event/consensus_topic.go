@@ -0,0 +1,80 @@
+package event
+
+import (
+	"errors"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/xuperchain/xuperchain/core/common/events"
+	cons_base "github.com/xuperchain/xuperchain/core/consensus/base"
+	"github.com/xuperchain/xuperchain/core/pb"
+)
+
+var _ Topic = (*ConsensusTopic)(nil)
+
+// ConsensusTopic handles events of consensus miners changing,
+// such as tdpos proposers of next term and xpoa validates updating
+type ConsensusTopic struct {
+	chainmg  ChainManager
+	notifier *eventNotifier
+}
+
+// NewConsensusTopic instances ConsensusTopic from ChainManager
+func NewConsensusTopic(chainmg ChainManager) *ConsensusTopic {
+	return &ConsensusTopic{
+		chainmg:  chainmg,
+		notifier: defaultNotifier,
+	}
+}
+
+// ParseFilter 从指定的bytes buffer反序列化topic过滤器
+// 返回的参数会作为入参传递给NewIterator的filter参数
+func (c *ConsensusTopic) ParseFilter(buf []byte) (interface{}, error) {
+	pbfilter := new(pb.ConsensusFilter)
+	err := proto.Unmarshal(buf, pbfilter)
+	if err != nil {
+		return nil, err
+	}
+	return pbfilter, nil
+}
+
+// MarshalEvent encode event payload returns from Iterator.Data()
+func (c *ConsensusTopic) MarshalEvent(x interface{}) ([]byte, error) {
+	msg := x.(proto.Message)
+	return proto.Marshal(msg)
+}
+
+// NewIterator make a new Iterator base on filter
+func (c *ConsensusTopic) NewIterator(ifilter interface{}) (Iterator, error) {
+	pbfilter, ok := ifilter.(*pb.ConsensusFilter)
+	if !ok {
+		return nil, errors.New("bad filter type for consensus event")
+	}
+	if _, err := c.chainmg.GetBlockStore(pbfilter.GetBcname()); err != nil {
+		return nil, err
+	}
+
+	bcname := pbfilter.GetBcname()
+	convert := func(em *events.EventMessage) (interface{}, bool) {
+		mcevent, ok := em.Message.(*cons_base.MinersChangedEvent)
+		if !ok {
+			return nil, false
+		}
+		return &pb.ConsensusChange{
+			Bcname:        bcname,
+			CurrentMiners: toConsensusMiners(mcevent.CurrentMiners),
+			NextMiners:    toConsensusMiners(mcevent.NextMiners),
+		}, true
+	}
+	return newNotifierIterator(c.notifier, convert, bcname, events.ProposerChanged, events.ValidatorsChanged), nil
+}
+
+func toConsensusMiners(miners []*cons_base.MinerInfo) []*pb.ConsensusMiner {
+	ret := make([]*pb.ConsensusMiner, 0, len(miners))
+	for _, miner := range miners {
+		ret = append(ret, &pb.ConsensusMiner{
+			Address:  miner.Address,
+			PeerInfo: miner.PeerInfo,
+		})
+	}
+	return ret
+}
@@ -0,0 +1,138 @@
+package event
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/xuperchain/xuperchain/core/contract/evm/abi"
+	"github.com/xuperchain/xuperchain/core/pb"
+)
+
+// eventDecoder decodes the body of contract events to arguments.
+// Events of evm contracts are decoded with the ABI of contract,
+// events of other contracts are decoded as json object and every key is an argument,
+// the keys declared as indexed when deploying the contract are indexed.
+type eventDecoder struct {
+	blockStore BlockStore
+
+	mutex sync.Mutex
+	// metas caches the event metadata of contracts
+	metas map[string]*contractEventMeta
+}
+
+// contractEventMeta is the event metadata of contract
+type contractEventMeta struct {
+	// abi is the ABI of evm contract
+	abi *abi.ABI
+	// indexes are the declared indexed keys of events, keyed by event name
+	indexes map[string][]string
+	// height is the tip height when the metadata of non evm contract is queried, the contract may be
+	// deployed or upgraded with new events declaration later, so the result is only reused at the same height
+	height int64
+}
+
+func newEventDecoder(blockStore BlockStore) *eventDecoder {
+	return &eventDecoder{
+		blockStore: blockStore,
+		metas:      make(map[string]*contractEventMeta),
+	}
+}
+
+// Decode returns the arguments of event
+func (d *eventDecoder) Decode(event *pb.ContractEvent) ([]*pb.EventArg, error) {
+	meta, err := d.getMeta(event.GetContract())
+	if err != nil {
+		return nil, err
+	}
+	if meta.abi == nil {
+		return decodeJSONEvent(event, meta.indexes[event.GetName()])
+	}
+
+	args, err := meta.abi.DecodeEvent(event.GetName(), event.GetBody())
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*pb.EventArg, 0, len(args))
+	for _, arg := range args {
+		ret = append(ret, &pb.EventArg{
+			Name:    arg.Name,
+			Type:    arg.Type,
+			Indexed: arg.Indexed,
+			Value:   arg.Value,
+		})
+	}
+	return ret, nil
+}
+
+func (d *eventDecoder) getMeta(contract string) (*contractEventMeta, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	height, err := d.blockStore.TipBlockHeight()
+	if err != nil {
+		return nil, err
+	}
+	if meta, ok := d.metas[contract]; ok && (meta.abi != nil || meta.height == height) {
+		return meta, nil
+	}
+
+	meta := &contractEventMeta{
+		height: height,
+	}
+	buf, err := d.blockStore.QueryContractABI(contract)
+	if err != nil {
+		return nil, err
+	}
+	if len(buf) != 0 {
+		meta.abi, err = abi.New(buf)
+		if err != nil {
+			return nil, fmt.Errorf("parse abi of contract %s error:%s", contract, err)
+		}
+	}
+	if meta.abi == nil {
+		buf, err = d.blockStore.QueryContractEvents(contract)
+		if err != nil {
+			return nil, err
+		}
+		if len(buf) != 0 {
+			if err := json.Unmarshal(buf, &meta.indexes); err != nil {
+				return nil, fmt.Errorf("parse events of contract %s error:%s", contract, err)
+			}
+		}
+	}
+	d.metas[contract] = meta
+	return meta, nil
+}
+
+// decodeJSONEvent decodes event body as json object, the keys are sorted to keep the order stable,
+// only the keys in indexes are indexed
+func decodeJSONEvent(event *pb.ContractEvent, indexes []string) ([]*pb.EventArg, error) {
+	var body map[string]json.RawMessage
+	if err := json.Unmarshal(event.GetBody(), &body); err != nil {
+		return nil, fmt.Errorf("event body of %s is not json object:%s", event.GetName(), err)
+	}
+	keys := make([]string, 0, len(body))
+	for key := range body {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	indexed := make(map[string]bool, len(indexes))
+	for _, key := range indexes {
+		indexed[key] = true
+	}
+	args := make([]*pb.EventArg, 0, len(keys))
+	for _, key := range keys {
+		var value string
+		if err := json.Unmarshal(body[key], &value); err != nil {
+			value = string(body[key])
+		}
+		args = append(args, &pb.EventArg{
+			Name:    key,
+			Indexed: indexed[key],
+			Value:   value,
+		})
+	}
+	return args, nil
+}
@@ -0,0 +1,158 @@
+package event
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/xuperchain/xuperchain/core/common/events"
+)
+
+// listenerBufferSize is the number of events buffered for a listener before it is dropped
+const listenerBufferSize = 1024
+
+// ErrListenerOverflow returns when the subscriber can not keep up with the events
+var ErrListenerOverflow = errors.New("event listener overflow, subscriber is too slow")
+
+// notifierEventTypes are the internal system events dispatched by eventNotifier
+var notifierEventTypes = []events.EventType{
+	events.UnconfirmedTxAdded,
+	events.ChainReorganized,
+	events.ProposerChanged,
+	events.ValidatorsChanged,
+}
+
+// defaultNotifier is shared by all topics, since EventBus identifies a handler by its code pointer
+// and could not register the same method of different instances.
+var defaultNotifier = newEventNotifier(events.GetEventBus())
+
+// eventNotifier subscribes internal system events from EventBus and broadcasts them to listeners.
+// Events come from the hot path of utxo and consensus, so the notifier never blocks:
+// a listener whose buffer is full is dropped with ErrListenerOverflow.
+type eventNotifier struct {
+	bus  *events.EventBus
+	once sync.Once
+
+	mutex     sync.Mutex
+	listeners map[*eventListener]struct{}
+}
+
+func newEventNotifier(bus *events.EventBus) *eventNotifier {
+	return &eventNotifier{
+		bus:       bus,
+		listeners: make(map[*eventListener]struct{}),
+	}
+}
+
+type eventListener struct {
+	bcname string
+	etypes map[events.EventType]bool
+	ch     chan *events.EventMessage
+	// done is closed when the listener is removed from notifier
+	done chan struct{}
+	err  error
+}
+
+// Listen registers a listener for events of bcname with given types
+func (n *eventNotifier) Listen(bcname string, etypes ...events.EventType) *eventListener {
+	n.once.Do(func() {
+		n.bus.SubscribeMulti(notifierEventTypes, n.handleEvent)
+	})
+
+	l := &eventListener{
+		bcname: bcname,
+		etypes: make(map[events.EventType]bool),
+		ch:     make(chan *events.EventMessage, listenerBufferSize),
+		done:   make(chan struct{}),
+	}
+	for _, etype := range etypes {
+		l.etypes[etype] = true
+	}
+
+	n.mutex.Lock()
+	n.listeners[l] = struct{}{}
+	n.mutex.Unlock()
+	return l
+}
+
+// Remove unregisters the listener, err is the reason of removing, nil if closed by subscriber
+func (n *eventNotifier) Remove(l *eventListener, err error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.remove(l, err)
+}
+
+func (n *eventNotifier) remove(l *eventListener, err error) {
+	if _, ok := n.listeners[l]; !ok {
+		return
+	}
+	delete(n.listeners, l)
+	l.err = err
+	close(l.done)
+}
+
+func (n *eventNotifier) handleEvent(em *events.EventMessage) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	for l := range n.listeners {
+		if l.bcname != em.BcName || !l.etypes[em.Type] {
+			continue
+		}
+		select {
+		case l.ch <- em:
+		default:
+			n.remove(l, ErrListenerOverflow)
+		}
+	}
+}
+
+// eventConvertFunc converts the event message to the event payload, returns false if the event is filtered
+type eventConvertFunc func(em *events.EventMessage) (interface{}, bool)
+
+var _ Iterator = (*notifierIterator)(nil)
+
+// notifierIterator iterates the live events from eventNotifier, it never ends until closed or overflow
+type notifierIterator struct {
+	notifier *eventNotifier
+	listener *eventListener
+	convert  eventConvertFunc
+	data     interface{}
+	err      error
+}
+
+func newNotifierIterator(notifier *eventNotifier, convert eventConvertFunc, bcname string,
+	etypes ...events.EventType) *notifierIterator {
+	return &notifierIterator{
+		notifier: notifier,
+		listener: notifier.Listen(bcname, etypes...),
+		convert:  convert,
+	}
+}
+
+func (n *notifierIterator) Next() bool {
+	for {
+		select {
+		case em := <-n.listener.ch:
+			data, ok := n.convert(em)
+			if !ok {
+				continue
+			}
+			n.data = data
+			return true
+		case <-n.listener.done:
+			n.err = n.listener.err
+			return false
+		}
+	}
+}
+
+func (n *notifierIterator) Data() interface{} {
+	return n.data
+}
+
+func (n *notifierIterator) Error() error {
+	return n.err
+}
+
+func (n *notifierIterator) Close() {
+	n.notifier.Remove(n.listener, nil)
+}
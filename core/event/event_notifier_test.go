@@ -0,0 +1,138 @@
+package event
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/xuperchain/xuperchain/core/common/events"
+	cons_base "github.com/xuperchain/xuperchain/core/consensus/base"
+	"github.com/xuperchain/xuperchain/core/pb"
+	"github.com/xuperchain/xuperchain/core/utxo"
+)
+
+func fireEvent(t *testing.T, bcname string, etype events.EventType, msg interface{}) {
+	em := &events.EventMessage{
+		BcName:  bcname,
+		Type:    etype,
+		Message: msg,
+	}
+	if err := events.GetEventBus().FireEvent(em); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUnconfirmedTxTopic(t *testing.T) {
+	topic := NewUnconfirmedTxTopic(newMockBlockStore())
+	iter, err := topic.NewIterator(&pb.UnconfirmedTxFilter{
+		Bcname:    "xuper",
+		Initiator: "alice",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer iter.Close()
+
+	event := &pb.ContractEvent{
+		Contract: "counter",
+		Name:     "increase",
+	}
+	// tx of other chain and tx not matched are filtered
+	fireEvent(t, "other", events.UnconfirmedTxAdded, newTxBuilder().Initiator("alice").Tx())
+	fireEvent(t, "xuper", events.UnconfirmedTxAdded, newTxBuilder().Initiator("bob").Tx())
+	tx := newTxBuilder().Initiator("alice").Invoke("counter", "increase", event).Tx()
+	fireEvent(t, "xuper", events.UnconfirmedTxAdded, tx)
+
+	if !iter.Next() {
+		t.Fatal(iter.Error())
+	}
+	utx := iter.Data().(*pb.UnconfirmedTx)
+	if utx.GetTx().GetTxid() != hex.EncodeToString(tx.GetTxid()) {
+		t.Fatalf("expect tx %x got %s", tx.GetTxid(), utx.GetTx().GetTxid())
+	}
+	if len(utx.GetTx().GetEvents()) != 1 || utx.GetTx().GetEvents()[0].GetName() != "increase" {
+		t.Fatalf("unexpected events %v", utx.GetTx().GetEvents())
+	}
+}
+
+func TestChainReorgTopic(t *testing.T) {
+	topic := NewChainReorgTopic(newMockBlockStore())
+	iter, err := topic.NewIterator(&pb.ChainReorgFilter{
+		Bcname: "xuper",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer iter.Close()
+
+	undo := newBlockBuilder().AddTx(newTxBuilder().Tx()).Block()
+	redo1 := newBlockBuilder().Block()
+	redo2 := newBlockBuilder().Block()
+	fireEvent(t, "xuper", events.ChainReorganized, &utxo.ChainReorgEvent{
+		BcName:     "xuper",
+		UndoBlocks: []*pb.InternalBlock{undo},
+		RedoBlocks: []*pb.InternalBlock{redo1, redo2},
+	})
+
+	if !iter.Next() {
+		t.Fatal(iter.Error())
+	}
+	reorg := iter.Data().(*pb.ChainReorg)
+	if len(reorg.GetUndoBlocks()) != 1 || len(reorg.GetRedoBlocks()) != 2 {
+		t.Fatalf("unexpected reorg %v", reorg)
+	}
+	if reorg.GetUndoBlocks()[0].GetBlockid() != hex.EncodeToString(undo.GetBlockid()) {
+		t.Fatalf("expect undo block %x got %s", undo.GetBlockid(), reorg.GetUndoBlocks()[0].GetBlockid())
+	}
+	if len(reorg.GetUndoBlocks()[0].GetTxs()) != 1 {
+		t.Fatalf("expect 1 tx in undo block, got %d", len(reorg.GetUndoBlocks()[0].GetTxs()))
+	}
+	if reorg.GetRedoBlocks()[1].GetBlockid() != hex.EncodeToString(redo2.GetBlockid()) {
+		t.Fatalf("expect redo block %x got %s", redo2.GetBlockid(), reorg.GetRedoBlocks()[1].GetBlockid())
+	}
+}
+
+func TestConsensusTopic(t *testing.T) {
+	topic := NewConsensusTopic(newMockBlockStore())
+	iter, err := topic.NewIterator(&pb.ConsensusFilter{
+		Bcname: "xuper",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer iter.Close()
+
+	for _, etype := range []events.EventType{events.ProposerChanged, events.ValidatorsChanged} {
+		fireEvent(t, "xuper", etype, &cons_base.MinersChangedEvent{
+			BcName:        "xuper",
+			CurrentMiners: []*cons_base.MinerInfo{{Address: "alice"}},
+			NextMiners:    []*cons_base.MinerInfo{{Address: "bob"}},
+		})
+		if !iter.Next() {
+			t.Fatal(iter.Error())
+		}
+		change := iter.Data().(*pb.ConsensusChange)
+		if change.GetNextMiners()[0].GetAddress() != "bob" {
+			t.Fatalf("unexpected consensus change %v", change)
+		}
+	}
+}
+
+func TestNotifierIteratorOverflow(t *testing.T) {
+	topic := NewConsensusTopic(newMockBlockStore())
+	iter, err := topic.NewIterator(&pb.ConsensusFilter{
+		Bcname: "overflow",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer iter.Close()
+
+	for i := 0; i <= listenerBufferSize; i++ {
+		fireEvent(t, "overflow", events.ProposerChanged, &cons_base.MinersChangedEvent{})
+	}
+	for iter.Next() {
+	}
+	if iter.Error() != ErrListenerOverflow {
+		t.Fatalf("expect overflow error, got %v", iter.Error())
+	}
+}
@@ -14,6 +14,8 @@ type filteredBlockIterator struct {
 	biter  *BlockIterator
 	filter *blockFilter
 	block  *pb.FilteredBlock
+	// cursor is the position where the subscription resumes from, nil if not resumed
+	cursor *pb.BlockCursor
 
 	endBlockNum int64
 
@@ -54,8 +56,12 @@ func (b *filteredBlockIterator) toFilteredBlock(block *pb.InternalBlock) *pb.Fil
 	}
 
 	hasEventFilter := hasEventFilter(b.filter)
+	skipIndex := b.skipTxIndex(fblock.Blockid)
 	var txs []*pb.FilteredTransaction
-	for _, tx := range block.GetTransactions() {
+	for idx, tx := range block.GetTransactions() {
+		if int64(idx) <= skipIndex {
+			continue
+		}
 		if !b.matchTx(tx) {
 			continue
 		}
@@ -68,6 +74,10 @@ func (b *filteredBlockIterator) toFilteredBlock(block *pb.InternalBlock) *pb.Fil
 		ftx := &pb.FilteredTransaction{
 			Txid:   hex.EncodeToString(tx.GetTxid()),
 			Events: events,
+			Index:  int64(idx),
+		}
+		if b.filter.GetDecodeEvent() {
+			ftx.DecodedEvents = decodeFilteredEvents(b.filter, events)
 		}
 
 		txs = append(txs, ftx)
@@ -76,8 +86,21 @@ func (b *filteredBlockIterator) toFilteredBlock(block *pb.InternalBlock) *pb.Fil
 	return fblock
 }
 
+// skipTxIndex returns the index of the last processed tx in the block of cursor, -1 for other blocks
+func (b *filteredBlockIterator) skipTxIndex(blockid string) int64 {
+	if b.cursor == nil || b.cursor.GetBlockid() != blockid {
+		return -1
+	}
+	return b.cursor.GetTxIndex()
+}
+
 func (b *filteredBlockIterator) parseFilteredEvents(tx *pb.Transaction) []*pb.ContractEvent {
-	if b.filter.GetExcludeTxEvent() {
+	return parseFilteredEvents(b.filter, tx)
+}
+
+// parseFilteredEvents returns the contract events of tx matching the filter
+func parseFilteredEvents(filter *blockFilter, tx *pb.Transaction) []*pb.ContractEvent {
+	if filter.GetExcludeTxEvent() {
 		return nil
 	}
 	events, err := xmodel.ParseContractEvents(tx)
@@ -88,7 +111,7 @@ func (b *filteredBlockIterator) parseFilteredEvents(tx *pb.Transaction) []*pb.Co
 
 	var ret []*pb.ContractEvent
 	for _, event := range events {
-		if !matchEvent(b.filter, event) {
+		if !matchEvent(filter, event) {
 			continue
 		}
 		ret = append(ret, event)
@@ -97,10 +120,34 @@ func (b *filteredBlockIterator) parseFilteredEvents(tx *pb.Transaction) []*pb.Co
 	return ret
 }
 
+// decodeFilteredEvents decodes events by filter.decoder, the result has the same order with events
+func decodeFilteredEvents(filter *blockFilter, events []*pb.ContractEvent) []*pb.DecodedContractEvent {
+	ret := make([]*pb.DecodedContractEvent, 0, len(events))
+	for _, event := range events {
+		devent := &pb.DecodedContractEvent{
+			Contract: event.GetContract(),
+			Name:     event.GetName(),
+		}
+		args, err := filter.decoder.Decode(event)
+		if err != nil {
+			log.Debug("decode contract event error", "contract", event.GetContract(), "event", event.GetName(), "error", err)
+		}
+		devent.Args = args
+		ret = append(ret, devent)
+	}
+	return ret
+}
+
 func (b *filteredBlockIterator) fetchBlock() (*pb.FilteredBlock, bool, error) {
 	for b.biter.Next() {
 		block := b.biter.Block()
 		filteredBlock := b.toFilteredBlock(block)
+		// the block of cursor has been processed if no tx left after the cursor
+		if b.skipTxIndex(filteredBlock.Blockid) >= 0 && len(filteredBlock.Txs) == 0 {
+			b.cursor = nil
+			continue
+		}
+		b.cursor = nil
 		return filteredBlock, true, nil
 	}
 	if b.biter.Error() != nil {
@@ -2,6 +2,7 @@ package event
 
 import (
 	"regexp"
+	"strings"
 
 	"github.com/xuperchain/xuperchain/core/pb"
 )
@@ -19,6 +20,7 @@ var txFilterFuncs = []txFilterFunc{
 
 var contractEventFilterFuncs = []contractEventFilterFunc{
 	matchEventName,
+	matchEventTopics,
 }
 
 type compiledBlockFilter struct {
@@ -33,6 +35,8 @@ type compiledBlockFilter struct {
 type blockFilter struct {
 	*pb.BlockFilter
 	compiled compiledBlockFilter
+	// decoder decodes contract events for topic filters and decode_event, nil if not needed
+	decoder *eventDecoder
 }
 
 func newBlockFilter(ori *pb.BlockFilter) (*blockFilter, error) {
@@ -145,7 +149,53 @@ func matchTx(filter *blockFilter, tx *pb.Transaction) bool {
 }
 
 func hasEventFilter(filter *blockFilter) bool {
-	return filter.EventName != ""
+	return filter.EventName != "" || len(filter.EventTopics) != 0
+}
+
+// needDecoder reports whether contract events should be decoded
+func needDecoder(filter *blockFilter) bool {
+	return len(filter.GetEventTopics()) != 0 || filter.GetDecodeEvent()
+}
+
+func matchEventTopics(filter *blockFilter, event *pb.ContractEvent) bool {
+	if len(filter.GetEventTopics()) == 0 {
+		return true
+	}
+	if filter.decoder == nil {
+		return false
+	}
+	args, err := filter.decoder.Decode(event)
+	if err != nil {
+		return false
+	}
+	for _, topic := range filter.GetEventTopics() {
+		if !matchEventTopic(topic, args) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchEventTopic(topic *pb.EventTopicFilter, args []*pb.EventArg) bool {
+	for _, arg := range args {
+		if !arg.GetIndexed() || arg.GetName() != topic.GetName() {
+			continue
+		}
+		for _, value := range topic.GetValues() {
+			if matchArgValue(arg, value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchArgValue compares hex encoded values of address and bytes case insensitively
+func matchArgValue(arg *pb.EventArg, value string) bool {
+	if arg.GetType() == "address" || strings.HasPrefix(arg.GetType(), "bytes") {
+		return strings.EqualFold(strings.TrimPrefix(arg.GetValue(), "0x"), strings.TrimPrefix(value, "0x"))
+	}
+	return arg.GetValue() == value
 }
 
 func matchEvent(filter *blockFilter, event *pb.ContractEvent) bool {
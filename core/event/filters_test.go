@@ -164,3 +164,160 @@ func TestFilterEvent(t *testing.T) {
 		})
 	})
 }
+
+const testTransferABI = `[{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},` +
+	`{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],` +
+	`"name":"Transfer","type":"event"}]`
+
+func expectTopicMatch(t *testing.T, store BlockStore, event *pb.ContractEvent, topics []*pb.EventTopicFilter, expect bool) {
+	filter, err := newBlockFilter(&pb.BlockFilter{
+		EventTopics: topics,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	filter.decoder = newEventDecoder(store)
+	if matchEvent(filter, event) != expect {
+		t.Fatalf("expect match %v, event:%s topics:%v", expect, event.GetBody(), topics)
+	}
+}
+
+func TestFilterEventTopics(t *testing.T) {
+	store := newMockBlockStore()
+	store.SetContractABI("erc20", []byte(testTransferABI))
+	store.SetContractEvents("counter", []byte(`{"increase":["key","value"]}`))
+
+	evmEvent := &pb.ContractEvent{
+		Contract: "erc20",
+		Name:     "Transfer",
+		Body:     []byte(`["B4E4AC2A2DF1FC1BD2D9D6B4AE44B0F5F6C6E6C0","93F86A462A3174C7AD1281BCF400A9F18D244E06",100]`),
+	}
+	jsonEvent := &pb.ContractEvent{
+		Contract: "counter",
+		Name:     "increase",
+		Body:     []byte(`{"key":"alice","value":1}`),
+	}
+
+	t.Run("evmIndexed", func(tt *testing.T) {
+		expectTopicMatch(tt, store, evmEvent, []*pb.EventTopicFilter{
+			{Name: "to", Values: []string{"0x93f86a462a3174c7ad1281bcf400a9f18d244e06"}},
+		}, true)
+	})
+	t.Run("evmAnyValue", func(tt *testing.T) {
+		expectTopicMatch(tt, store, evmEvent, []*pb.EventTopicFilter{
+			{Name: "from", Values: []string{"00", "B4E4AC2A2DF1FC1BD2D9D6B4AE44B0F5F6C6E6C0"}},
+		}, true)
+	})
+	t.Run("evmNotIndexed", func(tt *testing.T) {
+		expectTopicMatch(tt, store, evmEvent, []*pb.EventTopicFilter{
+			{Name: "value", Values: []string{"100"}},
+		}, false)
+	})
+	t.Run("evmAllTopics", func(tt *testing.T) {
+		expectTopicMatch(tt, store, evmEvent, []*pb.EventTopicFilter{
+			{Name: "from", Values: []string{"B4E4AC2A2DF1FC1BD2D9D6B4AE44B0F5F6C6E6C0"}},
+			{Name: "to", Values: []string{"B4E4AC2A2DF1FC1BD2D9D6B4AE44B0F5F6C6E6C0"}},
+		}, false)
+	})
+	t.Run("jsonMatch", func(tt *testing.T) {
+		expectTopicMatch(tt, store, jsonEvent, []*pb.EventTopicFilter{
+			{Name: "key", Values: []string{"alice"}},
+			{Name: "value", Values: []string{"1"}},
+		}, true)
+	})
+	t.Run("jsonNotMatch", func(tt *testing.T) {
+		expectTopicMatch(tt, store, jsonEvent, []*pb.EventTopicFilter{
+			{Name: "key", Values: []string{"bob"}},
+		}, false)
+	})
+	t.Run("jsonNotIndexed", func(tt *testing.T) {
+		event := &pb.ContractEvent{
+			Contract: "counter",
+			Name:     "decrease",
+			Body:     []byte(`{"key":"alice"}`),
+		}
+		expectTopicMatch(tt, store, event, []*pb.EventTopicFilter{
+			{Name: "key", Values: []string{"alice"}},
+		}, false)
+	})
+	t.Run("notJSON", func(tt *testing.T) {
+		event := &pb.ContractEvent{
+			Contract: "counter",
+			Name:     "increase",
+			Body:     []byte("raw"),
+		}
+		expectTopicMatch(tt, store, event, []*pb.EventTopicFilter{
+			{Name: "key", Values: []string{"alice"}},
+		}, false)
+	})
+}
+
+func TestEventDecoderLateDeploy(t *testing.T) {
+	store := newMockBlockStore()
+	decoder := newEventDecoder(store)
+	event := &pb.ContractEvent{
+		Contract: "counter",
+		Name:     "increase",
+		Body:     []byte(`{"key":"alice"}`),
+	}
+	args, err := decoder.Decode(event)
+	if err != nil || len(args) != 1 || args[0].GetIndexed() {
+		t.Fatalf("undeclared key should not be indexed, args:%v err:%v", args, err)
+	}
+
+	// 合约在之后的区块中部署, 新的区块高度下重新查询合约的事件声明
+	store.SetContractEvents("counter", []byte(`{"increase":["key"]}`))
+	args, _ = decoder.Decode(event)
+	if args[0].GetIndexed() {
+		t.Fatal("metadata should be reused at the same height")
+	}
+	store.AppendBlock(newBlockBuilder().Block())
+	args, err = decoder.Decode(event)
+	if err != nil || len(args) != 1 || !args[0].GetIndexed() {
+		t.Fatalf("declared key should be indexed, args:%v err:%v", args, err)
+	}
+
+	// 合约升级时更新了事件声明
+	store.SetContractEvents("counter", []byte(`{"increase":[]}`))
+	store.AppendBlock(newBlockBuilder().Block())
+	args, err = decoder.Decode(event)
+	if err != nil || len(args) != 1 || args[0].GetIndexed() {
+		t.Fatalf("key removed from declaration should not be indexed, args:%v err:%v", args, err)
+	}
+}
+
+func TestDecodeFilteredEvents(t *testing.T) {
+	store := newMockBlockStore()
+	store.SetContractABI("erc20", []byte(testTransferABI))
+	filter, err := newBlockFilter(&pb.BlockFilter{
+		DecodeEvent: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	filter.decoder = newEventDecoder(store)
+
+	events := decodeFilteredEvents(filter, []*pb.ContractEvent{
+		{
+			Contract: "erc20",
+			Name:     "Transfer",
+			Body:     []byte(`["B4E4AC2A2DF1FC1BD2D9D6B4AE44B0F5F6C6E6C0","93F86A462A3174C7AD1281BCF400A9F18D244E06",100]`),
+		},
+		{
+			Contract: "erc20",
+			Name:     "Approval",
+			Body:     []byte(`[]`),
+		},
+	})
+	if len(events) != 2 {
+		t.Fatalf("expect 2 events, got %d", len(events))
+	}
+	args := events[0].GetArgs()
+	if len(args) != 3 || args[2].GetName() != "value" || args[2].GetValue() != "100" ||
+		args[2].GetType() != "uint256" || args[2].GetIndexed() {
+		t.Fatalf("unexpected args %v", args)
+	}
+	if len(events[1].GetArgs()) != 0 {
+		t.Fatalf("expect no args for unknown event, got %v", events[1].GetArgs())
+	}
+}
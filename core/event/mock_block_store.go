@@ -12,12 +12,21 @@ import (
 type mockBlockStore struct {
 	mutex  sync.Mutex
 	blocks []*pb.InternalBlock
+	// all blocks including blocks on branch
+	blockMap map[string]*pb.InternalBlock
+	// abis of evm contracts
+	abis map[string][]byte
+	// declared indexed keys of events of non evm contracts
+	events map[string][]byte
 
 	heightNotifier *utxo.BlockHeightNotifier
 }
 
 func newMockBlockStore() *mockBlockStore {
 	return &mockBlockStore{
+		blockMap:       make(map[string]*pb.InternalBlock),
+		abis:           make(map[string][]byte),
+		events:         make(map[string][]byte),
 		heightNotifier: utxo.NewBlockHeightNotifier(),
 	}
 }
@@ -47,13 +56,73 @@ func (m *mockBlockStore) QueryBlockByHeight(height int64) (*pb.InternalBlock, er
 	return m.blocks[int(height)], nil
 }
 
+// QueryBlockHeader returns block of given blockid
+func (m *mockBlockStore) QueryBlockHeader(blockid []byte) (*pb.InternalBlock, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	block, ok := m.blockMap[string(blockid)]
+	if !ok {
+		return nil, ledger.ErrBlockNotExist
+	}
+	return block, nil
+}
+
+// QueryContractABI returns the ABI of evm contract
+func (m *mockBlockStore) QueryContractABI(contractName string) ([]byte, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.abis[contractName], nil
+}
+
+func (m *mockBlockStore) SetContractABI(contractName string, abi []byte) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.abis[contractName] = abi
+}
+
+// QueryContractEvents returns the declared indexed keys of events
+func (m *mockBlockStore) QueryContractEvents(contractName string) ([]byte, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.events[contractName], nil
+}
+
+func (m *mockBlockStore) SetContractEvents(contractName string, events []byte) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.events[contractName] = events
+}
+
 func (m *mockBlockStore) AppendBlock(block *pb.InternalBlock) {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
+	m.appendBlock(block)
+	m.heightNotifier.UpdateHeight(int64(len(m.blocks) - 1))
+}
+
+// Fork replaces the blocks from the given height with new blocks
+func (m *mockBlockStore) Fork(height int64, blocks ...*pb.InternalBlock) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	for _, block := range m.blocks[height:] {
+		block.InTrunk = false
+	}
+	m.blocks = m.blocks[:height]
+	for _, block := range blocks {
+		m.appendBlock(block)
+	}
+	m.heightNotifier.UpdateHeight(int64(len(m.blocks) - 1))
+}
+
+func (m *mockBlockStore) appendBlock(block *pb.InternalBlock) {
 	nblock := *block
 	nblock.Height = int64(len(m.blocks))
+	nblock.InTrunk = true
+	if len(m.blocks) > 0 {
+		nblock.PreHash = m.blocks[len(m.blocks)-1].GetBlockid()
+	}
 	m.blocks = append(m.blocks, &nblock)
-	m.heightNotifier.UpdateHeight(nblock.Height)
+	m.blockMap[string(nblock.Blockid)] = &nblock
 }
 
 // GetBlockStore get BlockStore base bcname(the name of block chain)
@@ -19,6 +19,9 @@ func NewRounterFromChainMG(chainmg ChainManager) *Router {
 		topics: make(map[pb.SubscribeType]Topic),
 	}
 	r.topics[pb.SubscribeType_BLOCK] = blockTopic
+	r.topics[pb.SubscribeType_UNCONFIRMED_TX] = NewUnconfirmedTxTopic(chainmg)
+	r.topics[pb.SubscribeType_CHAIN_REORG] = NewChainReorgTopic(chainmg)
+	r.topics[pb.SubscribeType_CONSENSUS] = NewConsensusTopic(chainmg)
 
 	return r
 }
@@ -0,0 +1,97 @@
+package event
+
+import (
+	"encoding/hex"
+	"errors"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/xuperchain/xuperchain/core/common/events"
+	"github.com/xuperchain/xuperchain/core/pb"
+)
+
+var _ Topic = (*UnconfirmedTxTopic)(nil)
+
+// UnconfirmedTxTopic handles events of transactions entering the unconfirmed pool
+type UnconfirmedTxTopic struct {
+	chainmg  ChainManager
+	notifier *eventNotifier
+}
+
+// NewUnconfirmedTxTopic instances UnconfirmedTxTopic from ChainManager
+func NewUnconfirmedTxTopic(chainmg ChainManager) *UnconfirmedTxTopic {
+	return &UnconfirmedTxTopic{
+		chainmg:  chainmg,
+		notifier: defaultNotifier,
+	}
+}
+
+// ParseFilter 从指定的bytes buffer反序列化topic过滤器
+// 返回的参数会作为入参传递给NewIterator的filter参数
+func (u *UnconfirmedTxTopic) ParseFilter(buf []byte) (interface{}, error) {
+	pbfilter := new(pb.UnconfirmedTxFilter)
+	err := proto.Unmarshal(buf, pbfilter)
+	if err != nil {
+		return nil, err
+	}
+	return pbfilter, nil
+}
+
+// MarshalEvent encode event payload returns from Iterator.Data()
+func (u *UnconfirmedTxTopic) MarshalEvent(x interface{}) ([]byte, error) {
+	msg := x.(proto.Message)
+	return proto.Marshal(msg)
+}
+
+// NewIterator make a new Iterator base on filter
+func (u *UnconfirmedTxTopic) NewIterator(ifilter interface{}) (Iterator, error) {
+	pbfilter, ok := ifilter.(*pb.UnconfirmedTxFilter)
+	if !ok {
+		return nil, errors.New("bad filter type for unconfirmed tx event")
+	}
+	blockStore, err := u.chainmg.GetBlockStore(pbfilter.GetBcname())
+	if err != nil {
+		return nil, err
+	}
+	// unconfirmed txs share the same tx and contract event filters with block
+	filter, err := newBlockFilter(&pb.BlockFilter{
+		Bcname:         pbfilter.GetBcname(),
+		ExcludeTxEvent: pbfilter.GetExcludeTxEvent(),
+		Contract:       pbfilter.GetContract(),
+		EventName:      pbfilter.GetEventName(),
+		Initiator:      pbfilter.GetInitiator(),
+		AuthRequire:    pbfilter.GetAuthRequire(),
+		FromAddr:       pbfilter.GetFromAddr(),
+		ToAddr:         pbfilter.GetToAddr(),
+		EventTopics:    pbfilter.GetEventTopics(),
+		DecodeEvent:    pbfilter.GetDecodeEvent(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if needDecoder(filter) {
+		filter.decoder = newEventDecoder(blockStore)
+	}
+
+	convert := func(em *events.EventMessage) (interface{}, bool) {
+		tx, ok := em.Message.(*pb.Transaction)
+		if !ok || !matchTx(filter, tx) {
+			return nil, false
+		}
+		txEvents := parseFilteredEvents(filter, tx)
+		if len(txEvents) == 0 && hasEventFilter(filter) {
+			return nil, false
+		}
+		ftx := &pb.FilteredTransaction{
+			Txid:   hex.EncodeToString(tx.GetTxid()),
+			Events: txEvents,
+		}
+		if filter.GetDecodeEvent() {
+			ftx.DecodedEvents = decodeFilteredEvents(filter, txEvents)
+		}
+		return &pb.UnconfirmedTx{
+			Bcname: filter.GetBcname(),
+			Tx:     ftx,
+		}, true
+	}
+	return newNotifierIterator(u.notifier, convert, filter.GetBcname(), events.UnconfirmedTxAdded), nil
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// accountConfig binds an ethereum address to the xuper keys in the accounts file, such as
+// [{"ethAddress": "0x...", "keys": "./data/keys", "contractAccount": "XC1111111111111111@xuper", "bindSignature": "0x..."}]
+type accountConfig struct {
+	EthAddress ethAddress `json:"ethAddress"`
+	// Keys is the directory of address, public.key and private.key
+	Keys string `json:"keys"`
+	// ContractAccount is used to deploy contracts, it's optional if the sender never deploys contracts
+	ContractAccount string `json:"contractAccount,omitempty"`
+	// BindSignature is the personal_sign of the xuper address by the ethereum address,
+	// it binds the ethereum address to the xuper address on chain, optional if bound before
+	BindSignature hexBytes `json:"bindSignature,omitempty"`
+}
+
+// account is the xuper identity of an ethereum sender, the transactions signed by the sender
+// are mapped onto xuper transactions initiated and signed by the xuper keys
+type account struct {
+	ethAddress      ethAddress
+	address         string
+	publicKey       string
+	privateKey      string
+	contractAccount string
+	bindSignature   []byte
+}
+
+type accountManager struct {
+	byEthAddress map[ethAddress]*account
+	// byAddress is used to show the ethereum sender of xuper transactions
+	byAddress map[string]*account
+}
+
+// loadAccounts load the accounts file, no account is bound if the file does not exist
+func loadAccounts(path string) (*accountManager, error) {
+	am := &accountManager{
+		byEthAddress: make(map[ethAddress]*account),
+		byAddress:    make(map[string]*account),
+	}
+	buf, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return am, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var configs []accountConfig
+	if err := json.Unmarshal(buf, &configs); err != nil {
+		return nil, fmt.Errorf("parse accounts file error: %v", err)
+	}
+	for _, cfg := range configs {
+		acc := &account{
+			ethAddress:      cfg.EthAddress,
+			contractAccount: cfg.ContractAccount,
+			bindSignature:   cfg.BindSignature,
+		}
+		if acc.address, err = readKeyFile(cfg.Keys, "address"); err != nil {
+			return nil, err
+		}
+		if acc.publicKey, err = readKeyFile(cfg.Keys, "public.key"); err != nil {
+			return nil, err
+		}
+		if acc.privateKey, err = readKeyFile(cfg.Keys, "private.key"); err != nil {
+			return nil, err
+		}
+		if _, ok := am.byEthAddress[acc.ethAddress]; ok {
+			return nil, fmt.Errorf("ethereum address %s is bound more than once", acc.ethAddress)
+		}
+		am.byEthAddress[acc.ethAddress] = acc
+		am.byAddress[acc.address] = acc
+	}
+	return am, nil
+}
+
+func readKeyFile(dir, name string) (string, error) {
+	buf, err := ioutil.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(buf)), nil
+}
+
+func (am *accountManager) get(addr ethAddress) (*account, bool) {
+	acc, ok := am.byEthAddress[addr]
+	return acc, ok
+}
+
+// ethAddressOf return the ethereum address of xuper address, the bound ethereum address is used if exists
+func (am *accountManager) ethAddressOf(address string) ethAddress {
+	if acc, ok := am.byAddress[address]; ok {
+		return acc.ethAddress
+	}
+	addr, err := xchainToEthAddress(address)
+	if err != nil {
+		return ethAddress(crypto.ZeroAddress)
+	}
+	return addr
+}
@@ -0,0 +1,170 @@
+package ethtx
+
+import (
+	"errors"
+	"math/big"
+)
+
+var (
+	// ErrInvalidRLP is returned while the input is not canonical rlp encoding
+	ErrInvalidRLP = errors.New("invalid rlp encoding")
+)
+
+// Item is a decoded rlp item, which is either a byte string or a list of items
+type Item struct {
+	IsList bool
+	Data   []byte
+	List   []Item
+	// Raw is the whole encoding of the item
+	Raw []byte
+}
+
+// DecodeRLP decode one rlp item which must consume the whole input
+func DecodeRLP(input []byte) (Item, error) {
+	item, rest, err := decodeItem(input)
+	if err != nil {
+		return Item{}, err
+	}
+	if len(rest) != 0 {
+		return Item{}, ErrInvalidRLP
+	}
+	return item, nil
+}
+
+func decodeItem(input []byte) (Item, []byte, error) {
+	if len(input) == 0 {
+		return Item{}, nil, ErrInvalidRLP
+	}
+	prefix := input[0]
+	var isList bool
+	var offset, size uint64
+	switch {
+	case prefix < 0x80:
+		return Item{Data: input[:1], Raw: input[:1]}, input[1:], nil
+	case prefix <= 0xb7:
+		offset, size = 1, uint64(prefix-0x80)
+		// 单字节小于0x80时必须编码为自身
+		if size == 1 && len(input) > 1 && input[1] < 0x80 {
+			return Item{}, nil, ErrInvalidRLP
+		}
+	case prefix < 0xc0:
+		lenOfSize := uint64(prefix - 0xb7)
+		s, err := decodeSize(input[1:], lenOfSize)
+		if err != nil {
+			return Item{}, nil, err
+		}
+		offset, size = 1+lenOfSize, s
+	case prefix <= 0xf7:
+		isList = true
+		offset, size = 1, uint64(prefix-0xc0)
+	default:
+		isList = true
+		lenOfSize := uint64(prefix - 0xf7)
+		s, err := decodeSize(input[1:], lenOfSize)
+		if err != nil {
+			return Item{}, nil, err
+		}
+		offset, size = 1+lenOfSize, s
+	}
+	if size > uint64(len(input))-offset {
+		return Item{}, nil, ErrInvalidRLP
+	}
+	end := offset + size
+	item := Item{
+		IsList: isList,
+		Raw:    input[:end],
+	}
+	if !isList {
+		item.Data = input[offset:end]
+		return item, input[end:], nil
+	}
+	payload := input[offset:end]
+	item.List = []Item{}
+	for len(payload) > 0 {
+		child, rest, err := decodeItem(payload)
+		if err != nil {
+			return Item{}, nil, err
+		}
+		item.List = append(item.List, child)
+		payload = rest
+	}
+	return item, input[end:], nil
+}
+
+// decodeSize decode the big endian length of long string or list,
+// which must have no leading zero and be larger than 55
+func decodeSize(input []byte, lenOfSize uint64) (uint64, error) {
+	if lenOfSize > 8 || uint64(len(input)) < lenOfSize || input[0] == 0 {
+		return 0, ErrInvalidRLP
+	}
+	size := uint64(0)
+	for _, b := range input[:lenOfSize] {
+		size = size<<8 | uint64(b)
+	}
+	if size < 56 {
+		return 0, ErrInvalidRLP
+	}
+	return size, nil
+}
+
+// Uint64 return the item as an unsigned integer
+func (it Item) Uint64() (uint64, error) {
+	if it.IsList || len(it.Data) > 8 || (len(it.Data) > 0 && it.Data[0] == 0) {
+		return 0, ErrInvalidRLP
+	}
+	v := uint64(0)
+	for _, b := range it.Data {
+		v = v<<8 | uint64(b)
+	}
+	return v, nil
+}
+
+// BigInt return the item as an unsigned big integer
+func (it Item) BigInt() (*big.Int, error) {
+	if it.IsList || len(it.Data) > 32 || (len(it.Data) > 0 && it.Data[0] == 0) {
+		return nil, ErrInvalidRLP
+	}
+	return new(big.Int).SetBytes(it.Data), nil
+}
+
+// EncodeBytes encode a byte string
+func EncodeBytes(b []byte) []byte {
+	if len(b) == 1 && b[0] < 0x80 {
+		return []byte{b[0]}
+	}
+	return append(encodeHeader(0x80, uint64(len(b))), b...)
+}
+
+// EncodeUint64 encode an unsigned integer
+func EncodeUint64(v uint64) []byte {
+	return EncodeBigInt(new(big.Int).SetUint64(v))
+}
+
+// EncodeBigInt encode an unsigned big integer, nil is encoded as zero
+func EncodeBigInt(v *big.Int) []byte {
+	if v == nil {
+		return EncodeBytes(nil)
+	}
+	return EncodeBytes(v.Bytes())
+}
+
+// EncodeList encode a list of encoded items
+func EncodeList(items ...[]byte) []byte {
+	size := 0
+	for _, item := range items {
+		size += len(item)
+	}
+	out := encodeHeader(0xc0, uint64(size))
+	for _, item := range items {
+		out = append(out, item...)
+	}
+	return out
+}
+
+func encodeHeader(base byte, size uint64) []byte {
+	if size < 56 {
+		return []byte{base + byte(size)}
+	}
+	sizeBytes := new(big.Int).SetUint64(size).Bytes()
+	return append([]byte{base + 55 + byte(len(sizeBytes))}, sizeBytes...)
+}
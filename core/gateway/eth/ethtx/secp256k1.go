@@ -0,0 +1,39 @@
+package ethtx
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+)
+
+var (
+	secpN     = btcec.S256().N
+	secpHalfN = new(big.Int).Rsh(secpN, 1)
+
+	// ErrInvalidSignature is returned while the public key can not be recovered from signature
+	ErrInvalidSignature = errors.New("invalid secp256k1 signature")
+)
+
+// recoverPublicKey recover the public key from signature (r, s, recid) of hash,
+// the public key is returned as the 64 bytes X || Y
+func recoverPublicKey(hash []byte, r, s *big.Int, recid byte) ([]byte, error) {
+	if r.Sign() <= 0 || r.Cmp(secpN) >= 0 || s.Sign() <= 0 || s.Cmp(secpN) >= 0 || recid > 3 {
+		return nil, ErrInvalidSignature
+	}
+	// 与以太坊一致, 拒绝高位的s以防止签名延展
+	if s.Cmp(secpHalfN) > 0 {
+		return nil, ErrInvalidSignature
+	}
+	// btcec的紧凑签名格式为 27+recid || R || S
+	sig := make([]byte, 65)
+	sig[0] = 27 + recid
+	rb, sb := r.Bytes(), s.Bytes()
+	copy(sig[33-len(rb):33], rb)
+	copy(sig[65-len(sb):], sb)
+	pub, _, err := btcec.RecoverCompact(btcec.S256(), sig, hash)
+	if err != nil {
+		return nil, ErrInvalidSignature
+	}
+	return pub.SerializeUncompressed()[1:], nil
+}
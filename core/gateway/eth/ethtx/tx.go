@@ -0,0 +1,177 @@
+// Package ethtx decodes the RLP encoded signed ethereum transactions and recovers their senders.
+// Only legacy transactions with EIP-155 replay protection are supported.
+package ethtx
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// AddressLength is the byte length of ethereum address
+const AddressLength = 20
+
+var (
+	// ErrUnsupportedTxType is returned for typed transactions of EIP-2718
+	ErrUnsupportedTxType = errors.New("unsupported transaction type, only legacy transaction is supported")
+	// ErrInvalidChainID is returned while the transaction is not signed for the chain with EIP-155
+	ErrInvalidChainID = errors.New("invalid chain id, transaction must be signed with EIP-155")
+)
+
+// Transaction is the signed legacy ethereum transaction
+type Transaction struct {
+	Nonce    uint64
+	GasPrice *big.Int
+	Gas      uint64
+	// To is nil for contract creation
+	To    []byte
+	Value *big.Int
+	Data  []byte
+	V     *big.Int
+	R     *big.Int
+	S     *big.Int
+
+	raw []byte
+}
+
+// Decode decode the rlp encoded signed transaction
+func Decode(raw []byte) (*Transaction, error) {
+	if len(raw) > 0 && raw[0] < 0xc0 {
+		return nil, ErrUnsupportedTxType
+	}
+	item, err := DecodeRLP(raw)
+	if err != nil {
+		return nil, err
+	}
+	if !item.IsList || len(item.List) != 9 {
+		return nil, ErrInvalidRLP
+	}
+	for _, field := range item.List {
+		if field.IsList {
+			return nil, ErrInvalidRLP
+		}
+	}
+	fields := item.List
+	tx := &Transaction{
+		Data: fields[5].Data,
+		raw:  raw,
+	}
+	if tx.Nonce, err = fields[0].Uint64(); err != nil {
+		return nil, err
+	}
+	if tx.GasPrice, err = fields[1].BigInt(); err != nil {
+		return nil, err
+	}
+	if tx.Gas, err = fields[2].Uint64(); err != nil {
+		return nil, err
+	}
+	switch len(fields[3].Data) {
+	case 0:
+	case AddressLength:
+		tx.To = fields[3].Data
+	default:
+		return nil, ErrInvalidRLP
+	}
+	if tx.Value, err = fields[4].BigInt(); err != nil {
+		return nil, err
+	}
+	if tx.V, err = fields[6].BigInt(); err != nil {
+		return nil, err
+	}
+	if tx.R, err = fields[7].BigInt(); err != nil {
+		return nil, err
+	}
+	if tx.S, err = fields[8].BigInt(); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// Hash return the transaction hash, which is keccak256 of the raw transaction
+func (tx *Transaction) Hash() []byte {
+	return Keccak256(tx.raw)
+}
+
+// ChainID return the chain id of EIP-155 signature, nil is returned for the signatures before EIP-155
+func (tx *Transaction) ChainID() *big.Int {
+	if tx.V.Cmp(big.NewInt(35)) < 0 {
+		return nil
+	}
+	// v = chainId * 2 + 35 + recid
+	chainID := new(big.Int).Sub(tx.V, big.NewInt(35))
+	return chainID.Rsh(chainID, 1)
+}
+
+// SigningHash return the EIP-155 hash signed by sender
+func (tx *Transaction) SigningHash(chainID *big.Int) []byte {
+	return Keccak256(EncodeList(
+		EncodeUint64(tx.Nonce),
+		EncodeBigInt(tx.GasPrice),
+		EncodeUint64(tx.Gas),
+		EncodeBytes(tx.To),
+		EncodeBigInt(tx.Value),
+		EncodeBytes(tx.Data),
+		EncodeBigInt(chainID),
+		EncodeUint64(0),
+		EncodeUint64(0),
+	))
+}
+
+// Sender verify the transaction is signed for chainID and recover the address of sender
+func (tx *Transaction) Sender(chainID *big.Int) ([]byte, error) {
+	txChainID := tx.ChainID()
+	if txChainID == nil || txChainID.Cmp(chainID) != 0 {
+		return nil, ErrInvalidChainID
+	}
+	recid := new(big.Int).Sub(tx.V, big.NewInt(35))
+	recid.Sub(recid, new(big.Int).Lsh(chainID, 1))
+	pub, err := recoverPublicKey(tx.SigningHash(chainID), tx.R, tx.S, byte(recid.Uint64()))
+	if err != nil {
+		return nil, err
+	}
+	return PublicKeyToAddress(pub), nil
+}
+
+// MessageHash return the hash of message signed by personal_sign,
+// which is keccak256("\x19Ethereum Signed Message:\n" + len(message) + message)
+func MessageHash(message []byte) []byte {
+	prefix := "\x19Ethereum Signed Message:\n" + strconv.Itoa(len(message))
+	return Keccak256([]byte(prefix), message)
+}
+
+// MessageSigner recover the address signing message by personal_sign,
+// sig is the 65 bytes R || S || V where V is 27 or 28
+func MessageSigner(message, sig []byte) ([]byte, error) {
+	if len(sig) != 65 || (sig[64] != 27 && sig[64] != 28) {
+		return nil, ErrInvalidSignature
+	}
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:64])
+	pub, err := recoverPublicKey(MessageHash(message), r, s, sig[64]-27)
+	if err != nil {
+		return nil, err
+	}
+	return PublicKeyToAddress(pub), nil
+}
+
+// CreateAddress return the address of contract created by sender with nonce,
+// which is keccak256(rlp([sender, nonce]))[12:]
+func CreateAddress(sender []byte, nonce uint64) []byte {
+	return Keccak256(EncodeList(EncodeBytes(sender), EncodeUint64(nonce)))[12:]
+}
+
+// PublicKeyToAddress return the address of the 64 bytes uncompressed public key X || Y
+func PublicKeyToAddress(pub []byte) []byte {
+	return Keccak256(pub)[12:]
+}
+
+// Keccak256 return the legacy keccak256 hash used by ethereum
+func Keccak256(data ...[]byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	for _, d := range data {
+		h.Write(d)
+	}
+	return h.Sum(nil)
+}
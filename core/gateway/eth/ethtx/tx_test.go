@@ -0,0 +1,152 @@
+package ethtx
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+)
+
+// 来自 EIP-155 的示例交易, 私钥为 0x4646...46
+const (
+	eip155RawTx       = "f86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83"
+	eip155SigningHash = "daf5a779ae972f972197303d7b574746c7ef83eadac0f2791ad23db92e4c8e53"
+	eip155Sender      = "9d8a62f656a8d1615c1294fd71e9cfb3e4855a4f"
+)
+
+func TestDecodeEIP155Tx(t *testing.T) {
+	raw, _ := hex.DecodeString(eip155RawTx)
+	tx, err := Decode(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.Nonce != 9 || tx.Gas != 21000 || tx.GasPrice.Cmp(big.NewInt(20000000000)) != 0 {
+		t.Errorf("unexpected tx fields %+v", tx)
+	}
+	value, _ := new(big.Int).SetString("1000000000000000000", 10)
+	if tx.Value.Cmp(value) != 0 || len(tx.Data) != 0 || len(tx.To) != AddressLength {
+		t.Errorf("unexpected tx fields %+v", tx)
+	}
+	chainID := big.NewInt(1)
+	if tx.ChainID().Cmp(chainID) != 0 {
+		t.Errorf("expect chain id 1 got %v", tx.ChainID())
+	}
+	if hex.EncodeToString(tx.SigningHash(chainID)) != eip155SigningHash {
+		t.Errorf("unexpected signing hash %x", tx.SigningHash(chainID))
+	}
+	sender, err := tx.Sender(chainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(sender) != eip155Sender {
+		t.Errorf("expect sender %s got %x", eip155Sender, sender)
+	}
+	if _, err := tx.Sender(big.NewInt(2)); err != ErrInvalidChainID {
+		t.Error("tx signed for another chain should be rejected", err)
+	}
+}
+
+func TestDecodeInvalidTx(t *testing.T) {
+	raw, _ := hex.DecodeString(eip155RawTx)
+	if _, err := Decode(append([]byte{0x02}, raw...)); err != ErrUnsupportedTxType {
+		t.Error("typed tx should be rejected", err)
+	}
+	if _, err := Decode(raw[:len(raw)-1]); err == nil {
+		t.Error("truncated tx should be rejected")
+	}
+	if _, err := Decode(append(append([]byte{}, raw...), 0x01)); err == nil {
+		t.Error("tx with trailing bytes should be rejected")
+	}
+}
+
+func TestRecoverPublicKey(t *testing.T) {
+	d, _ := hex.DecodeString("4646464646464646464646464646464646464646464646464646464646464646")
+	priv, pub := btcec.PrivKeyFromBytes(btcec.S256(), d)
+	expect := pub.SerializeUncompressed()[1:]
+	if hex.EncodeToString(PublicKeyToAddress(expect)) != eip155Sender {
+		t.Fatalf("unexpected address of private key %x", PublicKeyToAddress(expect))
+	}
+	for i := 0; i < 4; i++ {
+		hash := Keccak256([]byte("xuperchain"), []byte{byte(i)})
+		sig, err := btcec.SignCompact(btcec.S256(), priv, hash, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r, s, recid := new(big.Int).SetBytes(sig[1:33]), new(big.Int).SetBytes(sig[33:]), sig[0]-27
+		got, err := recoverPublicKey(hash, r, s, recid)
+		if err != nil || !bytes.Equal(got, expect) {
+			t.Fatalf("recover public key failed, err %v", err)
+		}
+		// 高位的s不被接受
+		highS := new(big.Int).Sub(secpN, s)
+		if _, err := recoverPublicKey(hash, r, highS, recid^1); err != ErrInvalidSignature {
+			t.Error("high s should be rejected", err)
+		}
+	}
+}
+
+func TestMessageSigner(t *testing.T) {
+	d, _ := hex.DecodeString("4646464646464646464646464646464646464646464646464646464646464646")
+	priv, _ := btcec.PrivKeyFromBytes(btcec.S256(), d)
+	message := []byte("dpzuVdosQrF2kmzumhVeFQZa1aYcdgFpN")
+	compact, err := btcec.SignCompact(btcec.S256(), priv, MessageHash(message), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 钱包签名的格式为 R || S || V
+	sig := append(append([]byte{}, compact[1:]...), compact[0])
+	signer, err := MessageSigner(message, sig)
+	if err != nil || hex.EncodeToString(signer) != eip155Sender {
+		t.Fatalf("unexpected signer %x, err %v", signer, err)
+	}
+	if signer, _ := MessageSigner([]byte("other"), sig); hex.EncodeToString(signer) == eip155Sender {
+		t.Error("signer of other message should not match")
+	}
+	sig[64] = 1
+	if _, err := MessageSigner(message, sig); err != ErrInvalidSignature {
+		t.Error("expect ErrInvalidSignature with bad v", err)
+	}
+}
+
+func TestRLP(t *testing.T) {
+	long := bytes.Repeat([]byte{0xaa}, 300)
+	enc := EncodeList(EncodeUint64(0), EncodeUint64(1024), EncodeBytes(long), EncodeList())
+	item, err := DecodeRLP(enc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !item.IsList || len(item.List) != 4 {
+		t.Fatalf("unexpected item %+v", item)
+	}
+	if v, err := item.List[1].Uint64(); err != nil || v != 1024 {
+		t.Errorf("expect 1024 got %d, err %v", v, err)
+	}
+	if !bytes.Equal(item.List[2].Data, long) || !item.List[3].IsList {
+		t.Error("unexpected decoded items")
+	}
+	// 非规范编码
+	for _, bad := range []string{"8100", "b800", "c3010203ff", "8201"} {
+		b, _ := hex.DecodeString(bad)
+		if _, err := DecodeRLP(b); err == nil {
+			t.Errorf("%s should be rejected", bad)
+		}
+	}
+	if _, err := (Item{Data: []byte{0, 1}}).Uint64(); err == nil {
+		t.Error("integer with leading zero should be rejected")
+	}
+}
+
+func TestCreateAddress(t *testing.T) {
+	sender, _ := hex.DecodeString("6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0")
+	expect := []string{
+		"cd234a471b72ba2f1ccf0a70fcaba648a5eecd8d",
+		"343c43a37d37dff08ae8c4a11544c718abb4fcf8",
+	}
+	for nonce, addr := range expect {
+		if got := hex.EncodeToString(CreateAddress(sender, uint64(nonce))); got != addr {
+			t.Errorf("expect %s got %s", addr, got)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+const (
+	jsonrpcVersion = "2.0"
+	// maxRequestSize limit the size of http body, which is enough for deploying large contracts
+	maxRequestSize = 5 << 20
+
+	errCodeParse          = -32700
+	errCodeInvalidRequest = -32600
+	errCodeMethodNotFound = -32601
+	errCodeInvalidParams  = -32602
+	errCodeServer         = -32000
+)
+
+// rpcMethod handle the positional params of a json-rpc call
+type rpcMethod func(params []json.RawMessage) (interface{}, error)
+
+type rpcRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+}
+
+type rpcResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+// rpcError is the error object of json-rpc, methods return it to set the error code
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *rpcError) Error() string {
+	return e.Message
+}
+
+func invalidParams(format string, args ...interface{}) error {
+	return &rpcError{Code: errCodeInvalidParams, Message: fmt.Sprintf(format, args...)}
+}
+
+// rpcServer serve json-rpc 2.0 calls over http, batch calls are supported
+type rpcServer struct {
+	methods map[string]rpcMethod
+}
+
+func newRPCServer(methods map[string]rpcMethod) *rpcServer {
+	return &rpcServer{
+		methods: methods,
+	}
+}
+
+func (s *rpcServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(s.handle(body))
+}
+
+// handle return the response of a single call or the responses of batch calls
+func (s *rpcServer) handle(body []byte) interface{} {
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '[' {
+		var reqs []json.RawMessage
+		if err := json.Unmarshal(body, &reqs); err != nil {
+			return errorResponse(nil, &rpcError{Code: errCodeParse, Message: err.Error()})
+		}
+		if len(reqs) == 0 {
+			return errorResponse(nil, &rpcError{Code: errCodeInvalidRequest, Message: "empty batch"})
+		}
+		resps := make([]*rpcResponse, 0, len(reqs))
+		for _, req := range reqs {
+			resps = append(resps, s.handleCall(req))
+		}
+		return resps
+	}
+	return s.handleCall(body)
+}
+
+func (s *rpcServer) handleCall(body []byte) *rpcResponse {
+	var req rpcRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return errorResponse(nil, &rpcError{Code: errCodeParse, Message: err.Error()})
+	}
+	if req.JSONRPC != jsonrpcVersion || req.Method == "" {
+		return errorResponse(req.ID, &rpcError{Code: errCodeInvalidRequest, Message: "invalid json-rpc request"})
+	}
+	method, ok := s.methods[req.Method]
+	if !ok {
+		return errorResponse(req.ID, &rpcError{Code: errCodeMethodNotFound, Message: "the method " + req.Method + " does not exist"})
+	}
+	var params []json.RawMessage
+	if len(req.Params) > 0 && string(req.Params) != "null" {
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return errorResponse(req.ID, invalidParams("params must be an array"))
+		}
+	}
+	result, err := method(params)
+	if err != nil {
+		return errorResponse(req.ID, err)
+	}
+	out, err := json.Marshal(result)
+	if err != nil {
+		return errorResponse(req.ID, err)
+	}
+	return &rpcResponse{
+		JSONRPC: jsonrpcVersion,
+		ID:      req.ID,
+		Result:  out,
+	}
+}
+
+func errorResponse(id json.RawMessage, err error) *rpcResponse {
+	rpcErr, ok := err.(*rpcError)
+	if !ok {
+		rpcErr = &rpcError{Code: errCodeServer, Message: err.Error()}
+	}
+	if id == nil {
+		id = json.RawMessage("null")
+	}
+	return &rpcResponse{
+		JSONRPC: jsonrpcVersion,
+		ID:      id,
+		Error:   rpcErr,
+	}
+}
+
+// parseParams unmarshal positional params into args, the missing optional params are kept as they are
+func parseParams(params []json.RawMessage, required int, args ...interface{}) error {
+	if len(params) < required {
+		return invalidParams("missing value for required argument %d", len(params))
+	}
+	if len(params) > len(args) {
+		return invalidParams("too many arguments, want at most %d", len(args))
+	}
+	for i, p := range params {
+		if err := json.Unmarshal(p, args[i]); err != nil {
+			return invalidParams("invalid argument %d: %v", i, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc"
+
+	"github.com/xuperchain/xuperchain/core/pb"
+)
+
+var (
+	rpcEndpoint = flag.String("gateway_endpoint", "localhost:37101", "endpoint of grpc service forward to")
+	// http port of ethereum json-rpc
+	httpEndpoint = flag.String("http_endpoint", ":8545", "endpoint of ethereum json-rpc service")
+	bcname       = flag.String("bcname", "xuper", "name of the chain")
+	// chain id used by EIP-155 signatures
+	chainID = flag.Int64("chain_id", 1337, "ethereum chain id of the chain")
+	// accounts bind ethereum addresses to xuper keys which sign the mapped transactions
+	accountsFile = flag.String("accounts", "./data/eth/accounts.json", "file of ethereum accounts bound to xuper keys")
+	// enable CROS
+	allowCROS = flag.Bool("allow_cros", false, "is allow Cross-origin resource sharing requests")
+
+	// InitialWindowSize window size
+	InitialWindowSize int32 = 128 << 10
+	// InitialConnWindowSize connection window size
+	InitialConnWindowSize int32 = 64 << 10
+	// ReadBufferSize buffer size
+	ReadBufferSize = 32 << 10
+	// WriteBufferSize write buffer size
+	WriteBufferSize = 32 << 10
+)
+
+func preflightHandler(w http.ResponseWriter, r *http.Request) {
+	headers := []string{"Content-Type", "Accept"}
+	w.Header().Set("Access-Control-Allow-Headers", strings.Join(headers, ","))
+	methods := []string{"POST", "OPTIONS"}
+	w.Header().Set("Access-Control-Allow-Methods", strings.Join(methods, ","))
+}
+
+// interupt
+func interupt(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// allow CROS requests
+		// Note: CROS is kind of dangerous in production environment
+		//       don't use this without consideration
+		if *allowCROS {
+			if origin := r.Header.Get("Origin"); origin != "" {
+				w.Header().Set("Access-Control-Allow-Origin", origin)
+				if r.Method == "OPTIONS" && r.Header.Get("Access-Control-Request-Method") != "" {
+					preflightHandler(w, r)
+					return
+				}
+			}
+		}
+
+		h.ServeHTTP(w, r)
+
+		// Request log
+		log.Printf("ip=%s method=%s URL=%s\n", r.RemoteAddr, r.Method, r.URL.Path)
+	})
+}
+
+func run() error {
+	opts := []grpc.DialOption{grpc.WithInsecure(), grpc.WithInitialWindowSize(InitialWindowSize), grpc.WithWriteBufferSize(WriteBufferSize), grpc.WithInitialConnWindowSize(InitialConnWindowSize), grpc.WithReadBufferSize(ReadBufferSize)}
+	conn, err := grpc.Dial(*rpcEndpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	accounts, err := loadAccounts(*accountsFile)
+	if err != nil {
+		return err
+	}
+	service := newEthService(pb.NewXchainClient(conn), *bcname, big.NewInt(*chainID), accounts, newTxStore())
+	return http.ListenAndServe(*httpEndpoint, interupt(newRPCServer(service.methods())))
+}
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		panic(err)
+	}
+}
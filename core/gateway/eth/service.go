@@ -0,0 +1,934 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/burrow/crypto"
+
+	"github.com/xuperchain/xuperchain/core/contract"
+	"github.com/xuperchain/xuperchain/core/contract/evm"
+	"github.com/xuperchain/xuperchain/core/contract/kernel"
+	"github.com/xuperchain/xuperchain/core/gateway/eth/ethtx"
+	"github.com/xuperchain/xuperchain/core/global"
+	"github.com/xuperchain/xuperchain/core/pb"
+	"github.com/xuperchain/xuperchain/core/xmodel"
+)
+
+const (
+	clientVersion = "xchain-ethgw/v1.0.0"
+	rpcTimeout    = 30 * time.Second
+	// transferGas is the gas estimated for plain transfers, which don't consume xuper gas
+	transferGas = 21000
+	// maxLogsBlockRange limit the number of blocks scanned by eth_getLogs
+	maxLogsBlockRange = 1000
+	// ethTxDescPrefix is the prefix of desc of xuper transactions mapped from ethereum transactions,
+	// which is followed by the hex ethereum transaction hash
+	ethTxDescPrefix = "eth:"
+)
+
+var (
+	errSenderNotBound  = errors.New("sender is not bound to a xuper account")
+	errNonceTooLow     = errors.New("nonce too low")
+	errNonceTooHigh    = errors.New("nonce too high")
+	errTxKnown         = errors.New("already known")
+	errNoContractAcct  = errors.New("sender has no contract account to deploy contracts")
+	errBlockNotFound   = errors.New("block not found")
+	errLogsRangeTooBig = fmt.Errorf("block range of eth_getLogs is limited to %d", maxLogsBlockRange)
+)
+
+// ethService implements the ethereum json-rpc methods on top of the xchain grpc service
+type ethService struct {
+	client   pb.XchainClient
+	bcname   string
+	chainID  *big.Int
+	accounts *accountManager
+	// store caches the transactions sent which may be not on chain yet
+	store *txStore
+	// sendLock serializes eth_sendRawTransaction so that the nonces of a sender are checked in order,
+	// the nonces are also checked by the chain, which rejects the transactions replayed
+	sendLock sync.Mutex
+}
+
+func newEthService(client pb.XchainClient, bcname string, chainID *big.Int,
+	accounts *accountManager, store *txStore) *ethService {
+	return &ethService{
+		client:   client,
+		bcname:   bcname,
+		chainID:  chainID,
+		accounts: accounts,
+		store:    store,
+	}
+}
+
+func (s *ethService) methods() map[string]rpcMethod {
+	return map[string]rpcMethod{
+		"web3_clientVersion":        s.clientVersion,
+		"net_version":               s.netVersion,
+		"net_listening":             s.netListening,
+		"net_peerCount":             s.netPeerCount,
+		"eth_chainId":               s.ethChainID,
+		"eth_blockNumber":           s.blockNumber,
+		"eth_gasPrice":              s.gasPrice,
+		"eth_accounts":              s.ethAccounts,
+		"eth_syncing":               s.syncing,
+		"eth_getBalance":            s.getBalance,
+		"eth_getTransactionCount":   s.getTransactionCount,
+		"eth_getCode":               s.getCode,
+		"eth_getStorageAt":          s.getStorageAt,
+		"eth_call":                  s.call,
+		"eth_estimateGas":           s.estimateGas,
+		"eth_sendRawTransaction":    s.sendRawTransaction,
+		"eth_getTransactionByHash":  s.getTransactionByHash,
+		"eth_getTransactionReceipt": s.getTransactionReceipt,
+		"eth_getBlockByNumber":      s.getBlockByNumber,
+		"eth_getBlockByHash":        s.getBlockByHash,
+		"eth_getLogs":               s.getLogs,
+	}
+}
+
+func (s *ethService) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), rpcTimeout)
+}
+
+func checkHeader(header *pb.Header) error {
+	if header != nil && header.GetError() != pb.XChainErrorEnum_SUCCESS {
+		return fmt.Errorf("xchain error: %s", header.GetError().String())
+	}
+	return nil
+}
+
+func (s *ethService) clientVersion(params []json.RawMessage) (interface{}, error) {
+	return clientVersion, nil
+}
+
+func (s *ethService) netVersion(params []json.RawMessage) (interface{}, error) {
+	return s.chainID.String(), nil
+}
+
+func (s *ethService) netListening(params []json.RawMessage) (interface{}, error) {
+	return true, nil
+}
+
+func (s *ethService) netPeerCount(params []json.RawMessage) (interface{}, error) {
+	ctx, cancel := s.context()
+	defer cancel()
+	reply, err := s.client.GetSystemStatus(ctx, &pb.CommonIn{Header: global.GHeader()})
+	if err != nil {
+		return nil, err
+	}
+	if err := checkHeader(reply.GetHeader()); err != nil {
+		return nil, err
+	}
+	return hexUint64(len(reply.GetSystemsStatus().GetPeerUrls())), nil
+}
+
+func (s *ethService) ethChainID(params []json.RawMessage) (interface{}, error) {
+	return newHexBig(s.chainID), nil
+}
+
+func (s *ethService) blockNumber(params []json.RawMessage) (interface{}, error) {
+	height, err := s.latestHeight()
+	if err != nil {
+		return nil, err
+	}
+	return hexUint64(height), nil
+}
+
+// gasPrice return zero since the fee of xuper transaction is the gas used of pre-execution
+func (s *ethService) gasPrice(params []json.RawMessage) (interface{}, error) {
+	return hexUint64(0), nil
+}
+
+func (s *ethService) ethAccounts(params []json.RawMessage) (interface{}, error) {
+	return []ethAddress{}, nil
+}
+
+func (s *ethService) syncing(params []json.RawMessage) (interface{}, error) {
+	return false, nil
+}
+
+func (s *ethService) getBalance(params []json.RawMessage) (interface{}, error) {
+	var addr ethAddress
+	var block blockNumber
+	if err := parseParams(params, 1, &addr, &block); err != nil {
+		return nil, err
+	}
+	address, err := s.xchainAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := s.context()
+	defer cancel()
+	status, err := s.client.GetBalance(ctx, &pb.AddressStatus{
+		Header:  global.GHeader(),
+		Address: address,
+		Bcs:     []*pb.TokenDetail{{Bcname: s.bcname}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := checkHeader(status.GetHeader()); err != nil {
+		return nil, err
+	}
+	if len(status.GetBcs()) == 0 {
+		return newHexBig(nil), nil
+	}
+	balance, ok := new(big.Int).SetString(status.GetBcs()[0].GetBalance(), 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid balance %s", status.GetBcs()[0].GetBalance())
+	}
+	return newHexBig(balance), nil
+}
+
+func (s *ethService) getTransactionCount(params []json.RawMessage) (interface{}, error) {
+	var addr ethAddress
+	var block blockNumber
+	if err := parseParams(params, 1, &addr, &block); err != nil {
+		return nil, err
+	}
+	nonce, err := s.nonce(addr)
+	if err != nil {
+		return nil, err
+	}
+	return hexUint64(nonce), nil
+}
+
+// nonce return the next nonce of addr recorded on chain, including the unconfirmed transactions,
+// addresses not bound can't send transactions and their nonces are 0
+func (s *ethService) nonce(addr ethAddress) (uint64, error) {
+	acc, ok := s.accounts.get(addr)
+	if !ok {
+		return 0, nil
+	}
+	ctx, cancel := s.context()
+	defer cancel()
+	resp, err := s.client.PreExec(ctx, &pb.InvokeRPCRequest{
+		Header: global.GHeader(),
+		Bcname: s.bcname,
+		Requests: []*pb.InvokeRequest{
+			{
+				ModuleName: kernelModuleName,
+				MethodName: nonceMethodName,
+				Args: map[string][]byte{
+					"from": []byte(hex.EncodeToString(addr[:])),
+				},
+			},
+		},
+		Initiator: acc.address,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if err := checkHeader(resp.GetHeader()); err != nil {
+		return 0, err
+	}
+	responses := resp.GetResponse().GetResponses()
+	if len(responses) == 0 {
+		return 0, fmt.Errorf("no response of %s", nonceMethodName)
+	}
+	return strconv.ParseUint(string(responses[0].GetBody()), 10, 64)
+}
+
+func (s *ethService) getCode(params []json.RawMessage) (interface{}, error) {
+	var addr ethAddress
+	var block blockNumber
+	if err := parseParams(params, 1, &addr, &block); err != nil {
+		return nil, err
+	}
+	name, err := evm.DetermineContractNameFromEVM(crypto.Address(addr))
+	if err != nil {
+		return hexBytes{}, nil
+	}
+	// 与 contract/evm 中合约代码的存储位置一致
+	code, err := s.queryState("contract", []byte(name+".code"))
+	if err != nil {
+		return nil, err
+	}
+	return hexBytes(code), nil
+}
+
+func (s *ethService) getStorageAt(params []json.RawMessage) (interface{}, error) {
+	var addr ethAddress
+	var slot hexBytes
+	var block blockNumber
+	if err := parseParams(params, 2, &addr, &slot, &block); err != nil {
+		return nil, err
+	}
+	if len(slot) > 32 {
+		return nil, invalidParams("invalid storage slot")
+	}
+	value := make([]byte, 32)
+	name, err := evm.DetermineContractNameFromEVM(crypto.Address(addr))
+	if err != nil {
+		return hexBytes(value), nil
+	}
+	key := make([]byte, 32)
+	copy(key[32-len(slot):], slot)
+	stored, err := s.queryState(name, key)
+	if err != nil {
+		return nil, err
+	}
+	if len(stored) <= 32 {
+		copy(value[32-len(stored):], stored)
+	}
+	return hexBytes(value), nil
+}
+
+// queryState read the value of xmodel key at the latest block, nil is returned if the key does not exist
+func (s *ethService) queryState(bucket string, key []byte) ([]byte, error) {
+	proof, err := s.queryStateProof(bucket, key)
+	if err != nil || !proof.GetExist() {
+		return nil, err
+	}
+	return proof.GetValue(), nil
+}
+
+// queryStateProof read the proof of xmodel key at the latest block
+func (s *ethService) queryStateProof(bucket string, key []byte) (*pb.StateProof, error) {
+	ctx, cancel := s.context()
+	defer cancel()
+	resp, err := s.client.GetStateProof(ctx, &pb.StateProofRequest{
+		Header: global.GHeader(),
+		Bcname: s.bcname,
+		Bucket: bucket,
+		Key:    key,
+		Height: -1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := checkHeader(resp.GetHeader()); err != nil {
+		return nil, err
+	}
+	return resp.GetProof(), nil
+}
+
+func (s *ethService) call(params []json.RawMessage) (interface{}, error) {
+	var args callArgs
+	var block blockNumber
+	if err := parseParams(params, 1, &args, &block); err != nil {
+		return nil, err
+	}
+	resp, _, err := s.preExecCall(&args)
+	if err != nil {
+		return nil, err
+	}
+	responses := resp.GetResponses()
+	if len(responses) == 0 {
+		return hexBytes{}, nil
+	}
+	return hexBytes(responses[len(responses)-1].GetBody()), nil
+}
+
+func (s *ethService) estimateGas(params []json.RawMessage) (interface{}, error) {
+	var args callArgs
+	var block blockNumber
+	if err := parseParams(params, 1, &args, &block); err != nil {
+		return nil, err
+	}
+	resp, mapped, err := s.preExecCall(&args)
+	if err != nil {
+		return nil, err
+	}
+	if mapped.request == nil {
+		return hexUint64(transferGas), nil
+	}
+	gas := requestsGas(resp.GetRequests())
+	// CALL 最多转发剩余gas的63/64, 预留余量避免按预估值执行时内部调用gas不足
+	return hexUint64(gas + gas/63 + 1), nil
+}
+
+// preExecCall pre-execute the call, the sender is the bound xuper account of from if it exists
+func (s *ethService) preExecCall(args *callArgs) (*pb.InvokeResponse, *mappedTx, error) {
+	from := ethAddress(crypto.ZeroAddress)
+	if args.From != nil {
+		from = *args.From
+	}
+	value := new(big.Int)
+	if args.Value != nil {
+		value = args.Value.ToInt()
+	}
+	gas := uint64(0)
+	if args.Gas != nil {
+		gas = uint64(*args.Gas)
+	}
+	nonce, err := s.nonce(from)
+	if err != nil {
+		return nil, nil, err
+	}
+	mapped, err := s.mapTx(from, args.To, args.data(), value, gas, nonce)
+	if err != nil {
+		return nil, nil, err
+	}
+	if mapped.request == nil {
+		return &pb.InvokeResponse{}, mapped, nil
+	}
+	resp, err := s.preExec(mapped)
+	return resp, mapped, err
+}
+
+func (s *ethService) sendRawTransaction(params []json.RawMessage) (interface{}, error) {
+	var raw hexBytes
+	if err := parseParams(params, 1, &raw); err != nil {
+		return nil, err
+	}
+	tx, err := ethtx.Decode(raw)
+	if err != nil {
+		return nil, invalidParams("decode transaction error: %v", err)
+	}
+	sender, err := tx.Sender(s.chainID)
+	if err != nil {
+		return nil, invalidParams("invalid sender: %v", err)
+	}
+	from, err := crypto.AddressFromBytes(sender)
+	if err != nil {
+		return nil, err
+	}
+	acc, ok := s.accounts.get(ethAddress(from))
+	if !ok {
+		return nil, errSenderNotBound
+	}
+	var to *ethAddress
+	if tx.To != nil {
+		addr, err := crypto.AddressFromBytes(tx.To)
+		if err != nil {
+			return nil, err
+		}
+		to = (*ethAddress)(&addr)
+	}
+
+	s.sendLock.Lock()
+	defer s.sendLock.Unlock()
+	nonce, err := s.nonce(acc.ethAddress)
+	if err != nil {
+		return nil, err
+	}
+	if tx.Nonce < nonce {
+		return nil, errNonceTooLow
+	}
+	if tx.Nonce > nonce {
+		return nil, errNonceTooHigh
+	}
+	hash := tx.Hash()
+	if _, ok, err := s.recordedTx(hash); err != nil || ok {
+		if err == nil {
+			err = errTxKnown
+		}
+		return nil, err
+	}
+	mapped, err := s.mapTx(acc.ethAddress, to, tx.Data, tx.Value, tx.Gas, tx.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	// 交易哈希和nonce记录在链上, 重放的交易在预执行和节点验证时都会被拒绝
+	mapped.record = recordRequest(raw)
+	if len(acc.bindSignature) > 0 {
+		mapped.bind = bindRequest(acc.bindSignature)
+	}
+	xtx, err := s.buildTx(acc, mapped, []byte(ethTxDescPrefix+hex.EncodeToString(hash)))
+	if err != nil {
+		return nil, err
+	}
+	if err := s.postTx(xtx); err != nil {
+		return nil, err
+	}
+	s.store.add(hash, &txRecord{
+		Txid:  xtx.GetTxid(),
+		From:  acc.ethAddress,
+		Nonce: tx.Nonce,
+	})
+	return hexBytes(hash), nil
+}
+
+func (s *ethService) getTransactionByHash(params []json.RawMessage) (interface{}, error) {
+	var hash hexBytes
+	if err := parseParams(params, 1, &hash); err != nil {
+		return nil, err
+	}
+	status, err := s.queryTx(hash)
+	if err != nil || status == nil {
+		return nil, err
+	}
+	tx := status.GetTx()
+	if !txConfirmed(status) {
+		return s.buildTransaction(tx, nil, 0), nil
+	}
+	block, index, err := s.txBlock(tx)
+	if err != nil {
+		return nil, err
+	}
+	return s.buildTransaction(tx, block, index), nil
+}
+
+func (s *ethService) getTransactionReceipt(params []json.RawMessage) (interface{}, error) {
+	var hash hexBytes
+	if err := parseParams(params, 1, &hash); err != nil {
+		return nil, err
+	}
+	status, err := s.queryTx(hash)
+	if err != nil || status == nil || !txConfirmed(status) {
+		return nil, err
+	}
+	tx := status.GetTx()
+	block, index, err := s.txBlock(tx)
+	if err != nil {
+		return nil, err
+	}
+	etx := s.buildTransaction(tx, block, index)
+	_, _, _, created := s.parseContractCall(tx)
+	gas := requestsGas(tx.GetContractRequests())
+	receipt := &ethReceipt{
+		TransactionHash:   etx.Hash,
+		TransactionIndex:  hexUint64(index),
+		BlockHash:         hexBytes(block.GetBlockid()),
+		BlockNumber:       hexUint64(block.GetHeight()),
+		From:              etx.From,
+		To:                etx.To,
+		CumulativeGasUsed: hexUint64(gas),
+		GasUsed:           hexUint64(gas),
+		ContractAddress:   created,
+		Logs:              []*ethLog{},
+		LogsBloom:         zeroBloom,
+		Status:            1,
+	}
+	if _, failed := block.GetFailedTxs()[hex.EncodeToString(tx.GetTxid())]; failed {
+		receipt.Status = 0
+	}
+	for _, log := range s.blockLogs(block) {
+		if uint64(log.TransactionIndex) == uint64(index) {
+			receipt.Logs = append(receipt.Logs, log)
+		}
+	}
+	return receipt, nil
+}
+
+func (s *ethService) getBlockByNumber(params []json.RawMessage) (interface{}, error) {
+	var number blockNumber
+	var full bool
+	if err := parseParams(params, 1, &number, &full); err != nil {
+		return nil, err
+	}
+	height, err := s.resolveHeight(number)
+	if err != nil {
+		return nil, err
+	}
+	block, err := s.blockByHeight(height)
+	if err == errBlockNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.buildBlock(block, full), nil
+}
+
+func (s *ethService) getBlockByHash(params []json.RawMessage) (interface{}, error) {
+	var hash hexBytes
+	var full bool
+	if err := parseParams(params, 1, &hash, &full); err != nil {
+		return nil, err
+	}
+	block, err := s.blockByID(hash)
+	if err == errBlockNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.buildBlock(block, full), nil
+}
+
+func (s *ethService) getLogs(params []json.RawMessage) (interface{}, error) {
+	var filter filterQuery
+	if err := parseParams(params, 1, &filter); err != nil {
+		return nil, err
+	}
+	var blocks []*pb.InternalBlock
+	if filter.BlockHash != nil {
+		block, err := s.blockByID(*filter.BlockHash)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	} else {
+		from, to := latestBlockNumber, latestBlockNumber
+		if filter.FromBlock != nil {
+			from = *filter.FromBlock
+		}
+		if filter.ToBlock != nil {
+			to = *filter.ToBlock
+		}
+		fromHeight, err := s.resolveHeight(from)
+		if err != nil {
+			return nil, err
+		}
+		toHeight, err := s.resolveHeight(to)
+		if err != nil {
+			return nil, err
+		}
+		if toHeight-fromHeight+1 > maxLogsBlockRange {
+			return nil, errLogsRangeTooBig
+		}
+		for height := fromHeight; height <= toHeight; height++ {
+			block, err := s.blockByHeight(height)
+			if err != nil {
+				return nil, err
+			}
+			blocks = append(blocks, block)
+		}
+	}
+	logs := []*ethLog{}
+	for _, block := range blocks {
+		for _, log := range s.blockLogs(block) {
+			if filter.match(log) {
+				logs = append(logs, log)
+			}
+		}
+	}
+	return logs, nil
+}
+
+func (f *filterQuery) match(log *ethLog) bool {
+	if len(f.Address) > 0 {
+		found := false
+		for _, addr := range f.Address {
+			if addr == log.Address {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	for i, topics := range f.Topics {
+		if len(topics) == 0 {
+			continue
+		}
+		if i >= len(log.Topics) {
+			return false
+		}
+		found := false
+		for _, topic := range topics {
+			if hex.EncodeToString(topic) == hex.EncodeToString(log.Topics[i]) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *ethService) latestHeight() (int64, error) {
+	ctx, cancel := s.context()
+	defer cancel()
+	status, err := s.client.GetBlockChainStatus(ctx, &pb.BCStatus{
+		Header: global.GHeader(),
+		Bcname: s.bcname,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if err := checkHeader(status.GetHeader()); err != nil {
+		return 0, err
+	}
+	return status.GetMeta().GetTrunkHeight(), nil
+}
+
+func (s *ethService) resolveHeight(number blockNumber) (int64, error) {
+	if number == latestBlockNumber || number == pendingBlockNumber {
+		return s.latestHeight()
+	}
+	return int64(number), nil
+}
+
+func (s *ethService) blockByHeight(height int64) (*pb.InternalBlock, error) {
+	ctx, cancel := s.context()
+	defer cancel()
+	block, err := s.client.GetBlockByHeight(ctx, &pb.BlockHeight{
+		Header: global.GHeader(),
+		Bcname: s.bcname,
+		Height: height,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := checkHeader(block.GetHeader()); err != nil {
+		return nil, err
+	}
+	if block.GetBlock() == nil {
+		return nil, errBlockNotFound
+	}
+	return block.GetBlock(), nil
+}
+
+func (s *ethService) blockByID(blockid []byte) (*pb.InternalBlock, error) {
+	ctx, cancel := s.context()
+	defer cancel()
+	block, err := s.client.GetBlock(ctx, &pb.BlockID{
+		Header:      global.GHeader(),
+		Bcname:      s.bcname,
+		Blockid:     blockid,
+		NeedContent: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := checkHeader(block.GetHeader()); err != nil {
+		return nil, err
+	}
+	if block.GetBlock() == nil || block.GetStatus() == pb.Block_NOEXIST {
+		return nil, errBlockNotFound
+	}
+	return block.GetBlock(), nil
+}
+
+// recordedTx return the xuper txid of ethereum hash, which is sent by the gateway recently
+// or recorded on chain by RecordEthTx
+func (s *ethService) recordedTx(hash []byte) ([]byte, bool, error) {
+	if record, ok := s.store.get(hash); ok {
+		return record.Txid, true, nil
+	}
+	proof, err := s.queryStateProof(kernel.EthTxBucket, hash)
+	if err != nil {
+		return nil, false, err
+	}
+	if !proof.GetExist() {
+		return nil, false, nil
+	}
+	return xmodel.GetTxidFromVersion(proof.GetVersion()), true, nil
+}
+
+// queryTx query the xuper transaction of ethereum hash, the hash is used as xuper txid
+// if it's not sent by the gateway. nil is returned if the transaction does not exist
+func (s *ethService) queryTx(hash []byte) (*pb.TxStatus, error) {
+	txid, ok, err := s.recordedTx(hash)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		txid = hash
+	}
+	ctx, cancel := s.context()
+	defer cancel()
+	status, err := s.client.QueryTx(ctx, &pb.TxStatus{
+		Header: global.GHeader(),
+		Bcname: s.bcname,
+		Txid:   txid,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := checkHeader(status.GetHeader()); err != nil {
+		return nil, err
+	}
+	if status.GetTx() == nil {
+		return nil, nil
+	}
+	return status, nil
+}
+
+func txConfirmed(status *pb.TxStatus) bool {
+	return status.GetStatus() == pb.TransactionStatus_CONFIRM && len(status.GetTx().GetBlockid()) > 0
+}
+
+// txBlock return the block of confirmed transaction and the index of transaction in block
+func (s *ethService) txBlock(tx *pb.Transaction) (*pb.InternalBlock, int, error) {
+	block, err := s.blockByID(tx.GetBlockid())
+	if err != nil {
+		return nil, 0, err
+	}
+	for i, t := range block.GetTransactions() {
+		if string(t.GetTxid()) == string(tx.GetTxid()) {
+			return block, i, nil
+		}
+	}
+	return nil, 0, fmt.Errorf("transaction %x not found in block %x", tx.GetTxid(), tx.GetBlockid())
+}
+
+// txHash return the ethereum hash of transaction mapped by the gateway, otherwise the xuper txid
+func txHash(tx *pb.Transaction) []byte {
+	desc := string(tx.GetDesc())
+	if len(desc) == len(ethTxDescPrefix)+64 && desc[:len(ethTxDescPrefix)] == ethTxDescPrefix {
+		if hash, err := hex.DecodeString(desc[len(ethTxDescPrefix):]); err == nil {
+			return hash
+		}
+	}
+	return tx.GetTxid()
+}
+
+func (s *ethService) buildBlock(block *pb.InternalBlock, full bool) *ethBlock {
+	stateRoot := block.GetStateRoot()
+	if len(stateRoot) == 0 {
+		stateRoot = zeroHash
+	}
+	out := &ethBlock{
+		Number:           hexUint64(block.GetHeight()),
+		Hash:             block.GetBlockid(),
+		ParentHash:       block.GetPreHash(),
+		Nonce:            make([]byte, 8),
+		Sha3Uncles:       emptyUncleHash,
+		LogsBloom:        zeroBloom,
+		TransactionsRoot: block.GetMerkleRoot(),
+		StateRoot:        stateRoot,
+		ReceiptsRoot:     zeroHash,
+		Miner:            s.accounts.ethAddressOf(string(block.GetProposer())),
+		ExtraData:        hexBytes{},
+		Size:             hexUint64(proto.Size(block)),
+		GasLimit:         hexUint64(contract.MaxLimits.Cpu),
+		Timestamp:        hexUint64(block.GetTimestamp() / int64(time.Second)),
+		Transactions:     []interface{}{},
+		Uncles:           []hexBytes{},
+	}
+	if len(out.ParentHash) == 0 {
+		out.ParentHash = zeroHash
+	}
+	gasUsed := int64(0)
+	for i, tx := range block.GetTransactions() {
+		gasUsed += requestsGas(tx.GetContractRequests())
+		if full {
+			out.Transactions = append(out.Transactions, s.buildTransaction(tx, block, i))
+		} else {
+			out.Transactions = append(out.Transactions, hexBytes(txHash(tx)))
+		}
+	}
+	out.GasUsed = hexUint64(gasUsed)
+	return out
+}
+
+// buildTransaction convert xuper transaction to ethereum transaction, block is nil for unconfirmed transaction
+func (s *ethService) buildTransaction(tx *pb.Transaction, block *pb.InternalBlock, index int) *ethTransaction {
+	hash := txHash(tx)
+	to, input, value, _ := s.parseContractCall(tx)
+	out := &ethTransaction{
+		Hash:  hash,
+		From:  s.accounts.ethAddressOf(tx.GetInitiator()),
+		To:    to,
+		Value: newHexBig(value),
+		Gas:   hexUint64(requestsGas(tx.GetContractRequests())),
+		Input: input,
+	}
+	if from, nonce, ok := parseRecordRequest(tx); ok {
+		out.From = from
+		out.Nonce = hexUint64(nonce)
+	}
+	if block != nil {
+		blockHash := hexBytes(block.GetBlockid())
+		height := hexUint64(block.GetHeight())
+		txIndex := hexUint64(index)
+		out.BlockHash = &blockHash
+		out.BlockNumber = &height
+		out.TransactionIndex = &txIndex
+	}
+	return out
+}
+
+// parseContractCall return the receiver, input and value of transaction, and the address of contract
+// created by the transaction. The first evm invoke or evm deploy request is used,
+// otherwise the transaction is treated as a plain transfer
+func (s *ethService) parseContractCall(tx *pb.Transaction) (*ethAddress, []byte, *big.Int, *ethAddress) {
+	value := new(big.Int)
+	for _, req := range tx.GetContractRequests() {
+		if req.GetModuleName() == evmModuleName {
+			addr, err := evm.ContractNameToEVMAddress(req.GetContractName())
+			if err != nil {
+				continue
+			}
+			if req.GetAmount() != "" {
+				value.SetString(req.GetAmount(), 10)
+			}
+			return (*ethAddress)(&addr), req.GetArgs()[evmInputArg], value, nil
+		}
+		if req.GetModuleName() == kernelModuleName && req.GetMethodName() == deployMethodName && isEvmDeploy(req) {
+			addr, err := evm.ContractNameToEVMAddress(string(req.GetArgs()["contract_name"]))
+			if err != nil {
+				continue
+			}
+			return nil, req.GetArgs()["contract_code"], value, (*ethAddress)(&addr)
+		}
+	}
+	for _, output := range tx.GetTxOutputs() {
+		toAddr := string(output.GetToAddr())
+		if toAddr == tx.GetInitiator() || toAddr == feeAddress {
+			continue
+		}
+		to := s.accounts.ethAddressOf(toAddr)
+		return &to, nil, value.SetBytes(output.GetAmount()), nil
+	}
+	return nil, nil, value, nil
+}
+
+// blockLogs return the logs of raw log events in block, the events decoded by abi can not be
+// converted back to ethereum logs and are skipped
+func (s *ethService) blockLogs(block *pb.InternalBlock) []*ethLog {
+	var logs []*ethLog
+	for i, tx := range block.GetTransactions() {
+		events, err := xmodel.ParseContractEvents(tx)
+		if err != nil {
+			continue
+		}
+		for _, event := range events {
+			if event.GetName() != evm.RawLogEventName {
+				continue
+			}
+			log, err := newEthLog(event)
+			if err != nil {
+				continue
+			}
+			log.BlockNumber = hexUint64(block.GetHeight())
+			log.BlockHash = block.GetBlockid()
+			log.TransactionHash = txHash(tx)
+			log.TransactionIndex = hexUint64(i)
+			log.LogIndex = hexUint64(len(logs))
+			logs = append(logs, log)
+		}
+	}
+	return logs
+}
+
+func newEthLog(event *pb.ContractEvent) (*ethLog, error) {
+	rawLog, err := evm.ParseRawLog(event)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := evm.ContractNameToEVMAddress(event.GetContract())
+	if err != nil {
+		return nil, err
+	}
+	log := &ethLog{
+		Address: ethAddress(addr),
+		Topics:  make([]hexBytes, 0, len(rawLog.Topics)),
+	}
+	for _, topic := range rawLog.Topics {
+		t, err := hex.DecodeString(topic)
+		if err != nil {
+			return nil, err
+		}
+		log.Topics = append(log.Topics, t)
+	}
+	if log.Data, err = hex.DecodeString(rawLog.Data); err != nil {
+		return nil, err
+	}
+	return log, nil
+}
+
+// requestsGas return the sum of cpu limits of requests, which is the gas used after pre-execution
+func requestsGas(requests []*pb.InvokeRequest) int64 {
+	gas := int64(0)
+	for _, req := range requests {
+		gas += contract.FromPbLimits(req.GetResourceLimits()).Cpu
+	}
+	return gas
+}
@@ -0,0 +1,256 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	"github.com/xuperchain/xuperchain/core/contract/evm"
+	"github.com/xuperchain/xuperchain/core/contract/kernel"
+	"github.com/xuperchain/xuperchain/core/pb"
+	"github.com/xuperchain/xuperchain/core/xmodel"
+)
+
+// fakeXchainClient serve the blocks in memory, the methods not overridden panic
+type fakeXchainClient struct {
+	pb.XchainClient
+	blocks []*pb.InternalBlock
+	// states are the values of xmodel keys, keyed by bucket/key
+	states map[string]*pb.StateProof
+}
+
+func (c *fakeXchainClient) GetStateProof(ctx context.Context, in *pb.StateProofRequest, opts ...grpc.CallOption) (*pb.StateProofResponse, error) {
+	proof, ok := c.states[in.Bucket+"/"+string(in.Key)]
+	if !ok {
+		proof = &pb.StateProof{Bucket: in.Bucket, Key: in.Key}
+	}
+	return &pb.StateProofResponse{Header: &pb.Header{}, Proof: proof}, nil
+}
+
+func (c *fakeXchainClient) GetBlockChainStatus(ctx context.Context, in *pb.BCStatus, opts ...grpc.CallOption) (*pb.BCStatus, error) {
+	return &pb.BCStatus{
+		Header: &pb.Header{},
+		Meta:   &pb.LedgerMeta{TrunkHeight: int64(len(c.blocks) - 1)},
+	}, nil
+}
+
+func (c *fakeXchainClient) GetBlockByHeight(ctx context.Context, in *pb.BlockHeight, opts ...grpc.CallOption) (*pb.Block, error) {
+	if in.Height < 0 || in.Height >= int64(len(c.blocks)) {
+		return &pb.Block{Header: &pb.Header{}}, nil
+	}
+	return &pb.Block{Header: &pb.Header{}, Block: c.blocks[in.Height]}, nil
+}
+
+func newRawLogTx(t *testing.T, txid string, contractName string, topics ...string) *pb.Transaction {
+	body, err := json.Marshal(&evm.RawLog{Topics: topics, Data: "ff"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	events := []*pb.ContractEvent{
+		{Contract: contractName, Name: "Decoded", Body: []byte("{}")},
+		{Contract: contractName, Name: evm.RawLogEventName, Body: body},
+	}
+	buf, err := xmodel.MarshalMessages(events)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &pb.Transaction{
+		Txid: []byte(txid),
+		TxOutputsExt: []*pb.TxOutputExt{
+			{Bucket: xmodel.TransientBucket, Key: []byte("contractEvent"), Value: buf},
+		},
+	}
+}
+
+func newTestService(t *testing.T, blocks []*pb.InternalBlock) *rpcServer {
+	accounts, err := loadAccounts("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	service := newEthService(&fakeXchainClient{blocks: blocks}, "xuper", big.NewInt(1337), accounts, newTxStore())
+	return newRPCServer(service.methods())
+}
+
+func call(t *testing.T, server *rpcServer, body string) *rpcResponse {
+	resp, ok := server.handle([]byte(body)).(*rpcResponse)
+	if !ok {
+		t.Fatalf("unexpected response of %s", body)
+	}
+	return resp
+}
+
+func TestRPCServer(t *testing.T) {
+	server := newTestService(t, []*pb.InternalBlock{{Height: 0}, {Height: 1}})
+	resp := call(t, server, `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`)
+	if resp.Error != nil || string(resp.Result) != `"0x1"` {
+		t.Fatalf("unexpected eth_blockNumber response %+v", resp)
+	}
+	resp = call(t, server, `{"jsonrpc":"2.0","id":2,"method":"eth_chainId","params":[]}`)
+	if string(resp.Result) != `"0x539"` || string(resp.ID) != "2" {
+		t.Fatalf("unexpected eth_chainId response %+v", resp)
+	}
+	resp = call(t, server, `{"jsonrpc":"2.0","id":3,"method":"eth_mining"}`)
+	if resp.Error == nil || resp.Error.Code != errCodeMethodNotFound {
+		t.Fatalf("expect method not found, got %+v", resp)
+	}
+	resp = call(t, server, `{"jsonrpc":"2.0","id":4,"method":"eth_getBalance","params":[]}`)
+	if resp.Error == nil || resp.Error.Code != errCodeInvalidParams {
+		t.Fatalf("expect invalid params, got %+v", resp)
+	}
+	resp = call(t, server, `{"id":5,`)
+	if resp.Error == nil || resp.Error.Code != errCodeParse {
+		t.Fatalf("expect parse error, got %+v", resp)
+	}
+
+	resps, ok := server.handle([]byte(`[{"jsonrpc":"2.0","id":1,"method":"net_version"},{"jsonrpc":"2.0","id":2,"method":"eth_gasPrice"}]`)).([]*rpcResponse)
+	if !ok || len(resps) != 2 {
+		t.Fatal("unexpected batch response")
+	}
+	if string(resps[0].Result) != `"1337"` || string(resps[1].Result) != `"0x0"` {
+		t.Fatalf("unexpected batch results %s %s", resps[0].Result, resps[1].Result)
+	}
+}
+
+func TestGetLogs(t *testing.T) {
+	topicA := strings.Repeat("aa", 32)
+	topicB := strings.Repeat("bb", 32)
+	blocks := []*pb.InternalBlock{
+		{Height: 0, Blockid: []byte{0}},
+		{
+			Height:  1,
+			Blockid: []byte{1},
+			Transactions: []*pb.Transaction{
+				newRawLogTx(t, "tx1", "counter", topicA),
+				newRawLogTx(t, "tx2", "storage", topicB),
+			},
+		},
+		{
+			Height:       2,
+			Blockid:      []byte{2},
+			Transactions: []*pb.Transaction{newRawLogTx(t, "tx3", "counter", topicA, topicB)},
+		},
+	}
+	server := newTestService(t, blocks)
+	counter, err := evm.ContractNameToEVMAddress("counter")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		filter string
+		txids  []string
+	}{
+		{`{"fromBlock":"earliest"}`, []string{"tx1", "tx2", "tx3"}},
+		{`{"fromBlock":"0x2","toBlock":"latest"}`, []string{"tx3"}},
+		{`{"fromBlock":"0x0","address":"0x` + hex.EncodeToString(counter[:]) + `"}`, []string{"tx1", "tx3"}},
+		{`{"fromBlock":"0x0","topics":[null,"0x` + topicB + `"]}`, []string{"tx3"}},
+		{`{"fromBlock":"0x0","topics":[["0x` + topicA + `","0x` + topicB + `"]]}`, []string{"tx1", "tx2", "tx3"}},
+	}
+	for i, tc := range testCases {
+		resp := call(t, server, `{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[`+tc.filter+`]}`)
+		if resp.Error != nil {
+			t.Fatalf("case %d: eth_getLogs error %s", i, resp.Error.Message)
+		}
+		var logs []struct {
+			TransactionHash hexBytes   `json:"transactionHash"`
+			Topics          []hexBytes `json:"topics"`
+			Data            hexBytes   `json:"data"`
+			LogIndex        hexUint64  `json:"logIndex"`
+		}
+		if err := json.Unmarshal(resp.Result, &logs); err != nil {
+			t.Fatal(err)
+		}
+		if len(logs) != len(tc.txids) {
+			t.Fatalf("case %d: expect %d logs, got %d", i, len(tc.txids), len(logs))
+		}
+		for j, log := range logs {
+			if string(log.TransactionHash) != tc.txids[j] {
+				t.Errorf("case %d: expect log of %s, got %s", i, tc.txids[j], log.TransactionHash)
+			}
+			if hex.EncodeToString(log.Data) != "ff" {
+				t.Errorf("case %d: unexpected log data %x", i, log.Data)
+			}
+		}
+	}
+
+	resp := call(t, server, `{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[{"fromBlock":"0x0","toBlock":"0x3e8"}]}`)
+	if resp.Error == nil {
+		t.Fatal("expect error when block range exceeds the limit")
+	}
+}
+
+func TestTxHashFromDesc(t *testing.T) {
+	hash := strings.Repeat("ab", 32)
+	tx := &pb.Transaction{Txid: []byte("txid"), Desc: []byte(ethTxDescPrefix + hash)}
+	if hex.EncodeToString(txHash(tx)) != hash {
+		t.Errorf("expect ethereum hash %s, got %x", hash, txHash(tx))
+	}
+	tx.Desc = []byte(`{"module":"evm"}`)
+	if string(txHash(tx)) != "txid" {
+		t.Errorf("expect xuper txid, got %x", txHash(tx))
+	}
+}
+
+func TestRecordRequest(t *testing.T) {
+	// 来自 EIP-155 的示例交易, nonce为9
+	raw, _ := hex.DecodeString("f86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83")
+	tx := &pb.Transaction{
+		ContractRequests: []*pb.InvokeRequest{recordRequest(raw)},
+	}
+	recordFrom, nonce, ok := parseRecordRequest(tx)
+	if !ok || hex.EncodeToString(recordFrom[:]) != "9d8a62f656a8d1615c1294fd71e9cfb3e4855a4f" || nonce != 9 {
+		t.Fatalf("unexpected record %v %d %v", recordFrom, nonce, ok)
+	}
+	if _, _, ok := parseRecordRequest(&pb.Transaction{}); ok {
+		t.Error("expect no record of transactions not sent by gateway")
+	}
+}
+
+func TestRecordedTx(t *testing.T) {
+	recorded := bytes.Repeat([]byte{1}, 32)
+	pending := bytes.Repeat([]byte{2}, 32)
+	client := &fakeXchainClient{
+		states: map[string]*pb.StateProof{
+			kernel.EthTxBucket + "/" + string(recorded): {
+				Exist:   true,
+				Version: xmodel.MakeVersion([]byte("tx1"), 0),
+			},
+		},
+	}
+	service := newEthService(client, "xuper", big.NewInt(1337), nil, newTxStore())
+	service.store.add(pending, &txRecord{Txid: []byte("tx2")})
+
+	testCases := []struct {
+		hash  []byte
+		txid  string
+		exist bool
+	}{
+		{recorded, "tx1", true},
+		{pending, "tx2", true},
+		{make([]byte, 32), "", false},
+	}
+	for i, tc := range testCases {
+		txid, ok, err := service.recordedTx(tc.hash)
+		if err != nil || ok != tc.exist || string(txid) != tc.txid {
+			t.Errorf("case %d: expect %s %v, got %s %v %v", i, tc.txid, tc.exist, txid, ok, err)
+		}
+	}
+}
+
+func TestDeployContractName(t *testing.T) {
+	var from ethAddress
+	from[19] = 1
+	name := deployContractName(from, 0)
+	if err := evm.DetermineContractName(name); err != nil {
+		t.Fatalf("invalid contract name %s: %v", name, err)
+	}
+	if name == deployContractName(from, 1) {
+		t.Error("contract names of different nonces should be different")
+	}
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"github.com/xuperchain/xuperchain/core/common"
+)
+
+// pendingTxCacheSize is the number of ethereum transactions cached before they are found on chain
+const pendingTxCacheSize = 10000
+
+// txRecord is the xuper transaction mapped from an ethereum transaction
+type txRecord struct {
+	Txid  []byte
+	From  ethAddress
+	Nonce uint64
+}
+
+// txStore caches the ethereum transactions sent by the gateway. The hashes and nonces are recorded
+// on chain by the RecordEthTx kernel method, which rejects replayed transactions, the cache only
+// serves the queries of transactions not confirmed yet and is lost after restart
+type txStore struct {
+	cache *common.LRUCache
+}
+
+func newTxStore() *txStore {
+	return &txStore{
+		cache: common.NewLRUCache(pendingTxCacheSize),
+	}
+}
+
+// get return the record of ethereum transaction hash
+func (s *txStore) get(hash []byte) (*txRecord, bool) {
+	v, ok := s.cache.Get(string(hash))
+	if !ok {
+		return nil, false
+	}
+	return v.(*txRecord), true
+}
+
+// add record the transaction sent
+func (s *txStore) add(hash []byte, record *txRecord) {
+	s.cache.Add(string(hash), record)
+}
@@ -0,0 +1,284 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/hyperledger/burrow/crypto"
+)
+
+var (
+	errInvalidHex = errors.New("invalid hex string, 0x prefix is required")
+
+	zeroHash  = make([]byte, 32)
+	zeroBloom = make([]byte, 256)
+	// emptyUncleHash is keccak256(rlp([]))
+	emptyUncleHash, _ = hex.DecodeString("1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347")
+)
+
+// hexBytes is the 0x prefixed hex encoding of bytes
+type hexBytes []byte
+
+func (b hexBytes) MarshalText() ([]byte, error) {
+	return []byte("0x" + hex.EncodeToString(b)), nil
+}
+
+func (b *hexBytes) UnmarshalText(text []byte) error {
+	s := string(text)
+	if !strings.HasPrefix(s, "0x") && !strings.HasPrefix(s, "0X") {
+		return errInvalidHex
+	}
+	s = s[2:]
+	if len(s)%2 == 1 {
+		s = "0" + s
+	}
+	buf, err := hex.DecodeString(s)
+	if err != nil {
+		return errInvalidHex
+	}
+	*b = buf
+	return nil
+}
+
+// hexUint64 is the 0x prefixed hex encoding of quantity
+type hexUint64 uint64
+
+func (v hexUint64) MarshalText() ([]byte, error) {
+	return []byte("0x" + strconv.FormatUint(uint64(v), 16)), nil
+}
+
+func (v *hexUint64) UnmarshalText(text []byte) error {
+	s := string(text)
+	if !strings.HasPrefix(s, "0x") || len(s) == 2 {
+		return errInvalidHex
+	}
+	n, err := strconv.ParseUint(s[2:], 16, 64)
+	if err != nil {
+		return err
+	}
+	*v = hexUint64(n)
+	return nil
+}
+
+// hexBig is the 0x prefixed hex encoding of big quantity
+type hexBig big.Int
+
+func newHexBig(v *big.Int) *hexBig {
+	if v == nil {
+		v = new(big.Int)
+	}
+	return (*hexBig)(v)
+}
+
+func (v *hexBig) ToInt() *big.Int {
+	return (*big.Int)(v)
+}
+
+func (v hexBig) MarshalText() ([]byte, error) {
+	i := big.Int(v)
+	return []byte("0x" + i.Text(16)), nil
+}
+
+func (v *hexBig) UnmarshalText(text []byte) error {
+	s := string(text)
+	if !strings.HasPrefix(s, "0x") || len(s) == 2 {
+		return errInvalidHex
+	}
+	i, ok := new(big.Int).SetString(s[2:], 16)
+	if !ok || i.Sign() < 0 {
+		return errInvalidHex
+	}
+	*v = hexBig(*i)
+	return nil
+}
+
+// ethAddress is the 0x prefixed hex encoding of address
+type ethAddress crypto.Address
+
+func (a ethAddress) MarshalText() ([]byte, error) {
+	return hexBytes(a[:]).MarshalText()
+}
+
+func (a *ethAddress) UnmarshalText(text []byte) error {
+	var b hexBytes
+	if err := b.UnmarshalText(text); err != nil {
+		return err
+	}
+	addr, err := crypto.AddressFromBytes(b)
+	if err != nil {
+		return err
+	}
+	*a = ethAddress(addr)
+	return nil
+}
+
+func (a ethAddress) String() string {
+	return "0x" + hex.EncodeToString(a[:])
+}
+
+// blockNumber is the block parameter, which is a hex height or one of the tags
+type blockNumber int64
+
+const (
+	latestBlockNumber   = blockNumber(-1)
+	pendingBlockNumber  = blockNumber(-2)
+	earliestBlockNumber = blockNumber(0)
+)
+
+func (n *blockNumber) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	switch s {
+	case "latest":
+		*n = latestBlockNumber
+	case "pending":
+		*n = pendingBlockNumber
+	case "earliest":
+		*n = earliestBlockNumber
+	default:
+		var v hexUint64
+		if err := v.UnmarshalText([]byte(s)); err != nil {
+			return err
+		}
+		*n = blockNumber(v)
+	}
+	return nil
+}
+
+// callArgs is the transaction object of eth_call and eth_estimateGas
+type callArgs struct {
+	From     *ethAddress `json:"from"`
+	To       *ethAddress `json:"to"`
+	Gas      *hexUint64  `json:"gas"`
+	GasPrice *hexBig     `json:"gasPrice"`
+	Value    *hexBig     `json:"value"`
+	Data     *hexBytes   `json:"data"`
+	Input    *hexBytes   `json:"input"`
+}
+
+func (args *callArgs) data() []byte {
+	if args.Input != nil {
+		return *args.Input
+	}
+	if args.Data != nil {
+		return *args.Data
+	}
+	return nil
+}
+
+// filterQuery is the filter of eth_getLogs
+type filterQuery struct {
+	BlockHash *hexBytes      `json:"blockHash"`
+	FromBlock *blockNumber   `json:"fromBlock"`
+	ToBlock   *blockNumber   `json:"toBlock"`
+	Address   addressFilter  `json:"address"`
+	Topics    []topicsFilter `json:"topics"`
+}
+
+// addressFilter is a single address or a list of addresses
+type addressFilter []ethAddress
+
+func (f *addressFilter) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) > 0 && data[0] == '[' {
+		return json.Unmarshal(data, (*[]ethAddress)(f))
+	}
+	var addr ethAddress
+	if err := json.Unmarshal(data, &addr); err != nil {
+		return err
+	}
+	*f = addressFilter{addr}
+	return nil
+}
+
+// topicsFilter is the topic filter of a position, nil matches any topic
+type topicsFilter []hexBytes
+
+func (f *topicsFilter) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) > 0 && data[0] == '[' {
+		return json.Unmarshal(data, (*[]hexBytes)(f))
+	}
+	var topic hexBytes
+	if err := json.Unmarshal(data, &topic); err != nil {
+		return err
+	}
+	*f = topicsFilter{topic}
+	return nil
+}
+
+type ethBlock struct {
+	Number           hexUint64     `json:"number"`
+	Hash             hexBytes      `json:"hash"`
+	ParentHash       hexBytes      `json:"parentHash"`
+	Nonce            hexBytes      `json:"nonce"`
+	Sha3Uncles       hexBytes      `json:"sha3Uncles"`
+	LogsBloom        hexBytes      `json:"logsBloom"`
+	TransactionsRoot hexBytes      `json:"transactionsRoot"`
+	StateRoot        hexBytes      `json:"stateRoot"`
+	ReceiptsRoot     hexBytes      `json:"receiptsRoot"`
+	Miner            ethAddress    `json:"miner"`
+	Difficulty       hexUint64     `json:"difficulty"`
+	TotalDifficulty  hexUint64     `json:"totalDifficulty"`
+	ExtraData        hexBytes      `json:"extraData"`
+	Size             hexUint64     `json:"size"`
+	GasLimit         hexUint64     `json:"gasLimit"`
+	GasUsed          hexUint64     `json:"gasUsed"`
+	Timestamp        hexUint64     `json:"timestamp"`
+	Transactions     []interface{} `json:"transactions"`
+	Uncles           []hexBytes    `json:"uncles"`
+}
+
+type ethTransaction struct {
+	Hash             hexBytes    `json:"hash"`
+	Nonce            hexUint64   `json:"nonce"`
+	BlockHash        *hexBytes   `json:"blockHash"`
+	BlockNumber      *hexUint64  `json:"blockNumber"`
+	TransactionIndex *hexUint64  `json:"transactionIndex"`
+	From             ethAddress  `json:"from"`
+	To               *ethAddress `json:"to"`
+	Value            *hexBig     `json:"value"`
+	Gas              hexUint64   `json:"gas"`
+	GasPrice         hexUint64   `json:"gasPrice"`
+	Input            hexBytes    `json:"input"`
+	V                hexUint64   `json:"v"`
+	R                hexUint64   `json:"r"`
+	S                hexUint64   `json:"s"`
+}
+
+type ethReceipt struct {
+	TransactionHash   hexBytes    `json:"transactionHash"`
+	TransactionIndex  hexUint64   `json:"transactionIndex"`
+	BlockHash         hexBytes    `json:"blockHash"`
+	BlockNumber       hexUint64   `json:"blockNumber"`
+	From              ethAddress  `json:"from"`
+	To                *ethAddress `json:"to"`
+	CumulativeGasUsed hexUint64   `json:"cumulativeGasUsed"`
+	GasUsed           hexUint64   `json:"gasUsed"`
+	ContractAddress   *ethAddress `json:"contractAddress"`
+	Logs              []*ethLog   `json:"logs"`
+	LogsBloom         hexBytes    `json:"logsBloom"`
+	Status            hexUint64   `json:"status"`
+}
+
+type ethLog struct {
+	Address          ethAddress `json:"address"`
+	Topics           []hexBytes `json:"topics"`
+	Data             hexBytes   `json:"data"`
+	BlockNumber      hexUint64  `json:"blockNumber"`
+	BlockHash        hexBytes   `json:"blockHash"`
+	TransactionHash  hexBytes   `json:"transactionHash"`
+	TransactionIndex hexUint64  `json:"transactionIndex"`
+	LogIndex         hexUint64  `json:"logIndex"`
+	Removed          bool       `json:"removed"`
+}
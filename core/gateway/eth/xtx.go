@@ -0,0 +1,375 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/burrow/crypto"
+
+	"github.com/xuperchain/xuperchain/core/contract/evm"
+	crypto_client "github.com/xuperchain/xuperchain/core/crypto/client"
+	"github.com/xuperchain/xuperchain/core/gateway/eth/ethtx"
+	"github.com/xuperchain/xuperchain/core/global"
+	"github.com/xuperchain/xuperchain/core/pb"
+	"github.com/xuperchain/xuperchain/core/utxo"
+	"github.com/xuperchain/xuperchain/core/utxo/txhash"
+)
+
+const (
+	evmModuleName    = "evm"
+	evmInvokeMethod  = "invoke"
+	evmInputArg      = "input"
+	kernelModuleName = "xkernel"
+	deployMethodName = "Deploy"
+	// recordMethodName records the ethereum transaction on chain and checks its nonce
+	recordMethodName = "RecordEthTx"
+	nonceMethodName  = "EthTxNonce"
+	bindMethodName   = "BindEthAddress"
+	// feeAddress is the receiver of transaction fee
+	feeAddress = "$"
+)
+
+var (
+	errValueInCreation = errors.New("value is not supported in contract creation")
+	errDataToAccount   = errors.New("data is not supported when sending to a non-contract address")
+)
+
+// mappedTx is the xuper form of an ethereum transaction
+type mappedTx struct {
+	initiator   string
+	authRequire string
+	// request is nil for plain transfers
+	request *pb.InvokeRequest
+	// record is the RecordEthTx request of transactions sent, nil for calls
+	record *pb.InvokeRequest
+	// bind is the BindEthAddress request of sender, nil if the account has no bind signature
+	bind *pb.InvokeRequest
+	// to is the receiver of value
+	to    string
+	value *big.Int
+}
+
+// mapTx map an ethereum transaction onto xuper contract request and transfer:
+// creation is mapped onto the deployment of evm contract under the contract account of sender,
+// call to contract address is mapped onto evm invoke with raw input, others are plain transfers
+func (s *ethService) mapTx(from ethAddress, to *ethAddress, data []byte, value *big.Int, gas, nonce uint64) (*mappedTx, error) {
+	acc, bound := s.accounts.get(from)
+	initiator, err := s.xchainAddress(from)
+	if err != nil {
+		return nil, err
+	}
+	m := &mappedTx{
+		initiator:   initiator,
+		authRequire: initiator,
+		value:       value,
+	}
+	if to == nil {
+		if !bound || acc.contractAccount == "" {
+			return nil, errNoContractAcct
+		}
+		if value.Sign() > 0 {
+			return nil, errValueInCreation
+		}
+		m.authRequire = acc.contractAccount + "/" + acc.address
+		m.request, err = deployRequest(acc.contractAccount, deployContractName(from, nonce), data)
+		if err != nil {
+			return nil, err
+		}
+	} else if name, err := evm.DetermineContractNameFromEVM(crypto.Address(*to)); err == nil {
+		m.to = name
+		m.request = &pb.InvokeRequest{
+			ModuleName:   evmModuleName,
+			ContractName: name,
+			MethodName:   evmInvokeMethod,
+			Args: map[string][]byte{
+				evmInputArg: data,
+			},
+		}
+		if value.Sign() > 0 {
+			m.request.Amount = value.String()
+		}
+	} else {
+		if len(data) > 0 {
+			return nil, errDataToAccount
+		}
+		if m.to, err = s.xchainAddress(*to); err != nil {
+			return nil, err
+		}
+	}
+	if m.request != nil && gas > 0 {
+		m.request.ResourceLimits = []*pb.ResourceLimit{
+			{Type: pb.ResourceType_CPU, Limit: int64(gas)},
+		}
+	}
+	return m, nil
+}
+
+// requests return the contract requests of mapped transaction, the bind and record requests are the first
+func (m *mappedTx) requests() []*pb.InvokeRequest {
+	var requests []*pb.InvokeRequest
+	if m.bind != nil {
+		requests = append(requests, m.bind)
+	}
+	if m.record != nil {
+		requests = append(requests, m.record)
+	}
+	if m.request != nil {
+		requests = append(requests, m.request)
+	}
+	return requests
+}
+
+// recordRequest record the signed ethereum transaction on chain, the chain rejects it if the sender
+// is not bound to the initiator, the hash exists or the nonce is not the next nonce of sender
+func recordRequest(raw []byte) *pb.InvokeRequest {
+	return &pb.InvokeRequest{
+		ModuleName: kernelModuleName,
+		MethodName: recordMethodName,
+		Args: map[string][]byte{
+			"raw": raw,
+		},
+	}
+}
+
+// bindRequest bind the ethereum address signing the initiator to the initiator on chain
+func bindRequest(signature []byte) *pb.InvokeRequest {
+	return &pb.InvokeRequest{
+		ModuleName: kernelModuleName,
+		MethodName: bindMethodName,
+		Args: map[string][]byte{
+			"signature": signature,
+		},
+	}
+}
+
+// parseRecordRequest return the sender and nonce of ethereum transaction recorded by tx
+func parseRecordRequest(tx *pb.Transaction) (ethAddress, uint64, bool) {
+	for _, req := range tx.GetContractRequests() {
+		if req.GetModuleName() != kernelModuleName || req.GetMethodName() != recordMethodName {
+			continue
+		}
+		var from ethAddress
+		etx, err := ethtx.Decode(req.GetArgs()["raw"])
+		if err != nil {
+			return from, 0, false
+		}
+		sender, err := etx.Sender(etx.ChainID())
+		if err != nil || len(sender) != len(from) {
+			return from, 0, false
+		}
+		copy(from[:], sender)
+		return from, etx.Nonce, true
+	}
+	return ethAddress{}, 0, false
+}
+
+// deployContractName derive the name of contract from the created address, the same as ethereum.
+// xuper contract name must start with a letter and no longer than 16 characters
+func deployContractName(from ethAddress, nonce uint64) string {
+	addr := ethtx.CreateAddress(from[:], nonce)
+	return "e" + hex.EncodeToString(addr)[:15]
+}
+
+func deployRequest(contractAccount, contractName string, code []byte) (*pb.InvokeRequest, error) {
+	desc, err := proto.Marshal(&pb.WasmCodeDesc{
+		ContractType: evmModuleName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.InvokeRequest{
+		ModuleName: kernelModuleName,
+		MethodName: deployMethodName,
+		Args: map[string][]byte{
+			"account_name":  []byte(contractAccount),
+			"contract_name": []byte(contractName),
+			// 未设置jsonEncoded, 合约代码即为带构造参数的部署字节码
+			"contract_code": code,
+			"contract_desc": desc,
+			"init_args":     []byte("{}"),
+			// 合约事件以原始日志的形式保存, 以便转换为以太坊日志
+			"contract_abi": []byte("[]"),
+		},
+	}, nil
+}
+
+func isEvmDeploy(req *pb.InvokeRequest) bool {
+	desc := new(pb.WasmCodeDesc)
+	if err := proto.Unmarshal(req.GetArgs()["contract_desc"], desc); err != nil {
+		return false
+	}
+	return desc.GetContractType() == evmModuleName
+}
+
+// xchainAddress return the xuper address of ethereum address, the bound xuper address is used if exists
+func (s *ethService) xchainAddress(addr ethAddress) (string, error) {
+	if acc, ok := s.accounts.get(addr); ok {
+		return acc.address, nil
+	}
+	address, _, err := evm.DetermineEVMAddress(crypto.Address(addr))
+	return address, err
+}
+
+// xchainToEthAddress convert xuper address, contract account or contract name to ethereum address
+func xchainToEthAddress(address string) (ethAddress, error) {
+	var addr crypto.Address
+	var err error
+	if evm.DetermineContractAccount(address) {
+		addr, err = evm.ContractAccountToEVMAddress(address)
+	} else if evm.DetermineContractName(address) == nil {
+		addr, err = evm.ContractNameToEVMAddress(address)
+	} else {
+		addr, err = evm.XchainToEVMAddress(address)
+	}
+	return ethAddress(addr), err
+}
+
+func (s *ethService) preExec(m *mappedTx) (*pb.InvokeResponse, error) {
+	ctx, cancel := s.context()
+	defer cancel()
+	resp, err := s.client.PreExec(ctx, &pb.InvokeRPCRequest{
+		Header:      global.GHeader(),
+		Bcname:      s.bcname,
+		Requests:    m.requests(),
+		Initiator:   m.initiator,
+		AuthRequire: []string{m.authRequire},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := checkHeader(resp.GetHeader()); err != nil {
+		return nil, err
+	}
+	for _, res := range resp.GetResponse().GetResponses() {
+		if res.GetStatus() >= 400 {
+			return nil, fmt.Errorf("execution reverted: %s", res.GetMessage())
+		}
+	}
+	return resp.GetResponse(), nil
+}
+
+// buildTx pre-execute the contract request and build the xuper transaction signed by the bound keys
+func (s *ethService) buildTx(acc *account, m *mappedTx, desc []byte) (*pb.Transaction, error) {
+	tx := &pb.Transaction{
+		Desc:      desc,
+		Nonce:     global.GenNonce(),
+		Timestamp: time.Now().UnixNano(),
+		Version:   utxo.TxVersion,
+		Initiator: m.initiator,
+	}
+	if len(m.requests()) != 0 {
+		resp, err := s.preExec(m)
+		if err != nil {
+			return nil, err
+		}
+		tx.TxInputsExt = resp.GetInputs()
+		tx.TxOutputsExt = resp.GetOutputs()
+		tx.ContractRequests = resp.GetRequests()
+		tx.TxInputs = append(tx.TxInputs, resp.GetUtxoInputs()...)
+		tx.TxOutputs = append(tx.TxOutputs, resp.GetUtxoOutputs()...)
+		if resp.GetGasUsed() > 0 {
+			tx.TxOutputs = append(tx.TxOutputs, &pb.TxOutput{
+				ToAddr: []byte(feeAddress),
+				Amount: big.NewInt(resp.GetGasUsed()).Bytes(),
+			})
+		}
+	}
+	if m.value.Sign() > 0 {
+		tx.TxOutputs = append(tx.TxOutputs, &pb.TxOutput{
+			ToAddr: []byte(m.to),
+			Amount: m.value.Bytes(),
+		})
+	}
+	totalNeed := new(big.Int)
+	for _, output := range tx.TxOutputs {
+		totalNeed.Add(totalNeed, new(big.Int).SetBytes(output.GetAmount()))
+	}
+	if totalNeed.Sign() > 0 {
+		inputs, change, err := s.selectUTXO(m.initiator, totalNeed)
+		if err != nil {
+			return nil, err
+		}
+		tx.TxInputs = append(tx.TxInputs, inputs...)
+		if change != nil {
+			tx.TxOutputs = append(tx.TxOutputs, change)
+		}
+	}
+	tx.AuthRequire = []string{m.authRequire}
+
+	cryptoClient, err := crypto_client.CreateCryptoClientFromJSONPublicKey([]byte(acc.publicKey))
+	if err != nil {
+		return nil, err
+	}
+	sign, err := txhash.ProcessSignTx(cryptoClient, tx, []byte(acc.privateKey))
+	if err != nil {
+		return nil, err
+	}
+	signInfo := &pb.SignatureInfo{
+		PublicKey: acc.publicKey,
+		Sign:      sign,
+	}
+	tx.InitiatorSigns = []*pb.SignatureInfo{signInfo}
+	tx.AuthRequireSigns = []*pb.SignatureInfo{signInfo}
+	if tx.Txid, err = txhash.MakeTransactionID(tx); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// selectUTXO select the utxos of address, the change is returned as an output to the address
+func (s *ethService) selectUTXO(address string, totalNeed *big.Int) ([]*pb.TxInput, *pb.TxOutput, error) {
+	ctx, cancel := s.context()
+	defer cancel()
+	utxoOutput, err := s.client.SelectUTXO(ctx, &pb.UtxoInput{
+		Header:    global.GHeader(),
+		Bcname:    s.bcname,
+		Address:   address,
+		TotalNeed: totalNeed.String(),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := checkHeader(utxoOutput.GetHeader()); err != nil {
+		return nil, nil, fmt.Errorf("insufficient funds: %v", err)
+	}
+	var inputs []*pb.TxInput
+	for _, u := range utxoOutput.GetUtxoList() {
+		inputs = append(inputs, &pb.TxInput{
+			RefTxid:   u.GetRefTxid(),
+			RefOffset: u.GetRefOffset(),
+			FromAddr:  u.GetToAddr(),
+			Amount:    u.GetAmount(),
+		})
+	}
+	total, ok := new(big.Int).SetString(utxoOutput.GetTotalSelected(), 10)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid total selected %s", utxoOutput.GetTotalSelected())
+	}
+	if total.Cmp(totalNeed) <= 0 {
+		return inputs, nil, nil
+	}
+	return inputs, &pb.TxOutput{
+		ToAddr: []byte(address),
+		Amount: total.Sub(total, totalNeed).Bytes(),
+	}, nil
+}
+
+func (s *ethService) postTx(tx *pb.Transaction) error {
+	ctx, cancel := s.context()
+	defer cancel()
+	reply, err := s.client.PostTx(ctx, &pb.TxStatus{
+		Header: global.GHeader(),
+		Bcname: s.bcname,
+		Status: pb.TransactionStatus_UNCONFIRM,
+		Tx:     tx,
+		Txid:   tx.GetTxid(),
+	})
+	if err != nil {
+		return err
+	}
+	return checkHeader(reply.GetHeader())
+}
@@ -0,0 +1,260 @@
+// Package kvdbtest is the conformance test suite for kvdb.Database engines.
+// Every engine plugin runs the same suite in its own tests, e.g.
+//
+//	func TestConformance(t *testing.T) {
+//		kvdbtest.RunSuite(t, func() kvdb.Database { return &PebbleDatabase{} })
+//	}
+package kvdbtest
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/xuperchain/xuperchain/core/kv/kvdb"
+)
+
+// NewDatabaseFunc returns a new unopened instance of the engine
+type NewDatabaseFunc func() kvdb.Database
+
+type testCase struct {
+	name string
+	fn   func(t *testing.T, db kvdb.Database)
+}
+
+var testCases = []testCase{
+	{"PutGet", testPutGet},
+	{"Delete", testDelete},
+	{"Batch", testBatch},
+	{"BatchPutIfAbsent", testBatchPutIfAbsent},
+	{"PrefixIterator", testPrefixIterator},
+	{"RangeIterator", testRangeIterator},
+	{"ReverseIterator", testReverseIterator},
+	{"FirstLast", testFirstLast},
+	{"EmptyIterator", testEmptyIterator},
+}
+
+// RunSuite runs all the conformance tests, each test runs with a new database
+func RunSuite(t *testing.T, newDB NewDatabaseFunc) {
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			path, err := ioutil.TempDir("", "kvdbtest")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(path)
+			db := newDB()
+			err = db.Open(path, map[string]interface{}{
+				"cache":     16,
+				"fds":       16,
+				"dataPaths": []string{},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+			tc.fn(t, db)
+		})
+	}
+}
+
+func put(t *testing.T, db kvdb.Database, keys ...string) {
+	for _, key := range keys {
+		if err := db.Put([]byte(key), []byte("v"+key)); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// collect iterates with step until it returns false, and returns the keys
+func collect(t *testing.T, iter kvdb.Iterator, step func() bool) []string {
+	var keys []string
+	for step() {
+		keys = append(keys, string(iter.Key()))
+		if string(iter.Value()) != "v"+string(iter.Key()) {
+			t.Fatalf("bad value %s of key %s", iter.Value(), iter.Key())
+		}
+	}
+	if err := iter.Error(); err != nil {
+		t.Fatal(err)
+	}
+	return keys
+}
+
+func expectKeys(t *testing.T, got []string, expect ...string) {
+	if fmt.Sprint(got) != fmt.Sprint(expect) {
+		t.Fatalf("expect keys %v, got %v", expect, got)
+	}
+}
+
+func testPutGet(t *testing.T, db kvdb.Database) {
+	put(t, db, "key1")
+	value, err := db.Get([]byte("key1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(value, []byte("vkey1")) {
+		t.Fatalf("expect vkey1, got %s", value)
+	}
+	exist, err := db.Has([]byte("key1"))
+	if err != nil || !exist {
+		t.Fatalf("expect key1 exists, got %v %v", exist, err)
+	}
+
+	_, err = db.Get([]byte("key2"))
+	if err == nil || !kvdb.ErrNotFound(err) {
+		t.Fatalf("expect not found error, got %v", err)
+	}
+	exist, err = db.Has([]byte("key2"))
+	if err != nil || exist {
+		t.Fatalf("expect key2 not exists, got %v %v", exist, err)
+	}
+}
+
+func testDelete(t *testing.T, db kvdb.Database) {
+	put(t, db, "key1")
+	if err := db.Delete([]byte("key1")); err != nil {
+		t.Fatal(err)
+	}
+	exist, err := db.Has([]byte("key1"))
+	if err != nil || exist {
+		t.Fatalf("expect key1 deleted, got %v %v", exist, err)
+	}
+}
+
+func testBatch(t *testing.T, db kvdb.Database) {
+	put(t, db, "key0")
+	batch := db.NewBatch()
+	batch.Put([]byte("key1"), []byte("vkey1"))
+	batch.Put([]byte("key2"), []byte("vkey2"))
+	batch.Delete([]byte("key0"))
+	if batch.ValueSize() == 0 {
+		t.Fatal("expect batch value size > 0")
+	}
+	exist, _ := db.Has([]byte("key1"))
+	if exist {
+		t.Fatal("batch should not be visible before write")
+	}
+	if err := batch.Write(); err != nil {
+		t.Fatal(err)
+	}
+	iter := db.NewIteratorWithPrefix([]byte("key"))
+	defer iter.Release()
+	expectKeys(t, collect(t, iter, iter.Next), "key1", "key2")
+
+	// batch is reusable after reset
+	batch.Reset()
+	if batch.ValueSize() != 0 {
+		t.Fatalf("expect value size 0 after reset, got %d", batch.ValueSize())
+	}
+	batch.Put([]byte("key3"), []byte("vkey3"))
+	if err := batch.Write(); err != nil {
+		t.Fatal(err)
+	}
+	exist, _ = db.Has([]byte("key3"))
+	if !exist {
+		t.Fatal("expect key3 exists after reused batch written")
+	}
+}
+
+func testBatchPutIfAbsent(t *testing.T, db kvdb.Database) {
+	batch := db.NewBatch()
+	if err := batch.PutIfAbsent([]byte("key1"), []byte("vkey1")); err != nil {
+		t.Fatal(err)
+	}
+	if !batch.Exist([]byte("key1")) {
+		t.Fatal("expect key1 exists in batch")
+	}
+	if err := batch.PutIfAbsent([]byte("key1"), []byte("vkey1")); err == nil {
+		t.Fatal("expect error when put duplicated key")
+	}
+	if err := batch.Write(); err != nil {
+		t.Fatal(err)
+	}
+	batch.Reset()
+	if batch.Exist([]byte("key1")) {
+		t.Fatal("expect batch empty after reset")
+	}
+}
+
+func testPrefixIterator(t *testing.T, db kvdb.Database) {
+	put(t, db, "a1", "b1", "b2", "b3", "c1")
+	iter := db.NewIteratorWithPrefix([]byte("b"))
+	defer iter.Release()
+	expectKeys(t, collect(t, iter, iter.Next), "b1", "b2", "b3")
+
+	// prefix with 0xff
+	put(t, db, "\xff1", "\xff\xff")
+	iter2 := db.NewIteratorWithPrefix([]byte("\xff"))
+	defer iter2.Release()
+	expectKeys(t, collect(t, iter2, iter2.Next), "\xff1", "\xff\xff")
+}
+
+func testRangeIterator(t *testing.T, db kvdb.Database) {
+	put(t, db, "a1", "b1", "b2", "b3", "c1")
+	iter := db.NewIteratorWithRange([]byte("a2"), []byte("b3"))
+	defer iter.Release()
+	expectKeys(t, collect(t, iter, iter.Next), "b1", "b2")
+}
+
+func testReverseIterator(t *testing.T, db kvdb.Database) {
+	put(t, db, "a1", "b1", "b2", "b3", "c1")
+	iter := db.NewIteratorWithPrefix([]byte("b"))
+	defer iter.Release()
+	if !iter.Last() {
+		t.Fatal("expect last is valid")
+	}
+	keys := []string{string(iter.Key())}
+	expectKeys(t, append(keys, collect(t, iter, iter.Prev)...), "b3", "b2", "b1")
+
+	riter := db.NewIteratorWithRange([]byte("a1"), []byte("b3"))
+	defer riter.Release()
+	if !riter.Last() {
+		t.Fatal("expect last is valid")
+	}
+	keys = []string{string(riter.Key())}
+	expectKeys(t, append(keys, collect(t, riter, riter.Prev)...), "b2", "b1", "a1")
+
+	// change direction in the middle
+	miter := db.NewIteratorWithPrefix([]byte("b"))
+	defer miter.Release()
+	miter.Next()
+	miter.Next()
+	if !miter.Prev() || string(miter.Key()) != "b1" {
+		t.Fatalf("expect b1 after Prev, got %s", miter.Key())
+	}
+	if !miter.Next() || string(miter.Key()) != "b2" {
+		t.Fatalf("expect b2 after Next, got %s", miter.Key())
+	}
+}
+
+func testFirstLast(t *testing.T, db kvdb.Database) {
+	put(t, db, "a1", "b1", "b2", "b3", "c1")
+	iter := db.NewIteratorWithPrefix([]byte("b"))
+	defer iter.Release()
+	if !iter.Last() || string(iter.Key()) != "b3" {
+		t.Fatalf("expect last b3, got %s", iter.Key())
+	}
+	if !iter.First() || string(iter.Key()) != "b1" {
+		t.Fatalf("expect first b1, got %s", iter.Key())
+	}
+	expectKeys(t, collect(t, iter, iter.Next), "b2", "b3")
+}
+
+func testEmptyIterator(t *testing.T, db kvdb.Database) {
+	put(t, db, "a1", "c1")
+	iter := db.NewIteratorWithPrefix([]byte("b"))
+	defer iter.Release()
+	if iter.Next() {
+		t.Fatalf("expect no key, got %s", iter.Key())
+	}
+	if iter.Last() {
+		t.Fatalf("expect no last key, got %s", iter.Key())
+	}
+	if iter.First() {
+		t.Fatalf("expect no first key, got %s", iter.Key())
+	}
+}
@@ -0,0 +1,221 @@
+package migrate
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/xuperchain/xuperchain/core/kv/kvdb"
+)
+
+// ChainDBs are the databases under the data path of a chain: blocks in ledger, utxo and states in utxoVM
+var ChainDBs = []string{"ledger", "utxoVM"}
+
+// genesisFile is the genesis config of chain, the kvengine of chain is read from it
+const genesisFile = "xuper.json"
+
+// ChainOptions is the options to migrate the storage of a chain
+type ChainOptions struct {
+	// SrcPath and DstPath are the data paths of chain, such as data/blockchain/xuper
+	SrcPath string
+	DstPath string
+	// SrcEngine and DstEngine are the kv plugin subtypes, such as default and badger
+	SrcEngine string
+	DstEngine string
+	// OtherPaths are the data paths of multi disk engines
+	SrcOtherPaths []string
+	DstOtherPaths []string
+
+	BatchSize             int
+	MemCacheSize          int
+	FileHandlersCacheSize int
+	// Progress is called with the db name and the number of records copied
+	Progress func(db string, count int64)
+}
+
+// MigrateChain copies all the databases of a stopped chain to a new data path with another kv engine,
+// verifies the record count and hash of every table, and writes the genesis config with the new kvengine.
+func MigrateChain(opt *ChainOptions) (map[string]Stats, error) {
+	if opt.SrcPath == "" || opt.DstPath == "" {
+		return nil, errors.New("src and dst path required")
+	}
+	srcAbs, _ := filepath.Abs(opt.SrcPath)
+	dstAbs, _ := filepath.Abs(opt.DstPath)
+	if srcAbs == dstAbs {
+		return nil, errors.New("src and dst path should be different")
+	}
+	if _, err := os.Stat(filepath.Join(opt.SrcPath, genesisFile)); err != nil {
+		return nil, fmt.Errorf("chain not found in %s: %s", opt.SrcPath, err)
+	}
+	if err := os.MkdirAll(opt.DstPath, 0755); err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]Stats)
+	for _, name := range ChainDBs {
+		stats, err := migrateDB(opt, name)
+		if err != nil {
+			return nil, fmt.Errorf("migrate %s error: %s", name, err)
+		}
+		result[name] = stats
+	}
+
+	if err := writeGenesis(opt); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func migrateDB(opt *ChainOptions, name string) (Stats, error) {
+	src, err := kvdb.NewKVDBInstance(&kvdb.KVParameter{
+		DBPath:                filepath.Join(opt.SrcPath, name),
+		KVEngineType:          opt.SrcEngine,
+		MemCacheSize:          opt.MemCacheSize,
+		FileHandlersCacheSize: opt.FileHandlersCacheSize,
+		OtherPaths:            opt.SrcOtherPaths,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+
+	dst, err := kvdb.NewKVDBInstance(&kvdb.KVParameter{
+		DBPath:                filepath.Join(opt.DstPath, name),
+		KVEngineType:          opt.DstEngine,
+		MemCacheSize:          opt.MemCacheSize,
+		FileHandlersCacheSize: opt.FileHandlersCacheSize,
+		OtherPaths:            opt.DstOtherPaths,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer dst.Close()
+
+	var progress func(int64)
+	if opt.Progress != nil {
+		progress = func(count int64) {
+			opt.Progress(name, count)
+		}
+	}
+	return CopyAndVerify(src, dst, opt.BatchSize, progress)
+}
+
+// writeGenesis copies the genesis config to dst with kvengine set to the new engine
+func writeGenesis(opt *ChainOptions) error {
+	buf, err := ioutil.ReadFile(filepath.Join(opt.SrcPath, genesisFile))
+	if err != nil {
+		return err
+	}
+	buf, err = SetKVEngine(buf, opt.DstEngine)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(opt.DstPath, genesisFile), buf, 0644)
+}
+
+// SetKVEngine sets the kvengine of genesis config to engine, the other fields are kept as they are
+func SetKVEngine(genesis []byte, engine string) ([]byte, error) {
+	rootJSON := map[string]json.RawMessage{}
+	if err := json.Unmarshal(genesis, &rootJSON); err != nil {
+		return nil, fmt.Errorf("parse %s error: %s", genesisFile, err)
+	}
+	value, err := json.Marshal(engine)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]byte, 0, len(genesis)+len(value)+16)
+	if start, end := fieldValueSpan(genesis, "kvengine"); start >= 0 {
+		res = append(res, genesis[:start]...)
+		res = append(res, value...)
+		return append(res, genesis[end:]...), nil
+	}
+	// 没有kvengine字段时插入到第一个字段之前, 沿用其缩进
+	open := bytes.IndexByte(genesis, '{') + 1
+	indent := genesis[open:skipSpace(genesis, open)]
+	res = append(res, genesis[:open]...)
+	res = append(res, indent...)
+	res = append(res, `"kvengine": `...)
+	res = append(res, value...)
+	if len(rootJSON) > 0 {
+		res = append(res, ',')
+	}
+	return append(res, genesis[open:]...), nil
+}
+
+// fieldValueSpan returns the span of the value of the top level field name in a valid JSON object,
+// or -1 if the field is not found
+func fieldValueSpan(buf []byte, name string) (int, int) {
+	depth := 0
+	for i := 0; i < len(buf); i++ {
+		switch buf[i] {
+		case '"':
+			end := stringEnd(buf, i)
+			if next := skipSpace(buf, end); depth == 1 && next < len(buf) && buf[next] == ':' {
+				var key string
+				if json.Unmarshal(buf[i:end], &key) == nil && key == name {
+					start := skipSpace(buf, next+1)
+					return start, valueEnd(buf, start)
+				}
+			}
+			i = end - 1
+		case '{', '[':
+			depth++
+		case '}', ']':
+			depth--
+		}
+	}
+	return -1, -1
+}
+
+// valueEnd returns the end of the JSON value starting at start
+func valueEnd(buf []byte, start int) int {
+	depth := 0
+	for i := start; i < len(buf); i++ {
+		switch buf[i] {
+		case '"':
+			i = stringEnd(buf, i) - 1
+			if depth == 0 {
+				return i + 1
+			}
+		case '{', '[':
+			depth++
+		case '}', ']':
+			if depth == 0 {
+				return i
+			}
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		case ',', ' ', '\t', '\r', '\n':
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return len(buf)
+}
+
+// stringEnd returns the index after the closing quote of the JSON string starting at start
+func stringEnd(buf []byte, start int) int {
+	for i := start + 1; i < len(buf); i++ {
+		switch buf[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1
+		}
+	}
+	return len(buf)
+}
+
+func skipSpace(buf []byte, i int) int {
+	for i < len(buf) && (buf[i] == ' ' || buf[i] == '\t' || buf[i] == '\r' || buf[i] == '\n') {
+		i++
+	}
+	return i
+}
@@ -0,0 +1,185 @@
+// Package migrate copies the records of a kvdb.Database into another one,
+// which is used to convert the storage of a stopped node between kvdb engines.
+package migrate
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"sort"
+
+	"github.com/xuperchain/xuperchain/core/kv/kvdb"
+)
+
+// DefaultBatchSize is the default size in bytes of values written in one batch
+const DefaultBatchSize = 4 * 1024 * 1024
+
+// ErrDestNotEmpty returns when the destination database already has records
+var ErrDestNotEmpty = errors.New("destination database is not empty")
+
+// TableStat is the statistics of the records in one table
+type TableStat struct {
+	// Prefix is the table prefix, such as B for blocks and ZU for extended utxo
+	Prefix string
+	Count  int64
+	// Hash is the sha256 of all the length prefixed keys and values in order
+	Hash []byte
+
+	h hash.Hash
+}
+
+// Stats is the statistics of all tables in a database
+type Stats map[string]*TableStat
+
+// tablePrefix returns the table of key, table prefix is one letter except the extended tables begin with Z
+func tablePrefix(key []byte) string {
+	if len(key) == 0 {
+		return ""
+	}
+	if key[0] == 'Z' && len(key) > 1 {
+		return string(key[:2])
+	}
+	return string(key[:1])
+}
+
+// Add accumulates a record into the stat of its table
+func (s Stats) Add(key, value []byte) {
+	prefix := tablePrefix(key)
+	stat, ok := s[prefix]
+	if !ok {
+		stat = &TableStat{
+			Prefix: prefix,
+			h:      sha256.New(),
+		}
+		s[prefix] = stat
+	}
+	var lenbuf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(lenbuf[:], uint64(len(key)))
+	stat.h.Write(lenbuf[:n])
+	stat.h.Write(key)
+	n = binary.PutUvarint(lenbuf[:], uint64(len(value)))
+	stat.h.Write(lenbuf[:n])
+	stat.h.Write(value)
+	stat.Count++
+}
+
+// Finish computes the hash of every table after all records added
+func (s Stats) Finish() Stats {
+	for _, stat := range s {
+		stat.Hash = stat.h.Sum(nil)
+	}
+	return s
+}
+
+// Prefixes returns the table prefixes in order
+func (s Stats) Prefixes() []string {
+	prefixes := make([]string, 0, len(s))
+	for prefix := range s {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+	return prefixes
+}
+
+// Compare returns error if the tables of two stats are not the same
+func (s Stats) Compare(other Stats) error {
+	if len(s) != len(other) {
+		return fmt.Errorf("table count mismatch, expect %d got %d", len(s), len(other))
+	}
+	for prefix, stat := range s {
+		ostat, ok := other[prefix]
+		if !ok {
+			return fmt.Errorf("table %q not found", prefix)
+		}
+		if stat.Count != ostat.Count {
+			return fmt.Errorf("table %q record count mismatch, expect %d got %d", prefix, stat.Count, ostat.Count)
+		}
+		if !bytes.Equal(stat.Hash, ostat.Hash) {
+			return fmt.Errorf("table %q hash mismatch, expect %x got %x", prefix, stat.Hash, ostat.Hash)
+		}
+	}
+	return nil
+}
+
+// Stat scans all the records of db and returns the table stats
+func Stat(db kvdb.Database) (Stats, error) {
+	stats := make(Stats)
+	iter := db.NewIteratorWithPrefix(nil)
+	defer iter.Release()
+	for iter.Next() {
+		stats.Add(iter.Key(), iter.Value())
+	}
+	if iter.Error() != nil {
+		return nil, iter.Error()
+	}
+	return stats.Finish(), nil
+}
+
+// Copy streams all the records of src into dst which must be empty, and returns the stats of src.
+// progress is called after every batch written if not nil.
+func Copy(src, dst kvdb.Database, batchSize int, progress func(count int64)) (Stats, error) {
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+	diter := dst.NewIteratorWithPrefix(nil)
+	notEmpty := diter.Next()
+	diter.Release()
+	if notEmpty {
+		return nil, ErrDestNotEmpty
+	}
+
+	stats := make(Stats)
+	var count int64
+	batch := dst.NewBatch()
+	iter := src.NewIteratorWithPrefix(nil)
+	defer iter.Release()
+	for iter.Next() {
+		// keys and values of iterator are only valid until next move
+		key := append([]byte(nil), iter.Key()...)
+		value := append([]byte(nil), iter.Value()...)
+		stats.Add(key, value)
+		if err := batch.Put(key, value); err != nil {
+			return nil, err
+		}
+		count++
+		if batch.ValueSize() < batchSize {
+			continue
+		}
+		if err := batch.Write(); err != nil {
+			return nil, err
+		}
+		batch.Reset()
+		if progress != nil {
+			progress(count)
+		}
+	}
+	if iter.Error() != nil {
+		return nil, iter.Error()
+	}
+	if err := batch.Write(); err != nil {
+		return nil, err
+	}
+	if progress != nil {
+		progress(count)
+	}
+	return stats.Finish(), nil
+}
+
+// CopyAndVerify copies src into dst, and verifies dst has the same records as src
+func CopyAndVerify(src, dst kvdb.Database, batchSize int, progress func(count int64)) (Stats, error) {
+	srcStats, err := Copy(src, dst, batchSize, progress)
+	if err != nil {
+		return nil, err
+	}
+	dstStats, err := Stat(dst)
+	if err != nil {
+		return nil, err
+	}
+	if err := srcStats.Compare(dstStats); err != nil {
+		return nil, fmt.Errorf("verify failed: %s", err)
+	}
+	return srcStats, nil
+}
@@ -0,0 +1,220 @@
+package migrate
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/xuperchain/xuperchain/core/kv/kvdb"
+)
+
+// memDatabase is a simple in memory kvdb.Database for testing
+type memDatabase struct {
+	data map[string][]byte
+}
+
+func newMemDatabase() *memDatabase {
+	return &memDatabase{data: make(map[string][]byte)}
+}
+
+func (m *memDatabase) Open(path string, options map[string]interface{}) error { return nil }
+func (m *memDatabase) Close()                                                 {}
+
+func (m *memDatabase) Put(key, value []byte) error {
+	m.data[string(key)] = append([]byte(nil), value...)
+	return nil
+}
+
+func (m *memDatabase) Get(key []byte) ([]byte, error) {
+	value, ok := m.data[string(key)]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return value, nil
+}
+
+func (m *memDatabase) Has(key []byte) (bool, error) {
+	_, ok := m.data[string(key)]
+	return ok, nil
+}
+
+func (m *memDatabase) Delete(key []byte) error {
+	delete(m.data, string(key))
+	return nil
+}
+
+func (m *memDatabase) NewBatch() kvdb.Batch {
+	return &memBatch{db: m, keys: map[string]bool{}}
+}
+
+func (m *memDatabase) NewIteratorWithRange(start, limit []byte) kvdb.Iterator {
+	return m.newIterator(func(key string) bool {
+		return key >= string(start) && (limit == nil || key < string(limit))
+	})
+}
+
+func (m *memDatabase) NewIteratorWithPrefix(prefix []byte) kvdb.Iterator {
+	return m.newIterator(func(key string) bool {
+		return strings.HasPrefix(key, string(prefix))
+	})
+}
+
+func (m *memDatabase) newIterator(match func(string) bool) kvdb.Iterator {
+	iter := &memIterator{db: m, pos: -1}
+	for key := range m.data {
+		if match(key) {
+			iter.keys = append(iter.keys, key)
+		}
+	}
+	sort.Strings(iter.keys)
+	return iter
+}
+
+type memIterator struct {
+	db   *memDatabase
+	keys []string
+	pos  int
+}
+
+func (i *memIterator) valid() bool   { return i.pos >= 0 && i.pos < len(i.keys) }
+func (i *memIterator) Next() bool    { i.pos++; return i.valid() }
+func (i *memIterator) Prev() bool    { i.pos--; return i.valid() }
+func (i *memIterator) First() bool   { i.pos = 0; return i.valid() }
+func (i *memIterator) Last() bool    { i.pos = len(i.keys) - 1; return i.valid() }
+func (i *memIterator) Error() error  { return nil }
+func (i *memIterator) Release()      {}
+func (i *memIterator) Key() []byte   { return []byte(i.keys[i.pos]) }
+func (i *memIterator) Value() []byte { return i.db.data[i.keys[i.pos]] }
+
+type memBatch struct {
+	db   *memDatabase
+	ops  [][2][]byte
+	size int
+	keys map[string]bool
+}
+
+func (b *memBatch) Put(key, value []byte) error {
+	b.ops = append(b.ops, [2][]byte{key, value})
+	b.size += len(value)
+	return nil
+}
+
+func (b *memBatch) Delete(key []byte) error {
+	b.ops = append(b.ops, [2][]byte{key, nil})
+	return nil
+}
+
+func (b *memBatch) PutIfAbsent(key, value []byte) error {
+	if b.keys[string(key)] {
+		return errors.New("duplicated key")
+	}
+	b.keys[string(key)] = true
+	return b.Put(key, value)
+}
+
+func (b *memBatch) Exist(key []byte) bool { return b.keys[string(key)] }
+func (b *memBatch) ValueSize() int        { return b.size }
+
+func (b *memBatch) Write() error {
+	for _, op := range b.ops {
+		if op[1] == nil {
+			b.db.Delete(op[0])
+			continue
+		}
+		b.db.Put(op[0], op[1])
+	}
+	return nil
+}
+
+func (b *memBatch) Reset() {
+	b.ops = nil
+	b.size = 0
+	b.keys = map[string]bool{}
+}
+
+func TestCopyAndVerify(t *testing.T) {
+	src := newMemDatabase()
+	for i := 0; i < 100; i++ {
+		src.Put([]byte(fmt.Sprintf("B%03d", i)), []byte("block"))
+		src.Put([]byte(fmt.Sprintf("ZU%03d", i)), []byte("utxo"))
+	}
+	src.Put([]byte("M"), []byte("meta"))
+
+	dst := newMemDatabase()
+	var batches int
+	stats, err := CopyAndVerify(src, dst, 64, func(int64) { batches++ })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if batches < 2 {
+		t.Fatalf("expect multiple batches, got %d", batches)
+	}
+	if fmt.Sprint(stats.Prefixes()) != "[B M ZU]" {
+		t.Fatalf("unexpected tables %v", stats.Prefixes())
+	}
+	if stats["B"].Count != 100 || stats["ZU"].Count != 100 || stats["M"].Count != 1 {
+		t.Fatalf("unexpected count B:%d ZU:%d M:%d", stats["B"].Count, stats["ZU"].Count, stats["M"].Count)
+	}
+	if len(dst.data) != len(src.data) {
+		t.Fatalf("expect %d records, got %d", len(src.data), len(dst.data))
+	}
+
+	// copy to a non empty database
+	if _, err := Copy(src, dst, 0, nil); err != ErrDestNotEmpty {
+		t.Fatalf("expect ErrDestNotEmpty, got %v", err)
+	}
+}
+
+func TestStatsCompare(t *testing.T) {
+	db := newMemDatabase()
+	db.Put([]byte("B1"), []byte("block"))
+	db.Put([]byte("U1"), []byte("utxo"))
+	expect, err := Stat(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db.Put([]byte("U1"), []byte("utxo2"))
+	got, _ := Stat(db)
+	if err := expect.Compare(got); err == nil || !strings.Contains(err.Error(), "hash mismatch") {
+		t.Fatalf("expect hash mismatch, got %v", err)
+	}
+
+	db.Put([]byte("U2"), []byte("utxo"))
+	got, _ = Stat(db)
+	if err := expect.Compare(got); err == nil || !strings.Contains(err.Error(), "count mismatch") {
+		t.Fatalf("expect count mismatch, got %v", err)
+	}
+
+	db.Put([]byte("C1"), []byte("confirmed"))
+	got, _ = Stat(db)
+	if err := expect.Compare(got); err == nil {
+		t.Fatal("expect table count mismatch")
+	}
+}
+
+func TestSetKVEngine(t *testing.T) {
+	genesis := "{\n    \"version\": \"1\",\n    \"kvengine\": \"default\",\n    \"award\": 100000000000000000001,\n" +
+		"    \"genesis_consensus\": {\"kvengine\": \"x\"}\n}"
+	buf, err := SetKVEngine([]byte(genesis), "badger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 只修改kvengine字段, 其余内容和格式保持不变
+	if expect := strings.Replace(genesis, `"default"`, `"badger"`, 1); string(buf) != expect {
+		t.Fatalf("unexpected genesis %s", buf)
+	}
+
+	buf, err = SetKVEngine([]byte("{\n  \"version\": \"1\"\n}"), "badger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(buf) != "{\n  \"kvengine\": \"badger\",\n  \"version\": \"1\"\n}" {
+		t.Fatalf("unexpected genesis %s", buf)
+	}
+	if _, err := SetKVEngine([]byte(`["kvengine"]`), "badger"); err == nil {
+		t.Fatal("expect error setting kvengine of invalid genesis")
+	}
+}
@@ -70,7 +70,11 @@ func (bdb *BadgerDatabase) Put(key []byte, value []byte) error {
 func (bdb *BadgerDatabase) Delete(key []byte) error {
 	wb := bdb.db.NewWriteBatch()
 	defer wb.Cancel()
-	return wb.Delete(key)
+	err := wb.Delete(key)
+	if err != nil {
+		return err
+	}
+	return wb.Flush()
 }
 
 func (bdb *BadgerDatabase) Get(key []byte) ([]byte, error) {
@@ -98,7 +102,7 @@ func (bdb *BadgerDatabase) Has(key []byte) (bool, error) {
 		return err
 	})
 	// align with leveldb, if the key doesn't exist, leveldb returns nil
-	if kvdb.ErrNotFound(err) {
+	if err != nil && kvdb.ErrNotFound(err) {
 		err = nil
 	}
 	return exist, err
@@ -177,6 +181,7 @@ func (b *BadgerBatch) ValueSize() int {
 
 func (b *BadgerBatch) Reset() {
 	b.size = 0
+	b.keys = map[string]bool{}
 }
 
 func (bdb *BadgerDatabase) NewIteratorWithPrefix(prefix []byte) kvdb.Iterator {
@@ -0,0 +1,14 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/xuperchain/xuperchain/core/kv/kvdb"
+	"github.com/xuperchain/xuperchain/core/kv/kvdb/kvdbtest"
+)
+
+func TestConformance(t *testing.T) {
+	kvdbtest.RunSuite(t, func() kvdb.Database {
+		return &BadgerDatabase{}
+	})
+}
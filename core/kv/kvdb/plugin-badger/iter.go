@@ -158,9 +158,13 @@ func (iter *BadgerIterator) First() bool {
 	for iter.Prev() {
 		key = iter.Key()
 	}
+	// no key in the range of iterator
+	if key == nil {
+		return false
+	}
 	iter.badgerIter.Seek(key)
 
-	return true
+	return iter.badgerIter.Valid()
 }
 
 func (iter *BadgerIterator) Error() error {
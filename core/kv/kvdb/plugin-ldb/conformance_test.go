@@ -0,0 +1,17 @@
+// +build single
+
+package main
+
+import (
+	"testing"
+
+	"github.com/xuperchain/xuperchain/core/kv/kvdb"
+	"github.com/xuperchain/xuperchain/core/kv/kvdb/kvdbtest"
+)
+
+// run with: go test --tags single
+func TestConformance(t *testing.T) {
+	kvdbtest.RunSuite(t, func() kvdb.Database {
+		return &LDBDatabase{}
+	})
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"github.com/cockroachdb/pebble"
+)
+
+// iterator position before the first key and after the last key, same as leveldb
+const (
+	dirSOI = iota
+	dirEOI
+	dirValid
+)
+
+// PebbleIterator wraps pebble.Iterator with the semantics of leveldb iterator:
+// a new iterator is positioned before the first key, Next moves to the first key,
+// and Prev after exhausted moves to the last key.
+type PebbleIterator struct {
+	iter *pebble.Iterator
+	dir  int
+}
+
+func newPebbleIterator(iter *pebble.Iterator) *PebbleIterator {
+	return &PebbleIterator{
+		iter: iter,
+		dir:  dirSOI,
+	}
+}
+
+func (p *PebbleIterator) update(valid bool, dir int) bool {
+	if valid {
+		p.dir = dirValid
+	} else {
+		p.dir = dir
+	}
+	return valid
+}
+
+// Key returns the key of current position, nil if the iterator is not valid
+func (p *PebbleIterator) Key() []byte {
+	if p.dir != dirValid {
+		return nil
+	}
+	return p.iter.Key()
+}
+
+// Value returns the value of current position, nil if the iterator is not valid
+func (p *PebbleIterator) Value() []byte {
+	if p.dir != dirValid {
+		return nil
+	}
+	return p.iter.Value()
+}
+
+func (p *PebbleIterator) Next() bool {
+	switch p.dir {
+	case dirSOI:
+		return p.update(p.iter.First(), dirEOI)
+	case dirEOI:
+		return false
+	}
+	return p.update(p.iter.Next(), dirEOI)
+}
+
+func (p *PebbleIterator) Prev() bool {
+	switch p.dir {
+	case dirSOI:
+		return false
+	case dirEOI:
+		return p.update(p.iter.Last(), dirSOI)
+	}
+	return p.update(p.iter.Prev(), dirSOI)
+}
+
+func (p *PebbleIterator) First() bool {
+	return p.update(p.iter.First(), dirEOI)
+}
+
+func (p *PebbleIterator) Last() bool {
+	return p.update(p.iter.Last(), dirSOI)
+}
+
+func (p *PebbleIterator) Error() error {
+	return p.iter.Error()
+}
+
+func (p *PebbleIterator) Release() {
+	p.iter.Close()
+}
@@ -0,0 +1,193 @@
+// pebble wrapper plugin
+// so 模式，package必须是main
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cockroachdb/pebble"
+	log "github.com/xuperchain/log15"
+
+	"github.com/xuperchain/xuperchain/core/kv/kvdb"
+)
+
+// PebbleDatabase define db backend based on pebble
+type PebbleDatabase struct {
+	fn  string     // filename of db
+	db  *pebble.DB // db instance
+	log log.Logger // logger instance
+}
+
+// GetInstance get instance of PebbleDatabase
+func GetInstance() interface{} {
+	return &PebbleDatabase{}
+}
+
+// Path returns the path to the database directory
+func (pdb *PebbleDatabase) Path() string {
+	return pdb.fn
+}
+
+// Open opens an instance of pebble with parameters (db path and other options)
+func (pdb *PebbleDatabase) Open(path string, options map[string]interface{}) error {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		os.MkdirAll(path, 0755)
+	}
+	logger := log.New("database", path)
+	// writes are not synced, same as leveldb with default write options
+	opts := &pebble.Options{}
+	// cache in MB and fds are shared with leveldb options
+	if cache, ok := options["cache"].(int); ok && cache > 0 {
+		opts.Cache = pebble.NewCache(int64(cache/2) * 1024 * 1024)
+		opts.MemTableSize = cache / 4 * 1024 * 1024
+		defer opts.Cache.Unref()
+	}
+	if fds, ok := options["fds"].(int); ok && fds > 0 {
+		opts.MaxOpenFiles = fds
+	}
+	db, err := pebble.Open(path, opts)
+	if err != nil {
+		logger.Warn("pebble open failed", "path", path, "err", err)
+		return err
+	}
+	pdb.fn = path
+	pdb.db = db
+	pdb.log = logger
+	return nil
+}
+
+// Close close database instance
+func (pdb *PebbleDatabase) Close() {
+	err := pdb.db.Close()
+	if err == nil {
+		pdb.log.Info("database closed")
+	} else {
+		pdb.log.Error("failed to close database", "err", err)
+	}
+}
+
+// Put puts the given key / value to the database
+func (pdb *PebbleDatabase) Put(key []byte, value []byte) error {
+	return pdb.db.Set(key, value, pebble.NoSync)
+}
+
+// Delete deletes the key from database
+func (pdb *PebbleDatabase) Delete(key []byte) error {
+	return pdb.db.Delete(key, pebble.NoSync)
+}
+
+// Get returns the given key if it's present.
+func (pdb *PebbleDatabase) Get(key []byte) ([]byte, error) {
+	value, closer, err := pdb.db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	// the value is only valid until closer.Close()
+	ret := make([]byte, len(value))
+	copy(ret, value)
+	return ret, nil
+}
+
+// Has if the given key exists
+func (pdb *PebbleDatabase) Has(key []byte) (bool, error) {
+	_, closer, err := pdb.db.Get(key)
+	// align with leveldb, if the key doesn't exist, leveldb returns nil
+	if err == pebble.ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	closer.Close()
+	return true, nil
+}
+
+// NewIteratorWithRange returns an instance of Iterator with range [start, limit)
+func (pdb *PebbleDatabase) NewIteratorWithRange(start []byte, limit []byte) kvdb.Iterator {
+	return newPebbleIterator(pdb.db.NewIter(&pebble.IterOptions{
+		LowerBound: start,
+		UpperBound: limit,
+	}))
+}
+
+// NewIteratorWithPrefix returns an instance of Iterator with prefix
+func (pdb *PebbleDatabase) NewIteratorWithPrefix(prefix []byte) kvdb.Iterator {
+	return newPebbleIterator(pdb.db.NewIter(&pebble.IterOptions{
+		LowerBound: prefix,
+		UpperBound: prefixLimit(prefix),
+	}))
+}
+
+// prefixLimit returns the smallest key larger than all keys with the prefix, nil if no such key
+func prefixLimit(prefix []byte) []byte {
+	limit := make([]byte, len(prefix))
+	copy(limit, prefix)
+	for i := len(limit) - 1; i >= 0; i-- {
+		if limit[i] < 0xff {
+			limit[i]++
+			return limit[:i+1]
+		}
+	}
+	return nil
+}
+
+// NewBatch returns batch instance of pebble
+func (pdb *PebbleDatabase) NewBatch() kvdb.Batch {
+	return &pebbleBatch{db: pdb.db, b: pdb.db.NewBatch(), keys: map[string]bool{}}
+}
+
+type pebbleBatch struct {
+	db   *pebble.DB
+	b    *pebble.Batch
+	size int
+	keys map[string]bool
+}
+
+func (b *pebbleBatch) Put(key, value []byte) error {
+	if err := b.b.Set(key, value, nil); err != nil {
+		return err
+	}
+	b.size += len(value)
+	return nil
+}
+
+func (b *pebbleBatch) Delete(key []byte) error {
+	if err := b.b.Delete(key, nil); err != nil {
+		return err
+	}
+	b.size += len(key)
+	return nil
+}
+
+func (b *pebbleBatch) PutIfAbsent(key, value []byte) error {
+	if !b.keys[string(key)] {
+		if err := b.b.Set(key, value, nil); err != nil {
+			return err
+		}
+		b.size += len(value)
+		b.keys[string(key)] = true
+		return nil
+	}
+	return fmt.Errorf("duplicated key in batch, (HEX) %x", key)
+}
+
+func (b *pebbleBatch) Exist(key []byte) bool {
+	return b.keys[string(key)]
+}
+
+// Write commits the batch, the batch keeps its content like leveldb until Reset
+func (b *pebbleBatch) Write() error {
+	return b.db.Apply(b.b, pebble.NoSync)
+}
+
+func (b *pebbleBatch) ValueSize() int {
+	return b.size
+}
+
+func (b *pebbleBatch) Reset() {
+	b.b.Reset()
+	b.size = 0
+	b.keys = map[string]bool{}
+}
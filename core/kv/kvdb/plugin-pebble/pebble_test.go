@@ -0,0 +1,28 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/xuperchain/xuperchain/core/kv/kvdb"
+	"github.com/xuperchain/xuperchain/core/kv/kvdb/kvdbtest"
+)
+
+func TestConformance(t *testing.T) {
+	kvdbtest.RunSuite(t, func() kvdb.Database {
+		return &PebbleDatabase{}
+	})
+}
+
+func TestPrefixLimit(t *testing.T) {
+	cases := map[string]string{
+		"":         "",
+		"a":        "b",
+		"a\xff":    "b",
+		"\xff\xff": "",
+	}
+	for prefix, expect := range cases {
+		if got := string(prefixLimit([]byte(prefix))); got != expect {
+			t.Fatalf("prefix %x expect limit %x, got %x", prefix, expect, got)
+		}
+	}
+}
@@ -14,6 +14,9 @@ import (
 // awardCacheSize system award cache, in avoid of double computing
 const awardCacheSize = 1000
 
+// DefaultArchiveChunkBytes is the default max size of contract data archived by one tx
+const DefaultArchiveChunkBytes = 256 << 10
+
 // RootConfig genesis block configure
 type RootConfig struct {
 	Version   string `json:"version"`
@@ -39,6 +42,7 @@ type RootConfig struct {
 		MemRate  int64 `json:"mem_rate"`
 		DiskRate int64 `json:"disk_rate"`
 		XfeeRate int64 `json:"xfee_rate"`
+		RentRate int64 `json:"rent_rate"`
 	} `json:"gas_price"`
 	Decimals          string                 `json:"decimals"`
 	GenesisConsensus  map[string]interface{} `json:"genesis_consensus"`
@@ -53,6 +57,17 @@ type RootConfig struct {
 	IrreversibleSlideWindow string `json:"irreversibleslidewindow"`
 	// GroupChainContract
 	GroupChainContract InvokeRequest `json:"group_chain_contract"`
+	// StateRoot whether to record the root of state tree and the changes of validators in block header,
+	// which are required by light nodes
+	StateRoot bool `json:"state_root"`
+	// StorageRent the rent of contract data in xmodel, disabled if period is not positive
+	StorageRent StorageRentConfig `json:"storage_rent"`
+	// Forks the heights from which the new consensus rules take effect
+	Forks ForkHeights `json:"forks"`
+	// Crypto the crypto plugin subtype of chain, the default crypto type if empty
+	Crypto string `json:"crypto"`
+	// KVEngine the kv plugin subtype of chain, the default kv engine if empty
+	KVEngine string `json:"kvengine"`
 }
 
 // GasPrice define gas rate for utxo
@@ -61,6 +76,40 @@ type GasPrice struct {
 	MemRate  int64 `json:"mem_rate" mapstructure:"mem_rate"`
 	DiskRate int64 `json:"disk_rate" mapstructure:"disk_rate"`
 	XfeeRate int64 `json:"xfee_rate" mapstructure:"xfee_rate"`
+	RentRate int64 `json:"rent_rate" mapstructure:"rent_rate"`
+}
+
+// StorageRentConfig define the rent of contract data in xmodel,
+// contracts pay gas_price.rent_rate for their data every Period blocks.
+// The rent is charged when SettleStorageRent or PayStorageRent tx is sent, not at every block
+type StorageRentConfig struct {
+	// Period the number of blocks of a rent period
+	Period int64 `json:"period"`
+	// GracePeriod the number of blocks a contract in arrears can be archived after
+	GracePeriod int64 `json:"grace_period"`
+	// ArchiveChunkBytes the max size of data archived by one tx, DefaultArchiveChunkBytes if not positive
+	ArchiveChunkBytes int64 `json:"archive_chunk_bytes"`
+}
+
+// ForkHeights define the heights from which the new consensus rules take effect,
+// a rule is disabled if its height is not positive, so chains created before keep their behaviour
+type ForkHeights struct {
+	// EvmResource limits the gas, memory and disk of EVM contracts by the resource limits of invoke request
+	EvmResource int64 `json:"evm_resource"`
+	// Slash accepts the double-sign evidence of consensus.slash and rejects the blocks of slashed proposers
+	Slash int64 `json:"slash"`
+	// EthGateway enables the xkernel methods recording ethereum transactions and saves the EVM events
+	// not defined in abi as raw logs
+	EthGateway int64 `json:"eth_gateway"`
+	// BlsXuperSign accepts the XuperSign aggregated by BLS public keys
+	BlsXuperSign int64 `json:"bls_xuper_sign"`
+	// ContractEvents saves the indexed keys of events declared by non evm contracts when deploying or upgrading them
+	ContractEvents int64 `json:"contract_events"`
+}
+
+// ForkActive returns whether the rule activated at forkHeight takes effect in the block at height
+func ForkActive(forkHeight, height int64) bool {
+	return forkHeight > 0 && height >= forkHeight
 }
 
 // InvokeRequest define genesis reserved_contracts configure
@@ -180,6 +229,10 @@ func NewGenesisBlock(ib *pb.InternalBlock) (*GenesisBlock, error) {
 		config.GasPrice.DiskRate = 0
 		config.GasPrice.MemRate = 0
 		config.GasPrice.XfeeRate = 0
+		config.GasPrice.RentRate = 0
+	}
+	if err := config.StorageRent.CheckGasPrice(config.GetGasPrice()); err != nil {
+		return nil, err
 	}
 	gb.config = config
 	return gb, nil
@@ -223,6 +276,33 @@ func (rc *RootConfig) GetGasPrice() *pb.GasPrice {
 		MemRate:  rc.GasPrice.MemRate,
 		DiskRate: rc.GasPrice.DiskRate,
 		XfeeRate: rc.GasPrice.XfeeRate,
+		RentRate: rc.GasPrice.RentRate,
 	}
 	return gasPrice
 }
+
+// GetForkHeights get the activation heights of new consensus rules
+func (rc *RootConfig) GetForkHeights() ForkHeights {
+	return rc.Forks
+}
+
+// CheckGasPrice returns error if storage rent is enabled and the rent can't be charged by gasPrice,
+// which is paid as xfee, so xfee_rate must be positive while rent_rate is positive
+func (c *StorageRentConfig) CheckGasPrice(gasPrice *pb.GasPrice) error {
+	if c.Period > 0 && gasPrice.GetRentRate() > 0 && gasPrice.GetXfeeRate() <= 0 {
+		return fmt.Errorf("storage rent needs positive xfee_rate while rent_rate is %d", gasPrice.GetRentRate())
+	}
+	return nil
+}
+
+// GetStorageRent get storage rent config, returns nil if storage rent is disabled
+func (rc *RootConfig) GetStorageRent() *StorageRentConfig {
+	if rc.StorageRent.Period <= 0 {
+		return nil
+	}
+	storageRent := rc.StorageRent
+	if storageRent.ArchiveChunkBytes <= 0 {
+		storageRent.ArchiveChunkBytes = DefaultArchiveChunkBytes
+	}
+	return &storageRent
+}
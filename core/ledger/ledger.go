@@ -35,6 +35,8 @@ var (
 	ErrMinerInterrupt = errors.New("new block interrupts the process of the miner")
 	// ErrTxNotConfirmed return tx not confirmed error
 	ErrTxNotConfirmed = errors.New("transaction not confirmed")
+	// ErrStateRootMismatch is returned when the state root of block is not computed from its transactions
+	ErrStateRootMismatch = errors.New("state root mismatch")
 	// NumCPU returns the number of CPU cores for the current system
 	NumCPU = runtime.NumCPU()
 )
@@ -84,6 +86,7 @@ type Ledger struct {
 	enablePowMinning bool
 	powMutex         *sync.Mutex
 	confirmBatch     kvdb.Batch //新增区块
+	headerOnly       bool       //轻节点只保存区块头
 }
 
 // ConfirmStatus block status
@@ -199,6 +202,17 @@ func (l *Ledger) GetLDB() kvdb.Database {
 	return l.baseDB
 }
 
+// SetHeaderOnly makes the ledger keep block headers only, used by light nodes.
+// Blocks are confirmed without transactions, their merkle root and state root are trusted by consensus.
+func (l *Ledger) SetHeaderOnly() {
+	l.headerOnly = true
+}
+
+// IsHeaderOnly returns whether the ledger keeps block headers only
+func (l *Ledger) IsHeaderOnly() bool {
+	return l.headerOnly
+}
+
 func (l *Ledger) loadGenesisBlock() error {
 	if len(l.meta.RootBlockid) == 0 {
 		return ErrBlockNotExist
@@ -226,6 +240,10 @@ func (l *Ledger) FormatRootBlock(txList []*pb.Transaction) (*pb.InternalBlock, e
 		block.MerkleRoot = block.MerkleTree[len(block.MerkleTree)-1]
 	}
 	var err error
+	block.StateRoot, _, err = l.makeStateRoot(true, nil, nil, txList)
+	if err != nil {
+		return nil, err
+	}
 	block.Blockid, err = MakeBlockID(block)
 	if err != nil {
 		return nil, err
@@ -238,7 +256,7 @@ func (l *Ledger) FormatBlock(txList []*pb.Transaction,
 	proposer []byte, ecdsaPk *ecdsa.PrivateKey, /*矿工的公钥私钥*/
 	timestamp int64, curTerm int64, curBlockNum int64,
 	preHash []byte, utxoTotal *big.Int) (*pb.InternalBlock, error) {
-	return l.formatBlock(txList, proposer, ecdsaPk, timestamp, curTerm, curBlockNum, preHash, 0, utxoTotal, true, nil, nil, 0)
+	return l.formatBlock(txList, proposer, ecdsaPk, timestamp, curTerm, curBlockNum, preHash, 0, utxoTotal, true, nil, nil, 0, nil, nil, nil)
 }
 
 // FormatMinerBlock format block for miner
@@ -246,8 +264,9 @@ func (l *Ledger) FormatMinerBlock(txList []*pb.Transaction,
 	proposer []byte, ecdsaPk *ecdsa.PrivateKey, /*矿工的公钥私钥*/
 	timestamp int64, curTerm int64, curBlockNum int64,
 	preHash []byte, targetBits int32, utxoTotal *big.Int,
-	qc *pb.QuorumCert, failedTxs map[string]string, blockHeight int64) (*pb.InternalBlock, error) {
-	return l.formatBlock(txList, proposer, ecdsaPk, timestamp, curTerm, curBlockNum, preHash, targetBits, utxoTotal, true, qc, failedTxs, blockHeight)
+	qc *pb.QuorumCert, failedTxs map[string]string, blockHeight int64, vrfProof []byte,
+	nextValidators []byte, tc *pb.TimeoutCert) (*pb.InternalBlock, error) {
+	return l.formatBlock(txList, proposer, ecdsaPk, timestamp, curTerm, curBlockNum, preHash, targetBits, utxoTotal, true, qc, failedTxs, blockHeight, vrfProof, nextValidators, tc)
 }
 
 // IsProofed check workload proof
@@ -267,7 +286,7 @@ func (l *Ledger) FormatFakeBlock(txList []*pb.Transaction,
 	proposer []byte, ecdsaPk *ecdsa.PrivateKey, /*矿工的公钥私钥*/
 	timestamp int64, curTerm int64, curBlockNum int64,
 	preHash []byte, utxoTotal *big.Int, blockHeight int64) (*pb.InternalBlock, error) {
-	return l.formatBlock(txList, proposer, ecdsaPk, timestamp, curTerm, curBlockNum, preHash, 0, utxoTotal, false, nil, nil, blockHeight)
+	return l.formatBlock(txList, proposer, ecdsaPk, timestamp, curTerm, curBlockNum, preHash, 0, utxoTotal, false, nil, nil, blockHeight, nil, nil, nil)
 }
 
 /*
@@ -277,7 +296,8 @@ func (l *Ledger) formatBlock(txList []*pb.Transaction,
 	proposer []byte, ecdsaPk *ecdsa.PrivateKey, /*矿工的公钥私钥*/
 	timestamp int64, curTerm int64, curBlockNum int64,
 	preHash []byte, targetBits int32, utxoTotal *big.Int, needSign bool,
-	qc *pb.QuorumCert, failedTxs map[string]string, blockHeight int64) (*pb.InternalBlock, error) {
+	qc *pb.QuorumCert, failedTxs map[string]string, blockHeight int64, vrfProof []byte,
+	nextValidators []byte, tc *pb.TimeoutCert) (*pb.InternalBlock, error) {
 	l.xlog.Info("begin format block", "preHash", fmt.Sprintf("%x", preHash))
 	//编译的环境变量指定
 	block := &pb.InternalBlock{Version: BlockVersion}
@@ -290,6 +310,9 @@ func (l *Ledger) formatBlock(txList []*pb.Transaction,
 	block.TargetBits = targetBits
 	block.Justify = qc
 	block.Height = blockHeight
+	block.VrfProof = vrfProof
+	block.NextValidators = nextValidators
+	block.TimeoutCert = tc
 	jsPk, pkErr := l.cryptoClient.GetEcdsaPublicKeyJsonFormatStr(ecdsaPk)
 	if pkErr != nil {
 		return nil, pkErr
@@ -314,6 +337,12 @@ func (l *Ledger) formatBlock(txList []*pb.Transaction,
 		block.MerkleRoot = block.MerkleTree[len(block.MerkleTree)-1]
 	}
 	var err error
+	if needSign {
+		block.StateRoot, _, err = l.makeStateRoot(false, preHash, proposer, txList)
+		if err != nil {
+			return nil, err
+		}
+	}
 	block.Blockid, err = MakeBlockID(block)
 	if err != nil {
 		return nil, err
@@ -575,6 +604,26 @@ func (l *Ledger) ConfirmBlock(block *pb.InternalBlock, isRoot bool) ConfirmStatu
 	batchWrite.Reset()
 	newMeta := proto.Clone(l.meta).(*pb.LedgerMeta)
 	splitHeight := newMeta.TrunkHeight
+	// 状态树的新节点和区块一起写入
+	stateRoot, tree, stateErr := l.makeStateRoot(isRoot, block.PreHash, block.Proposer, realTransactions)
+	if l.headerOnly && !isRoot {
+		// 只有区块头时无法计算状态根, 由区块头的共识校验保证
+		stateRoot, tree, stateErr = block.StateRoot, nil, nil
+	}
+	if stateErr == nil && !bytes.Equal(stateRoot, block.StateRoot) {
+		stateErr = fmt.Errorf("%s, expect %x got %x", ErrStateRootMismatch, stateRoot, block.StateRoot)
+	}
+	if stateErr == nil && tree != nil {
+		stateErr = tree.flush(batchWrite)
+	}
+	if stateErr != nil {
+		confirmStatus.Succ = false
+		confirmStatus.Error = stateErr
+		block.Transactions = realTransactions
+		l.xlog.Warn("verify state root fail", "stateErr", stateErr)
+		return confirmStatus
+	}
+	blkTimer.Mark("stateRoot")
 	if isRoot { //确认创世块
 		if block.PreHash != nil && len(block.PreHash) > 0 {
 			confirmStatus.Succ = false
@@ -782,7 +831,8 @@ func (l *Ledger) queryBlock(blockid []byte, needBody bool) (*pb.InternalBlock, e
 	if parserErr != nil {
 		return nil, parserErr
 	}
-	if needBody {
+	// 轻节点只有创世块保存了交易
+	if needBody && !(l.headerOnly && block.Height > 0) {
 		realTransactions := make([]*pb.Transaction, 0)
 		for _, txid := range block.MerkleTree[:block.TxCount] {
 			pbTxBuf, kvErr := l.confirmedTable.Get(txid)
@@ -1007,6 +1057,19 @@ func (l *Ledger) GetGasPrice() *pb.GasPrice {
 	return l.GenesisBlock.GetConfig().GetGasPrice()
 }
 
+// GetStorageRent returns the storage rent config of genesis, nil if storage rent is disabled
+func (l *Ledger) GetStorageRent() *StorageRentConfig {
+	return l.GenesisBlock.GetConfig().GetStorageRent()
+}
+
+// GetForkHeights return the activation heights of new consensus rules in genesis config
+func (l *Ledger) GetForkHeights() ForkHeights {
+	if l.GenesisBlock == nil {
+		return ForkHeights{}
+	}
+	return l.GenesisBlock.GetConfig().GetForkHeights()
+}
+
 func (l *Ledger) GetNoFee() bool {
 	return l.GenesisBlock.GetConfig().NoFee
 }
@@ -1077,6 +1140,19 @@ func (l *Ledger) QueryBlockByHeight(height int64) (*pb.InternalBlock, error) {
 	return l.QueryBlock(blockID)
 }
 
+// QueryBlockHeaderByHeight query block header by height
+func (l *Ledger) QueryBlockHeaderByHeight(height int64) (*pb.InternalBlock, error) {
+	sHeight := []byte(fmt.Sprintf("%020d", height))
+	blockID, kvErr := l.heightTable.Get(sHeight)
+	if kvErr != nil {
+		if common.NormalizedKVError(kvErr) == common.ErrKVNotFound {
+			return nil, ErrBlockNotExist
+		}
+		return nil, kvErr
+	}
+	return l.QueryBlockHeader(blockID)
+}
+
 // QueryLastBlock query last block by height
 func (l *Ledger) QueryLastBlock() (*pb.InternalBlock, error) {
 	lastBlockHeight := l.meta.GetTrunkHeight()
@@ -1207,10 +1283,21 @@ func (l *Ledger) VerifyBlock(block *pb.InternalBlock, logid string) (bool, error
 		return false, nil
 	}
 
-	errv := VerifyMerkle(block)
-	if errv != nil {
-		l.xlog.Warn("VerifyMerkle error", "logid", logid, "error", errv)
-		return false, nil
+	// 只有区块头时不校验交易和状态根
+	if !l.headerOnly {
+		errv := VerifyMerkle(block)
+		if errv != nil {
+			l.xlog.Warn("VerifyMerkle error", "logid", logid, "error", errv)
+			return false, nil
+		}
+	}
+
+	// 父区块不在本地时, 状态根在ConfirmBlock时校验
+	if !l.headerOnly && len(block.PreHash) > 0 && l.ExistBlock(block.PreHash) {
+		if err := l.VerifyStateRoot(block); err != nil {
+			l.xlog.Warn("VerifyBlock VerifyStateRoot error", "logid", logid, "error", err)
+			return false, err
+		}
 	}
 
 	k, err := l.cryptoClient.GetEcdsaPublicKeyFromJsonStr(string(block.Pubkey))
@@ -103,6 +103,43 @@ func encodeJustify(buf *bytes.Buffer, block *pb.InternalBlock) error {
 				return err
 			}
 		}
+		// 聚合签名只在开启BLS时存在, 保持原有区块的blockid不变
+		if len(block.Justify.SignInfos.AggregatedSign) > 0 {
+			err = binary.Write(buf, binary.LittleEndian, block.Justify.SignInfos.AggregatedSign)
+			if err != nil {
+				return err
+			}
+			err = binary.Write(buf, binary.LittleEndian, block.Justify.SignInfos.SignerBitmap)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// encodeTimeoutCert 超时证书只在轮换出块人的区块中存在, 保持原有区块的blockid不变
+func encodeTimeoutCert(buf *bytes.Buffer, block *pb.InternalBlock) error {
+	if block.TimeoutCert == nil {
+		return nil
+	}
+	err := binary.Write(buf, binary.LittleEndian, block.TimeoutCert.ViewNumber)
+	if err != nil {
+		return err
+	}
+	for _, sign := range block.TimeoutCert.GetSignInfos().GetQCSignInfos() {
+		err = binary.Write(buf, binary.LittleEndian, []byte(sign.Address))
+		if err != nil {
+			return err
+		}
+		err = binary.Write(buf, binary.LittleEndian, []byte(sign.PublicKey))
+		if err != nil {
+			return err
+		}
+		err = binary.Write(buf, binary.LittleEndian, sign.Sign)
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -183,5 +220,27 @@ func MakeBlockID(block *pb.InternalBlock) ([]byte, error) {
 	if err != nil {
 		return nil, fmt.Errorf("encodeJustify failed, err=%v", err)
 	}
+	if len(block.StateRoot) > 0 {
+		err = binary.Write(buf, binary.LittleEndian, block.StateRoot)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(block.VrfProof) > 0 {
+		err = binary.Write(buf, binary.LittleEndian, block.VrfProof)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(block.NextValidators) > 0 {
+		err = binary.Write(buf, binary.LittleEndian, block.NextValidators)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = encodeTimeoutCert(buf, block)
+	if err != nil {
+		return nil, err
+	}
 	return hash.DoubleSha256(buf.Bytes()), nil
 }
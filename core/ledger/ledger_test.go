@@ -1,6 +1,7 @@
 package ledger
 
 import (
+	"bytes"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
@@ -477,3 +478,43 @@ func TestBlockHeader(t *testing.T) {
 	fmt.Printf("%v\n", sizem)
 	fmt.Printf("used:%s\n", time.Now().Sub(tstart))
 }
+
+func TestTimeoutCertBlockID(t *testing.T) {
+	block := makeProofTestBlock(t, 1)
+	// 不携带超时证书的区块blockid不变
+	block.TimeoutCert = nil
+	if id, _ := MakeBlockID(block); !bytes.Equal(id, block.Blockid) {
+		t.Fatal("expect blockid unchanged without timeout cert")
+	}
+	block.TimeoutCert = &pb.TimeoutCert{
+		ViewNumber: 10,
+		SignInfos: &pb.QCSignInfos{
+			QCSignInfos: []*pb.SignInfo{{Address: "addr", PublicKey: "pk", Sign: []byte("sign")}},
+		},
+	}
+	id, _ := MakeBlockID(block)
+	if bytes.Equal(id, block.Blockid) {
+		t.Fatal("expect timeout cert covered by blockid")
+	}
+	block.TimeoutCert.ViewNumber = 11
+	if other, _ := MakeBlockID(block); bytes.Equal(other, id) {
+		t.Fatal("expect view of timeout cert covered by blockid")
+	}
+}
+
+func TestGenesisStorageRentGasPrice(t *testing.T) {
+	genesis := func(desc string) *pb.InternalBlock {
+		return &pb.InternalBlock{Transactions: []*pb.Transaction{{Coinbase: true, Desc: []byte(desc)}}}
+	}
+	// 开启租金时xfee_rate为0, 租金无法收取
+	if _, err := NewGenesisBlock(genesis(`{"storage_rent": {"period": 10}, "gas_price": {"rent_rate": 10}}`)); err == nil {
+		t.Error("expect error enabling storage rent without xfee_rate")
+	}
+	if _, err := NewGenesisBlock(genesis(`{"storage_rent": {"period": 10}, "gas_price": {"rent_rate": 10, "xfee_rate": 1}}`)); err != nil {
+		t.Error(err)
+	}
+	// nofee的链不收取租金
+	if _, err := NewGenesisBlock(genesis(`{"nofee": true, "storage_rent": {"period": 10}, "gas_price": {"rent_rate": 10}}`)); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,347 @@
+package ledger
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/xuperchain/xuperchain/core/crypto/hash"
+	"github.com/xuperchain/xuperchain/core/pb"
+	"github.com/xuperchain/xuperchain/core/utxo/txhash"
+)
+
+var (
+	// ErrInvalidProof is returned when a proof can not be verified
+	ErrInvalidProof = errors.New("invalid proof")
+	// ErrStateRootDisabled is returned when state proof is requested but state root is not enabled
+	ErrStateRootDisabled = errors.New("state root is not enabled in genesis config")
+)
+
+// proofHeader returns the block header carried by proofs, MakeBlockID does not depend on the removed fields
+func proofHeader(block *pb.InternalBlock) *pb.InternalBlock {
+	header := proto.Clone(block).(*pb.InternalBlock)
+	header.Transactions = nil
+	header.MerkleTree = nil
+	return header
+}
+
+// MakeTxProof makes the merkle path of tx in block, the merkle tree of block must be present
+func MakeTxProof(block *pb.InternalBlock, tx *pb.Transaction) (*pb.TxProof, error) {
+	tree := block.MerkleTree
+	leafSize := getLeafSize(int(block.TxCount))
+	if len(tree) != leafSize*2-1 {
+		return nil, fmt.Errorf("merkle tree of block %x is not complete", block.Blockid)
+	}
+	index := -1
+	for i := 0; i < int(block.TxCount); i++ {
+		if bytes.Equal(tree[i], tx.Txid) {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return nil, fmt.Errorf("tx %x is not in block %x", tx.Txid, block.Blockid)
+	}
+	proof := &pb.TxProof{
+		Block: proofHeader(block),
+		Tx:    tx,
+		Index: int32(index),
+	}
+	// 逐层向上, 记录兄弟节点
+	offset, pos := 0, index
+	for width := leafSize; width > 1; width /= 2 {
+		proof.MerklePath = append(proof.MerklePath, tree[offset+(pos^1)])
+		offset += width
+		pos /= 2
+	}
+	return proof, nil
+}
+
+// VerifyTxProof checks the tx is included in the block of proof.
+// The caller should make sure the blockid of proof is on the trusted chain.
+func VerifyTxProof(proof *pb.TxProof) error {
+	block, tx := proof.GetBlock(), proof.GetTx()
+	if block == nil || tx == nil {
+		return ErrInvalidProof
+	}
+	blockid, err := MakeBlockID(block)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(blockid, block.Blockid) {
+		return fmt.Errorf("%s, blockid is not computed from the header", ErrInvalidProof)
+	}
+	txid, err := txhash.MakeTransactionID(tx)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(txid, tx.Txid) {
+		return fmt.Errorf("%s, txid is not computed from the tx", ErrInvalidProof)
+	}
+	if proof.Index < 0 || proof.Index >= block.TxCount {
+		return fmt.Errorf("%s, index %d out of range", ErrInvalidProof, proof.Index)
+	}
+	leafSize := getLeafSize(int(block.TxCount))
+	if 1<<uint(len(proof.MerklePath)) != leafSize {
+		return fmt.Errorf("%s, merkle path length %d mismatch tx count %d", ErrInvalidProof, len(proof.MerklePath), block.TxCount)
+	}
+	node, pos := txid, proof.Index
+	for _, sibling := range proof.MerklePath {
+		switch {
+		case pos%2 == 1:
+			node = hash.DoubleSha256(bytes.Join([][]byte{sibling, node}, []byte{}))
+		case len(sibling) == 0: //没有右孩子
+			node = hash.DoubleSha256(bytes.Join([][]byte{node, node}, []byte{}))
+		default:
+			node = hash.DoubleSha256(bytes.Join([][]byte{node, sibling}, []byte{}))
+		}
+		pos /= 2
+	}
+	if !bytes.Equal(node, block.MerkleRoot) {
+		return fmt.Errorf("%s, merkle root mismatch", ErrInvalidProof)
+	}
+	return nil
+}
+
+// MakeStateProof makes the proof of a xmodel key in the state tree of block.
+// version and value are the data of the key at the block got from xmodel, version is empty if the key does not exist.
+func (l *Ledger) MakeStateProof(block *pb.InternalBlock, bucket string, key []byte, version string, value []byte) (*pb.StateProof, error) {
+	if len(block.StateRoot) == 0 {
+		return nil, ErrStateRootDisabled
+	}
+	proof := &pb.StateProof{
+		Block:   proofHeader(block),
+		Bucket:  bucket,
+		Key:     key,
+		Exist:   version != "",
+		Version: version,
+	}
+	if proof.Exist {
+		proof.Value = value
+	}
+	var err error
+	proof.Siblings, proof.Leaf, err = l.statePath(block.StateRoot, hash.UsingSha256(XModelStateKey(bucket, key)))
+	if err != nil {
+		return nil, err
+	}
+	// 服务端先自行校验, 避免xmodel与状态树不一致时返回错误的证明
+	if err := VerifyStateProof(proof); err != nil {
+		return nil, fmt.Errorf("state of %s/%s is inconsistent with state root: %s", bucket, key, err)
+	}
+	return proof, nil
+}
+
+// VerifyStateProof checks the value of the key, or that the key does not exist, in the state tree of the block of proof.
+// The caller should make sure the blockid of proof is on the trusted chain.
+func VerifyStateProof(proof *pb.StateProof) error {
+	block := proof.GetBlock()
+	if block == nil {
+		return ErrInvalidProof
+	}
+	blockid, err := MakeBlockID(block)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(blockid, block.Blockid) {
+		return fmt.Errorf("%s, blockid is not computed from the header", ErrInvalidProof)
+	}
+	keyHash := hash.UsingSha256(XModelStateKey(proof.Bucket, proof.Key))
+	if proof.Exist {
+		expect := encodeLeafNode(keyHash, hash.UsingSha256(XModelStateValue(proof.Version, proof.Value)))
+		if !bytes.Equal(expect, proof.Leaf) {
+			return fmt.Errorf("%s, leaf mismatch the value", ErrInvalidProof)
+		}
+	} else if len(proof.Leaf) > 0 {
+		// 路径末端是其他key的叶子, 说明该key不存在
+		if len(proof.Leaf) != stateNodeSize || proof.Leaf[0] != leafNodeFlag {
+			return fmt.Errorf("%s, malformed leaf", ErrInvalidProof)
+		}
+		if bytes.Equal(proof.Leaf[1:1+stateHashSize], keyHash) {
+			return fmt.Errorf("%s, key exists", ErrInvalidProof)
+		}
+	}
+	return verifyStatePath(keyHash, proof.Leaf, proof.Siblings, block.StateRoot)
+}
+
+// statePath returns the siblings from root to the end of the path of keyHash and the leaf at the end
+func (l *Ledger) statePath(root []byte, keyHash []byte) ([][]byte, []byte, error) {
+	var siblings [][]byte
+	t := newStateTree(l.baseDB)
+	h := root
+	for depth := 0; !isEmptyStateHash(h); depth++ {
+		node, err := t.getNode(h)
+		if err != nil {
+			return nil, nil, err
+		}
+		if node[0] == leafNodeFlag {
+			return siblings, node, nil
+		}
+		left, right := node[1:1+stateHashSize], node[1+stateHashSize:]
+		if stateBit(keyHash, depth) == 0 {
+			siblings = append(siblings, right)
+			h = left
+		} else {
+			siblings = append(siblings, left)
+			h = right
+		}
+	}
+	return siblings, nil, nil
+}
+
+// verifyStatePath checks the path from leaf to root along keyHash
+func verifyStatePath(keyHash []byte, leaf []byte, siblings [][]byte, root []byte) error {
+	var node []byte
+	if len(leaf) > 0 {
+		node = hash.UsingSha256(leaf)
+	}
+	return verifyStateNodePath(keyHash, node, siblings, root)
+}
+
+// verifyStateNodePath checks the path from the node of hash to root along keyHash, the node is at depth len(siblings)
+func verifyStateNodePath(keyHash []byte, node []byte, siblings [][]byte, root []byte) error {
+	if len(siblings) > stateTreeBits {
+		return fmt.Errorf("%s, too many siblings", ErrInvalidProof)
+	}
+	for depth := len(siblings) - 1; depth >= 0; depth-- {
+		sibling := siblings[depth]
+		if len(sibling) != stateHashSize {
+			return fmt.Errorf("%s, malformed sibling", ErrInvalidProof)
+		}
+		if stateBit(keyHash, depth) == 0 {
+			node = hash.UsingSha256(encodeInnerNode(node, sibling))
+		} else {
+			node = hash.UsingSha256(encodeInnerNode(sibling, node))
+		}
+	}
+	if isEmptyStateHash(node) {
+		node = EmptyStateRoot
+	}
+	if !bytes.Equal(node, root) {
+		return fmt.Errorf("%s, state root mismatch", ErrInvalidProof)
+	}
+	return nil
+}
+
+// MakeUtxoProof makes the proof of an utxo of addr in the state tree of block
+func (l *Ledger) MakeUtxoProof(block *pb.InternalBlock, addr []byte, txid []byte, offset int32,
+	amount []byte, frozenHeight int64) (*pb.UtxoProof, error) {
+	if len(block.StateRoot) == 0 {
+		return nil, ErrStateRootDisabled
+	}
+	proof := &pb.UtxoProof{
+		RefTxid:      txid,
+		RefOffset:    offset,
+		Amount:       amount,
+		FrozenHeight: frozenHeight,
+	}
+	var err error
+	proof.Siblings, _, err = l.statePath(block.StateRoot, utxoStateKeyHash(addr, txid, offset))
+	if err != nil {
+		return nil, err
+	}
+	if err := VerifyUtxoProof(block.StateRoot, addr, proof); err != nil {
+		return nil, fmt.Errorf("utxo %x_%d is inconsistent with state root: %s", txid, offset, err)
+	}
+	return proof, nil
+}
+
+// VerifyUtxoProof checks the utxo of addr exists in the state tree of root.
+// The caller should make sure root is the state root of a block on the trusted chain,
+// the proofs can't tell whether some utxos of addr are omitted.
+func VerifyUtxoProof(root []byte, addr []byte, proof *pb.UtxoProof) error {
+	if proof == nil {
+		return ErrInvalidProof
+	}
+	keyHash := utxoStateKeyHash(addr, proof.RefTxid, proof.RefOffset)
+	leaf := encodeLeafNode(keyHash, hash.UsingSha256(UtxoStateValue(proof.Amount, proof.FrozenHeight)))
+	return verifyStatePath(keyHash, leaf, proof.Siblings, root)
+}
+
+// MakeUtxoSetProof fills the path along the utxo prefix of res.Address in the state tree of block into res,
+// which proves that res.Proofs contain all the utxos of the address. res.Proofs are the utxos got from utxoVM
+func (l *Ledger) MakeUtxoSetProof(block *pb.InternalBlock, res *pb.UtxoProofResponse) error {
+	if len(block.StateRoot) == 0 {
+		return ErrStateRootDisabled
+	}
+	prefix := make([]byte, stateHashSize)
+	copy(prefix, utxoPrefix([]byte(res.Address)))
+	t := newStateTree(l.baseDB)
+	res.Siblings, res.Leaf = nil, nil
+	h := block.StateRoot
+	for depth := 0; depth < utxoPrefixSize*8 && !isEmptyStateHash(h); depth++ {
+		node, err := t.getNode(h)
+		if err != nil {
+			return err
+		}
+		if node[0] == leafNodeFlag {
+			if len(res.Proofs) == 0 {
+				res.Leaf = node
+			}
+			break
+		}
+		left, right := node[1:1+stateHashSize], node[1+stateHashSize:]
+		if stateBit(prefix, depth) == 0 {
+			res.Siblings = append(res.Siblings, right)
+			h = left
+		} else {
+			res.Siblings = append(res.Siblings, left)
+			h = right
+		}
+	}
+	if err := VerifyUtxoSetProof(block.StateRoot, res); err != nil {
+		return fmt.Errorf("utxos of %s are inconsistent with state root: %s", res.Address, err)
+	}
+	return nil
+}
+
+// VerifyUtxoSetProof checks res.Proofs are all the utxos of res.Address in the state tree of root,
+// the subtree of the utxo prefix of address is rebuilt from the utxos and checked along res.Siblings.
+// The caller should make sure root is the state root of a block on the trusted chain.
+func VerifyUtxoSetProof(root []byte, res *pb.UtxoProofResponse) error {
+	if len(res.Siblings) > utxoPrefixSize*8 {
+		return fmt.Errorf("%s, too many siblings", ErrInvalidProof)
+	}
+	addr := []byte(res.Address)
+	prefix := make([]byte, stateHashSize)
+	copy(prefix, utxoPrefix(addr))
+	leaves := make([]stateChange, 0, len(res.Proofs))
+	for _, proof := range res.Proofs {
+		if proof == nil {
+			return ErrInvalidProof
+		}
+		leaves = append(leaves, stateChange{
+			keyHash:   utxoStateKeyHash(addr, proof.RefTxid, proof.RefOffset),
+			valueHash: hash.UsingSha256(UtxoStateValue(proof.Amount, proof.FrozenHeight)),
+		})
+	}
+	sort.Slice(leaves, func(i, j int) bool {
+		return bytes.Compare(leaves[i].keyHash, leaves[j].keyHash) < 0
+	})
+	for i := 1; i < len(leaves); i++ {
+		// 同一个utxo不能重复计算
+		if bytes.Equal(leaves[i-1].keyHash, leaves[i].keyHash) {
+			return fmt.Errorf("%s, duplicated utxo", ErrInvalidProof)
+		}
+	}
+
+	var node []byte
+	if len(leaves) > 0 {
+		if len(res.Leaf) > 0 {
+			return fmt.Errorf("%s, unexpected leaf", ErrInvalidProof)
+		}
+		node = newStateTree(nil).buildNode(len(res.Siblings), leaves)
+	} else if len(res.Leaf) > 0 {
+		// 路径末端是其他key的叶子, 说明该地址没有utxo
+		if len(res.Leaf) != stateNodeSize || res.Leaf[0] != leafNodeFlag {
+			return fmt.Errorf("%s, malformed leaf", ErrInvalidProof)
+		}
+		if bytes.HasPrefix(res.Leaf[1:], prefix[:utxoPrefixSize]) {
+			return fmt.Errorf("%s, utxo omitted", ErrInvalidProof)
+		}
+		node = hash.UsingSha256(res.Leaf)
+	}
+	return verifyStateNodePath(prefix, node, res.Siblings, root)
+}
@@ -0,0 +1,280 @@
+package ledger
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+
+	crypto_client "github.com/xuperchain/xuperchain/core/crypto/client"
+	"github.com/xuperchain/xuperchain/core/pb"
+	"github.com/xuperchain/xuperchain/core/utxo/txhash"
+)
+
+func makeProofTestBlock(t *testing.T, txCount int) *pb.InternalBlock {
+	block := &pb.InternalBlock{
+		Version: BlockVersion,
+		PreHash: []byte("prehash"),
+		TxCount: int32(txCount),
+	}
+	for i := 0; i < txCount; i++ {
+		tx := &pb.Transaction{Desc: []byte(fmt.Sprintf("tx%d", i))}
+		tx.Txid, _ = txhash.MakeTransactionID(tx)
+		block.Transactions = append(block.Transactions, tx)
+	}
+	block.MerkleTree = MakeMerkleTree(block.Transactions)
+	block.MerkleRoot = block.MerkleTree[len(block.MerkleTree)-1]
+	var err error
+	block.Blockid, err = MakeBlockID(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return block
+}
+
+func TestTxProof(t *testing.T) {
+	for txCount := 1; txCount <= 9; txCount++ {
+		block := makeProofTestBlock(t, txCount)
+		for _, tx := range block.Transactions {
+			proof, err := MakeTxProof(block, tx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(proof.Block.Transactions) != 0 {
+				t.Fatal("expect transactions removed from proof")
+			}
+			if err := VerifyTxProof(proof); err != nil {
+				t.Fatalf("tx count %d index %d, %v", txCount, proof.Index, err)
+			}
+		}
+	}
+
+	block := makeProofTestBlock(t, 5)
+	proof, _ := MakeTxProof(block, block.Transactions[4])
+	other := &pb.Transaction{Desc: []byte("other")}
+	other.Txid, _ = txhash.MakeTransactionID(other)
+	if _, err := MakeTxProof(block, other); err == nil {
+		t.Fatal("expect error of tx not in block")
+	}
+
+	// 篡改交易内容
+	proof.Tx.Desc = []byte("tampered")
+	if err := VerifyTxProof(proof); err == nil {
+		t.Fatal("expect error of tampered tx")
+	}
+	// 冒用其他交易的位置
+	proof, _ = MakeTxProof(block, block.Transactions[4])
+	proof.Index = 3
+	if err := VerifyTxProof(proof); err == nil {
+		t.Fatal("expect error of wrong index")
+	}
+	// 篡改merkle路径
+	proof, _ = MakeTxProof(block, block.Transactions[1])
+	proof.MerklePath[1] = proof.MerklePath[0]
+	if err := VerifyTxProof(proof); err == nil {
+		t.Fatal("expect error of tampered merkle path")
+	}
+	// 篡改区块头
+	proof, _ = MakeTxProof(block, block.Transactions[1])
+	proof.Block.MerkleRoot = proof.MerklePath[0]
+	if err := VerifyTxProof(proof); err == nil {
+		t.Fatal("expect error of tampered block")
+	}
+}
+
+func TestStateProof(t *testing.T) {
+	workSpace, dirErr := ioutil.TempDir("/tmp", "")
+	if dirErr != nil {
+		t.Fatal(dirErr)
+	}
+	os.RemoveAll(workSpace)
+	defer os.RemoveAll(workSpace)
+	ledger, err := NewLedger(workSpace, nil, nil, DefaultKvEngine, crypto_client.CryptoTypeDefault)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ledger.Close()
+
+	t1 := &pb.Transaction{}
+	t1.TxOutputs = append(t1.TxOutputs, &pb.TxOutput{Amount: []byte("888"), ToAddr: []byte(BobAddress)})
+	t1.Coinbase = true
+	t1.Desc = []byte(`{"maxblocksize" : "128", "state_root": true}`)
+	t1.Txid, _ = txhash.MakeTransactionID(t1)
+	block, err := ledger.FormatRootBlock([]*pb.Transaction{t1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status := ledger.ConfirmBlock(block, true); !status.Succ {
+		t.Fatalf("confirm genesis block fail, %v", status.Error)
+	}
+
+	ecdsaPk, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	t2 := &pb.Transaction{}
+	t2.TxInputs = append(t2.TxInputs, &pb.TxInput{RefTxid: t1.Txid, RefOffset: 0, FromAddr: []byte(BobAddress)})
+	t2.TxOutputs = append(t2.TxOutputs, &pb.TxOutput{Amount: []byte("888"), ToAddr: []byte(AliceAddress)})
+	t2.TxOutputs = append(t2.TxOutputs, &pb.TxOutput{Amount: []byte("1"), ToAddr: []byte(AliceAddress)})
+	for i := 0; i < 10; i++ {
+		t2.TxOutputsExt = append(t2.TxOutputsExt, &pb.TxOutputExt{
+			Bucket: "bucket",
+			Key:    []byte(fmt.Sprintf("key%d", i)),
+			Value:  []byte(fmt.Sprintf("value%d", i)),
+		})
+	}
+	t2.Txid, _ = txhash.MakeTransactionID(t2)
+	block2, err := ledger.FormatBlock([]*pb.Transaction{t2}, []byte(AliceAddress), ecdsaPk,
+		223456789, 0, 0, block.Blockid, big.NewInt(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status := ledger.ConfirmBlock(block2, false); !status.Succ {
+		t.Fatalf("confirm block fail, %v", status.Error)
+	}
+
+	version := fmt.Sprintf("%x_%d", t2.Txid, 3)
+	proof, err := ledger.MakeStateProof(block2, "bucket", []byte("key3"), version, []byte("value3"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proof.Exist || len(proof.Siblings) == 0 {
+		t.Fatalf("unexpected proof %v", proof)
+	}
+	if err := VerifyStateProof(proof); err != nil {
+		t.Fatal(err)
+	}
+	// 篡改value
+	proof.Value = []byte("value4")
+	if err := VerifyStateProof(proof); err == nil {
+		t.Fatal("expect error of tampered value")
+	}
+	// 伪造不存在的证明
+	proof.Exist = false
+	if err := VerifyStateProof(proof); err == nil {
+		t.Fatal("expect error of fake absence")
+	}
+
+	// 不存在的key
+	proof, err = ledger.MakeStateProof(block2, "bucket", []byte("nokey"), "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyStateProof(proof); err != nil {
+		t.Fatal(err)
+	}
+	// 与状态树不一致的数据不能生成证明
+	if _, err := ledger.MakeStateProof(block2, "bucket", []byte("key3"), version, []byte("wrong")); err == nil {
+		t.Fatal("expect error of inconsistent state")
+	}
+	if _, err := ledger.MakeStateProof(block, "bucket", []byte("key3"), version, []byte("value3")); err == nil {
+		t.Fatal("expect error of key not exist in genesis block")
+	}
+
+	// utxo证明
+	utxoProof, err := ledger.MakeUtxoProof(block2, []byte(AliceAddress), t2.Txid, 0, []byte("888"), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyUtxoProof(block2.StateRoot, []byte(AliceAddress), utxoProof); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyUtxoProof(block2.StateRoot, []byte(BobAddress), utxoProof); err == nil {
+		t.Fatal("expect error of wrong address")
+	}
+	utxoProof.Amount = []byte("999")
+	if err := VerifyUtxoProof(block2.StateRoot, []byte(AliceAddress), utxoProof); err == nil {
+		t.Fatal("expect error of tampered amount")
+	}
+	// 已花费的utxo不能生成证明
+	if _, err := ledger.MakeUtxoProof(block2, []byte(BobAddress), t1.Txid, 0, []byte("888"), 0); err == nil {
+		t.Fatal("expect error of spent utxo")
+	}
+
+	// 地址的全部utxo
+	utxoSet := &pb.UtxoProofResponse{
+		Address: AliceAddress,
+		Proofs: []*pb.UtxoProof{
+			{RefTxid: t2.Txid, RefOffset: 1, Amount: []byte("1")},
+			{RefTxid: t2.Txid, RefOffset: 0, Amount: []byte("888")},
+		},
+	}
+	if err := ledger.MakeUtxoSetProof(block2, utxoSet); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyUtxoSetProof(block2.StateRoot, utxoSet); err != nil {
+		t.Fatal(err)
+	}
+	// 遗漏utxo
+	omitted := &pb.UtxoProofResponse{
+		Address:  AliceAddress,
+		Proofs:   utxoSet.Proofs[:1],
+		Siblings: utxoSet.Siblings,
+	}
+	if err := VerifyUtxoSetProof(block2.StateRoot, omitted); err == nil {
+		t.Fatal("expect error of omitted utxo")
+	}
+	if err := ledger.MakeUtxoSetProof(block2, omitted); err == nil {
+		t.Fatal("expect error of omitted utxo")
+	}
+	omitted.Proofs = nil
+	if err := ledger.MakeUtxoSetProof(block2, omitted); err == nil {
+		t.Fatal("expect error of omitted utxo")
+	}
+	utxoSet.Proofs = append(utxoSet.Proofs, utxoSet.Proofs[0])
+	if err := VerifyUtxoSetProof(block2.StateRoot, utxoSet); err == nil {
+		t.Fatal("expect error of duplicated utxo")
+	}
+	// 没有utxo的地址
+	emptySet := &pb.UtxoProofResponse{Address: BobAddress}
+	if err := ledger.MakeUtxoSetProof(block2, emptySet); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyUtxoSetProof(block2.StateRoot, emptySet); err != nil {
+		t.Fatal(err)
+	}
+	emptySet.Proofs = []*pb.UtxoProof{{RefTxid: t1.Txid, RefOffset: 0, Amount: []byte("888")}}
+	if err := VerifyUtxoSetProof(block2.StateRoot, emptySet); err == nil {
+		t.Fatal("expect error of spent utxo")
+	}
+
+	// 只保存区块头的账本
+	lightSpace, dirErr := ioutil.TempDir("/tmp", "")
+	if dirErr != nil {
+		t.Fatal(dirErr)
+	}
+	os.RemoveAll(lightSpace)
+	defer os.RemoveAll(lightSpace)
+	light, err := NewLedger(lightSpace, nil, nil, DefaultKvEngine, crypto_client.CryptoTypeDefault)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer light.Close()
+	light.SetHeaderOnly()
+	lightRoot, err := light.FormatRootBlock([]*pb.Transaction{t1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status := light.ConfirmBlock(lightRoot, true); !status.Succ {
+		t.Fatalf("confirm genesis block fail, %v", status.Error)
+	}
+	header, err := ledger.QueryBlockHeader(block2.Blockid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	header.MerkleTree = nil
+	if status := light.ConfirmBlock(header, false); !status.Succ {
+		t.Fatalf("confirm block header fail, %v", status.Error)
+	}
+	if light.GetMeta().TrunkHeight != 1 {
+		t.Fatalf("unexpected trunk height %d", light.GetMeta().TrunkHeight)
+	}
+	lightBlock, err := light.QueryBlockByHeight(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lightBlock.Transactions) != 0 || string(lightBlock.StateRoot) != string(block2.StateRoot) {
+		t.Fatalf("unexpected header %v", lightBlock)
+	}
+}
@@ -0,0 +1,233 @@
+package ledger
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/xuperchain/xuperchain/core/crypto/hash"
+	"github.com/xuperchain/xuperchain/core/pb"
+)
+
+// 与utxo和xmodel中的定义保持一致, ledger不能引用这两个包
+const (
+	feePlaceholder  = "$"
+	transientBucket = "$transient"
+	bucketSeperator = "/"
+	xmodelDelFlag   = "\x00"
+)
+
+// stateTreeBatchSize is the size of tree nodes written in one batch when rebuilding state tree
+const stateTreeBatchSize = 4 << 20
+
+// utxoPrefixSize is the size of the prefix shared by the paths of utxos of an address in state tree
+const utxoPrefixSize = stateHashSize / 2
+
+// UtxoStateKey returns the key of an utxo in state tree, the same as its key in utxoVM
+func UtxoStateKey(addr []byte, txid []byte, offset int32) []byte {
+	return []byte(fmt.Sprintf("%s%s_%x_%d", pb.UTXOTablePrefix, addr, txid, offset))
+}
+
+// utxoPrefix returns the path prefix of the utxos of addr in state tree
+func utxoPrefix(addr []byte) []byte {
+	return hash.UsingSha256([]byte(fmt.Sprintf("%s%s_", pb.UTXOTablePrefix, addr)))[:utxoPrefixSize]
+}
+
+// utxoStateKeyHash returns the path of an utxo in state tree. Different from xmodel keys, the path starts with
+// the hash of address, so that all the utxos of an address are in one subtree which can be proved as a whole
+func utxoStateKeyHash(addr []byte, txid []byte, offset int32) []byte {
+	keyHash := make([]byte, 0, stateHashSize)
+	keyHash = append(keyHash, utxoPrefix(addr)...)
+	return append(keyHash, hash.UsingSha256(UtxoStateKey(addr, txid, offset))[:stateHashSize-utxoPrefixSize]...)
+}
+
+// UtxoStateValue returns the value of an utxo in state tree
+func UtxoStateValue(amount []byte, frozenHeight int64) []byte {
+	buf := new(bytes.Buffer)
+	buf.Write(big.NewInt(0).SetBytes(amount).Bytes())
+	binary.Write(buf, binary.BigEndian, frozenHeight)
+	return buf.Bytes()
+}
+
+// XModelStateKey returns the key of a xmodel data in state tree, the same as its key in xmodel
+func XModelStateKey(bucket string, key []byte) []byte {
+	k := []byte(pb.ExtUtxoTablePrefix + bucket + bucketSeperator)
+	return append(k, key...)
+}
+
+// XModelStateValue returns the value of a xmodel data in state tree, version is txid_offset of the tx writing the data
+func XModelStateValue(version string, value []byte) []byte {
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(buf, uint64(len(version)))
+	buf = append(buf[:n], version...)
+	return append(buf, value...)
+}
+
+func putStateChange(changes []stateChange, key, value []byte) []stateChange {
+	return putStateChangeHash(changes, hash.UsingSha256(key), value)
+}
+
+func putStateChangeHash(changes []stateChange, keyHash, value []byte) []stateChange {
+	c := stateChange{keyHash: keyHash}
+	if value != nil {
+		c.valueHash = hash.UsingSha256(value)
+	}
+	return append(changes, c)
+}
+
+// makeStateChanges collects the changes of utxo and xmodel made by txs in order,
+// the fee outputs are utxos of the proposer of block the same as in utxoVM
+func makeStateChanges(proposer []byte, txs []*pb.Transaction) []stateChange {
+	var changes []stateChange
+	for _, tx := range txs {
+		for offset, txOutExt := range tx.TxOutputsExt {
+			if txOutExt.Bucket == transientBucket {
+				continue
+			}
+			key := XModelStateKey(txOutExt.Bucket, txOutExt.Key)
+			if bytes.Equal(txOutExt.Value, []byte(xmodelDelFlag)) {
+				changes = putStateChange(changes, key, nil)
+				continue
+			}
+			version := fmt.Sprintf("%x_%d", tx.Txid, offset)
+			changes = putStateChange(changes, key, XModelStateValue(version, txOutExt.Value))
+		}
+		for _, txInput := range tx.TxInputs {
+			changes = putStateChangeHash(changes, utxoStateKeyHash(txInput.FromAddr, txInput.RefTxid, txInput.RefOffset), nil)
+		}
+		for offset, txOutput := range tx.TxOutputs {
+			if big.NewInt(0).SetBytes(txOutput.Amount).Sign() == 0 {
+				continue
+			}
+			toAddr, frozenHeight := txOutput.ToAddr, txOutput.FrozenHeight
+			if bytes.Equal(toAddr, []byte(feePlaceholder)) {
+				// 小费在utxoVM中替换为矿工的utxo, 且没有冻结高度
+				toAddr, frozenHeight = proposer, 0
+			}
+			changes = putStateChangeHash(changes, utxoStateKeyHash(toAddr, tx.Txid, int32(offset)),
+				UtxoStateValue(txOutput.Amount, frozenHeight))
+		}
+	}
+	return changes
+}
+
+// StateRootEnabled returns whether blocks record the root of state tree and the changes of validators
+func (l *Ledger) StateRootEnabled() bool {
+	return l.isStateRootEnabled(false, nil)
+}
+
+// isStateRootEnabled returns whether the state root is enabled in genesis config,
+// txs is used to parse the config when confirming genesis block
+func (l *Ledger) isStateRootEnabled(isRoot bool, txs []*pb.Transaction) bool {
+	if !isRoot {
+		return l.GenesisBlock != nil && l.GenesisBlock.GetConfig().StateRoot
+	}
+	for _, tx := range txs {
+		if !tx.Coinbase {
+			continue
+		}
+		config := &RootConfig{}
+		if err := json.Unmarshal(tx.Desc, config); err != nil {
+			return false
+		}
+		return config.StateRoot
+	}
+	return false
+}
+
+// makeStateRoot applies the changes of txs to the state tree of the pre block and returns the new root,
+// it returns nil if state root is not enabled
+func (l *Ledger) makeStateRoot(isRoot bool, preHash []byte, proposer []byte, txs []*pb.Transaction) ([]byte, *stateTree, error) {
+	if !l.isStateRootEnabled(isRoot, txs) {
+		return nil, nil, nil
+	}
+	var preRoot []byte
+	if !isRoot {
+		preBlock, err := l.fetchBlock(preHash)
+		if err != nil {
+			return nil, nil, err
+		}
+		preRoot = preBlock.StateRoot
+	}
+	tree := newStateTree(l.baseDB)
+	root, err := tree.update(preRoot, makeStateChanges(proposer, txs))
+	if err != nil {
+		return nil, nil, err
+	}
+	return root, tree, nil
+}
+
+// VerifyStateRoot checks the state root of block is computed from its pre block and transactions,
+// the pre block must exist in the ledger
+func (l *Ledger) VerifyStateRoot(block *pb.InternalBlock) error {
+	root, _, err := l.makeStateRoot(false, block.PreHash, block.Proposer, block.Transactions)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(root, block.StateRoot) {
+		return fmt.Errorf("%s, expect %x got %x", ErrStateRootMismatch, root, block.StateRoot)
+	}
+	return nil
+}
+
+// StateTreeBuilder collects all the utxos and xmodel data of a state and rebuilds its state tree,
+// which is used to verify a state imported from untrusted sources against the state root of block
+type StateTreeBuilder struct {
+	puts []stateChange
+}
+
+// NewStateTreeBuilder returns an empty StateTreeBuilder
+func NewStateTreeBuilder() *StateTreeBuilder {
+	return &StateTreeBuilder{}
+}
+
+// PutUtxo adds an utxo, utxos of zero amount are not in state tree
+func (b *StateTreeBuilder) PutUtxo(addr []byte, txid []byte, offset int32, amount []byte, frozenHeight int64) {
+	if big.NewInt(0).SetBytes(amount).Sign() == 0 {
+		return
+	}
+	b.puts = putStateChangeHash(b.puts, utxoStateKeyHash(addr, txid, offset), UtxoStateValue(amount, frozenHeight))
+}
+
+// PutXModel adds a xmodel data, version is txid_offset of the tx writing the data
+func (b *StateTreeBuilder) PutXModel(bucket string, key []byte, version string, value []byte) {
+	b.puts = putStateChange(b.puts, XModelStateKey(bucket, key), XModelStateValue(version, value))
+}
+
+// RebuildStateTree builds the state tree from the state collected by b, and saves the tree nodes into ledger
+// if its root equals to expect, the nodes already in ledger are overwritten since they may be untrusted
+func (l *Ledger) RebuildStateTree(b *StateTreeBuilder, expect []byte) error {
+	sort.Slice(b.puts, func(i, j int) bool {
+		return bytes.Compare(b.puts[i].keyHash, b.puts[j].keyHash) < 0
+	})
+	for i := 1; i < len(b.puts); i++ {
+		if bytes.Equal(b.puts[i-1].keyHash, b.puts[i].keyHash) {
+			return fmt.Errorf("duplicated state key %x", b.puts[i].keyHash)
+		}
+	}
+	tree := newStateTree(nil)
+	root := tree.buildNode(0, b.puts)
+	if isEmptyStateHash(root) {
+		root = EmptyStateRoot
+	}
+	if !bytes.Equal(root, expect) {
+		return fmt.Errorf("%s, expect %x got %x", ErrStateRootMismatch, expect, root)
+	}
+	batch := l.baseDB.NewBatch()
+	for h, node := range tree.nodes {
+		if err := batch.Put(append([]byte(pb.StateTreePrefix), h...), node); err != nil {
+			return err
+		}
+		if batch.ValueSize() < stateTreeBatchSize {
+			continue
+		}
+		if err := batch.Write(); err != nil {
+			return err
+		}
+		batch.Reset()
+	}
+	return batch.Write()
+}
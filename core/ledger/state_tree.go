@@ -0,0 +1,248 @@
+package ledger
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/xuperchain/xuperchain/core/crypto/hash"
+	"github.com/xuperchain/xuperchain/core/kv/kvdb"
+	"github.com/xuperchain/xuperchain/core/pb"
+)
+
+// 状态树是以sha256(key)为路径的稀疏merkle树, 只含一个叶子的子树压缩为该叶子.
+// 叶子节点为 0x00|sha256(key)|sha256(value), 中间节点为 0x01|left|right, 空子树为32字节的0.
+// 节点以hash为key保存在账本的ZS表中, 不同区块的状态树共享未改变的节点
+const (
+	leafNodeFlag  = 0x00
+	innerNodeFlag = 0x01
+	stateHashSize = 32
+	stateNodeSize = 1 + 2*stateHashSize
+	stateTreeBits = stateHashSize * 8
+)
+
+var (
+	// ErrStateNodeCorrupted is returned when a state tree node is malformed
+	ErrStateNodeCorrupted = errors.New("state tree node corrupted")
+	// EmptyStateRoot is the root of an empty state tree
+	EmptyStateRoot = make([]byte, stateHashSize)
+)
+
+// stateChange is the change of one key, valueHash is nil if the key is deleted
+type stateChange struct {
+	keyHash   []byte
+	valueHash []byte
+}
+
+// stateTree updates the state tree, new nodes are kept in memory until flushed into a batch
+type stateTree struct {
+	db    kvdb.Database
+	nodes map[string][]byte
+}
+
+func newStateTree(db kvdb.Database) *stateTree {
+	return &stateTree{
+		db:    db,
+		nodes: make(map[string][]byte),
+	}
+}
+
+func isEmptyStateHash(h []byte) bool {
+	return len(h) == 0 || bytes.Equal(h, EmptyStateRoot)
+}
+
+// stateBit returns the bit of keyHash at depth, the highest bit first
+func stateBit(keyHash []byte, depth int) byte {
+	return (keyHash[depth/8] >> (7 - uint(depth%8))) & 1
+}
+
+func encodeLeafNode(keyHash, valueHash []byte) []byte {
+	node := make([]byte, 0, stateNodeSize)
+	node = append(node, leafNodeFlag)
+	node = append(node, keyHash...)
+	return append(node, valueHash...)
+}
+
+func encodeInnerNode(left, right []byte) []byte {
+	node := make([]byte, 0, stateNodeSize)
+	node = append(node, innerNodeFlag)
+	if isEmptyStateHash(left) {
+		left = EmptyStateRoot
+	}
+	if isEmptyStateHash(right) {
+		right = EmptyStateRoot
+	}
+	node = append(node, left...)
+	return append(node, right...)
+}
+
+func (t *stateTree) putNode(node []byte) []byte {
+	h := hash.UsingSha256(node)
+	t.nodes[string(h)] = node
+	return h
+}
+
+func (t *stateTree) getNode(h []byte) ([]byte, error) {
+	if node, ok := t.nodes[string(h)]; ok {
+		return node, nil
+	}
+	node, err := t.db.Get(append([]byte(pb.StateTreePrefix), h...))
+	if err != nil {
+		return nil, fmt.Errorf("get state tree node %x error: %v", h, err)
+	}
+	if len(node) != stateNodeSize || (node[0] != leafNodeFlag && node[0] != innerNodeFlag) {
+		return nil, ErrStateNodeCorrupted
+	}
+	return node, nil
+}
+
+// flush writes the new nodes into batch
+func (t *stateTree) flush(batch kvdb.Batch) error {
+	for h, node := range t.nodes {
+		if err := batch.Put(append([]byte(pb.StateTreePrefix), h...), node); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// update applies the changes to the tree of root and returns the new root.
+// If a key has more than one change, the last one takes effect.
+func (t *stateTree) update(root []byte, changes []stateChange) ([]byte, error) {
+	sorted := make([]stateChange, 0, len(changes))
+	index := make(map[string]int, len(changes))
+	for _, c := range changes {
+		if i, ok := index[string(c.keyHash)]; ok {
+			sorted[i] = c
+			continue
+		}
+		index[string(c.keyHash)] = len(sorted)
+		sorted = append(sorted, c)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].keyHash, sorted[j].keyHash) < 0
+	})
+	newRoot, err := t.updateNode(root, 0, sorted)
+	if err != nil {
+		return nil, err
+	}
+	if isEmptyStateHash(newRoot) {
+		return EmptyStateRoot, nil
+	}
+	return newRoot, nil
+}
+
+func (t *stateTree) updateNode(h []byte, depth int, changes []stateChange) ([]byte, error) {
+	if len(changes) == 0 {
+		return h, nil
+	}
+	if isEmptyStateHash(h) {
+		return t.build(depth, changes), nil
+	}
+	node, err := t.getNode(h)
+	if err != nil {
+		return nil, err
+	}
+	if node[0] == leafNodeFlag {
+		// 原有叶子没有被修改时, 和变更一起重建这棵子树
+		leafKey := node[1 : 1+stateHashSize]
+		i := sort.Search(len(changes), func(i int) bool {
+			return bytes.Compare(changes[i].keyHash, leafKey) >= 0
+		})
+		if i == len(changes) || !bytes.Equal(changes[i].keyHash, leafKey) {
+			merged := make([]stateChange, 0, len(changes)+1)
+			merged = append(merged, changes[:i]...)
+			merged = append(merged, stateChange{keyHash: leafKey, valueHash: node[1+stateHashSize:]})
+			changes = append(merged, changes[i:]...)
+		}
+		return t.build(depth, changes), nil
+	}
+	if depth >= stateTreeBits {
+		return nil, ErrStateNodeCorrupted
+	}
+	split := splitStateChanges(changes, depth)
+	left, err := t.updateNode(node[1:1+stateHashSize], depth+1, changes[:split])
+	if err != nil {
+		return nil, err
+	}
+	right, err := t.updateNode(node[1+stateHashSize:], depth+1, changes[split:])
+	if err != nil {
+		return nil, err
+	}
+	return t.makeInner(left, right)
+}
+
+// makeInner keeps the tree compact, a subtree with only one leaf is replaced by the leaf
+func (t *stateTree) makeInner(left, right []byte) ([]byte, error) {
+	if isEmptyStateHash(left) && isEmptyStateHash(right) {
+		return nil, nil
+	}
+	if isEmptyStateHash(left) || isEmptyStateHash(right) {
+		child := left
+		if isEmptyStateHash(left) {
+			child = right
+		}
+		node, err := t.getNode(child)
+		if err != nil {
+			return nil, err
+		}
+		if node[0] == leafNodeFlag {
+			return child, nil
+		}
+	}
+	return t.putNode(encodeInnerNode(left, right)), nil
+}
+
+// build creates a subtree from the changes, deleted keys are ignored
+func (t *stateTree) build(depth int, changes []stateChange) []byte {
+	puts := make([]stateChange, 0, len(changes))
+	for _, c := range changes {
+		if c.valueHash != nil {
+			puts = append(puts, c)
+		}
+	}
+	return t.buildNode(depth, puts)
+}
+
+func (t *stateTree) buildNode(depth int, puts []stateChange) []byte {
+	switch len(puts) {
+	case 0:
+		return nil
+	case 1:
+		return t.putNode(encodeLeafNode(puts[0].keyHash, puts[0].valueHash))
+	}
+	split := splitStateChanges(puts, depth)
+	left := t.buildNode(depth+1, puts[:split])
+	right := t.buildNode(depth+1, puts[split:])
+	return t.putNode(encodeInnerNode(left, right))
+}
+
+// splitStateChanges returns the index of the first change whose bit at depth is 1
+func splitStateChanges(changes []stateChange, depth int) int {
+	return sort.Search(len(changes), func(i int) bool {
+		return stateBit(changes[i].keyHash, depth) == 1
+	})
+}
+
+// WalkStateTree calls fn with the db key and value of every node of the state tree of root
+func (l *Ledger) WalkStateTree(root []byte, fn func(key, value []byte) error) error {
+	if isEmptyStateHash(root) {
+		return nil
+	}
+	t := newStateTree(l.baseDB)
+	node, err := t.getNode(root)
+	if err != nil {
+		return err
+	}
+	if err := fn(append([]byte(pb.StateTreePrefix), root...), node); err != nil {
+		return err
+	}
+	if node[0] == leafNodeFlag {
+		return nil
+	}
+	if err := l.WalkStateTree(node[1:1+stateHashSize], fn); err != nil {
+		return err
+	}
+	return l.WalkStateTree(node[1+stateHashSize:], fn)
+}
@@ -0,0 +1,203 @@
+package ledger
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+
+	crypto_client "github.com/xuperchain/xuperchain/core/crypto/client"
+	"github.com/xuperchain/xuperchain/core/kv/kvdb"
+	"github.com/xuperchain/xuperchain/core/pb"
+	"github.com/xuperchain/xuperchain/core/utxo/txhash"
+)
+
+func newTestStateDB(t *testing.T) (kvdb.Database, func()) {
+	workSpace, err := ioutil.TempDir("/tmp", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := kvdb.NewKVDBInstance(&kvdb.KVParameter{
+		DBPath:                workSpace,
+		KVEngineType:          DefaultKvEngine,
+		MemCacheSize:          MemCacheSize,
+		FileHandlersCacheSize: FileHandlersCacheSize,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db, func() {
+		db.Close()
+		os.RemoveAll(workSpace)
+	}
+}
+
+func testStateChanges(from, to int, value string) []stateChange {
+	var changes []stateChange
+	for i := from; i < to; i++ {
+		var v []byte
+		if value != "" {
+			v = []byte(value)
+		}
+		changes = putStateChange(changes, []byte(fmt.Sprintf("key%d", i)), v)
+	}
+	return changes
+}
+
+func updateStateTree(t *testing.T, db kvdb.Database, root []byte, changes []stateChange) []byte {
+	tree := newStateTree(db)
+	newRoot, err := tree.update(root, changes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	batch := db.NewBatch()
+	if err := tree.flush(batch); err != nil {
+		t.Fatal(err)
+	}
+	if err := batch.Write(); err != nil {
+		t.Fatal(err)
+	}
+	return newRoot
+}
+
+func TestStateTree(t *testing.T) {
+	db, clean := newTestStateDB(t)
+	defer clean()
+
+	// 一次写入和分批写入得到相同的根
+	root := updateStateTree(t, db, nil, testStateChanges(0, 100, "v"))
+	root1 := updateStateTree(t, db, nil, testStateChanges(0, 50, "v"))
+	root1 = updateStateTree(t, db, root1, testStateChanges(50, 100, "v"))
+	if !bytes.Equal(root, root1) {
+		t.Fatalf("expect root %x got %x", root, root1)
+	}
+
+	// 修改后再改回来, 根不变
+	root2 := updateStateTree(t, db, root, testStateChanges(10, 20, "v2"))
+	if bytes.Equal(root, root2) {
+		t.Fatal("expect root changed")
+	}
+	root2 = updateStateTree(t, db, root2, testStateChanges(10, 20, "v"))
+	if !bytes.Equal(root, root2) {
+		t.Fatalf("expect root %x got %x", root, root2)
+	}
+
+	// 删除后和从未写入的树相同
+	root3 := updateStateTree(t, db, root, testStateChanges(30, 100, ""))
+	expect := updateStateTree(t, db, nil, testStateChanges(0, 30, "v"))
+	if !bytes.Equal(root3, expect) {
+		t.Fatalf("expect root %x got %x", expect, root3)
+	}
+	root3 = updateStateTree(t, db, root3, testStateChanges(0, 30, ""))
+	if !bytes.Equal(root3, EmptyStateRoot) {
+		t.Fatalf("expect empty root got %x", root3)
+	}
+
+	// 同一个key的多次变更以最后一次为准
+	changes := append(testStateChanges(0, 100, "v2"), testStateChanges(0, 100, "v")...)
+	if root4 := updateStateTree(t, db, nil, changes); !bytes.Equal(root, root4) {
+		t.Fatalf("expect root %x got %x", root, root4)
+	}
+
+	count := 0
+	l := &Ledger{baseDB: db}
+	err := l.WalkStateTree(root, func(key, value []byte) error {
+		count++
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count < 199 {
+		t.Fatalf("expect at least 199 nodes got %d", count)
+	}
+}
+
+func TestStateRoot(t *testing.T) {
+	workSpace, dirErr := ioutil.TempDir("/tmp", "")
+	if dirErr != nil {
+		t.Fatal(dirErr)
+	}
+	os.RemoveAll(workSpace)
+	defer os.RemoveAll(workSpace)
+	ledger, err := NewLedger(workSpace, nil, nil, DefaultKvEngine, crypto_client.CryptoTypeDefault)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ledger.Close()
+
+	t1 := &pb.Transaction{}
+	t1.TxOutputs = append(t1.TxOutputs, &pb.TxOutput{Amount: []byte("888"), ToAddr: []byte(BobAddress)})
+	t1.Coinbase = true
+	t1.Desc = []byte(`{"maxblocksize" : "128", "state_root": true}`)
+	t1.Txid, _ = txhash.MakeTransactionID(t1)
+	block, err := ledger.FormatRootBlock([]*pb.Transaction{t1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(block.StateRoot) == 0 {
+		t.Fatal("expect state root of genesis block")
+	}
+	if status := ledger.ConfirmBlock(block, true); !status.Succ {
+		t.Fatalf("confirm genesis block fail, %v", status.Error)
+	}
+
+	ecdsaPk, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	t2 := &pb.Transaction{}
+	t2.TxInputs = append(t2.TxInputs, &pb.TxInput{RefTxid: t1.Txid, RefOffset: 0, FromAddr: []byte(BobAddress)})
+	t2.TxOutputs = append(t2.TxOutputs, &pb.TxOutput{Amount: []byte("880"), ToAddr: []byte(AliceAddress)})
+	t2.TxOutputs = append(t2.TxOutputs, &pb.TxOutput{Amount: []byte("8"), ToAddr: []byte(feePlaceholder)})
+	t2.TxOutputsExt = append(t2.TxOutputsExt, &pb.TxOutputExt{Bucket: "bucket", Key: []byte("key"), Value: []byte("value")})
+	t2.Txid, _ = txhash.MakeTransactionID(t2)
+	block2, err := ledger.FormatBlock([]*pb.Transaction{t2}, []byte(AliceAddress), ecdsaPk,
+		223456789, 0, 0, block.Blockid, big.NewInt(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(block2.StateRoot) == 0 || bytes.Equal(block.StateRoot, block2.StateRoot) {
+		t.Fatalf("unexpected state root %x", block2.StateRoot)
+	}
+	if err := ledger.VerifyStateRoot(block2); err != nil {
+		t.Fatal(err)
+	}
+
+	// 篡改后的状态根不能被确认
+	fakeBlock := *block2
+	fakeBlock.StateRoot = block.StateRoot
+	if status := ledger.ConfirmBlock(&fakeBlock, false); status.Succ {
+		t.Fatal("expect error of state root mismatch")
+	}
+	if status := ledger.ConfirmBlock(block2, false); !status.Succ {
+		t.Fatalf("confirm block fail, %v", status.Error)
+	}
+	header, err := ledger.QueryBlockHeader(block2.Blockid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(header.StateRoot, block2.StateRoot) {
+		t.Fatalf("expect state root %x got %x", block2.StateRoot, header.StateRoot)
+	}
+
+	// 由完整状态重建的状态树需与区块的状态根一致
+	version := fmt.Sprintf("%x_%d", t2.Txid, 0)
+	builder := NewStateTreeBuilder()
+	builder.PutUtxo([]byte(AliceAddress), t2.Txid, 0, []byte("880"), 0)
+	// 小费是出块矿工的utxo
+	builder.PutUtxo([]byte(AliceAddress), t2.Txid, 1, []byte("8"), 0)
+	builder.PutXModel("bucket", []byte("key"), version, []byte("value"))
+	if err := ledger.RebuildStateTree(builder, block2.StateRoot); err != nil {
+		t.Fatal(err)
+	}
+	fakeBuilder := NewStateTreeBuilder()
+	fakeBuilder.PutUtxo([]byte(AliceAddress), t2.Txid, 0, []byte("880"), 0)
+	fakeBuilder.PutUtxo([]byte(feePlaceholder), t2.Txid, 1, []byte("8"), 0)
+	fakeBuilder.PutXModel("bucket", []byte("key"), version, []byte("value"))
+	if err := ledger.RebuildStateTree(fakeBuilder, block2.StateRoot); err == nil {
+		t.Fatal("expect error of tampered state")
+	}
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/patrickmn/go-cache"
 	prom "github.com/prometheus/client_golang/prometheus"
 	log "github.com/xuperchain/log15"
+
+	"github.com/xuperchain/xuperchain/core/common/config"
 	xuperp2p "github.com/xuperchain/xuperchain/core/p2p/pb"
 )
 
@@ -38,28 +40,60 @@ type HandlerMap struct {
 	msgHandled       *cache.Cache
 	enableMetric     bool
 	quitCh           chan bool
+	// limiter drop messages exceeding the rate limitation, nil means no limitation
+	limiter *MsgLimiter
+	// scheduler handle messages by priority in its workers, nil means handling in the goroutine of stream
+	scheduler *MsgScheduler
+}
+
+// HandlerMapOption define single option function of HandlerMap
+type HandlerMapOption func(*HandlerMap)
+
+// WithMsgLimits limit the rate of each message type for every peer
+func WithMsgLimits(limits map[string]config.MsgRateLimit) HandlerMapOption {
+	return func(hm *HandlerMap) {
+		hm.limiter = NewMsgLimiter(limits)
+	}
+}
+
+// WithMsgScheduler handle messages by priority in the worker pools of scheduler.
+// Do not use it if the response must be written before HandleMessage returns.
+func WithMsgScheduler(queueSize int, workers int) HandlerMapOption {
+	return func(hm *HandlerMap) {
+		hm.scheduler = NewMsgScheduler(queueSize, workers)
+	}
 }
 
 // NewHandlerMap create instance of HandlerMap
-func NewHandlerMap(log log.Logger, enableMetric bool) (*HandlerMap, error) {
+func NewHandlerMap(log log.Logger, enableMetric bool, opts ...HandlerMapOption) (*HandlerMap, error) {
 	log.Trace("Create NewHandlerMap")
-	return &HandlerMap{
+	hm := &HandlerMap{
 		lg:               log,
 		subscriberCenter: new(sync.Map),
 		msgHandled:       cache.New(time.Duration(3)*time.Second, 1*time.Second),
 		enableMetric:     enableMetric,
 		quitCh:           make(chan bool, 1),
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(hm)
+	}
+	return hm, nil
 }
 
 // Start start message handling
 func (hm *HandlerMap) Start() {
 	hm.lg.Trace("Start HandlerMap")
+	if hm.scheduler != nil {
+		hm.scheduler.Start()
+	}
 }
 
 // Stop stop message handling
 func (hm *HandlerMap) Stop() {
 	hm.lg.Trace("Stop HandlerMap")
+	if hm.scheduler != nil {
+		hm.scheduler.Stop()
+	}
 }
 
 // GetSubscriberCenter get the map of subscribers
@@ -148,6 +182,11 @@ func (hm *HandlerMap) HandleMessage(stream interface{}, msg *xuperp2p.XuperMessa
 		hm.lg.Warn("HandlerMap load subscribeCenter not found!", "msgType", msgType)
 		return nil
 	}
+	if hm.limiter != nil && !hm.limiter.Allow(msg.GetHeader().GetFrom(), msgType) {
+		hm.lg.Trace("HandlerMap drop msg exceeding rate limit", "logid", msg.GetHeader().GetLogid(), "msgType", msgType, "from", msg.GetHeader().GetFrom())
+		hm.markMsgDropped(msg, MsgDropRateLimit)
+		return nil
+	}
 
 	if hm.enableMetric {
 		metricLabels := prom.Labels{
@@ -158,7 +197,18 @@ func (hm *HandlerMap) HandleMessage(stream interface{}, msg *xuperp2p.XuperMessa
 	}
 
 	if ms, ok := v.(*MultiSubscriber); ok {
+		if hm.scheduler != nil && !isResponseMsg(msgType) {
+			// 按优先级排队, 保证共识和区块消息不被交易消息阻塞
+			if !hm.scheduler.push(&msgJob{stream: stream, msg: msg, ms: ms}) {
+				hm.lg.Warn("HandlerMap drop msg because queue is full", "logid", msg.GetHeader().GetLogid(), "msgType", msgType)
+				hm.markMsgDropped(msg, MsgDropQueueFull)
+				return nil
+			}
+			hm.MarkMsgAsHandled(msg)
+			return nil
+		}
 		// 如果注册了回调方法，则调用回调方法, 如果注册了channel,则进行通知
+		// 响应消息只需通知等待的请求方, 直接投递
 		ms.handleMessage(stream, msg)
 		hm.MarkMsgAsHandled(msg)
 		return nil
@@ -166,3 +216,15 @@ func (hm *HandlerMap) HandleMessage(stream interface{}, msg *xuperp2p.XuperMessa
 	hm.lg.Warn("HandleMessage get MultiSubscriber error")
 	return nil
 }
+
+// markMsgDropped count the dropped message in metrics
+func (hm *HandlerMap) markMsgDropped(msg *xuperp2p.XuperMessage, reason string) {
+	if !hm.enableMetric {
+		return
+	}
+	DefaultP2pMetrics.P2PMsgDropped.With(prom.Labels{
+		"bcname": msg.GetHeader().GetBcname(),
+		"type":   msg.GetHeader().GetType().String(),
+		"reason": reason,
+	}).Inc()
+}
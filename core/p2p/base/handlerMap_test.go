@@ -74,8 +74,10 @@ func (msub *MockSubscriber) SetElement(e *list.Element) {
 }
 
 // HandleMessage process a message
-func (*MockSubscriber) HandleMessage(stream interface{}, msg *xuperp2p.XuperMessage) {
-	return
+func (msub *MockSubscriber) HandleMessage(stream interface{}, msg *xuperp2p.XuperMessage) {
+	if msub.handler != nil {
+		msub.handler(context.Background(), msg)
+	}
 }
 
 func TestStartHandlerMap(t *testing.T) {
@@ -103,6 +103,14 @@ func GetResMsgType(msgType xuperp2p.XuperMessage_MessageType) xuperp2p.XuperMess
 		return xuperp2p.XuperMessage_GET_RPC_PORT_RES
 	case xuperp2p.XuperMessage_GET_AUTHENTICATION:
 		return xuperp2p.XuperMessage_GET_AUTHENTICATION_RES
+	case xuperp2p.XuperMessage_GET_BLOCK_HEADERS:
+		return xuperp2p.XuperMessage_GET_BLOCK_HEADERS_RES
+	case xuperp2p.XuperMessage_GET_BLOCK_TXS:
+		return xuperp2p.XuperMessage_GET_BLOCK_TXS_RES
+	case xuperp2p.XuperMessage_GET_TX_PROOF:
+		return xuperp2p.XuperMessage_GET_TX_PROOF_RES
+	case xuperp2p.XuperMessage_GET_UTXO_PROOF:
+		return xuperp2p.XuperMessage_GET_UTXO_PROOF_RES
 	default:
 		return xuperp2p.XuperMessage_MSG_TYPE_NONE
 	}
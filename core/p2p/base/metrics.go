@@ -22,23 +22,38 @@ var (
 			Help: "Current flow out of p2p server",
 		},
 		[]string{"bcname", "type"})
+	p2pMsgDropped = prom.NewCounterVec(
+		prom.CounterOpts{
+			Name: "p2p_msg_dropped",
+			Help: "Number of received messages dropped by p2p server",
+		},
+		[]string{"bcname", "type", "reason"})
+)
+
+// reasons of dropping received messages
+const (
+	MsgDropRateLimit = "rate_limit"
+	MsgDropQueueFull = "queue_full"
 )
 
 // p2pMetrics is the metrics of p2p server
 type p2pMetrics struct {
-	P2PFlowIn  *prom.CounterVec
-	P2PFlowOut *prom.CounterVec
+	P2PFlowIn     *prom.CounterVec
+	P2PFlowOut    *prom.CounterVec
+	P2PMsgDropped *prom.CounterVec
 }
 
 // newP2pMetrics return
 func newP2pMetrics() *p2pMetrics {
 	return &p2pMetrics{
-		P2PFlowIn:  p2pFlowIn,
-		P2PFlowOut: p2pFlowOut,
+		P2PFlowIn:     p2pFlowIn,
+		P2PFlowOut:    p2pFlowOut,
+		P2PMsgDropped: p2pMsgDropped,
 	}
 }
 
 func init() {
 	prom.MustRegister(p2pFlowIn)
 	prom.MustRegister(p2pFlowOut)
+	prom.MustRegister(p2pMsgDropped)
 }
@@ -82,3 +82,18 @@ func (mp *MockP2pServer) SetCorePeers(corePeers *CorePeersInfo) error {
 func (mp *MockP2pServer) SetXchainAddr(bcname string, info *XchainAddrInfo) {
 
 }
+
+// ReportPeer implements the ReportPeer interface
+func (mp *MockP2pServer) ReportPeer(peerID string, event PeerEvent) {
+
+}
+
+// GetBannedPeers implements the GetBannedPeers interface
+func (mp *MockP2pServer) GetBannedPeers() []*BannedPeer {
+	return nil
+}
+
+// UnbanPeers implements the UnbanPeers interface
+func (mp *MockP2pServer) UnbanPeers(peerIDs []string) []*BannedPeer {
+	return nil
+}
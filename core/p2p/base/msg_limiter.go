@@ -0,0 +1,96 @@
+package base
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+
+	"github.com/xuperchain/xuperchain/core/common/config"
+	xuperp2p "github.com/xuperchain/xuperchain/core/p2p/pb"
+)
+
+// buckets of peers idle for MsgBucketExpiration are released
+const MsgBucketExpiration = 10 * time.Minute
+
+// TokenBucket is a token bucket refilled at rate tokens per second, holding burst tokens at most
+type TokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucket create a full TokenBucket
+func NewTokenBucket(rate float64, burst int64, now time.Time) *TokenBucket {
+	return &TokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   now,
+	}
+}
+
+// Allow take one token from the bucket, return false if there's no token left
+func (tb *TokenBucket) Allow(now time.Time) bool {
+	if elapsed := now.Sub(tb.last).Seconds(); elapsed > 0 {
+		tb.tokens += elapsed * tb.rate
+		if tb.tokens > tb.burst {
+			tb.tokens = tb.burst
+		}
+	}
+	tb.last = now
+	if tb.tokens < 1 {
+		return false
+	}
+	tb.tokens--
+	return true
+}
+
+// MsgLimiter limit the rate of each message type for every peer
+type MsgLimiter struct {
+	limits map[xuperp2p.XuperMessage_MessageType]config.MsgRateLimit
+	// key: peer + "_" + message type, value: *TokenBucket
+	buckets *cache.Cache
+	mutex   sync.Mutex
+}
+
+// NewMsgLimiter create MsgLimiter from config, the key of limits is the name of message type,
+// invalid names and limits are ignored
+func NewMsgLimiter(limits map[string]config.MsgRateLimit) *MsgLimiter {
+	ml := &MsgLimiter{
+		limits:  make(map[xuperp2p.XuperMessage_MessageType]config.MsgRateLimit),
+		buckets: cache.New(MsgBucketExpiration, MsgBucketExpiration),
+	}
+	for name, limit := range limits {
+		// viper会将配置中map的key转为小写
+		msgType, ok := xuperp2p.XuperMessage_MessageType_value[strings.ToUpper(name)]
+		if !ok || limit.Rate <= 0 || limit.Burst <= 0 {
+			continue
+		}
+		ml.limits[xuperp2p.XuperMessage_MessageType(msgType)] = limit
+	}
+	return ml
+}
+
+// Allow check whether the message of msgType from peer is allowed now
+func (ml *MsgLimiter) Allow(peer string, msgType xuperp2p.XuperMessage_MessageType) bool {
+	limit, ok := ml.limits[msgType]
+	if !ok {
+		return true
+	}
+	key := peer + "_" + msgType.String()
+	now := time.Now()
+	ml.mutex.Lock()
+	defer ml.mutex.Unlock()
+	var tb *TokenBucket
+	if v, ok := ml.buckets.Get(key); ok {
+		tb = v.(*TokenBucket)
+	} else {
+		tb = NewTokenBucket(limit.Rate, limit.Burst, now)
+	}
+	// 每次访问都刷新过期时间, 只释放长时间空闲的节点
+	ml.buckets.Set(key, tb, cache.DefaultExpiration)
+	return tb.Allow(now)
+}
@@ -0,0 +1,63 @@
+package base
+
+import (
+	"testing"
+	"time"
+
+	"github.com/xuperchain/xuperchain/core/common/config"
+	xuperp2p "github.com/xuperchain/xuperchain/core/p2p/pb"
+)
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Now()
+	tb := NewTokenBucket(2, 3, now)
+	testCases := []struct {
+		elapsed  time.Duration
+		expected bool
+	}{
+		{0, true},
+		{0, true},
+		{0, true},
+		{0, false},
+		// 0.5秒补充1个令牌
+		{500 * time.Millisecond, true},
+		{500 * time.Millisecond, false},
+		// 补充的令牌不超过burst
+		{10 * time.Second, true},
+		{10 * time.Second, true},
+		{10 * time.Second, true},
+		{10 * time.Second, false},
+	}
+	for index, tc := range testCases {
+		if actual := tb.Allow(now.Add(tc.elapsed)); actual != tc.expected {
+			t.Errorf("case %d expected %v actual %v", index, tc.expected, actual)
+		}
+	}
+}
+
+func TestMsgLimiter(t *testing.T) {
+	ml := NewMsgLimiter(map[string]config.MsgRateLimit{
+		"posttx":        {Rate: 0.001, Burst: 2},
+		"GET_BLOCK":     {Rate: 0, Burst: 2},
+		"NOT_EXIST_MSG": {Rate: 1, Burst: 1},
+	})
+	if len(ml.limits) != 1 {
+		t.Fatalf("expect only POSTTX limited, got %v", ml.limits)
+	}
+	for i := 0; i < 2; i++ {
+		if !ml.Allow("peer1", xuperp2p.XuperMessage_POSTTX) {
+			t.Fatalf("msg %d should be allowed", i)
+		}
+	}
+	if ml.Allow("peer1", xuperp2p.XuperMessage_POSTTX) {
+		t.Error("msg exceeding burst should be dropped")
+	}
+	if !ml.Allow("peer2", xuperp2p.XuperMessage_POSTTX) {
+		t.Error("buckets of peers should be independent")
+	}
+	for i := 0; i < 10; i++ {
+		if !ml.Allow("peer1", xuperp2p.XuperMessage_GET_BLOCK) {
+			t.Fatal("msg type without limit should be allowed")
+		}
+	}
+}
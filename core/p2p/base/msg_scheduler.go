@@ -0,0 +1,151 @@
+package base
+
+import (
+	"sync"
+
+	xuperp2p "github.com/xuperchain/xuperchain/core/p2p/pb"
+)
+
+// MsgPriority is the priority of received messages, smaller value is served first
+type MsgPriority int
+
+// define the message priorities
+const (
+	// MsgPriorityHigh consensus and block messages, and responses of our own requests
+	MsgPriorityHigh MsgPriority = iota
+	// MsgPriorityNormal queries from peers
+	MsgPriorityNormal
+	// MsgPriorityLow tx gossip
+	MsgPriorityLow
+
+	msgPriorityCount
+)
+
+var msgPriorityNames = map[MsgPriority]string{
+	MsgPriorityHigh:   "high",
+	MsgPriorityNormal: "normal",
+	MsgPriorityLow:    "low",
+}
+
+func (p MsgPriority) String() string {
+	return msgPriorityNames[p]
+}
+
+// GetMsgPriority return the priority of message type
+func GetMsgPriority(msgType xuperp2p.XuperMessage_MessageType) MsgPriority {
+	switch msgType {
+	case xuperp2p.XuperMessage_CHAINED_BFT_NEW_VIEW_MSG,
+		xuperp2p.XuperMessage_CHAINED_BFT_NEW_PROPOSAL_MSG,
+		xuperp2p.XuperMessage_CHAINED_BFT_VOTE_MSG,
+		xuperp2p.XuperMessage_SENDBLOCK,
+		xuperp2p.XuperMessage_NEW_BLOCKID,
+		xuperp2p.XuperMessage_NEW_COMPACT_BLOCK,
+		xuperp2p.XuperMessage_GET_BLOCK_RES,
+		xuperp2p.XuperMessage_GET_BLOCK_TXS_RES,
+		xuperp2p.XuperMessage_GET_BLOCKCHAINSTATUS_RES,
+		xuperp2p.XuperMessage_CONFIRM_BLOCKCHAINSTATUS_RES,
+		xuperp2p.XuperMessage_GET_RPC_PORT_RES,
+		xuperp2p.XuperMessage_GET_AUTHENTICATION_RES,
+		xuperp2p.XuperMessage_GET_BLOCK_HEADERS_RES:
+		return MsgPriorityHigh
+	case xuperp2p.XuperMessage_POSTTX, xuperp2p.XuperMessage_BATCHPOSTTX:
+		return MsgPriorityLow
+	default:
+		return MsgPriorityNormal
+	}
+}
+
+// isResponseMsg return whether msgType is the response of a request, responses only wake up the waiting requesters,
+// so they are delivered immediately instead of waiting for workers which may be blocked by the requesters
+func isResponseMsg(msgType xuperp2p.XuperMessage_MessageType) bool {
+	switch msgType {
+	case xuperp2p.XuperMessage_GET_BLOCK_RES,
+		xuperp2p.XuperMessage_GET_BLOCKCHAINSTATUS_RES,
+		xuperp2p.XuperMessage_CONFIRM_BLOCKCHAINSTATUS_RES,
+		xuperp2p.XuperMessage_GET_RPC_PORT_RES,
+		xuperp2p.XuperMessage_GET_AUTHENTICATION_RES,
+		xuperp2p.XuperMessage_GET_BLOCK_HEADERS_RES,
+		xuperp2p.XuperMessage_GET_BLOCK_TXS_RES,
+		xuperp2p.XuperMessage_GET_TX_PROOF_RES,
+		xuperp2p.XuperMessage_GET_UTXO_PROOF_RES:
+		return true
+	}
+	return false
+}
+
+// msgJob is a received message waiting to be handled by subscribers
+type msgJob struct {
+	stream interface{}
+	msg    *xuperp2p.XuperMessage
+	ms     *MultiSubscriber
+}
+
+// MsgScheduler handle received messages by priority. Every priority has its own queue and pool of workers
+// which run the handlers of subscribers, so that the messages of high priority are never blocked by the
+// handling of lower ones, and the concurrency of low priority handlers is bounded
+type MsgScheduler struct {
+	queues  [msgPriorityCount]chan *msgJob
+	workers [msgPriorityCount]int
+	quitCh  chan bool
+	once    sync.Once
+}
+
+// NewMsgScheduler create MsgScheduler with queueSize for each priority, high and normal priorities
+// have workers goroutines each, and low priority has half of them
+func NewMsgScheduler(queueSize int, workers int) *MsgScheduler {
+	if queueSize <= 0 {
+		queueSize = 1
+	}
+	if workers <= 0 {
+		workers = 1
+	}
+	sc := &MsgScheduler{
+		quitCh: make(chan bool),
+	}
+	for i := range sc.queues {
+		sc.queues[i] = make(chan *msgJob, queueSize)
+		sc.workers[i] = workers
+	}
+	if workers > 1 {
+		sc.workers[MsgPriorityLow] = workers / 2
+	}
+	return sc
+}
+
+// Start start the workers of all priorities
+func (sc *MsgScheduler) Start() {
+	for priority, workers := range sc.workers {
+		for i := 0; i < workers; i++ {
+			go sc.work(sc.queues[priority])
+		}
+	}
+}
+
+// Stop stop all workers, messages left in queues are dropped
+func (sc *MsgScheduler) Stop() {
+	sc.once.Do(func() {
+		close(sc.quitCh)
+	})
+}
+
+// push put the job into the queue of its priority, return false if the queue is full
+func (sc *MsgScheduler) push(job *msgJob) bool {
+	select {
+	case sc.queues[GetMsgPriority(job.msg.GetHeader().GetType())] <- job:
+		return true
+	default:
+		return false
+	}
+}
+
+// work handle the jobs of queue until the scheduler is stopped
+func (sc *MsgScheduler) work(queue chan *msgJob) {
+	for {
+		select {
+		case job := <-queue:
+			job.ms.handleMessage(job.stream, job.msg)
+		case <-sc.quitCh:
+			return
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package base
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	xuperp2p "github.com/xuperchain/xuperchain/core/p2p/pb"
+)
+
+func newSchedulerTestJob(ms *MultiSubscriber, msgType xuperp2p.XuperMessage_MessageType) *msgJob {
+	return &msgJob{
+		msg: &xuperp2p.XuperMessage{
+			Header: &xuperp2p.XuperMessage_MessageHeader{Type: msgType},
+		},
+		ms: ms,
+	}
+}
+
+func newSchedulerTestSubscriber(msgType xuperp2p.XuperMessage_MessageType, handler XuperHandler) *MultiSubscriber {
+	ms := NewMultiSubscriber()
+	ms.register(NewMockSubscriber(nil, msgType, handler, "", nil))
+	return ms
+}
+
+func TestMsgSchedulerPriority(t *testing.T) {
+	sc := NewMsgScheduler(1, 2)
+	if sc.workers[MsgPriorityHigh] != 2 || sc.workers[MsgPriorityNormal] != 2 || sc.workers[MsgPriorityLow] != 1 {
+		t.Fatalf("unexpected workers %v", sc.workers)
+	}
+	sc.Start()
+	defer sc.Stop()
+
+	// 低优先级的处理阻塞住唯一的工作协程
+	blockCh := make(chan struct{})
+	lowStarted := make(chan struct{}, 1)
+	lowMs := newSchedulerTestSubscriber(xuperp2p.XuperMessage_POSTTX,
+		func(ctx context.Context, msg *xuperp2p.XuperMessage) (*xuperp2p.XuperMessage, error) {
+			lowStarted <- struct{}{}
+			<-blockCh
+			return nil, nil
+		})
+	if !sc.push(newSchedulerTestJob(lowMs, xuperp2p.XuperMessage_POSTTX)) {
+		t.Fatal("push POSTTX failed")
+	}
+	<-lowStarted
+	if !sc.push(newSchedulerTestJob(lowMs, xuperp2p.XuperMessage_BATCHPOSTTX)) {
+		t.Fatal("push BATCHPOSTTX failed")
+	}
+	if sc.push(newSchedulerTestJob(lowMs, xuperp2p.XuperMessage_POSTTX)) {
+		t.Fatal("push should fail when queue is full")
+	}
+
+	// 高优先级消息不受低优先级处理的影响
+	highDone := make(chan struct{}, 1)
+	highMs := newSchedulerTestSubscriber(xuperp2p.XuperMessage_CHAINED_BFT_VOTE_MSG,
+		func(ctx context.Context, msg *xuperp2p.XuperMessage) (*xuperp2p.XuperMessage, error) {
+			highDone <- struct{}{}
+			return nil, nil
+		})
+	if !sc.push(newSchedulerTestJob(highMs, xuperp2p.XuperMessage_CHAINED_BFT_VOTE_MSG)) {
+		t.Fatal("push CHAINED_BFT_VOTE_MSG failed")
+	}
+	select {
+	case <-highDone:
+	case <-time.After(5 * time.Second):
+		t.Fatal("high priority message blocked by low priority handler")
+	}
+	close(blockCh)
+
+	sc.Stop()
+	sc.Stop()
+}
+
+func TestIsResponseMsg(t *testing.T) {
+	if !isResponseMsg(xuperp2p.XuperMessage_GET_BLOCK_RES) {
+		t.Error("GET_BLOCK_RES should be response")
+	}
+	if isResponseMsg(xuperp2p.XuperMessage_GET_BLOCK) {
+		t.Error("GET_BLOCK should not be response")
+	}
+}
+
+func TestGetMsgPriority(t *testing.T) {
+	testCases := map[xuperp2p.XuperMessage_MessageType]MsgPriority{
+		xuperp2p.XuperMessage_CHAINED_BFT_NEW_VIEW_MSG: MsgPriorityHigh,
+		xuperp2p.XuperMessage_NEW_BLOCKID:              MsgPriorityHigh,
+		xuperp2p.XuperMessage_GET_BLOCK_RES:            MsgPriorityHigh,
+		xuperp2p.XuperMessage_NEW_COMPACT_BLOCK:        MsgPriorityHigh,
+		xuperp2p.XuperMessage_GET_BLOCK_TXS:            MsgPriorityNormal,
+		xuperp2p.XuperMessage_GET_BLOCK:                MsgPriorityNormal,
+		xuperp2p.XuperMessage_PING:                     MsgPriorityNormal,
+		xuperp2p.XuperMessage_POSTTX:                   MsgPriorityLow,
+	}
+	for msgType, expected := range testCases {
+		if actual := GetMsgPriority(msgType); actual != expected {
+			t.Errorf("%s expected %s actual %s", msgType, expected, actual)
+		}
+	}
+}
@@ -38,4 +38,11 @@ type P2PServer interface {
 
 	// SetXchainAddr Set xchain address from xchaincore
 	SetXchainAddr(bcname string, info *XchainAddrInfo)
+
+	// ReportPeer 上报节点的异常行为, 评分过低的节点会被断开并临时封禁
+	ReportPeer(peerID string, event PeerEvent)
+	// GetBannedPeers 查询被临时封禁的节点
+	GetBannedPeers() []*BannedPeer
+	// UnbanPeers 解除节点的封禁, peerIDs为空时解除全部, 返回被解除封禁的节点
+	UnbanPeers(peerIDs []string) []*BannedPeer
 }
@@ -63,16 +63,22 @@ func (ms *MultiSubscriber) unRegister(sub Subscriber) error {
 	return nil
 }
 
+// handleMessage call the subscribers of msg, the subscribers are called without lock
+// since the handlers may run for a long time
 func (ms *MultiSubscriber) handleMessage(stream interface{}, msg *xuperp2p.XuperMessage) {
+	var subs []Subscriber
 	ms.lk.Lock()
-	defer ms.lk.Unlock()
 	for e := ms.elem.Front(); e != nil; e = e.Next() {
 		if sub, ok := e.Value.(Subscriber); !ok {
 			continue
 		} else {
 			if sub.GetMessageFrom() == "" || (sub.GetMessageFrom() == msg.GetHeader().GetFrom()) {
-				sub.HandleMessage(stream, msg)
+				subs = append(subs, sub)
 			}
 		}
 	}
+	ms.lk.Unlock()
+	for _, sub := range subs {
+		sub.HandleMessage(stream, msg)
+	}
 }
@@ -17,3 +17,48 @@ type XchainAddrInfo struct {
 	Prikey []byte
 	PeerID string
 }
+
+// PeerEvent is the behaviour of a peer which affects its score
+type PeerEvent int
+
+// peer events reported by p2p module and xchaincore
+const (
+	// PeerEventGoodResponse peer responded in time
+	PeerEventGoodResponse PeerEvent = iota
+	// PeerEventSlowResponse peer responded, but the latency is too high
+	PeerEventSlowResponse
+	// PeerEventTimeout peer did not respond before timeout
+	PeerEventTimeout
+	// PeerEventInvalidMessage peer sent a message with wrong checksum
+	PeerEventInvalidMessage
+	// PeerEventInvalidTx peer posted a tx which can never be valid
+	PeerEventInvalidTx
+	// PeerEventInvalidBlock peer sent a block failed to be verified
+	PeerEventInvalidBlock
+)
+
+var peerEventNames = map[PeerEvent]string{
+	PeerEventGoodResponse:   "good_response",
+	PeerEventSlowResponse:   "slow_response",
+	PeerEventTimeout:        "timeout",
+	PeerEventInvalidMessage: "invalid_message",
+	PeerEventInvalidTx:      "invalid_tx",
+	PeerEventInvalidBlock:   "invalid_block",
+}
+
+func (e PeerEvent) String() string {
+	if name, ok := peerEventNames[e]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// BannedPeer defines a peer banned temporarily because of low score
+type BannedPeer struct {
+	PeerID string `json:"peer_id"`
+	// Reason is the event which made the score reach the ban threshold
+	Reason string `json:"reason"`
+	// BannedAt and ExpireAt are unix timestamps in seconds
+	BannedAt int64 `json:"banned_at"`
+	ExpireAt int64 `json:"expire_at"`
+}
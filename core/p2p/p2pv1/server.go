@@ -70,7 +70,8 @@ func (p *P2PServerV1) Init(cfg config.P2PConfig, lg log.Logger, extra map[string
 	if extra["enableMetric"] != nil && extra["enableMetric"].(bool) {
 		p.enableMetric = true
 	}
-	hm, err := p2p_base.NewHandlerMap(lg, p.enableMetric)
+	// p2pv1的响应需在HandleMessage返回前写回grpc流, 因此只做限流不做异步调度
+	hm, err := p2p_base.NewHandlerMap(lg, p.enableMetric, p2p_base.WithMsgLimits(cfg.MsgRateLimits))
 	if err != nil {
 		p.log.Error("Init P2PServerV1 NewHandlerMap error", "error", err)
 		return ErrCreateHandlerMap
@@ -409,6 +410,21 @@ func (p *P2PServerV1) SetXchainAddr(bcname string, info *p2p_base.XchainAddrInfo
 	p.localAddr[bcname] = info
 }
 
+// ReportPeer report the misbehaviour of a peer
+func (p *P2PServerV1) ReportPeer(peerID string, event p2p_base.PeerEvent) {
+	// TODO: p2pv1 only support static nodes at this time, do not support peer scoring
+}
+
+// GetBannedPeers get the peers banned temporarily
+func (p *P2PServerV1) GetBannedPeers() []*p2p_base.BannedPeer {
+	return nil
+}
+
+// UnbanPeers clear bans of the given peers
+func (p *P2PServerV1) UnbanPeers(peerIDs []string) []*p2p_base.BannedPeer {
+	return nil
+}
+
 // startServer start p2p server
 func (p *P2PServerV1) startServer() {
 	options := append([]grpc.ServerOption{}, grpc.MaxRecvMsgSize(int(p.config.MaxMessageSize)<<20),
@@ -2,6 +2,7 @@ package p2pv2
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"sync"
@@ -32,8 +33,9 @@ import (
 
 // define the common config
 const (
-	XuperProtocolID    = "/xuper/2.0.0" // protocol version
-	P2PMultiAddrPrefix = "p2pMulti_"
+	XuperProtocolID     = "/xuper/2.0.0" // protocol version
+	P2PMultiAddrPrefix  = "p2pMulti_"
+	P2PBannedPeerPrefix = "p2pBanned_"
 )
 
 var (
@@ -84,6 +86,8 @@ type Node struct {
 	routeLock   sync.RWMutex
 	// StreamLimit
 	streamLimit *StreamLimit
+	// peerScore score peers and ban the misbehaving ones
+	peerScore *PeerScore
 	// ldb persist peers info and get peers info
 	ldb kvdb.Database
 	// isStorePeers determine whether open isStorePeers
@@ -115,6 +119,7 @@ func NewNode(cfg config.P2PConfig, log log.Logger) (*Node, error) {
 		coreRoute: make(map[string]*corePeersRoute),
 		// new StreamLimit
 		streamLimit:  &StreamLimit{},
+		peerScore:    &PeerScore{},
 		isStorePeers: cfg.IsStorePeers,
 		p2pDataPath:  cfg.P2PDataPath,
 	}
@@ -131,6 +136,14 @@ func NewNode(cfg config.P2PConfig, log log.Logger) (*Node, error) {
 
 	// initialize StreamLimit, set limit size
 	no.streamLimit.Init(cfg.StreamIPLimitSize, log)
+	no.peerScore.Init(cfg.PeerBanScore, time.Duration(cfg.PeerBanDuration)*time.Second, log)
+	if no.isStorePeers {
+		bans, err := no.getBannedPeersFromDisk()
+		if err != nil {
+			no.log.Warn("getBannedPeersFromDisk error", "err", err)
+		}
+		no.peerScore.AddBans(bans)
+	}
 
 	if no.kdht, err = dht.New(ctx, ho); err != nil {
 		return nil, ErrCreateKadDht
@@ -154,6 +167,7 @@ func NewNode(cfg config.P2PConfig, log log.Logger) (*Node, error) {
 		if err != nil {
 			no.log.Warn("getPeersFromDisk error", "err", err)
 		}
+		peers = no.filterBannedPeers(peers)
 	}
 	if len(cfg.BootNodes) > 0 {
 		peers = append(peers, cfg.BootNodes...)
@@ -238,6 +252,7 @@ func (no *Node) Start() {
 		case <-t.C:
 			no.log.Trace("RoutingTable", "size", no.kdht.RoutingTable().Size())
 			no.kdht.RoutingTable().Print()
+			no.peerScore.DecayScores()
 			if no.isStorePeers {
 				ret := no.persistPeersToDisk()
 				if !ret {
@@ -535,6 +550,89 @@ func newBaseDB(dbPath string) (kvdb.Database, error) {
 	return kvdb.NewKVDBInstance(kvParam)
 }
 
+// filterBannedPeers remove the banned peers from the given net urls
+func (no *Node) filterBannedPeers(addrs []string) []string {
+	res := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		id, err := p2p_base.GetIDFromAddr(addr)
+		if err == nil && no.peerScore.IsBanned(id.Pretty()) {
+			continue
+		}
+		res = append(res, addr)
+	}
+	return res
+}
+
+// ReportPeer update the score of peer, the peer will be disconnected and banned if its score is too low
+func (no *Node) ReportPeer(peerID string, event p2p_base.PeerEvent) {
+	ban := no.peerScore.Report(peerID, event)
+	if ban == nil {
+		return
+	}
+	if id, err := peer.IDB58Decode(peerID); err == nil {
+		if str, err := no.strPool.FindStream(id); err == nil {
+			str.reset()
+		}
+		no.kdht.RoutingTable().Remove(id)
+		no.host.Network().ClosePeer(id)
+	}
+	if no.isStorePeers {
+		if err := no.persistBannedPeer(ban); err != nil {
+			no.log.Warn("persistBannedPeer error", "peer", peerID, "err", err)
+		}
+	}
+}
+
+// GetBannedPeers return the peers banned now
+func (no *Node) GetBannedPeers() []*p2p_base.BannedPeer {
+	return no.peerScore.BannedPeers()
+}
+
+// UnbanPeers clear bans of the given peers, all bans are cleared if peerIDs is empty
+func (no *Node) UnbanPeers(peerIDs []string) []*p2p_base.BannedPeer {
+	cleared := no.peerScore.Unban(peerIDs)
+	if no.isStorePeers && len(cleared) > 0 {
+		batch := no.ldb.NewBatch()
+		for _, ban := range cleared {
+			batch.Delete([]byte(P2PBannedPeerPrefix + ban.PeerID))
+		}
+		if err := batch.Write(); err != nil {
+			no.log.Warn("p2p module, UnbanPeers delete from disk error", "err", err)
+		}
+	}
+	return cleared
+}
+
+// persistBannedPeer persist the ban of peer to disk, so that the peer is still banned after restart
+func (no *Node) persistBannedPeer(ban *p2p_base.BannedPeer) error {
+	value, err := json.Marshal(ban)
+	if err != nil {
+		return err
+	}
+	return no.ldb.Put([]byte(P2PBannedPeerPrefix+ban.PeerID), value)
+}
+
+// getBannedPeersFromDisk get banned peers from disk, expired records are deleted
+func (no *Node) getBannedPeersFromDisk() ([]*p2p_base.BannedPeer, error) {
+	bans := []*p2p_base.BannedPeer{}
+	batch := no.ldb.NewBatch()
+	it := no.ldb.NewIteratorWithPrefix([]byte(P2PBannedPeerPrefix))
+	defer it.Release()
+	now := time.Now().Unix()
+	for it.Next() {
+		ban := &p2p_base.BannedPeer{}
+		if err := json.Unmarshal(it.Value(), ban); err != nil || ban.ExpireAt <= now {
+			batch.Delete(append([]byte{}, it.Key()...))
+			continue
+		}
+		bans = append(bans, ban)
+	}
+	if it.Error() != nil {
+		return nil, it.Error()
+	}
+	return bans, batch.Write()
+}
+
 // getP2PMultiAddrPrefix return P2PMultiAddrPrefix
 func (no *Node) getP2PMultiAddrPrefix() string {
 	return P2PMultiAddrPrefix
@@ -0,0 +1,191 @@
+package p2pv2
+
+import (
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/xuperchain/log15"
+
+	p2p_base "github.com/xuperchain/xuperchain/core/p2p/base"
+)
+
+// define the score of peers
+const (
+	// MaxPeerScore limit the score a peer can earn by good behaviours,
+	// so that a peer can not hide misbehaviours with a long good history
+	MaxPeerScore = 50
+	// PeerScoreRecovery is the score recovered by every DecayScores call for peers with negative score
+	PeerScoreRecovery = 5
+)
+
+// peerEventScores define how each event affects the score of a peer
+var peerEventScores = map[p2p_base.PeerEvent]int64{
+	p2p_base.PeerEventGoodResponse:   1,
+	p2p_base.PeerEventSlowResponse:   -2,
+	p2p_base.PeerEventTimeout:        -10,
+	p2p_base.PeerEventInvalidMessage: -20,
+	p2p_base.PeerEventInvalidTx:      -5,
+	p2p_base.PeerEventInvalidBlock:   -50,
+}
+
+// PeerScore scores peers by their behaviours and bans peers with low score temporarily
+type PeerScore struct {
+	// key: peer id, value: score
+	scores map[string]int64
+	// key: peer id, value: ban info
+	bans map[string]*p2p_base.BannedPeer
+	// mutex for scores and bans
+	mutex *sync.Mutex
+	// peer is banned when its score falls to banScore
+	banScore    int64
+	banDuration time.Duration
+	log         log.Logger
+}
+
+// Init initialize the PeerScore
+func (ps *PeerScore) Init(banScore int64, banDuration time.Duration, lg log.Logger) {
+	ps.scores = make(map[string]int64)
+	ps.bans = make(map[string]*p2p_base.BannedPeer)
+	ps.mutex = &sync.Mutex{}
+	if banScore >= 0 {
+		banScore = -1
+	}
+	ps.banScore = banScore
+	ps.banDuration = banDuration
+
+	if lg == nil {
+		lg = log.New("module", "p2pv2")
+		lg.SetHandler(log.StreamHandler(os.Stderr, log.LogfmtFormat()))
+	}
+	ps.log = lg
+}
+
+// Report update the score of peer by event, the ban info is returned if the peer is banned by this event
+func (ps *PeerScore) Report(peerID string, event p2p_base.PeerEvent) *p2p_base.BannedPeer {
+	delta, ok := peerEventScores[event]
+	if !ok || peerID == "" {
+		return nil
+	}
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+	if ps.isBannedLockFree(peerID) {
+		return nil
+	}
+	score := ps.scores[peerID] + delta
+	if score > MaxPeerScore {
+		score = MaxPeerScore
+	}
+	if score > ps.banScore {
+		ps.scores[peerID] = score
+		return nil
+	}
+
+	// 分数过低, 临时封禁该节点, 封禁结束后从零分开始
+	delete(ps.scores, peerID)
+	now := time.Now()
+	ban := &p2p_base.BannedPeer{
+		PeerID:   peerID,
+		Reason:   event.String(),
+		BannedAt: now.Unix(),
+		ExpireAt: now.Add(ps.banDuration).Unix(),
+	}
+	ps.bans[peerID] = ban
+	ps.log.Warn("PeerScore ban peer", "peer", peerID, "reason", ban.Reason, "expireAt", ban.ExpireAt)
+	return ban
+}
+
+// Score return the current score of peer
+func (ps *PeerScore) Score(peerID string) int64 {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+	return ps.scores[peerID]
+}
+
+// IsBanned check whether the peer is banned now
+func (ps *PeerScore) IsBanned(peerID string) bool {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+	return ps.isBannedLockFree(peerID)
+}
+
+func (ps *PeerScore) isBannedLockFree(peerID string) bool {
+	ban, ok := ps.bans[peerID]
+	if !ok {
+		return false
+	}
+	if ban.ExpireAt <= time.Now().Unix() {
+		delete(ps.bans, peerID)
+		return false
+	}
+	return true
+}
+
+// DecayScores recover the negative scores step by step, so that occasional faults of a peer will be forgotten
+func (ps *PeerScore) DecayScores() {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+	for peerID, score := range ps.scores {
+		if score >= 0 {
+			continue
+		}
+		score += PeerScoreRecovery
+		if score >= 0 {
+			delete(ps.scores, peerID)
+			continue
+		}
+		ps.scores[peerID] = score
+	}
+}
+
+// BannedPeers return the peers banned now, sorted by peer id
+func (ps *PeerScore) BannedPeers() []*p2p_base.BannedPeer {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+	bans := []*p2p_base.BannedPeer{}
+	for peerID, ban := range ps.bans {
+		if !ps.isBannedLockFree(peerID) {
+			continue
+		}
+		bans = append(bans, ban)
+	}
+	sort.Slice(bans, func(i, j int) bool {
+		return bans[i].PeerID < bans[j].PeerID
+	})
+	return bans
+}
+
+// AddBans restore the bans persisted before, expired bans are ignored
+func (ps *PeerScore) AddBans(bans []*p2p_base.BannedPeer) {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+	now := time.Now().Unix()
+	for _, ban := range bans {
+		if ban == nil || ban.PeerID == "" || ban.ExpireAt <= now {
+			continue
+		}
+		ps.bans[ban.PeerID] = ban
+	}
+}
+
+// Unban clear bans of the given peers, all bans are cleared if peerIDs is empty
+func (ps *PeerScore) Unban(peerIDs []string) []*p2p_base.BannedPeer {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+	if len(peerIDs) == 0 {
+		for peerID := range ps.bans {
+			peerIDs = append(peerIDs, peerID)
+		}
+		sort.Strings(peerIDs)
+	}
+	cleared := []*p2p_base.BannedPeer{}
+	for _, peerID := range peerIDs {
+		if ban, ok := ps.bans[peerID]; ok {
+			cleared = append(cleared, ban)
+			delete(ps.bans, peerID)
+		}
+		delete(ps.scores, peerID)
+	}
+	return cleared
+}
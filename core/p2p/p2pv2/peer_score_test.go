@@ -0,0 +1,102 @@
+package p2pv2
+
+import (
+	"testing"
+	"time"
+
+	p2p_base "github.com/xuperchain/xuperchain/core/p2p/base"
+)
+
+func TestPeerScoreBan(t *testing.T) {
+	ps := &PeerScore{}
+	ps.Init(-100, time.Hour, nil)
+
+	testCases := []struct {
+		event    p2p_base.PeerEvent
+		score    int64
+		isBanned bool
+	}{
+		{event: p2p_base.PeerEventGoodResponse, score: 1},
+		{event: p2p_base.PeerEventTimeout, score: -9},
+		{event: p2p_base.PeerEventInvalidMessage, score: -29},
+		{event: p2p_base.PeerEventInvalidBlock, score: -79},
+		{event: p2p_base.PeerEventSlowResponse, score: -81},
+		{event: p2p_base.PeerEventInvalidMessage, score: 0, isBanned: true},
+		// 封禁期间不再计分
+		{event: p2p_base.PeerEventGoodResponse, score: 0, isBanned: true},
+	}
+	for index, tc := range testCases {
+		ps.Report("peer1", tc.event)
+		if score := ps.Score("peer1"); score != tc.score {
+			t.Errorf("case %d expected score %d actual %d", index, tc.score, score)
+		}
+		if banned := ps.IsBanned("peer1"); banned != tc.isBanned {
+			t.Errorf("case %d expected banned %v actual %v", index, tc.isBanned, banned)
+		}
+	}
+	bans := ps.BannedPeers()
+	if len(bans) != 1 || bans[0].PeerID != "peer1" || bans[0].Reason != "invalid_message" {
+		t.Fatalf("unexpected bans %v", bans)
+	}
+}
+
+func TestPeerScoreMaxAndDecay(t *testing.T) {
+	ps := &PeerScore{}
+	ps.Init(-100, time.Hour, nil)
+	for i := 0; i < 100; i++ {
+		ps.Report("good", p2p_base.PeerEventGoodResponse)
+	}
+	if score := ps.Score("good"); score != MaxPeerScore {
+		t.Errorf("expected score %d actual %d", MaxPeerScore, score)
+	}
+	ps.Report("bad", p2p_base.PeerEventTimeout)
+	ps.Report("bad", p2p_base.PeerEventInvalidTx)
+	ps.DecayScores()
+	if score := ps.Score("bad"); score != -15+PeerScoreRecovery {
+		t.Errorf("expected score %d actual %d", -15+PeerScoreRecovery, score)
+	}
+	ps.DecayScores()
+	ps.DecayScores()
+	if score := ps.Score("bad"); score != 0 {
+		t.Errorf("expected score 0 actual %d", score)
+	}
+	if score := ps.Score("good"); score != MaxPeerScore {
+		t.Errorf("positive score should not decay, actual %d", score)
+	}
+}
+
+func TestPeerScoreUnban(t *testing.T) {
+	ps := &PeerScore{}
+	ps.Init(-100, time.Hour, nil)
+	now := time.Now().Unix()
+	ps.AddBans([]*p2p_base.BannedPeer{
+		{PeerID: "a", ExpireAt: now + 100},
+		{PeerID: "b", ExpireAt: now + 100},
+		{PeerID: "c", ExpireAt: now + 100},
+		{PeerID: "expired", ExpireAt: now - 1},
+	})
+	if bans := ps.BannedPeers(); len(bans) != 3 || bans[0].PeerID != "a" {
+		t.Fatalf("unexpected bans %v", bans)
+	}
+	if cleared := ps.Unban([]string{"b", "unknown"}); len(cleared) != 1 || cleared[0].PeerID != "b" {
+		t.Fatalf("unexpected cleared bans %v", cleared)
+	}
+	if ps.IsBanned("b") || !ps.IsBanned("a") {
+		t.Fatal("unexpected ban status after unban")
+	}
+	if cleared := ps.Unban(nil); len(cleared) != 2 {
+		t.Fatalf("unexpected cleared bans %v", cleared)
+	}
+	if len(ps.BannedPeers()) != 0 {
+		t.Fatal("expect no banned peers")
+	}
+
+	// 封禁到期后自动解除
+	ps.Init(-10, 0, nil)
+	if ban := ps.Report("d", p2p_base.PeerEventInvalidBlock); ban == nil {
+		t.Fatal("expect peer banned")
+	}
+	if ps.IsBanned("d") {
+		t.Fatal("expect ban expired")
+	}
+}
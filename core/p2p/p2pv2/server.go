@@ -58,7 +58,9 @@ func (p *P2PServerV2) Init(cfg config.P2PConfig, lg log.Logger, extra map[string
 	if extra["enableMetric"] != nil && extra["enableMetric"].(bool) {
 		p.enableMetric = true
 	}
-	hm, err := p2p_base.NewHandlerMap(lg, p.enableMetric)
+	hm, err := p2p_base.NewHandlerMap(lg, p.enableMetric,
+		p2p_base.WithMsgLimits(cfg.MsgRateLimits),
+		p2p_base.WithMsgScheduler(cfg.MsgQueueSize, cfg.MsgWorkers))
 	if err != nil {
 		lg.Trace("NewP2PServerV2 new handler map error", "errors", err)
 		return ErrCreateHandlerMap
@@ -313,3 +315,18 @@ func (p *P2PServerV2) SetCorePeers(cp *p2p_base.CorePeersInfo) error {
 func (p *P2PServerV2) SetXchainAddr(bcname string, info *p2p_base.XchainAddrInfo) {
 	p.node.SetXchainAddr(bcname, info)
 }
+
+// ReportPeer report the misbehaviour of a peer, the peer is banned temporarily when its score is too low
+func (p *P2PServerV2) ReportPeer(peerID string, event p2p_base.PeerEvent) {
+	p.node.ReportPeer(peerID, event)
+}
+
+// GetBannedPeers get the peers banned temporarily
+func (p *P2PServerV2) GetBannedPeers() []*p2p_base.BannedPeer {
+	return p.node.GetBannedPeers()
+}
+
+// UnbanPeers clear bans of the given peers, all bans are cleared if peerIDs is empty
+func (p *P2PServerV2) UnbanPeers(peerIDs []string) []*p2p_base.BannedPeer {
+	return p.node.UnbanPeers(peerIDs)
+}
@@ -129,6 +129,14 @@ func (s *Stream) handlerNewMessage(msg *p2pPb.XuperMessage) error {
 		s.node.log.Warn("Stream not ready, omit", "msg", msg)
 		return nil
 	}
+	// 以流的对端身份为准, 避免恶意节点伪造From使其他节点被扣分
+	if msg.GetHeader() != nil {
+		msg.Header.From = s.p.Pretty()
+	}
+	// 校验失败的消息仍交给上层, 由上层按原有逻辑拒绝
+	if !p2p_base.VerifyDataCheckSum(msg) {
+		s.node.ReportPeer(s.p.Pretty(), p2p_base.PeerEventInvalidMessage)
+	}
 
 	return s.node.srv.handlerMap.HandleMessage(s, msg)
 }
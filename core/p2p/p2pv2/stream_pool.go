@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"time"
 
 	net "github.com/libp2p/go-libp2p-net"
 	peer "github.com/libp2p/go-libp2p-peer"
@@ -65,6 +66,11 @@ func (sp *StreamPool) Add(s net.Stream) *Stream {
 	// filter by StreamLimit first
 	addrStr := s.Conn().RemoteMultiaddr().String()
 	peerID := s.Conn().RemotePeer()
+	if sp.no.peerScore.IsBanned(peerID.Pretty()) {
+		sp.log.Trace("StreamPool refuse stream of banned peer", "peer", peerID.Pretty())
+		s.Reset()
+		return nil
+	}
 	if ok := sp.no.streamLimit.AddStream(addrStr, peerID); !ok {
 		s.Reset()
 		return nil
@@ -226,14 +232,24 @@ func (sp *StreamPool) sendMessageWithResponse(ctx context.Context, msg *p2pPb.Xu
 		sp.log.Warn("StreamPool sendMessageWithResponse streamForPeer error!", "error", err.Error())
 		return
 	}
+	start := time.Now()
 	res, err := str.SendMessageWithResponse(ctx, msg)
 	if err != nil {
 		if common.NormalizedKVError(err) == common.ErrP2PError {
 			sp.DelStream(str)
 		}
+		if err == ErrTimeout {
+			sp.no.ReportPeer(p.Pretty(), p2p_base.PeerEventTimeout)
+		}
 		sp.log.Warn("StreamPool sendMessageWithResponse SendMessageWithResponse error!", "error", err.Error())
 		return
 	}
+	// 响应耗时超过超时时间的一半视为慢节点
+	if time.Since(start) > time.Duration(sp.no.srv.config.Timeout)*time.Second/2 {
+		sp.no.ReportPeer(p.Pretty(), p2p_base.PeerEventSlowResponse)
+	} else {
+		sp.no.ReportPeer(p.Pretty(), p2p_base.PeerEventGoodResponse)
+	}
 	ch <- res
 }
 
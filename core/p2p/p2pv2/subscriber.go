@@ -89,31 +89,30 @@ func (sub *MsgSubscriber) HandleMessage(msgStream interface{}, msg *xuperp2p.Xup
 		return
 	}
 
+	// 回调在消息调度器的工作协程中执行, 其并发度由调度器按优先级控制
 	if sub.handler != nil {
-		go func(sub *MsgSubscriber, s *Stream, msg *xuperp2p.XuperMessage) {
-			ctx := context.WithValue(context.Background(), "Stream", s)
-			if msg.Header.Type != xuperp2p.XuperMessage_GET_AUTHENTICATION_RES &&
-				msg.Header.Type != xuperp2p.XuperMessage_GET_AUTHENTICATION {
-				if s.node.srv.config.IsAuthentication && !s.auth() {
-					sub.log.Trace("Stream not authenticated")
-					resType := p2p_base.GetResMsgType(msg.GetHeader().GetType())
-					res, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, "", msg.GetHeader().GetLogid(),
-						resType, []byte(""), xuperp2p.XuperMessage_GET_AUTHENTICATION_NOT_PASS)
-					if err := s.writeData(res); err != nil {
-						sub.log.Warn("Stream not authenticated to write msg error", "err", err)
-					}
-					return
+		ctx := context.WithValue(context.Background(), "Stream", s)
+		if msg.Header.Type != xuperp2p.XuperMessage_GET_AUTHENTICATION_RES &&
+			msg.Header.Type != xuperp2p.XuperMessage_GET_AUTHENTICATION {
+			if s.node.srv.config.IsAuthentication && !s.auth() {
+				sub.log.Trace("Stream not authenticated")
+				resType := p2p_base.GetResMsgType(msg.GetHeader().GetType())
+				res, _ := p2p_base.NewXuperMessage(p2p_base.XuperMsgVersion2, "", msg.GetHeader().GetLogid(),
+					resType, []byte(""), xuperp2p.XuperMessage_GET_AUTHENTICATION_NOT_PASS)
+				if err := s.writeData(res); err != nil {
+					sub.log.Warn("Stream not authenticated to write msg error", "err", err)
 				}
+				return
 			}
-
-			res, err := sub.handler(ctx, msg)
-			if err != nil {
-				sub.log.Warn("subscriber handleMessage error", "err", err)
-			}
-			if err := s.writeData(res); err != nil {
-				sub.log.Warn("subscriber handleMessage to write msg error", "err", err)
-			}
-		}(sub, s, msg)
+		}
+
+		res, err := sub.handler(ctx, msg)
+		if err != nil {
+			sub.log.Warn("subscriber handleMessage error", "err", err)
+		}
+		if err := s.writeData(res); err != nil {
+			sub.log.Warn("subscriber handleMessage to write msg error", "err", err)
+		}
 		return
 	}
 	if sub.msgCh == nil {
@@ -51,6 +51,20 @@ const (
 	XuperMessage_NEW_BLOCKID XuperMessage_MessageType = 18
 	// new node used to add to network automatic
 	XuperMessage_NEW_NODE XuperMessage_MessageType = 19
+	// get trunk block headers by height, used by header-first sync
+	XuperMessage_GET_BLOCK_HEADERS     XuperMessage_MessageType = 20
+	XuperMessage_GET_BLOCK_HEADERS_RES XuperMessage_MessageType = 21
+	// compact block relay, block header with short txids
+	XuperMessage_NEW_COMPACT_BLOCK XuperMessage_MessageType = 22
+	// get the missing txs of a compact block by indexes
+	XuperMessage_GET_BLOCK_TXS     XuperMessage_MessageType = 23
+	XuperMessage_GET_BLOCK_TXS_RES XuperMessage_MessageType = 24
+	// get the merkle proof of a tx, used by light nodes
+	XuperMessage_GET_TX_PROOF     XuperMessage_MessageType = 25
+	XuperMessage_GET_TX_PROOF_RES XuperMessage_MessageType = 26
+	// get the utxos of an address with state proofs, used by light nodes
+	XuperMessage_GET_UTXO_PROOF     XuperMessage_MessageType = 27
+	XuperMessage_GET_UTXO_PROOF_RES XuperMessage_MessageType = 28
 )
 
 var XuperMessage_MessageType_name = map[int32]string{
@@ -74,6 +88,15 @@ var XuperMessage_MessageType_name = map[int32]string{
 	17: "CHAINED_BFT_VOTE_MSG",
 	18: "NEW_BLOCKID",
 	19: "NEW_NODE",
+	20: "GET_BLOCK_HEADERS",
+	21: "GET_BLOCK_HEADERS_RES",
+	22: "NEW_COMPACT_BLOCK",
+	23: "GET_BLOCK_TXS",
+	24: "GET_BLOCK_TXS_RES",
+	25: "GET_TX_PROOF",
+	26: "GET_TX_PROOF_RES",
+	27: "GET_UTXO_PROOF",
+	28: "GET_UTXO_PROOF_RES",
 }
 
 var XuperMessage_MessageType_value = map[string]int32{
@@ -97,6 +120,15 @@ var XuperMessage_MessageType_value = map[string]int32{
 	"CHAINED_BFT_VOTE_MSG":         17,
 	"NEW_BLOCKID":                  18,
 	"NEW_NODE":                     19,
+	"GET_BLOCK_HEADERS":            20,
+	"GET_BLOCK_HEADERS_RES":        21,
+	"NEW_COMPACT_BLOCK":            22,
+	"GET_BLOCK_TXS":                23,
+	"GET_BLOCK_TXS_RES":            24,
+	"GET_TX_PROOF":                 25,
+	"GET_TX_PROOF_RES":             26,
+	"GET_UTXO_PROOF":               27,
+	"GET_UTXO_PROOF_RES":           28,
 }
 
 func (x XuperMessage_MessageType) String() string {
@@ -362,47 +394,51 @@ func init() {
 func init() { proto.RegisterFile("message.proto", fileDescriptor_33c57e4bae7b9afd) }
 
 var fileDescriptor_33c57e4bae7b9afd = []byte{
-	// 658 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xcb, 0x4e, 0xdb, 0x40,
-	0x14, 0x86, 0xc9, 0x3d, 0x3e, 0xb9, 0x30, 0x1c, 0x10, 0xb5, 0x28, 0x6a, 0xa3, 0xa8, 0x6a, 0xb3,
-	0xca, 0x82, 0x4a, 0x5d, 0x55, 0x95, 0x1c, 0x67, 0x48, 0x2c, 0xc8, 0x8c, 0x35, 0x33, 0xe1, 0xb2,
-	0xb2, 0x0c, 0x18, 0x8a, 0x4a, 0xe2, 0xc8, 0x09, 0x55, 0x79, 0xa1, 0x6e, 0xfb, 0x14, 0xdd, 0xf7,
-	0x91, 0xaa, 0x19, 0x27, 0x21, 0xdc, 0xda, 0x55, 0x72, 0xfe, 0xff, 0x3b, 0x73, 0x2e, 0x33, 0x32,
-	0xd4, 0x46, 0xd1, 0x74, 0x1a, 0x5e, 0x45, 0xed, 0x49, 0x12, 0xcf, 0x62, 0x2c, 0xff, 0xb8, 0x9d,
-	0x44, 0xc9, 0x64, 0x6f, 0xd2, 0xfc, 0x63, 0x41, 0xf5, 0x44, 0x07, 0x83, 0x14, 0xc0, 0xcf, 0x50,
-	0xec, 0x47, 0xe1, 0x45, 0x94, 0xd8, 0x99, 0x46, 0xa6, 0x55, 0xd9, 0x7b, 0xd7, 0x5e, 0xb0, 0xed,
-	0x55, 0xae, 0x3d, 0xff, 0x4d, 0x59, 0x31, 0xcf, 0xc1, 0x4f, 0x90, 0xef, 0x86, 0xb3, 0xd0, 0xce,
-	0x9a, 0xdc, 0xe6, 0xbf, 0x73, 0x35, 0x29, 0x0c, 0xbf, 0xf3, 0x2b, 0x0b, 0xb5, 0x07, 0x27, 0xa2,
-	0x0d, 0xa5, 0xef, 0x51, 0x32, 0xbd, 0x8e, 0xc7, 0xa6, 0x11, 0x4b, 0x2c, 0x42, 0xdc, 0x82, 0xc2,
-	0x4d, 0x7c, 0x75, 0x7d, 0x61, 0x8a, 0x58, 0x22, 0x0d, 0x10, 0x21, 0x7f, 0x99, 0xc4, 0x23, 0x3b,
-	0x67, 0x44, 0xf3, 0x1f, 0xb7, 0xa1, 0x78, 0x76, 0x3e, 0x0e, 0x47, 0x91, 0x9d, 0x37, 0xea, 0x3c,
-	0xd2, 0x5d, 0xce, 0xee, 0x26, 0x91, 0x5d, 0x68, 0x64, 0x5a, 0xf5, 0xff, 0x75, 0xa9, 0xee, 0x26,
-	0x91, 0x30, 0x3c, 0x36, 0xa1, 0x7a, 0x11, 0xce, 0x42, 0xf7, 0x6b, 0x74, 0xfe, 0x4d, 0xde, 0x8e,
-	0xec, 0x62, 0x23, 0xd3, 0xaa, 0x89, 0x07, 0x1a, 0x7e, 0x01, 0x2b, 0x4a, 0x92, 0x38, 0xd1, 0x69,
-	0x76, 0xc9, 0x14, 0x68, 0xbc, 0x50, 0x80, 0x2e, 0x38, 0x71, 0x9f, 0x82, 0xef, 0xa1, 0x1e, 0x8d,
-	0xc3, 0xb3, 0x9b, 0xc8, 0x8d, 0x47, 0x93, 0x24, 0x9a, 0x4e, 0xed, 0x72, 0x23, 0xd3, 0x2a, 0x8b,
-	0x47, 0xea, 0xce, 0x07, 0xa8, 0xac, 0xac, 0x51, 0xaf, 0x6b, 0x34, 0xbd, 0xf2, 0xc6, 0x97, 0xb1,
-	0xd9, 0x40, 0x55, 0x2c, 0xc2, 0xe6, 0xef, 0xdc, 0x92, 0x34, 0x05, 0x6a, 0x60, 0x49, 0xca, 0xba,
-	0x9d, 0x43, 0xee, 0x1e, 0x90, 0x35, 0x04, 0x28, 0xfa, 0x5c, 0x2a, 0x75, 0x42, 0x32, 0xb8, 0x0e,
-	0x95, 0x8e, 0xa3, 0xdc, 0xfe, 0x5c, 0xc8, 0x6a, 0xb6, 0x47, 0x55, 0x90, 0xb2, 0x39, 0x2c, 0x43,
-	0xde, 0xf7, 0x58, 0x8f, 0xe4, 0xd1, 0x86, 0xad, 0xa5, 0xe1, 0xf6, 0x1d, 0x8f, 0x49, 0xe5, 0xa8,
-	0xa1, 0x24, 0x05, 0xdc, 0x80, 0xda, 0xd2, 0x09, 0x04, 0x95, 0xa4, 0x88, 0xbb, 0x60, 0x3f, 0x07,
-	0x1b, 0xb7, 0xa4, 0x5d, 0x97, 0xb3, 0x7d, 0x4f, 0x0c, 0x9e, 0x1e, 0x57, 0xc6, 0x06, 0xec, 0xbe,
-	0xe4, 0x9a, 0x7c, 0x4b, 0x17, 0x1c, 0xc8, 0x5e, 0xa0, 0x4e, 0x7d, 0x1a, 0x30, 0xce, 0x28, 0x01,
-	0x24, 0x50, 0xd5, 0x05, 0x85, 0xef, 0x06, 0x3e, 0x17, 0x8a, 0x54, 0x70, 0x0b, 0xc8, 0xaa, 0x62,
-	0x52, 0xab, 0xb8, 0x0d, 0xa8, 0x55, 0x67, 0xa8, 0xfa, 0x94, 0x29, 0xcf, 0x75, 0x94, 0xc7, 0x19,
-	0xa9, 0xe1, 0x0e, 0x6c, 0x3f, 0xd5, 0x4d, 0x4e, 0xdd, 0xb4, 0xab, 0x7b, 0xa0, 0xdd, 0xa0, 0xb3,
-	0xaf, 0x02, 0x46, 0x8f, 0x83, 0x23, 0x8f, 0x1e, 0x07, 0x03, 0xd9, 0x23, 0xeb, 0xa6, 0xdd, 0x47,
-	0xae, 0x2f, 0xb8, 0xcf, 0xa5, 0x73, 0x68, 0x08, 0xa2, 0x37, 0xb7, 0x4a, 0x1c, 0x71, 0x45, 0x8d,
-	0xb3, 0xa1, 0xb7, 0xaf, 0x79, 0x33, 0xa6, 0xd7, 0x25, 0x88, 0x55, 0x28, 0x6b, 0x81, 0xf1, 0x2e,
-	0x25, 0x9b, 0xcd, 0x9f, 0x59, 0xb0, 0x96, 0x2f, 0x06, 0x2b, 0x50, 0x92, 0x43, 0xd7, 0xa5, 0x52,
-	0x92, 0x35, 0x7d, 0x2f, 0x66, 0xf2, 0x8c, 0x9e, 0x7c, 0xc8, 0x0e, 0x18, 0x3f, 0x0e, 0xa8, 0x10,
-	0x5c, 0x90, 0x2c, 0x6e, 0xc2, 0xba, 0xdb, 0xa7, 0xee, 0x41, 0x20, 0x87, 0x83, 0xb9, 0x98, 0xd3,
-	0x43, 0x0c, 0xd9, 0xc0, 0x11, 0xb2, 0x9f, 0xf6, 0x15, 0x74, 0x78, 0xf7, 0x74, 0xee, 0xe6, 0x11,
-	0xa1, 0xee, 0x72, 0xc6, 0xa8, 0xab, 0xf7, 0xb4, 0x3f, 0x94, 0x94, 0x14, 0x9e, 0x5e, 0xf8, 0x9c,
-	0x2e, 0xe2, 0x2b, 0xd8, 0x5c, 0x51, 0x19, 0x57, 0xf4, 0xc4, 0x93, 0x8a, 0x94, 0x74, 0xe5, 0xfb,
-	0x97, 0x90, 0xd2, 0x65, 0x6c, 0xc2, 0x9b, 0x17, 0xef, 0x33, 0x65, 0xac, 0xc5, 0x7b, 0x79, 0xb4,
-	0xfe, 0xd4, 0x05, 0x7c, 0x0b, 0xaf, 0x9f, 0x71, 0x19, 0x57, 0x81, 0xef, 0x48, 0x49, 0x2a, 0x67,
-	0x45, 0xf3, 0x8d, 0xfb, 0xf8, 0x37, 0x00, 0x00, 0xff, 0xff, 0x2f, 0xeb, 0xf2, 0x99, 0xf4, 0x04,
-	0x00, 0x00,
+	// 736 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x5d, 0x6f, 0xdb, 0x36,
+	0x14, 0x86, 0x6b, 0xc7, 0x9f, 0xc7, 0x76, 0xc2, 0x9c, 0x7c, 0x54, 0x4d, 0x83, 0xcd, 0x30, 0x86,
+	0x2d, 0x57, 0xb9, 0xe8, 0x80, 0x5d, 0x0d, 0x03, 0x64, 0x9a, 0xb6, 0x85, 0xd4, 0xa4, 0x40, 0x52,
+	0x8d, 0x7b, 0x25, 0x28, 0x8d, 0x9a, 0x15, 0xab, 0x2d, 0x43, 0x76, 0x87, 0xf5, 0x3f, 0xec, 0x7e,
+	0xff, 0x60, 0xb7, 0xfb, 0x8b, 0x03, 0x29, 0xc9, 0x1f, 0x71, 0xb2, 0x5d, 0x25, 0x7c, 0xcf, 0xf3,
+	0xf2, 0x1c, 0xbd, 0x3c, 0x30, 0x74, 0x66, 0xf1, 0x72, 0x19, 0x3d, 0xc4, 0xd7, 0x8b, 0x34, 0x59,
+	0x25, 0xd8, 0xf8, 0xe3, 0xcb, 0x22, 0x4e, 0x17, 0x6f, 0x16, 0xbd, 0xbf, 0x5a, 0xd0, 0x9e, 0x9a,
+	0xc3, 0x24, 0x03, 0xf0, 0x67, 0xa8, 0x8d, 0xe3, 0xe8, 0x3e, 0x4e, 0x9d, 0x52, 0xb7, 0x74, 0xd5,
+	0x7a, 0xf3, 0xdd, 0x75, 0xc1, 0x5e, 0x6f, 0x73, 0xd7, 0xf9, 0xdf, 0x8c, 0x95, 0xb9, 0x07, 0x7f,
+	0x82, 0xca, 0x20, 0x5a, 0x45, 0x4e, 0xd9, 0x7a, 0x7b, 0xff, 0xed, 0x35, 0xa4, 0xb4, 0xfc, 0xc5,
+	0x3f, 0x65, 0xe8, 0xec, 0xdc, 0x88, 0x0e, 0xd4, 0x7f, 0x8f, 0xd3, 0xe5, 0xa7, 0x64, 0x6e, 0x07,
+	0x69, 0xca, 0xe2, 0x88, 0xa7, 0x50, 0xfd, 0x9c, 0x3c, 0x7c, 0xba, 0xb7, 0x4d, 0x9a, 0x32, 0x3b,
+	0x20, 0x42, 0xe5, 0x63, 0x9a, 0xcc, 0x9c, 0x03, 0x2b, 0xda, 0xff, 0xf1, 0x1c, 0x6a, 0x77, 0x1f,
+	0xe6, 0xd1, 0x2c, 0x76, 0x2a, 0x56, 0xcd, 0x4f, 0x66, 0xca, 0xd5, 0xd7, 0x45, 0xec, 0x54, 0xbb,
+	0xa5, 0xab, 0xc3, 0xff, 0x9b, 0x52, 0x7f, 0x5d, 0xc4, 0xd2, 0xf2, 0xd8, 0x83, 0xf6, 0x7d, 0xb4,
+	0x8a, 0xe8, 0xaf, 0xf1, 0x87, 0xdf, 0xd4, 0x97, 0x99, 0x53, 0xeb, 0x96, 0xae, 0x3a, 0x72, 0x47,
+	0xc3, 0x5f, 0xa0, 0x19, 0xa7, 0x69, 0x92, 0x1a, 0x9b, 0x53, 0xb7, 0x0d, 0xba, 0xcf, 0x34, 0x60,
+	0x05, 0x27, 0x37, 0x16, 0xfc, 0x1e, 0x0e, 0xe3, 0x79, 0x74, 0xf7, 0x39, 0xa6, 0xc9, 0x6c, 0x91,
+	0xc6, 0xcb, 0xa5, 0xd3, 0xe8, 0x96, 0xae, 0x1a, 0xf2, 0x91, 0x7a, 0xf1, 0x03, 0xb4, 0xb6, 0x62,
+	0x34, 0x71, 0xcd, 0x96, 0x0f, 0xde, 0xfc, 0x63, 0x62, 0x13, 0x68, 0xcb, 0xe2, 0xd8, 0xfb, 0xb3,
+	0xba, 0x26, 0x6d, 0x83, 0x0e, 0x34, 0x15, 0xe3, 0x83, 0xfe, 0x5b, 0x41, 0x6f, 0xc8, 0x0b, 0x04,
+	0xa8, 0xf9, 0x42, 0x69, 0x3d, 0x25, 0x25, 0x3c, 0x82, 0x56, 0xdf, 0xd5, 0x74, 0x9c, 0x0b, 0x65,
+	0xc3, 0x8e, 0x98, 0x0e, 0x33, 0xf6, 0x00, 0x1b, 0x50, 0xf1, 0x3d, 0x3e, 0x22, 0x15, 0x74, 0xe0,
+	0x74, 0x5d, 0xa0, 0x63, 0xd7, 0xe3, 0x4a, 0xbb, 0x3a, 0x50, 0xa4, 0x8a, 0xc7, 0xd0, 0x59, 0x57,
+	0x42, 0xc9, 0x14, 0xa9, 0xe1, 0x25, 0x38, 0x4f, 0xc1, 0xb6, 0x5a, 0x37, 0x55, 0x2a, 0xf8, 0xd0,
+	0x93, 0x93, 0xfd, 0xeb, 0x1a, 0xd8, 0x85, 0xcb, 0xe7, 0xaa, 0xd6, 0xdf, 0x34, 0x0d, 0x27, 0x6a,
+	0x14, 0xea, 0xf7, 0x3e, 0x0b, 0xb9, 0xe0, 0x8c, 0x00, 0x12, 0x68, 0x9b, 0x86, 0xd2, 0xa7, 0xa1,
+	0x2f, 0xa4, 0x26, 0x2d, 0x3c, 0x05, 0xb2, 0xad, 0x58, 0x6b, 0x1b, 0xcf, 0x01, 0x8d, 0xea, 0x06,
+	0x7a, 0xcc, 0xb8, 0xf6, 0xa8, 0xab, 0x3d, 0xc1, 0x49, 0x07, 0x2f, 0xe0, 0x7c, 0x5f, 0xb7, 0x9e,
+	0x43, 0x3b, 0xae, 0x99, 0x81, 0x0d, 0xc2, 0xfe, 0x50, 0x87, 0x9c, 0xdd, 0x86, 0xef, 0x3c, 0x76,
+	0x1b, 0x4e, 0xd4, 0x88, 0x1c, 0xd9, 0x71, 0x1f, 0x55, 0x7d, 0x29, 0x7c, 0xa1, 0xdc, 0xb7, 0x96,
+	0x20, 0x26, 0xb9, 0x6d, 0xe2, 0x9d, 0xd0, 0xcc, 0x56, 0x8e, 0x4d, 0xfa, 0x86, 0xb7, 0x9f, 0xe9,
+	0x0d, 0x08, 0x62, 0x1b, 0x1a, 0x46, 0xe0, 0x62, 0xc0, 0xc8, 0x09, 0x9e, 0xc1, 0xf1, 0x26, 0xd8,
+	0x31, 0x73, 0x07, 0x4c, 0x2a, 0x72, 0x8a, 0xaf, 0xe0, 0x6c, 0x4f, 0xb6, 0xa3, 0x9e, 0x19, 0x87,
+	0xf1, 0x53, 0x31, 0xf1, 0x5d, 0x5a, 0xbc, 0xe2, 0xf9, 0xee, 0x0b, 0xe9, 0xa9, 0x22, 0x2f, 0x77,
+	0xef, 0xd6, 0xd3, 0xec, 0x02, 0xa7, 0xc8, 0x51, 0x4f, 0xcd, 0x47, 0x88, 0x21, 0x79, 0x55, 0xe4,
+	0x58, 0x28, 0x96, 0xbb, 0x40, 0x84, 0x43, 0xa3, 0x06, 0x7a, 0x2a, 0x72, 0xf2, 0x75, 0x91, 0xed,
+	0x46, 0xb3, 0xec, 0x65, 0xef, 0xef, 0x32, 0x34, 0xd7, 0x8b, 0x8f, 0x2d, 0xa8, 0xab, 0x80, 0x52,
+	0xa6, 0x14, 0x79, 0x61, 0xd6, 0xcb, 0x3e, 0x60, 0xc9, 0x34, 0x0e, 0xf8, 0x0d, 0x17, 0xb7, 0x21,
+	0x93, 0x52, 0x48, 0x52, 0xc6, 0x13, 0x38, 0xa2, 0x63, 0x46, 0x6f, 0x42, 0x15, 0x4c, 0x72, 0xf1,
+	0xc0, 0xbc, 0x45, 0xc0, 0x27, 0xae, 0x54, 0xe3, 0x2c, 0xde, 0xb0, 0x2f, 0x06, 0xef, 0xf3, 0x6a,
+	0xc5, 0x4c, 0x45, 0x05, 0xe7, 0x8c, 0x9a, 0xe7, 0x1e, 0x06, 0x8a, 0x91, 0xea, 0xfe, 0xde, 0xe6,
+	0x74, 0x0d, 0x5f, 0xc2, 0xc9, 0x96, 0xca, 0x85, 0x66, 0x53, 0x4f, 0x69, 0x52, 0x37, 0x9d, 0x37,
+	0xd9, 0x64, 0x74, 0x03, 0x7b, 0xf0, 0xcd, 0xb3, 0x6b, 0x99, 0x31, 0xcd, 0x62, 0xed, 0x1f, 0x6d,
+	0x51, 0x56, 0x05, 0xfc, 0x16, 0x5e, 0x3f, 0x51, 0xe5, 0x42, 0x87, 0xbe, 0xab, 0x14, 0x69, 0xdd,
+	0xd5, 0xec, 0x4f, 0xf5, 0x8f, 0xff, 0x0e, 0x00, 0xa2, 0x97, 0xe8, 0xd9, 0xbb, 0x05, 0x00, 0x00,
 }
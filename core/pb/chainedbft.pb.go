@@ -135,15 +135,18 @@ func (m *QuorumCert) GetSignInfos() *QCSignInfos {
 }
 
 // QCSignInfos is the signs of the leader gathered from replicas of a specifically certType.
-// A slice of signs is used at present.
-// TODO @qizheng09: It will be change to Threshold-Signatures after
-// Crypto lib support Threshold-Signatures.
+// A slice of signs is used by default, while BLS is enabled the signs are aggregated
+// into one signature, and the signers are marked in a bitmap of validate sets.
 type QCSignInfos struct {
 	// QCSignInfos
-	QCSignInfos          []*SignInfo `protobuf:"bytes,1,rep,name=QCSignInfos,proto3" json:"QCSignInfos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	QCSignInfos []*SignInfo `protobuf:"bytes,1,rep,name=QCSignInfos,proto3" json:"QCSignInfos,omitempty"`
+	// AggregatedSign is the aggregated BLS signature of replicas
+	AggregatedSign []byte `protobuf:"bytes,2,opt,name=AggregatedSign,proto3" json:"AggregatedSign,omitempty"`
+	// SignerBitmap marks the signers, bit i(LSB first in byte i/8) refers to the i-th validate
+	SignerBitmap         []byte   `protobuf:"bytes,3,opt,name=SignerBitmap,proto3" json:"SignerBitmap,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *QCSignInfos) Reset()         { *m = QCSignInfos{} }
@@ -178,6 +181,20 @@ func (m *QCSignInfos) GetQCSignInfos() []*SignInfo {
 	return nil
 }
 
+func (m *QCSignInfos) GetAggregatedSign() []byte {
+	if m != nil {
+		return m.AggregatedSign
+	}
+	return nil
+}
+
+func (m *QCSignInfos) GetSignerBitmap() []byte {
+	if m != nil {
+		return m.SignerBitmap
+	}
+	return nil
+}
+
 // SignInfo is the signature information of the
 type SignInfo struct {
 	Address              string   `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
@@ -249,10 +266,13 @@ type ChainedBftPhaseMessage struct {
 	// MsgDigest is the digest of the msgg
 	MsgDigest []byte `protobuf:"bytes,5,opt,name=MsgDigest,proto3" json:"MsgDigest,omitempty"`
 	// Signature for this msg
-	Signature            *SignInfo `protobuf:"bytes,6,opt,name=Signature,proto3" json:"Signature,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Signature *SignInfo `protobuf:"bytes,6,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	// TimeoutCert is the timeout votes of the previous view, carried in "NEW_VIEW_MESSAGE" while view timeout.
+	// It contains only the sender's vote before 2f+1 votes are gathered.
+	TimeoutCert          *TimeoutCert `protobuf:"bytes,7,opt,name=TimeoutCert,proto3" json:"TimeoutCert,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *ChainedBftPhaseMessage) Reset()         { *m = ChainedBftPhaseMessage{} }
@@ -322,6 +342,63 @@ func (m *ChainedBftPhaseMessage) GetSignature() *SignInfo {
 	return nil
 }
 
+func (m *ChainedBftPhaseMessage) GetTimeoutCert() *TimeoutCert {
+	if m != nil {
+		return m.TimeoutCert
+	}
+	return nil
+}
+
+// TimeoutCert is the certificate that a view is timeout, which combines the timeout votes from replicas.
+type TimeoutCert struct {
+	// The view number which is timeout.
+	ViewNumber int64 `protobuf:"varint,1,opt,name=ViewNumber,proto3" json:"ViewNumber,omitempty"`
+	// SignInfos is the timeout votes gathered from replicas.
+	SignInfos            *QCSignInfos `protobuf:"bytes,2,opt,name=SignInfos,proto3" json:"SignInfos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *TimeoutCert) Reset()         { *m = TimeoutCert{} }
+func (m *TimeoutCert) String() string { return proto.CompactTextString(m) }
+func (*TimeoutCert) ProtoMessage()    {}
+func (*TimeoutCert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2652a5c831a51bf, []int{4}
+}
+
+func (m *TimeoutCert) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TimeoutCert.Unmarshal(m, b)
+}
+func (m *TimeoutCert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TimeoutCert.Marshal(b, m, deterministic)
+}
+func (m *TimeoutCert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeoutCert.Merge(m, src)
+}
+func (m *TimeoutCert) XXX_Size() int {
+	return xxx_messageInfo_TimeoutCert.Size(m)
+}
+func (m *TimeoutCert) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeoutCert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeoutCert proto.InternalMessageInfo
+
+func (m *TimeoutCert) GetViewNumber() int64 {
+	if m != nil {
+		return m.ViewNumber
+	}
+	return 0
+}
+
+func (m *TimeoutCert) GetSignInfos() *QCSignInfos {
+	if m != nil {
+		return m.SignInfos
+	}
+	return nil
+}
+
 // ChainedBftVoteMessage is the vote message of the protocal.
 type ChainedBftVoteMessage struct {
 	// The id of this message votes for.
@@ -337,7 +414,7 @@ func (m *ChainedBftVoteMessage) Reset()         { *m = ChainedBftVoteMessage{} }
 func (m *ChainedBftVoteMessage) String() string { return proto.CompactTextString(m) }
 func (*ChainedBftVoteMessage) ProtoMessage()    {}
 func (*ChainedBftVoteMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e2652a5c831a51bf, []int{4}
+	return fileDescriptor_e2652a5c831a51bf, []int{5}
 }
 
 func (m *ChainedBftVoteMessage) XXX_Unmarshal(b []byte) error {
@@ -378,38 +455,43 @@ func init() {
 	proto.RegisterType((*QCSignInfos)(nil), "pb.QCSignInfos")
 	proto.RegisterType((*SignInfo)(nil), "pb.SignInfo")
 	proto.RegisterType((*ChainedBftPhaseMessage)(nil), "pb.ChainedBftPhaseMessage")
+	proto.RegisterType((*TimeoutCert)(nil), "pb.TimeoutCert")
 	proto.RegisterType((*ChainedBftVoteMessage)(nil), "pb.ChainedBftVoteMessage")
 }
 
 func init() { proto.RegisterFile("chainedbft.proto", fileDescriptor_e2652a5c831a51bf) }
 
 var fileDescriptor_e2652a5c831a51bf = []byte{
-	// 424 bytes of a gzipped FileDescriptorProto
+	// 488 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x6e, 0x9b, 0x40,
-	0x10, 0x86, 0xbb, 0xd8, 0xb5, 0xc3, 0x80, 0x5c, 0x34, 0x52, 0xab, 0x3d, 0x54, 0x2d, 0xe2, 0x84,
-	0xa2, 0x96, 0x83, 0x7b, 0xee, 0x21, 0xc5, 0x1c, 0x68, 0x4b, 0x8a, 0x37, 0x91, 0x73, 0x8c, 0xc0,
-	0x2c, 0x04, 0x29, 0x31, 0x88, 0x5d, 0x54, 0xf9, 0xd8, 0x17, 0xeb, 0xb3, 0x55, 0x6c, 0x8c, 0xc1,
-	0xb2, 0xd4, 0xf6, 0x36, 0xf3, 0xcf, 0xcf, 0x8c, 0xe6, 0x1b, 0x16, 0xac, 0xed, 0x43, 0x52, 0xee,
-	0x78, 0x96, 0xe6, 0xd2, 0xab, 0x9b, 0x4a, 0x56, 0xa8, 0xd5, 0xa9, 0xf3, 0x9b, 0x00, 0xac, 0xdb,
-	0xaa, 0x69, 0x9f, 0x7c, 0xde, 0x48, 0x7c, 0x07, 0x10, 0x37, 0x55, 0x5d, 0x89, 0xe4, 0x31, 0xcc,
-	0x28, 0xb1, 0x89, 0x6b, 0xb2, 0x91, 0x82, 0x36, 0x18, 0x7d, 0x16, 0x89, 0x82, 0x6a, 0xca, 0x30,
-	0x96, 0xf0, 0x3d, 0x4c, 0x6f, 0xf7, 0x35, 0xa7, 0x13, 0x9b, 0xb8, 0x8b, 0xa5, 0xe1, 0xd5, 0xa9,
-	0xb7, 0xf6, 0x6f, 0x64, 0x22, 0x39, 0x53, 0x85, 0x6e, 0xc4, 0xa6, 0xe4, 0x3f, 0xaf, 0xdb, 0xa7,
-	0x94, 0x37, 0x74, 0x6a, 0x13, 0x77, 0xc2, 0x46, 0x0a, 0x7e, 0x04, 0xfd, 0xa6, 0x2c, 0x76, 0xe1,
-	0x2e, 0xaf, 0x04, 0x7d, 0x69, 0x13, 0xd7, 0x58, 0xbe, 0x3a, 0x74, 0xe9, 0x65, 0x36, 0x38, 0x9c,
-	0xcf, 0x60, 0x8c, 0x2a, 0xe8, 0x9d, 0xa4, 0x94, 0xd8, 0x13, 0xd7, 0x58, 0x9a, 0xdd, 0xf7, 0xbd,
-	0xc8, 0xc6, 0x06, 0x67, 0x03, 0x17, 0x7d, 0x82, 0x14, 0xe6, 0x57, 0x59, 0xd6, 0x70, 0x21, 0xd4,
-	0xe6, 0x3a, 0xeb, 0x53, 0x7c, 0x0b, 0x7a, 0xdc, 0xa6, 0x8f, 0xe5, 0xf6, 0x1b, 0xdf, 0xab, 0xa5,
-	0x75, 0x36, 0x08, 0x88, 0x30, 0xed, 0x7a, 0xa8, 0x95, 0x4d, 0xa6, 0x62, 0xe7, 0x97, 0x06, 0x6f,
-	0xfc, 0x67, 0xe0, 0x5f, 0x72, 0x19, 0x3f, 0x24, 0x82, 0x47, 0x5c, 0x88, 0xa4, 0xe0, 0x47, 0x42,
-	0xe4, 0xff, 0x08, 0x69, 0x67, 0x84, 0xbc, 0xe1, 0x48, 0x6b, 0x5f, 0x4d, 0x35, 0x96, 0x0b, 0xd5,
-	0xe6, 0x78, 0x48, 0x36, 0x72, 0xe0, 0x07, 0xd0, 0xbf, 0xb6, 0x42, 0x96, 0xf9, 0x7e, 0xed, 0x2b,
-	0xe0, 0xe7, 0xf6, 0xc1, 0xd0, 0xed, 0x1a, 0x89, 0x62, 0x55, 0x16, 0x5c, 0x48, 0xc5, 0xdf, 0x64,
-	0x83, 0x80, 0x97, 0xcf, 0xd7, 0x49, 0x64, 0xdb, 0x70, 0x3a, 0x53, 0xbd, 0x4e, 0xe9, 0x0e, 0x65,
-	0x67, 0x0b, 0xaf, 0x07, 0x04, 0x9b, 0x4a, 0x1e, 0x09, 0xfc, 0xeb, 0x2f, 0x3b, 0x19, 0xa2, 0xfd,
-	0x75, 0xc8, 0xe5, 0x77, 0x98, 0x1f, 0xe8, 0xa1, 0x09, 0x17, 0xd7, 0xc1, 0xdd, 0xfd, 0x26, 0x0c,
-	0xee, 0xac, 0x17, 0x68, 0xc0, 0x3c, 0x66, 0x41, 0x7c, 0xc5, 0x02, 0x8b, 0xe0, 0x02, 0x20, 0x66,
-	0xc1, 0xbd, 0xff, 0x23, 0x8a, 0xc2, 0x5b, 0x4b, 0x43, 0x80, 0xd9, 0x21, 0x9e, 0x74, 0xf1, 0x2a,
-	0xf0, 0xc3, 0x55, 0x60, 0x4d, 0xd3, 0x99, 0x7a, 0x19, 0x9f, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0x7e, 0xac, 0x10, 0xdb, 0x2d, 0x03, 0x00, 0x00,
+	0x10, 0x86, 0xbb, 0xd8, 0xb5, 0xc3, 0x80, 0x5c, 0xb4, 0x52, 0xab, 0x3d, 0x54, 0x2d, 0xe2, 0x50,
+	0xa1, 0xa8, 0x45, 0xaa, 0xfb, 0x04, 0x0e, 0xe6, 0x40, 0x5b, 0x52, 0xbc, 0xb1, 0x9c, 0x4b, 0xa5,
+	0x08, 0xcc, 0x9a, 0x20, 0xc5, 0x06, 0xb1, 0x8b, 0x2a, 0x3f, 0x42, 0xdf, 0xa7, 0xe7, 0x3e, 0x5b,
+	0xc5, 0xda, 0x18, 0x48, 0xaa, 0x24, 0x27, 0xef, 0x7e, 0xf3, 0x7b, 0x86, 0x99, 0x7f, 0x16, 0x8c,
+	0xf5, 0x6d, 0x94, 0xed, 0x58, 0x12, 0x6f, 0x84, 0x53, 0x94, 0xb9, 0xc8, 0xb1, 0x52, 0xc4, 0xd6,
+	0x5f, 0x04, 0xb0, 0xa8, 0xf2, 0xb2, 0xda, 0xba, 0xac, 0x14, 0xf8, 0x1d, 0x40, 0x58, 0xe6, 0x45,
+	0xce, 0xa3, 0x3b, 0x3f, 0x21, 0xc8, 0x44, 0xb6, 0x4e, 0x3b, 0x04, 0x9b, 0xa0, 0x35, 0xb7, 0x80,
+	0xa7, 0x44, 0x91, 0x82, 0x2e, 0xc2, 0xef, 0x61, 0xb8, 0xdc, 0x17, 0x8c, 0x0c, 0x4c, 0x64, 0x4f,
+	0xa6, 0x9a, 0x53, 0xc4, 0xce, 0xc2, 0xbd, 0x12, 0x91, 0x60, 0x54, 0x06, 0xea, 0x12, 0xab, 0x8c,
+	0xfd, 0xba, 0xac, 0xb6, 0x31, 0x2b, 0xc9, 0xd0, 0x44, 0xf6, 0x80, 0x76, 0x08, 0xfe, 0x04, 0xea,
+	0x55, 0x96, 0xee, 0xfc, 0xdd, 0x26, 0xe7, 0xe4, 0xa5, 0x89, 0x6c, 0x6d, 0xfa, 0xea, 0x98, 0xa5,
+	0xc1, 0xb4, 0x55, 0x58, 0xbf, 0x11, 0x68, 0x9d, 0x10, 0x76, 0x7a, 0x57, 0x82, 0xcc, 0x81, 0xad,
+	0x4d, 0xf5, 0x3a, 0x41, 0x03, 0x69, 0x4f, 0xff, 0x01, 0x26, 0xb3, 0x34, 0x2d, 0x59, 0x1a, 0x09,
+	0x96, 0xd4, 0xf8, 0xd8, 0xd4, 0x3d, 0x8a, 0x2d, 0xd0, 0xeb, 0x5f, 0x56, 0x5e, 0x64, 0x62, 0x1b,
+	0x15, 0xb2, 0x3f, 0x9d, 0xf6, 0x98, 0xb5, 0x82, 0xb3, 0x26, 0x31, 0x26, 0x30, 0x9e, 0x25, 0x49,
+	0xc9, 0x38, 0x97, 0x63, 0x54, 0x69, 0x73, 0xc5, 0x6f, 0x41, 0x0d, 0xab, 0xf8, 0x2e, 0x5b, 0x7f,
+	0x63, 0x7b, 0x59, 0x4c, 0xa5, 0x2d, 0xc0, 0x18, 0x86, 0xf2, 0x2b, 0x0e, 0xf9, 0xe5, 0xd9, 0xfa,
+	0xa3, 0xc0, 0x1b, 0xf7, 0xe0, 0xde, 0xc5, 0x46, 0x84, 0xb7, 0x11, 0x67, 0x01, 0xe3, 0x3c, 0x4a,
+	0xd9, 0x69, 0xdc, 0xe8, 0x79, 0xe3, 0x56, 0x1e, 0x8c, 0xdb, 0x69, 0x1d, 0x5f, 0xb8, 0xb2, 0xaa,
+	0x36, 0x9d, 0xc8, 0x34, 0xa7, 0xad, 0xa0, 0x1d, 0x05, 0xfe, 0x08, 0xea, 0xd7, 0x8a, 0x8b, 0x6c,
+	0xb3, 0x5f, 0xb8, 0x64, 0xf8, 0x5f, 0x79, 0x2b, 0xa8, 0x7b, 0x0d, 0x78, 0x3a, 0xcf, 0x52, 0xc6,
+	0x85, 0x34, 0x53, 0xa7, 0x2d, 0xc0, 0xe7, 0x07, 0xab, 0x23, 0x51, 0x95, 0x8c, 0x8c, 0x4c, 0xf4,
+	0xc0, 0xa9, 0x36, 0x8c, 0x3f, 0x83, 0xb6, 0xcc, 0xb6, 0x2c, 0xaf, 0x44, 0x5d, 0x83, 0x8c, 0xdb,
+	0xc5, 0xe8, 0x60, 0xda, 0xd5, 0x58, 0x3f, 0x7b, 0x7f, 0xb9, 0x37, 0x09, 0xf4, 0xf8, 0xe2, 0x29,
+	0x4f, 0x2e, 0xde, 0x1a, 0x5e, 0xb7, 0x9e, 0xac, 0x72, 0x71, 0xb2, 0xe4, 0xa9, 0x37, 0xd4, 0xeb,
+	0x5a, 0x79, 0xb4, 0xeb, 0xf3, 0xef, 0x30, 0x3e, 0xda, 0x89, 0x75, 0x38, 0xbb, 0xf4, 0xae, 0x6f,
+	0x56, 0xbe, 0x77, 0x6d, 0xbc, 0xc0, 0x1a, 0x8c, 0x43, 0xea, 0x85, 0x33, 0xea, 0x19, 0x08, 0x4f,
+	0x00, 0x42, 0xea, 0xdd, 0xb8, 0x3f, 0x82, 0xc0, 0x5f, 0x1a, 0x0a, 0x06, 0x18, 0x1d, 0xcf, 0x83,
+	0xfa, 0x3c, 0xf7, 0x5c, 0x7f, 0xee, 0x19, 0xc3, 0x78, 0x24, 0xdf, 0xfd, 0x97, 0x7f, 0x03, 0x00,
+	0x2a, 0x81, 0xa9, 0x76, 0x0b, 0x04, 0x00, 0x00,
 }
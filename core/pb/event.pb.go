@@ -29,14 +29,26 @@ type SubscribeType int32
 const (
 	// 区块事件，payload为BlockFilter
 	SubscribeType_BLOCK SubscribeType = 0
+	// 未确认交易事件，payload为UnconfirmedTxFilter
+	SubscribeType_UNCONFIRMED_TX SubscribeType = 1
+	// 分叉回滚事件，payload为ChainReorgFilter
+	SubscribeType_CHAIN_REORG SubscribeType = 2
+	// 共识变更事件，payload为ConsensusFilter
+	SubscribeType_CONSENSUS SubscribeType = 3
 )
 
 var SubscribeType_name = map[int32]string{
 	0: "BLOCK",
+	1: "UNCONFIRMED_TX",
+	2: "CHAIN_REORG",
+	3: "CONSENSUS",
 }
 
 var SubscribeType_value = map[string]int32{
-	"BLOCK": 0,
+	"BLOCK":          0,
+	"UNCONFIRMED_TX": 1,
+	"CHAIN_REORG":    2,
+	"CONSENSUS":      3,
 }
 
 func (x SubscribeType) String() string {
@@ -180,27 +192,83 @@ func (m *BlockRange) GetEnd() string {
 	return ""
 }
 
+// BlockCursor 订阅流中已经处理到的位置，断线重连后从该位置继续订阅
+type BlockCursor struct {
+	// 最后处理的区块id
+	Blockid string `protobuf:"bytes,1,opt,name=blockid,proto3" json:"blockid,omitempty"`
+	// 最后处理的交易在区块中的序号，-1表示该区块未处理
+	TxIndex              int64    `protobuf:"varint,2,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockCursor) Reset()         { *m = BlockCursor{} }
+func (m *BlockCursor) String() string { return proto.CompactTextString(m) }
+func (*BlockCursor) ProtoMessage()    {}
+func (*BlockCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{3}
+}
+
+func (m *BlockCursor) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockCursor.Unmarshal(m, b)
+}
+func (m *BlockCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockCursor.Marshal(b, m, deterministic)
+}
+func (m *BlockCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockCursor.Merge(m, src)
+}
+func (m *BlockCursor) XXX_Size() int {
+	return xxx_messageInfo_BlockCursor.Size(m)
+}
+func (m *BlockCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockCursor proto.InternalMessageInfo
+
+func (m *BlockCursor) GetBlockid() string {
+	if m != nil {
+		return m.Blockid
+	}
+	return ""
+}
+
+func (m *BlockCursor) GetTxIndex() int64 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
 type BlockFilter struct {
-	Bcname               string      `protobuf:"bytes,1,opt,name=bcname,proto3" json:"bcname,omitempty"`
-	Range                *BlockRange `protobuf:"bytes,2,opt,name=range,proto3" json:"range,omitempty"`
-	ExcludeTx            bool        `protobuf:"varint,3,opt,name=exclude_tx,json=excludeTx,proto3" json:"exclude_tx,omitempty"`
-	ExcludeTxEvent       bool        `protobuf:"varint,4,opt,name=exclude_tx_event,json=excludeTxEvent,proto3" json:"exclude_tx_event,omitempty"`
-	Contract             string      `protobuf:"bytes,10,opt,name=contract,proto3" json:"contract,omitempty"`
-	EventName            string      `protobuf:"bytes,11,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
-	Initiator            string      `protobuf:"bytes,12,opt,name=initiator,proto3" json:"initiator,omitempty"`
-	AuthRequire          string      `protobuf:"bytes,13,opt,name=auth_require,json=authRequire,proto3" json:"auth_require,omitempty"`
-	FromAddr             string      `protobuf:"bytes,14,opt,name=from_addr,json=fromAddr,proto3" json:"from_addr,omitempty"`
-	ToAddr               string      `protobuf:"bytes,15,opt,name=to_addr,json=toAddr,proto3" json:"to_addr,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Bcname         string      `protobuf:"bytes,1,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Range          *BlockRange `protobuf:"bytes,2,opt,name=range,proto3" json:"range,omitempty"`
+	ExcludeTx      bool        `protobuf:"varint,3,opt,name=exclude_tx,json=excludeTx,proto3" json:"exclude_tx,omitempty"`
+	ExcludeTxEvent bool        `protobuf:"varint,4,opt,name=exclude_tx_event,json=excludeTxEvent,proto3" json:"exclude_tx_event,omitempty"`
+	// 设置后忽略range.start，从cursor之后继续订阅
+	Cursor      *BlockCursor `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Contract    string       `protobuf:"bytes,10,opt,name=contract,proto3" json:"contract,omitempty"`
+	EventName   string       `protobuf:"bytes,11,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	Initiator   string       `protobuf:"bytes,12,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	AuthRequire string       `protobuf:"bytes,13,opt,name=auth_require,json=authRequire,proto3" json:"auth_require,omitempty"`
+	FromAddr    string       `protobuf:"bytes,14,opt,name=from_addr,json=fromAddr,proto3" json:"from_addr,omitempty"`
+	ToAddr      string       `protobuf:"bytes,15,opt,name=to_addr,json=toAddr,proto3" json:"to_addr,omitempty"`
+	// 按合约事件的索引字段过滤，多个条件需要同时满足
+	EventTopics []*EventTopicFilter `protobuf:"bytes,16,rep,name=event_topics,json=eventTopics,proto3" json:"event_topics,omitempty"`
+	// 是否返回解码后的合约事件参数
+	DecodeEvent          bool     `protobuf:"varint,17,opt,name=decode_event,json=decodeEvent,proto3" json:"decode_event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *BlockFilter) Reset()         { *m = BlockFilter{} }
 func (m *BlockFilter) String() string { return proto.CompactTextString(m) }
 func (*BlockFilter) ProtoMessage()    {}
 func (*BlockFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d17a9d3f0ddf27e, []int{3}
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{4}
 }
 
 func (m *BlockFilter) XXX_Unmarshal(b []byte) error {
@@ -249,6 +317,13 @@ func (m *BlockFilter) GetExcludeTxEvent() bool {
 	return false
 }
 
+func (m *BlockFilter) GetCursor() *BlockCursor {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
 func (m *BlockFilter) GetContract() string {
 	if m != nil {
 		return m.Contract
@@ -291,19 +366,206 @@ func (m *BlockFilter) GetToAddr() string {
 	return ""
 }
 
+func (m *BlockFilter) GetEventTopics() []*EventTopicFilter {
+	if m != nil {
+		return m.EventTopics
+	}
+	return nil
+}
+
+func (m *BlockFilter) GetDecodeEvent() bool {
+	if m != nil {
+		return m.DecodeEvent
+	}
+	return false
+}
+
+// EventTopicFilter 合约事件索引字段的过滤条件
+type EventTopicFilter struct {
+	// 字段名，EVM合约为事件中indexed参数的名字，其他合约为部署时声明的json格式事件body中的索引key
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 字段取值，匹配任意一个即可
+	Values               []string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventTopicFilter) Reset()         { *m = EventTopicFilter{} }
+func (m *EventTopicFilter) String() string { return proto.CompactTextString(m) }
+func (*EventTopicFilter) ProtoMessage()    {}
+func (*EventTopicFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{5}
+}
+
+func (m *EventTopicFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventTopicFilter.Unmarshal(m, b)
+}
+func (m *EventTopicFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventTopicFilter.Marshal(b, m, deterministic)
+}
+func (m *EventTopicFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTopicFilter.Merge(m, src)
+}
+func (m *EventTopicFilter) XXX_Size() int {
+	return xxx_messageInfo_EventTopicFilter.Size(m)
+}
+func (m *EventTopicFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTopicFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTopicFilter proto.InternalMessageInfo
+
+func (m *EventTopicFilter) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventTopicFilter) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+type EventArg struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// EVM合约为ABI中的参数类型，其他合约为空
+	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Indexed              bool     `protobuf:"varint,3,opt,name=indexed,proto3" json:"indexed,omitempty"`
+	Value                string   `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventArg) Reset()         { *m = EventArg{} }
+func (m *EventArg) String() string { return proto.CompactTextString(m) }
+func (*EventArg) ProtoMessage()    {}
+func (*EventArg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{6}
+}
+
+func (m *EventArg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventArg.Unmarshal(m, b)
+}
+func (m *EventArg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventArg.Marshal(b, m, deterministic)
+}
+func (m *EventArg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventArg.Merge(m, src)
+}
+func (m *EventArg) XXX_Size() int {
+	return xxx_messageInfo_EventArg.Size(m)
+}
+func (m *EventArg) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventArg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventArg proto.InternalMessageInfo
+
+func (m *EventArg) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventArg) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *EventArg) GetIndexed() bool {
+	if m != nil {
+		return m.Indexed
+	}
+	return false
+}
+
+func (m *EventArg) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type DecodedContractEvent struct {
+	Contract             string      `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Name                 string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Args                 []*EventArg `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *DecodedContractEvent) Reset()         { *m = DecodedContractEvent{} }
+func (m *DecodedContractEvent) String() string { return proto.CompactTextString(m) }
+func (*DecodedContractEvent) ProtoMessage()    {}
+func (*DecodedContractEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{7}
+}
+
+func (m *DecodedContractEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecodedContractEvent.Unmarshal(m, b)
+}
+func (m *DecodedContractEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecodedContractEvent.Marshal(b, m, deterministic)
+}
+func (m *DecodedContractEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodedContractEvent.Merge(m, src)
+}
+func (m *DecodedContractEvent) XXX_Size() int {
+	return xxx_messageInfo_DecodedContractEvent.Size(m)
+}
+func (m *DecodedContractEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodedContractEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecodedContractEvent proto.InternalMessageInfo
+
+func (m *DecodedContractEvent) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *DecodedContractEvent) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DecodedContractEvent) GetArgs() []*EventArg {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
 type FilteredTransaction struct {
-	Txid                 string           `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
-	Events               []*ContractEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Txid   string           `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	Events []*ContractEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	// 交易在区块中的序号
+	Index int64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	// 设置decode_event时返回，与events一一对应，无法解码的事件args为空
+	DecodedEvents        []*DecodedContractEvent `protobuf:"bytes,4,rep,name=decoded_events,json=decodedEvents,proto3" json:"decoded_events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
 func (m *FilteredTransaction) Reset()         { *m = FilteredTransaction{} }
 func (m *FilteredTransaction) String() string { return proto.CompactTextString(m) }
 func (*FilteredTransaction) ProtoMessage()    {}
 func (*FilteredTransaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d17a9d3f0ddf27e, []int{4}
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{8}
 }
 
 func (m *FilteredTransaction) XXX_Unmarshal(b []byte) error {
@@ -338,6 +600,20 @@ func (m *FilteredTransaction) GetEvents() []*ContractEvent {
 	return nil
 }
 
+func (m *FilteredTransaction) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *FilteredTransaction) GetDecodedEvents() []*DecodedContractEvent {
+	if m != nil {
+		return m.DecodedEvents
+	}
+	return nil
+}
+
 type FilteredBlock struct {
 	Bcname               string                 `protobuf:"bytes,1,opt,name=bcname,proto3" json:"bcname,omitempty"`
 	Blockid              string                 `protobuf:"bytes,2,opt,name=blockid,proto3" json:"blockid,omitempty"`
@@ -352,7 +628,7 @@ func (m *FilteredBlock) Reset()         { *m = FilteredBlock{} }
 func (m *FilteredBlock) String() string { return proto.CompactTextString(m) }
 func (*FilteredBlock) ProtoMessage()    {}
 func (*FilteredBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d17a9d3f0ddf27e, []int{5}
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{9}
 }
 
 func (m *FilteredBlock) XXX_Unmarshal(b []byte) error {
@@ -401,61 +677,517 @@ func (m *FilteredBlock) GetTxs() []*FilteredTransaction {
 	return nil
 }
 
+type UnconfirmedTxFilter struct {
+	Bcname         string `protobuf:"bytes,1,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	ExcludeTxEvent bool   `protobuf:"varint,4,opt,name=exclude_tx_event,json=excludeTxEvent,proto3" json:"exclude_tx_event,omitempty"`
+	Contract       string `protobuf:"bytes,10,opt,name=contract,proto3" json:"contract,omitempty"`
+	EventName      string `protobuf:"bytes,11,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	Initiator      string `protobuf:"bytes,12,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	AuthRequire    string `protobuf:"bytes,13,opt,name=auth_require,json=authRequire,proto3" json:"auth_require,omitempty"`
+	FromAddr       string `protobuf:"bytes,14,opt,name=from_addr,json=fromAddr,proto3" json:"from_addr,omitempty"`
+	ToAddr         string `protobuf:"bytes,15,opt,name=to_addr,json=toAddr,proto3" json:"to_addr,omitempty"`
+	// 按合约事件的索引字段过滤，多个条件需要同时满足
+	EventTopics []*EventTopicFilter `protobuf:"bytes,16,rep,name=event_topics,json=eventTopics,proto3" json:"event_topics,omitempty"`
+	// 是否返回解码后的合约事件参数
+	DecodeEvent          bool     `protobuf:"varint,17,opt,name=decode_event,json=decodeEvent,proto3" json:"decode_event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnconfirmedTxFilter) Reset()         { *m = UnconfirmedTxFilter{} }
+func (m *UnconfirmedTxFilter) String() string { return proto.CompactTextString(m) }
+func (*UnconfirmedTxFilter) ProtoMessage()    {}
+func (*UnconfirmedTxFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{10}
+}
+
+func (m *UnconfirmedTxFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnconfirmedTxFilter.Unmarshal(m, b)
+}
+func (m *UnconfirmedTxFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnconfirmedTxFilter.Marshal(b, m, deterministic)
+}
+func (m *UnconfirmedTxFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnconfirmedTxFilter.Merge(m, src)
+}
+func (m *UnconfirmedTxFilter) XXX_Size() int {
+	return xxx_messageInfo_UnconfirmedTxFilter.Size(m)
+}
+func (m *UnconfirmedTxFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnconfirmedTxFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnconfirmedTxFilter proto.InternalMessageInfo
+
+func (m *UnconfirmedTxFilter) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *UnconfirmedTxFilter) GetExcludeTxEvent() bool {
+	if m != nil {
+		return m.ExcludeTxEvent
+	}
+	return false
+}
+
+func (m *UnconfirmedTxFilter) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *UnconfirmedTxFilter) GetEventName() string {
+	if m != nil {
+		return m.EventName
+	}
+	return ""
+}
+
+func (m *UnconfirmedTxFilter) GetInitiator() string {
+	if m != nil {
+		return m.Initiator
+	}
+	return ""
+}
+
+func (m *UnconfirmedTxFilter) GetAuthRequire() string {
+	if m != nil {
+		return m.AuthRequire
+	}
+	return ""
+}
+
+func (m *UnconfirmedTxFilter) GetFromAddr() string {
+	if m != nil {
+		return m.FromAddr
+	}
+	return ""
+}
+
+func (m *UnconfirmedTxFilter) GetToAddr() string {
+	if m != nil {
+		return m.ToAddr
+	}
+	return ""
+}
+
+func (m *UnconfirmedTxFilter) GetEventTopics() []*EventTopicFilter {
+	if m != nil {
+		return m.EventTopics
+	}
+	return nil
+}
+
+func (m *UnconfirmedTxFilter) GetDecodeEvent() bool {
+	if m != nil {
+		return m.DecodeEvent
+	}
+	return false
+}
+
+// UnconfirmedTx 进入未确认交易池的交易，该交易后续可能被打包也可能被丢弃
+type UnconfirmedTx struct {
+	Bcname               string               `protobuf:"bytes,1,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Tx                   *FilteredTransaction `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *UnconfirmedTx) Reset()         { *m = UnconfirmedTx{} }
+func (m *UnconfirmedTx) String() string { return proto.CompactTextString(m) }
+func (*UnconfirmedTx) ProtoMessage()    {}
+func (*UnconfirmedTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{11}
+}
+
+func (m *UnconfirmedTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnconfirmedTx.Unmarshal(m, b)
+}
+func (m *UnconfirmedTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnconfirmedTx.Marshal(b, m, deterministic)
+}
+func (m *UnconfirmedTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnconfirmedTx.Merge(m, src)
+}
+func (m *UnconfirmedTx) XXX_Size() int {
+	return xxx_messageInfo_UnconfirmedTx.Size(m)
+}
+func (m *UnconfirmedTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnconfirmedTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnconfirmedTx proto.InternalMessageInfo
+
+func (m *UnconfirmedTx) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *UnconfirmedTx) GetTx() *FilteredTransaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+type ChainReorgFilter struct {
+	Bcname               string   `protobuf:"bytes,1,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	ExcludeTx            bool     `protobuf:"varint,3,opt,name=exclude_tx,json=excludeTx,proto3" json:"exclude_tx,omitempty"`
+	ExcludeTxEvent       bool     `protobuf:"varint,4,opt,name=exclude_tx_event,json=excludeTxEvent,proto3" json:"exclude_tx_event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChainReorgFilter) Reset()         { *m = ChainReorgFilter{} }
+func (m *ChainReorgFilter) String() string { return proto.CompactTextString(m) }
+func (*ChainReorgFilter) ProtoMessage()    {}
+func (*ChainReorgFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{12}
+}
+
+func (m *ChainReorgFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChainReorgFilter.Unmarshal(m, b)
+}
+func (m *ChainReorgFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChainReorgFilter.Marshal(b, m, deterministic)
+}
+func (m *ChainReorgFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainReorgFilter.Merge(m, src)
+}
+func (m *ChainReorgFilter) XXX_Size() int {
+	return xxx_messageInfo_ChainReorgFilter.Size(m)
+}
+func (m *ChainReorgFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainReorgFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainReorgFilter proto.InternalMessageInfo
+
+func (m *ChainReorgFilter) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *ChainReorgFilter) GetExcludeTx() bool {
+	if m != nil {
+		return m.ExcludeTx
+	}
+	return false
+}
+
+func (m *ChainReorgFilter) GetExcludeTxEvent() bool {
+	if m != nil {
+		return m.ExcludeTxEvent
+	}
+	return false
+}
+
+// ChainReorg 切换到新主干时被回滚和重新执行的区块
+type ChainReorg struct {
+	Bcname string `protobuf:"bytes,1,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	// 被回滚的区块，从旧的链顶到分叉点
+	UndoBlocks []*FilteredBlock `protobuf:"bytes,2,rep,name=undo_blocks,json=undoBlocks,proto3" json:"undo_blocks,omitempty"`
+	// 重新执行的区块，从分叉点到新的链顶
+	RedoBlocks           []*FilteredBlock `protobuf:"bytes,3,rep,name=redo_blocks,json=redoBlocks,proto3" json:"redo_blocks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ChainReorg) Reset()         { *m = ChainReorg{} }
+func (m *ChainReorg) String() string { return proto.CompactTextString(m) }
+func (*ChainReorg) ProtoMessage()    {}
+func (*ChainReorg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{13}
+}
+
+func (m *ChainReorg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChainReorg.Unmarshal(m, b)
+}
+func (m *ChainReorg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChainReorg.Marshal(b, m, deterministic)
+}
+func (m *ChainReorg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainReorg.Merge(m, src)
+}
+func (m *ChainReorg) XXX_Size() int {
+	return xxx_messageInfo_ChainReorg.Size(m)
+}
+func (m *ChainReorg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainReorg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainReorg proto.InternalMessageInfo
+
+func (m *ChainReorg) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *ChainReorg) GetUndoBlocks() []*FilteredBlock {
+	if m != nil {
+		return m.UndoBlocks
+	}
+	return nil
+}
+
+func (m *ChainReorg) GetRedoBlocks() []*FilteredBlock {
+	if m != nil {
+		return m.RedoBlocks
+	}
+	return nil
+}
+
+type ConsensusFilter struct {
+	Bcname               string   `protobuf:"bytes,1,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConsensusFilter) Reset()         { *m = ConsensusFilter{} }
+func (m *ConsensusFilter) String() string { return proto.CompactTextString(m) }
+func (*ConsensusFilter) ProtoMessage()    {}
+func (*ConsensusFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{14}
+}
+
+func (m *ConsensusFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConsensusFilter.Unmarshal(m, b)
+}
+func (m *ConsensusFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConsensusFilter.Marshal(b, m, deterministic)
+}
+func (m *ConsensusFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsensusFilter.Merge(m, src)
+}
+func (m *ConsensusFilter) XXX_Size() int {
+	return xxx_messageInfo_ConsensusFilter.Size(m)
+}
+func (m *ConsensusFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsensusFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsensusFilter proto.InternalMessageInfo
+
+func (m *ConsensusFilter) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+type ConsensusMiner struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	PeerInfo             string   `protobuf:"bytes,2,opt,name=peer_info,json=peerInfo,proto3" json:"peer_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConsensusMiner) Reset()         { *m = ConsensusMiner{} }
+func (m *ConsensusMiner) String() string { return proto.CompactTextString(m) }
+func (*ConsensusMiner) ProtoMessage()    {}
+func (*ConsensusMiner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{15}
+}
+
+func (m *ConsensusMiner) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConsensusMiner.Unmarshal(m, b)
+}
+func (m *ConsensusMiner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConsensusMiner.Marshal(b, m, deterministic)
+}
+func (m *ConsensusMiner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsensusMiner.Merge(m, src)
+}
+func (m *ConsensusMiner) XXX_Size() int {
+	return xxx_messageInfo_ConsensusMiner.Size(m)
+}
+func (m *ConsensusMiner) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsensusMiner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsensusMiner proto.InternalMessageInfo
+
+func (m *ConsensusMiner) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ConsensusMiner) GetPeerInfo() string {
+	if m != nil {
+		return m.PeerInfo
+	}
+	return ""
+}
+
+// ConsensusChange 共识的出块节点发生变化
+type ConsensusChange struct {
+	Bcname               string            `protobuf:"bytes,1,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	CurrentMiners        []*ConsensusMiner `protobuf:"bytes,2,rep,name=current_miners,json=currentMiners,proto3" json:"current_miners,omitempty"`
+	NextMiners           []*ConsensusMiner `protobuf:"bytes,3,rep,name=next_miners,json=nextMiners,proto3" json:"next_miners,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ConsensusChange) Reset()         { *m = ConsensusChange{} }
+func (m *ConsensusChange) String() string { return proto.CompactTextString(m) }
+func (*ConsensusChange) ProtoMessage()    {}
+func (*ConsensusChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{16}
+}
+
+func (m *ConsensusChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConsensusChange.Unmarshal(m, b)
+}
+func (m *ConsensusChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConsensusChange.Marshal(b, m, deterministic)
+}
+func (m *ConsensusChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsensusChange.Merge(m, src)
+}
+func (m *ConsensusChange) XXX_Size() int {
+	return xxx_messageInfo_ConsensusChange.Size(m)
+}
+func (m *ConsensusChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsensusChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsensusChange proto.InternalMessageInfo
+
+func (m *ConsensusChange) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *ConsensusChange) GetCurrentMiners() []*ConsensusMiner {
+	if m != nil {
+		return m.CurrentMiners
+	}
+	return nil
+}
+
+func (m *ConsensusChange) GetNextMiners() []*ConsensusMiner {
+	if m != nil {
+		return m.NextMiners
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("pb.SubscribeType", SubscribeType_name, SubscribeType_value)
 	proto.RegisterType((*SubscribeRequest)(nil), "pb.SubscribeRequest")
 	proto.RegisterType((*Event)(nil), "pb.Event")
 	proto.RegisterType((*BlockRange)(nil), "pb.BlockRange")
+	proto.RegisterType((*BlockCursor)(nil), "pb.BlockCursor")
 	proto.RegisterType((*BlockFilter)(nil), "pb.BlockFilter")
+	proto.RegisterType((*EventTopicFilter)(nil), "pb.EventTopicFilter")
+	proto.RegisterType((*EventArg)(nil), "pb.EventArg")
+	proto.RegisterType((*DecodedContractEvent)(nil), "pb.DecodedContractEvent")
 	proto.RegisterType((*FilteredTransaction)(nil), "pb.FilteredTransaction")
 	proto.RegisterType((*FilteredBlock)(nil), "pb.FilteredBlock")
+	proto.RegisterType((*UnconfirmedTxFilter)(nil), "pb.UnconfirmedTxFilter")
+	proto.RegisterType((*UnconfirmedTx)(nil), "pb.UnconfirmedTx")
+	proto.RegisterType((*ChainReorgFilter)(nil), "pb.ChainReorgFilter")
+	proto.RegisterType((*ChainReorg)(nil), "pb.ChainReorg")
+	proto.RegisterType((*ConsensusFilter)(nil), "pb.ConsensusFilter")
+	proto.RegisterType((*ConsensusMiner)(nil), "pb.ConsensusMiner")
+	proto.RegisterType((*ConsensusChange)(nil), "pb.ConsensusChange")
 }
 
 func init() { proto.RegisterFile("event.proto", fileDescriptor_2d17a9d3f0ddf27e) }
 
 var fileDescriptor_2d17a9d3f0ddf27e = []byte{
-	// 502 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0xdf, 0x6f, 0xd3, 0x30,
-	0x10, 0x26, 0x4d, 0x7f, 0x2c, 0x97, 0xb4, 0x14, 0x33, 0x31, 0xab, 0x80, 0xd4, 0x45, 0x20, 0x65,
-	0x3c, 0x54, 0xa8, 0xf0, 0x8c, 0xc4, 0x26, 0x10, 0x12, 0x08, 0x84, 0xd7, 0xf7, 0xc8, 0x49, 0xbc,
-	0xd5, 0xa2, 0x8b, 0x33, 0xc7, 0x99, 0xd2, 0xbf, 0x82, 0xff, 0x8a, 0xbf, 0x0b, 0xf9, 0x9c, 0x66,
-	0x9a, 0x04, 0x6f, 0xbe, 0xef, 0xfb, 0xee, 0xee, 0xbb, 0xb3, 0x0d, 0xa1, 0xb8, 0x13, 0xa5, 0x59,
-	0x55, 0x5a, 0x19, 0x45, 0x06, 0x55, 0xb6, 0x88, 0xda, 0x7c, 0xcb, 0x65, 0xe9, 0x90, 0xf8, 0x27,
-	0xcc, 0x2f, 0x9b, 0xac, 0xce, 0xb5, 0xcc, 0x04, 0x13, 0xb7, 0x8d, 0xa8, 0x0d, 0x79, 0x0d, 0x43,
-	0xb3, 0xaf, 0x04, 0xf5, 0x96, 0x5e, 0x32, 0x5b, 0x3f, 0x59, 0x55, 0xd9, 0xaa, 0xd7, 0x6c, 0xf6,
-	0x95, 0x60, 0x48, 0x93, 0x67, 0x30, 0xbe, 0x92, 0x3b, 0x23, 0x34, 0x1d, 0x2c, 0xbd, 0x24, 0x62,
-	0x5d, 0x14, 0x9f, 0xc2, 0xe8, 0x93, 0xed, 0x49, 0x28, 0x4c, 0x2a, 0xbe, 0xdf, 0x29, 0x5e, 0x60,
-	0xa9, 0x88, 0x1d, 0xc2, 0xf8, 0x3d, 0xc0, 0xf9, 0x4e, 0xe5, 0xbf, 0x18, 0x2f, 0xaf, 0x05, 0x39,
-	0x86, 0x51, 0x6d, 0xb8, 0x36, 0xa8, 0x0a, 0x98, 0x0b, 0xc8, 0x1c, 0x7c, 0x51, 0x16, 0x58, 0x3b,
-	0x60, 0xf6, 0x18, 0xff, 0x19, 0x40, 0x88, 0x69, 0x9f, 0xb1, 0x91, 0x35, 0x90, 0xe5, 0x25, 0xbf,
-	0x11, 0x5d, 0x62, 0x17, 0x91, 0x57, 0x30, 0xd2, 0xb6, 0x30, 0xe6, 0x86, 0xeb, 0x99, 0x1d, 0xe0,
-	0xbe, 0x1d, 0x73, 0x24, 0x79, 0x09, 0x20, 0xda, 0x7c, 0xd7, 0x14, 0x22, 0x35, 0x2d, 0xf5, 0x97,
-	0x5e, 0x72, 0xc4, 0x82, 0x0e, 0xd9, 0xb4, 0x24, 0x81, 0xf9, 0x3d, 0x9d, 0xe2, 0x12, 0xe9, 0x10,
-	0x45, 0xb3, 0x5e, 0xe4, 0xc6, 0x5c, 0xc0, 0x51, 0xae, 0x4a, 0xa3, 0x79, 0x6e, 0x28, 0xa0, 0x91,
-	0x3e, 0xc6, 0x26, 0x56, 0x94, 0xa2, 0xcd, 0x10, 0xd9, 0x00, 0x91, 0xef, 0xd6, 0xe9, 0x0b, 0x08,
-	0x64, 0x29, 0x8d, 0xe4, 0x46, 0x69, 0x1a, 0x39, 0xb6, 0x07, 0xc8, 0x29, 0x44, 0xbc, 0x31, 0xdb,
-	0x54, 0x8b, 0xdb, 0x46, 0x6a, 0x41, 0xa7, 0x28, 0x08, 0x2d, 0xc6, 0x1c, 0x44, 0x9e, 0x43, 0x70,
-	0xa5, 0xd5, 0x4d, 0xca, 0x8b, 0x42, 0xd3, 0x99, 0x6b, 0x6e, 0x81, 0x8f, 0x45, 0xa1, 0xc9, 0x09,
-	0x4c, 0x8c, 0x72, 0xd4, 0x63, 0xb7, 0x20, 0xa3, 0x2c, 0x11, 0x6f, 0xe0, 0xa9, 0x5b, 0xa1, 0x28,
-	0x36, 0x9a, 0x97, 0x35, 0xcf, 0x8d, 0x54, 0x25, 0x21, 0x30, 0x34, 0xad, 0x2c, 0xba, 0x6d, 0xe2,
-	0x99, 0x9c, 0xc1, 0x18, 0xed, 0xd6, 0x74, 0xb0, 0xf4, 0x93, 0xd0, 0xbd, 0x86, 0x8b, 0x6e, 0x3c,
-	0x9c, 0x9f, 0x75, 0x82, 0xf8, 0xb7, 0x07, 0xd3, 0x43, 0x59, 0x5c, 0xf7, 0x7f, 0x2f, 0x88, 0xc2,
-	0x24, 0xb3, 0x02, 0x79, 0xb8, 0xde, 0x43, 0x68, 0x47, 0xc6, 0x63, 0xba, 0x15, 0xf2, 0x7a, 0x6b,
-	0xf0, 0x5a, 0x7c, 0x16, 0x22, 0xf6, 0x05, 0x21, 0x72, 0x06, 0xbe, 0x69, 0x6b, 0x3a, 0x44, 0x3b,
-	0x27, 0xd6, 0xce, 0x3f, 0x66, 0x61, 0x56, 0xf3, 0x66, 0x01, 0xd3, 0x07, 0x0f, 0x97, 0x04, 0x30,
-	0x3a, 0xff, 0xf6, 0xe3, 0xe2, 0xeb, 0xfc, 0xd1, 0xfa, 0x03, 0x44, 0x68, 0xff, 0x52, 0xe8, 0x3b,
-	0x99, 0x0b, 0xb2, 0x82, 0xa0, 0xd7, 0x92, 0xe3, 0x07, 0x6f, 0xbe, 0xfb, 0x17, 0x8b, 0xc0, 0xa2,
-	0x98, 0xf4, 0xd6, 0xcb, 0xc6, 0xf8, 0x7f, 0xde, 0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x74, 0x59,
-	0xa4, 0xba, 0x60, 0x03, 0x00, 0x00,
+	// 968 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0xc6, 0x71, 0x7f, 0xe2, 0xe3, 0x24, 0xf5, 0xce, 0x56, 0xac, 0x29, 0x20, 0xa5, 0x16, 0x68,
+	0x53, 0x2e, 0x2a, 0x94, 0x45, 0x42, 0xdc, 0x2c, 0x6a, 0xb3, 0xdd, 0xdd, 0x0a, 0x36, 0x85, 0x49,
+	0x2a, 0x71, 0x67, 0x39, 0xf6, 0x24, 0xb1, 0x48, 0x67, 0xb2, 0xe3, 0x71, 0xe5, 0xbe, 0x03, 0x12,
+	0xd7, 0x3c, 0x03, 0x8f, 0xc2, 0x73, 0xf0, 0x1e, 0x68, 0xce, 0x4c, 0xdc, 0x64, 0xd5, 0xb0, 0x5c,
+	0x70, 0xb9, 0x77, 0x73, 0x7e, 0xe7, 0x3b, 0xdf, 0x39, 0xc7, 0x1e, 0xf0, 0xd9, 0x2d, 0xe3, 0xea,
+	0x74, 0x29, 0x85, 0x12, 0xa4, 0xb1, 0x9c, 0x1c, 0xb5, 0xaa, 0x74, 0x9e, 0xe4, 0xdc, 0x68, 0xa2,
+	0x9f, 0x21, 0x18, 0x95, 0x93, 0x22, 0x95, 0xf9, 0x84, 0x51, 0xf6, 0xb6, 0x64, 0x85, 0x22, 0x5f,
+	0xc2, 0x8e, 0xba, 0x5b, 0xb2, 0xd0, 0xe9, 0x3a, 0xbd, 0x4e, 0xff, 0xd1, 0xe9, 0x72, 0x72, 0x5a,
+	0xfb, 0x8c, 0xef, 0x96, 0x8c, 0xa2, 0x99, 0x7c, 0x0c, 0x7b, 0xd3, 0x7c, 0xa1, 0x98, 0x0c, 0x1b,
+	0x5d, 0xa7, 0xd7, 0xa2, 0x56, 0x8a, 0x8e, 0x61, 0xf7, 0x42, 0xdf, 0x49, 0x42, 0xd8, 0x5f, 0x26,
+	0x77, 0x0b, 0x91, 0x64, 0x98, 0xaa, 0x45, 0x57, 0x62, 0xf4, 0x0d, 0xc0, 0xf9, 0x42, 0xa4, 0xbf,
+	0xd2, 0x84, 0xcf, 0x18, 0x39, 0x84, 0xdd, 0x42, 0x25, 0x52, 0xa1, 0x97, 0x47, 0x8d, 0x40, 0x02,
+	0x70, 0x19, 0xcf, 0x30, 0xb7, 0x47, 0xf5, 0x31, 0x3a, 0x07, 0x1f, 0xa3, 0x06, 0xa5, 0x2c, 0x84,
+	0xd4, 0xe9, 0x27, 0x5a, 0xcc, 0x33, 0x1b, 0xb8, 0x12, 0xc9, 0x27, 0xd0, 0x54, 0x55, 0x9c, 0xf3,
+	0x8c, 0x55, 0x18, 0xef, 0xd2, 0x7d, 0x55, 0x5d, 0x6a, 0x31, 0xfa, 0xcb, 0xb5, 0x49, 0x5e, 0x22,
+	0x58, 0x5d, 0xc4, 0x24, 0xe5, 0xc9, 0x0d, 0xb3, 0x39, 0xac, 0x44, 0xbe, 0x80, 0x5d, 0xa9, 0xc1,
+	0x61, 0xbc, 0xdf, 0xef, 0x68, 0x12, 0xee, 0x21, 0x53, 0x63, 0x24, 0x9f, 0x03, 0xb0, 0x2a, 0x5d,
+	0x94, 0x19, 0x8b, 0x55, 0x15, 0xba, 0x5d, 0xa7, 0xd7, 0xa4, 0x9e, 0xd5, 0x8c, 0x2b, 0xd2, 0x83,
+	0xe0, 0xde, 0x1c, 0x63, 0x23, 0xc2, 0x1d, 0x74, 0xea, 0xd4, 0x4e, 0x86, 0xaa, 0xa7, 0xb0, 0x97,
+	0x62, 0x55, 0xe1, 0x2e, 0xde, 0x77, 0x50, 0xdf, 0x67, 0x8a, 0xa5, 0xd6, 0x4c, 0x8e, 0xa0, 0x99,
+	0x0a, 0xae, 0x64, 0x92, 0xaa, 0x10, 0x10, 0x71, 0x2d, 0x23, 0x1a, 0x9d, 0x2d, 0xc6, 0x7a, 0x7c,
+	0xb4, 0x7a, 0xa8, 0x19, 0xea, 0x92, 0x3e, 0x03, 0x2f, 0xe7, 0xb9, 0xca, 0x13, 0x25, 0x64, 0xd8,
+	0x32, 0xd6, 0x5a, 0x41, 0x8e, 0xa1, 0x95, 0x94, 0x6a, 0x1e, 0x4b, 0xf6, 0xb6, 0xcc, 0x25, 0x0b,
+	0xdb, 0xe8, 0xe0, 0x6b, 0x1d, 0x35, 0x2a, 0xf2, 0x29, 0x78, 0x53, 0x29, 0x6e, 0xe2, 0x24, 0xcb,
+	0x64, 0xd8, 0x31, 0x97, 0x6b, 0xc5, 0x59, 0x96, 0x49, 0xf2, 0x04, 0xf6, 0x95, 0x30, 0xa6, 0x03,
+	0xc3, 0xa4, 0x12, 0x68, 0xf8, 0x16, 0x5a, 0x06, 0x95, 0x12, 0xcb, 0x3c, 0x2d, 0xc2, 0xa0, 0xeb,
+	0xf6, 0xfc, 0xfe, 0xa1, 0x2e, 0x10, 0x6b, 0x1f, 0x6b, 0xb5, 0xe9, 0x06, 0xf5, 0x59, 0xad, 0x29,
+	0x34, 0xa2, 0x8c, 0xa5, 0x22, 0x63, 0x96, 0xb9, 0x47, 0xc8, 0x9c, 0x6f, 0x74, 0x18, 0x1a, 0x3d,
+	0x87, 0xe0, 0xdd, 0x1c, 0x84, 0xc0, 0xce, 0x5a, 0x3f, 0xf1, 0xac, 0xbb, 0x7c, 0x9b, 0x2c, 0x4a,
+	0x56, 0x84, 0x8d, 0xae, 0xab, 0xb1, 0x19, 0x29, 0x9a, 0x40, 0x13, 0xe3, 0xcf, 0xe4, 0xec, 0xc1,
+	0x38, 0x62, 0x37, 0xc1, 0x0c, 0x21, 0x9e, 0xf5, 0xd8, 0xe1, 0x64, 0xb1, 0xcc, 0x36, 0x7c, 0x25,
+	0xea, 0x39, 0xc6, 0xbc, 0xd8, 0x63, 0x8f, 0x1a, 0x21, 0x9a, 0xc3, 0xe1, 0x0b, 0x84, 0x9c, 0x0d,
+	0x6c, 0xa3, 0x4c, 0xcb, 0xd7, 0x3b, 0xe9, 0xbc, 0xd3, 0xc9, 0x15, 0x96, 0xc6, 0x1a, 0x96, 0x2e,
+	0xec, 0x24, 0x72, 0x56, 0x84, 0x2e, 0xf2, 0xd7, 0xaa, 0xf9, 0x3b, 0x93, 0x33, 0x8a, 0x96, 0xe8,
+	0x4f, 0x07, 0x1e, 0x1b, 0x12, 0x58, 0x36, 0x96, 0x09, 0x2f, 0x92, 0x54, 0xe5, 0x82, 0x63, 0x15,
+	0x55, 0xbd, 0x25, 0x78, 0x26, 0x27, 0xb0, 0x87, 0xac, 0x1a, 0x46, 0x7c, 0xb3, 0xe5, 0x1b, 0x00,
+	0xa9, 0x75, 0xd0, 0x65, 0x99, 0x55, 0x72, 0x71, 0x95, 0x8c, 0x40, 0xbe, 0x87, 0x8e, 0xe9, 0x44,
+	0x16, 0xdb, 0x44, 0x3b, 0x98, 0x28, 0xd4, 0x89, 0x1e, 0x2a, 0x98, 0xb6, 0xad, 0x3f, 0x4a, 0x45,
+	0xf4, 0xbb, 0x03, 0xed, 0x15, 0x5a, 0x9c, 0xf4, 0xad, 0xbb, 0xb8, 0xb6, 0xe8, 0x8d, 0xcd, 0x45,
+	0x3f, 0x86, 0x16, 0x1e, 0xe3, 0x39, 0xcb, 0x67, 0x73, 0x65, 0x11, 0xfa, 0xa8, 0x7b, 0x8d, 0x2a,
+	0x72, 0x02, 0xae, 0xaa, 0x56, 0xe0, 0x9e, 0x68, 0x70, 0x0f, 0x50, 0x44, 0xb5, 0x4f, 0xf4, 0x77,
+	0x03, 0x1e, 0x5f, 0xf3, 0x54, 0xf0, 0x69, 0x2e, 0x6f, 0x58, 0x36, 0xae, 0xde, 0xf3, 0x8d, 0xf8,
+	0xef, 0xeb, 0xfd, 0x61, 0x6b, 0xd7, 0xb7, 0xf6, 0x27, 0x68, 0x6f, 0xd0, 0xbc, 0x95, 0xe0, 0xa7,
+	0xd0, 0x50, 0x95, 0xfd, 0x02, 0x6f, 0x6d, 0x5d, 0x43, 0x55, 0x51, 0x01, 0xc1, 0x40, 0xff, 0xd4,
+	0x28, 0x13, 0x72, 0xf6, 0x9e, 0xae, 0xfd, 0x5f, 0xdf, 0xec, 0xe8, 0x37, 0x07, 0xe0, 0xfe, 0xd6,
+	0xad, 0xf7, 0xf5, 0xc1, 0x2f, 0x79, 0x26, 0x62, 0x1c, 0xca, 0x8d, 0x75, 0xdb, 0x98, 0x7e, 0x0a,
+	0xda, 0x0b, 0x8f, 0x85, 0x8e, 0x91, 0xec, 0x3e, 0xc6, 0xdd, 0x1a, 0xa3, 0xbd, 0x4c, 0x4c, 0x74,
+	0x02, 0x07, 0x03, 0xc1, 0x0b, 0xc6, 0x8b, 0xb2, 0xf8, 0x77, 0x0a, 0xa2, 0x57, 0xd0, 0xa9, 0x5d,
+	0xdf, 0xe4, 0x9c, 0xe1, 0xbf, 0x54, 0x0f, 0x01, 0x2b, 0x8a, 0xd5, 0xbf, 0xd4, 0x8a, 0x7a, 0x7c,
+	0x96, 0x8c, 0xc9, 0x38, 0xe7, 0x53, 0x61, 0xd7, 0xaf, 0xa9, 0x15, 0x97, 0x7c, 0x2a, 0xa2, 0x3f,
+	0x9c, 0xb5, 0x4b, 0x07, 0x73, 0xfc, 0x27, 0x6e, 0xe3, 0xe1, 0x3b, 0xe8, 0xa4, 0xa5, 0x94, 0x7a,
+	0xa6, 0x6e, 0xf4, 0x9d, 0x2b, 0x2a, 0x88, 0xfd, 0xf2, 0xac, 0xc1, 0xa1, 0x6d, 0xeb, 0x89, 0x52,
+	0x41, 0x9e, 0x81, 0xcf, 0x59, 0x55, 0xc7, 0xb9, 0x5b, 0xe3, 0x40, 0xbb, 0x99, 0xa0, 0xaf, 0x86,
+	0xd0, 0xde, 0x78, 0xb5, 0x10, 0x0f, 0x76, 0xcf, 0x7f, 0xbc, 0x1a, 0xfc, 0x10, 0x7c, 0x44, 0x08,
+	0x74, 0xae, 0x87, 0x83, 0xab, 0xe1, 0xcb, 0x4b, 0xfa, 0xe6, 0xe2, 0x45, 0x3c, 0xfe, 0x25, 0x70,
+	0xc8, 0x01, 0xf8, 0x83, 0xd7, 0x67, 0x97, 0xc3, 0x98, 0x5e, 0x5c, 0xd1, 0x57, 0x41, 0x83, 0xb4,
+	0xc1, 0x1b, 0x5c, 0x0d, 0x47, 0x17, 0xc3, 0xd1, 0xf5, 0x28, 0x70, 0xfb, 0xcf, 0xa1, 0x85, 0x7d,
+	0x1f, 0x31, 0x79, 0x9b, 0xa7, 0x8c, 0x9c, 0x82, 0x57, 0xe7, 0x27, 0x87, 0x1b, 0x8f, 0x24, 0xfb,
+	0x90, 0x3a, 0xf2, 0xea, 0x75, 0xf9, 0xda, 0x99, 0xec, 0xe1, 0x83, 0xeb, 0xd9, 0x3f, 0x03, 0x00,
+	0xdd, 0x79, 0xf5, 0x43, 0x91, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
-var _ grpc.ClientConn
+var _ grpc.ClientConnInterface
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+const _ = grpc.SupportPackageIsVersion6
 
 // EventServiceClient is the client API for EventService service.
 //
@@ -465,10 +1197,10 @@ type EventServiceClient interface {
 }
 
 type eventServiceClient struct {
-	cc *grpc.ClientConn
+	cc grpc.ClientConnInterface
 }
 
-func NewEventServiceClient(cc *grpc.ClientConn) EventServiceClient {
+func NewEventServiceClient(cc grpc.ClientConnInterface) EventServiceClient {
 	return &eventServiceClient{cc}
 }
 
@@ -20,4 +20,6 @@ const (
 	ExtUtxoDelTablePrefix    = "ZD"
 	BlockHeightPrefix        = "ZH"
 	BranchInfoPrefix         = "ZI"
+	StateTreePrefix          = "ZS"
+	ConsSlashPrefix          = "ZL"
 )
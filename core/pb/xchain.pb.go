@@ -235,6 +235,7 @@ const (
 	PermissionRule_SIGN_SUM       PermissionRule = 4
 	PermissionRule_CA_SERVER      PermissionRule = 5
 	PermissionRule_COMMUNITY_VOTE PermissionRule = 6
+	PermissionRule_SIGN_TIMELOCK  PermissionRule = 7
 )
 
 var PermissionRule_name = map[int32]string{
@@ -245,6 +246,7 @@ var PermissionRule_name = map[int32]string{
 	4: "SIGN_SUM",
 	5: "CA_SERVER",
 	6: "COMMUNITY_VOTE",
+	7: "SIGN_TIMELOCK",
 }
 
 var PermissionRule_value = map[string]int32{
@@ -255,6 +257,7 @@ var PermissionRule_value = map[string]int32{
 	"SIGN_SUM":       4,
 	"CA_SERVER":      5,
 	"COMMUNITY_VOTE": 6,
+	"SIGN_TIMELOCK":  7,
 }
 
 func (x PermissionRule) String() string {
@@ -836,6 +839,385 @@ func (m *BlockID) GetNeedContent() bool {
 	return false
 }
 
+// BlockHeadersRequest get the trunk block headers in [height, height+size)
+type BlockHeadersRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Height               int64    `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Size                 int64    `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockHeadersRequest) Reset()         { *m = BlockHeadersRequest{} }
+func (m *BlockHeadersRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockHeadersRequest) ProtoMessage()    {}
+func (*BlockHeadersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{7}
+}
+
+func (m *BlockHeadersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockHeadersRequest.Unmarshal(m, b)
+}
+func (m *BlockHeadersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockHeadersRequest.Marshal(b, m, deterministic)
+}
+func (m *BlockHeadersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockHeadersRequest.Merge(m, src)
+}
+func (m *BlockHeadersRequest) XXX_Size() int {
+	return xxx_messageInfo_BlockHeadersRequest.Size(m)
+}
+func (m *BlockHeadersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockHeadersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockHeadersRequest proto.InternalMessageInfo
+
+func (m *BlockHeadersRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BlockHeadersRequest) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *BlockHeadersRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockHeadersRequest) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type BlockHeadersResponse struct {
+	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname string  `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	// block headers without transactions, in ascending order of height
+	Blocks               []*InternalBlock `protobuf:"bytes,3,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *BlockHeadersResponse) Reset()         { *m = BlockHeadersResponse{} }
+func (m *BlockHeadersResponse) String() string { return proto.CompactTextString(m) }
+func (*BlockHeadersResponse) ProtoMessage()    {}
+func (*BlockHeadersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{8}
+}
+
+func (m *BlockHeadersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockHeadersResponse.Unmarshal(m, b)
+}
+func (m *BlockHeadersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockHeadersResponse.Marshal(b, m, deterministic)
+}
+func (m *BlockHeadersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockHeadersResponse.Merge(m, src)
+}
+func (m *BlockHeadersResponse) XXX_Size() int {
+	return xxx_messageInfo_BlockHeadersResponse.Size(m)
+}
+func (m *BlockHeadersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockHeadersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockHeadersResponse proto.InternalMessageInfo
+
+func (m *BlockHeadersResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BlockHeadersResponse) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *BlockHeadersResponse) GetBlocks() []*InternalBlock {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+// CompactBlock is the block header with short txids, used in Compact_BroadCast_Mode
+type CompactBlock struct {
+	Header  *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname  string  `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Blockid []byte  `protobuf:"bytes,3,opt,name=blockid,proto3" json:"blockid,omitempty"`
+	// block without transactions and merkle tree
+	Block *InternalBlock `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`
+	// short txid of every tx in the block, in the order of the block
+	ShortTxids []uint64 `protobuf:"fixed64,5,rep,packed,name=short_txids,json=shortTxids,proto3" json:"short_txids,omitempty"`
+	// txs that receivers can't have in the unconfirmed pool, such as coinbase txs
+	PrefilledTxs         []*PrefilledTx `protobuf:"bytes,6,rep,name=prefilled_txs,json=prefilledTxs,proto3" json:"prefilled_txs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CompactBlock) Reset()         { *m = CompactBlock{} }
+func (m *CompactBlock) String() string { return proto.CompactTextString(m) }
+func (*CompactBlock) ProtoMessage()    {}
+func (*CompactBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{9}
+}
+
+func (m *CompactBlock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompactBlock.Unmarshal(m, b)
+}
+func (m *CompactBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompactBlock.Marshal(b, m, deterministic)
+}
+func (m *CompactBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactBlock.Merge(m, src)
+}
+func (m *CompactBlock) XXX_Size() int {
+	return xxx_messageInfo_CompactBlock.Size(m)
+}
+func (m *CompactBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactBlock proto.InternalMessageInfo
+
+func (m *CompactBlock) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *CompactBlock) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *CompactBlock) GetBlockid() []byte {
+	if m != nil {
+		return m.Blockid
+	}
+	return nil
+}
+
+func (m *CompactBlock) GetBlock() *InternalBlock {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *CompactBlock) GetShortTxids() []uint64 {
+	if m != nil {
+		return m.ShortTxids
+	}
+	return nil
+}
+
+func (m *CompactBlock) GetPrefilledTxs() []*PrefilledTx {
+	if m != nil {
+		return m.PrefilledTxs
+	}
+	return nil
+}
+
+type PrefilledTx struct {
+	// index of the tx in the block
+	Index                int32        `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Tx                   *Transaction `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *PrefilledTx) Reset()         { *m = PrefilledTx{} }
+func (m *PrefilledTx) String() string { return proto.CompactTextString(m) }
+func (*PrefilledTx) ProtoMessage()    {}
+func (*PrefilledTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{10}
+}
+
+func (m *PrefilledTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrefilledTx.Unmarshal(m, b)
+}
+func (m *PrefilledTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrefilledTx.Marshal(b, m, deterministic)
+}
+func (m *PrefilledTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrefilledTx.Merge(m, src)
+}
+func (m *PrefilledTx) XXX_Size() int {
+	return xxx_messageInfo_PrefilledTx.Size(m)
+}
+func (m *PrefilledTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrefilledTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrefilledTx proto.InternalMessageInfo
+
+func (m *PrefilledTx) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *PrefilledTx) GetTx() *Transaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+// BlockTxsRequest get the txs of block by indexes
+type BlockTxsRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Blockid              []byte   `protobuf:"bytes,3,opt,name=blockid,proto3" json:"blockid,omitempty"`
+	Indexes              []int32  `protobuf:"varint,4,rep,packed,name=indexes,proto3" json:"indexes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockTxsRequest) Reset()         { *m = BlockTxsRequest{} }
+func (m *BlockTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockTxsRequest) ProtoMessage()    {}
+func (*BlockTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{11}
+}
+
+func (m *BlockTxsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockTxsRequest.Unmarshal(m, b)
+}
+func (m *BlockTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockTxsRequest.Marshal(b, m, deterministic)
+}
+func (m *BlockTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockTxsRequest.Merge(m, src)
+}
+func (m *BlockTxsRequest) XXX_Size() int {
+	return xxx_messageInfo_BlockTxsRequest.Size(m)
+}
+func (m *BlockTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockTxsRequest proto.InternalMessageInfo
+
+func (m *BlockTxsRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BlockTxsRequest) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *BlockTxsRequest) GetBlockid() []byte {
+	if m != nil {
+		return m.Blockid
+	}
+	return nil
+}
+
+func (m *BlockTxsRequest) GetIndexes() []int32 {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+type BlockTxsResponse struct {
+	Header  *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname  string  `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Blockid []byte  `protobuf:"bytes,3,opt,name=blockid,proto3" json:"blockid,omitempty"`
+	// txs in the same order as the indexes in request
+	Txs                  []*Transaction `protobuf:"bytes,4,rep,name=txs,proto3" json:"txs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *BlockTxsResponse) Reset()         { *m = BlockTxsResponse{} }
+func (m *BlockTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*BlockTxsResponse) ProtoMessage()    {}
+func (*BlockTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{12}
+}
+
+func (m *BlockTxsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockTxsResponse.Unmarshal(m, b)
+}
+func (m *BlockTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockTxsResponse.Marshal(b, m, deterministic)
+}
+func (m *BlockTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockTxsResponse.Merge(m, src)
+}
+func (m *BlockTxsResponse) XXX_Size() int {
+	return xxx_messageInfo_BlockTxsResponse.Size(m)
+}
+func (m *BlockTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockTxsResponse proto.InternalMessageInfo
+
+func (m *BlockTxsResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BlockTxsResponse) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *BlockTxsResponse) GetBlockid() []byte {
+	if m != nil {
+		return m.Blockid
+	}
+	return nil
+}
+
+func (m *BlockTxsResponse) GetTxs() []*Transaction {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
 type BlockHeight struct {
 	Header               *Header  `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
 	Bcname               string   `protobuf:"bytes,1,opt,name=bcname,proto3" json:"bcname,omitempty"`
@@ -849,7 +1231,7 @@ func (m *BlockHeight) Reset()         { *m = BlockHeight{} }
 func (m *BlockHeight) String() string { return proto.CompactTextString(m) }
 func (*BlockHeight) ProtoMessage()    {}
 func (*BlockHeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{7}
+	return fileDescriptor_db0991b9525664ca, []int{13}
 }
 
 func (m *BlockHeight) XXX_Unmarshal(b []byte) error {
@@ -902,7 +1284,7 @@ func (m *CommonReply) Reset()         { *m = CommonReply{} }
 func (m *CommonReply) String() string { return proto.CompactTextString(m) }
 func (*CommonReply) ProtoMessage()    {}
 func (*CommonReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{8}
+	return fileDescriptor_db0991b9525664ca, []int{14}
 }
 
 func (m *CommonReply) XXX_Unmarshal(b []byte) error {
@@ -917,15 +1299,756 @@ func (m *CommonReply) XXX_Merge(src proto.Message) {
 func (m *CommonReply) XXX_Size() int {
 	return xxx_messageInfo_CommonReply.Size(m)
 }
-func (m *CommonReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_CommonReply.DiscardUnknown(m)
+func (m *CommonReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommonReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommonReply proto.InternalMessageInfo
+
+func (m *CommonReply) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type TxProofRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Txid                 []byte   `protobuf:"bytes,3,opt,name=txid,proto3" json:"txid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxProofRequest) Reset()         { *m = TxProofRequest{} }
+func (m *TxProofRequest) String() string { return proto.CompactTextString(m) }
+func (*TxProofRequest) ProtoMessage()    {}
+func (*TxProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{15}
+}
+
+func (m *TxProofRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxProofRequest.Unmarshal(m, b)
+}
+func (m *TxProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxProofRequest.Marshal(b, m, deterministic)
+}
+func (m *TxProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxProofRequest.Merge(m, src)
+}
+func (m *TxProofRequest) XXX_Size() int {
+	return xxx_messageInfo_TxProofRequest.Size(m)
+}
+func (m *TxProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxProofRequest proto.InternalMessageInfo
+
+func (m *TxProofRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *TxProofRequest) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *TxProofRequest) GetTxid() []byte {
+	if m != nil {
+		return m.Txid
+	}
+	return nil
+}
+
+// TxProof proves a tx is included in a block
+type TxProof struct {
+	// block header without transactions and merkle tree
+	Block *InternalBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	Tx    *Transaction   `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx,omitempty"`
+	// index of tx in the block
+	Index int32 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	// siblings from leaf to root, an empty sibling means the node is hashed with
+	// itself
+	MerklePath           [][]byte `protobuf:"bytes,4,rep,name=merkle_path,json=merklePath,proto3" json:"merkle_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxProof) Reset()         { *m = TxProof{} }
+func (m *TxProof) String() string { return proto.CompactTextString(m) }
+func (*TxProof) ProtoMessage()    {}
+func (*TxProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{16}
+}
+
+func (m *TxProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxProof.Unmarshal(m, b)
+}
+func (m *TxProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxProof.Marshal(b, m, deterministic)
+}
+func (m *TxProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxProof.Merge(m, src)
+}
+func (m *TxProof) XXX_Size() int {
+	return xxx_messageInfo_TxProof.Size(m)
+}
+func (m *TxProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxProof proto.InternalMessageInfo
+
+func (m *TxProof) GetBlock() *InternalBlock {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *TxProof) GetTx() *Transaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *TxProof) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *TxProof) GetMerklePath() [][]byte {
+	if m != nil {
+		return m.MerklePath
+	}
+	return nil
+}
+
+type TxProofResponse struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Proof                *TxProof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxProofResponse) Reset()         { *m = TxProofResponse{} }
+func (m *TxProofResponse) String() string { return proto.CompactTextString(m) }
+func (*TxProofResponse) ProtoMessage()    {}
+func (*TxProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{17}
+}
+
+func (m *TxProofResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxProofResponse.Unmarshal(m, b)
+}
+func (m *TxProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxProofResponse.Marshal(b, m, deterministic)
+}
+func (m *TxProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxProofResponse.Merge(m, src)
+}
+func (m *TxProofResponse) XXX_Size() int {
+	return xxx_messageInfo_TxProofResponse.Size(m)
+}
+func (m *TxProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxProofResponse proto.InternalMessageInfo
+
+func (m *TxProofResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *TxProofResponse) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *TxProofResponse) GetProof() *TxProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type StateProofRequest struct {
+	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname string  `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Bucket string  `protobuf:"bytes,3,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key    []byte  `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	// height of the trunk block, the latest block if height < 0
+	Height               int64    `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateProofRequest) Reset()         { *m = StateProofRequest{} }
+func (m *StateProofRequest) String() string { return proto.CompactTextString(m) }
+func (*StateProofRequest) ProtoMessage()    {}
+func (*StateProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{18}
+}
+
+func (m *StateProofRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateProofRequest.Unmarshal(m, b)
+}
+func (m *StateProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateProofRequest.Marshal(b, m, deterministic)
+}
+func (m *StateProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateProofRequest.Merge(m, src)
+}
+func (m *StateProofRequest) XXX_Size() int {
+	return xxx_messageInfo_StateProofRequest.Size(m)
+}
+func (m *StateProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateProofRequest proto.InternalMessageInfo
+
+func (m *StateProofRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *StateProofRequest) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *StateProofRequest) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *StateProofRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *StateProofRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// StateProof proves the value of a xmodel key, or that the key does not exist,
+// in the state tree of a block
+type StateProof struct {
+	// block header without transactions and merkle tree
+	Block  *InternalBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	Bucket string         `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key    []byte         `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Exist  bool           `protobuf:"varint,4,opt,name=exist,proto3" json:"exist,omitempty"`
+	Value  []byte         `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	// version is txid_offset of the tx writing the value
+	Version string `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
+	// siblings from root to leaf
+	Siblings [][]byte `protobuf:"bytes,7,rep,name=siblings,proto3" json:"siblings,omitempty"`
+	// leaf node at the end of the path, it belongs to another key or is empty
+	// if the key does not exist
+	Leaf                 []byte   `protobuf:"bytes,8,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateProof) Reset()         { *m = StateProof{} }
+func (m *StateProof) String() string { return proto.CompactTextString(m) }
+func (*StateProof) ProtoMessage()    {}
+func (*StateProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{19}
+}
+
+func (m *StateProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateProof.Unmarshal(m, b)
+}
+func (m *StateProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateProof.Marshal(b, m, deterministic)
+}
+func (m *StateProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateProof.Merge(m, src)
+}
+func (m *StateProof) XXX_Size() int {
+	return xxx_messageInfo_StateProof.Size(m)
+}
+func (m *StateProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateProof proto.InternalMessageInfo
+
+func (m *StateProof) GetBlock() *InternalBlock {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *StateProof) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *StateProof) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *StateProof) GetExist() bool {
+	if m != nil {
+		return m.Exist
+	}
+	return false
+}
+
+func (m *StateProof) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *StateProof) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *StateProof) GetSiblings() [][]byte {
+	if m != nil {
+		return m.Siblings
+	}
+	return nil
+}
+
+func (m *StateProof) GetLeaf() []byte {
+	if m != nil {
+		return m.Leaf
+	}
+	return nil
+}
+
+type StateProofResponse struct {
+	Header               *Header     `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string      `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Proof                *StateProof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *StateProofResponse) Reset()         { *m = StateProofResponse{} }
+func (m *StateProofResponse) String() string { return proto.CompactTextString(m) }
+func (*StateProofResponse) ProtoMessage()    {}
+func (*StateProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{20}
+}
+
+func (m *StateProofResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateProofResponse.Unmarshal(m, b)
+}
+func (m *StateProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateProofResponse.Marshal(b, m, deterministic)
+}
+func (m *StateProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateProofResponse.Merge(m, src)
+}
+func (m *StateProofResponse) XXX_Size() int {
+	return xxx_messageInfo_StateProofResponse.Size(m)
+}
+func (m *StateProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateProofResponse proto.InternalMessageInfo
+
+func (m *StateProofResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *StateProofResponse) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *StateProofResponse) GetProof() *StateProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type TraceTxRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Txid                 []byte   `protobuf:"bytes,3,opt,name=txid,proto3" json:"txid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TraceTxRequest) Reset()         { *m = TraceTxRequest{} }
+func (m *TraceTxRequest) String() string { return proto.CompactTextString(m) }
+func (*TraceTxRequest) ProtoMessage()    {}
+func (*TraceTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{21}
+}
+
+func (m *TraceTxRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TraceTxRequest.Unmarshal(m, b)
+}
+func (m *TraceTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TraceTxRequest.Marshal(b, m, deterministic)
+}
+func (m *TraceTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraceTxRequest.Merge(m, src)
+}
+func (m *TraceTxRequest) XXX_Size() int {
+	return xxx_messageInfo_TraceTxRequest.Size(m)
+}
+func (m *TraceTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraceTxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraceTxRequest proto.InternalMessageInfo
+
+func (m *TraceTxRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *TraceTxRequest) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *TraceTxRequest) GetTxid() []byte {
+	if m != nil {
+		return m.Txid
+	}
+	return nil
+}
+
+type TraceResponse struct {
+	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname string  `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	// trace is the json encoded call tree with syscalls, resource usage and
+	// read/write sets
+	Trace                string   `protobuf:"bytes,3,opt,name=trace,proto3" json:"trace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TraceResponse) Reset()         { *m = TraceResponse{} }
+func (m *TraceResponse) String() string { return proto.CompactTextString(m) }
+func (*TraceResponse) ProtoMessage()    {}
+func (*TraceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{22}
+}
+
+func (m *TraceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TraceResponse.Unmarshal(m, b)
+}
+func (m *TraceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TraceResponse.Marshal(b, m, deterministic)
+}
+func (m *TraceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraceResponse.Merge(m, src)
+}
+func (m *TraceResponse) XXX_Size() int {
+	return xxx_messageInfo_TraceResponse.Size(m)
+}
+func (m *TraceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraceResponse proto.InternalMessageInfo
+
+func (m *TraceResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *TraceResponse) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *TraceResponse) GetTrace() string {
+	if m != nil {
+		return m.Trace
+	}
+	return ""
+}
+
+type UtxoProofRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Address              string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UtxoProofRequest) Reset()         { *m = UtxoProofRequest{} }
+func (m *UtxoProofRequest) String() string { return proto.CompactTextString(m) }
+func (*UtxoProofRequest) ProtoMessage()    {}
+func (*UtxoProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{23}
+}
+
+func (m *UtxoProofRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UtxoProofRequest.Unmarshal(m, b)
+}
+func (m *UtxoProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UtxoProofRequest.Marshal(b, m, deterministic)
+}
+func (m *UtxoProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UtxoProofRequest.Merge(m, src)
+}
+func (m *UtxoProofRequest) XXX_Size() int {
+	return xxx_messageInfo_UtxoProofRequest.Size(m)
+}
+func (m *UtxoProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UtxoProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UtxoProofRequest proto.InternalMessageInfo
+
+func (m *UtxoProofRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *UtxoProofRequest) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *UtxoProofRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// UtxoProof proves an utxo exists in the state tree of a block
+type UtxoProof struct {
+	RefTxid      []byte `protobuf:"bytes,1,opt,name=ref_txid,json=refTxid,proto3" json:"ref_txid,omitempty"`
+	RefOffset    int32  `protobuf:"varint,2,opt,name=ref_offset,json=refOffset,proto3" json:"ref_offset,omitempty"`
+	Amount       []byte `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	FrozenHeight int64  `protobuf:"varint,4,opt,name=frozen_height,json=frozenHeight,proto3" json:"frozen_height,omitempty"`
+	// siblings from root to leaf
+	Siblings             [][]byte `protobuf:"bytes,5,rep,name=siblings,proto3" json:"siblings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UtxoProof) Reset()         { *m = UtxoProof{} }
+func (m *UtxoProof) String() string { return proto.CompactTextString(m) }
+func (*UtxoProof) ProtoMessage()    {}
+func (*UtxoProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{24}
+}
+
+func (m *UtxoProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UtxoProof.Unmarshal(m, b)
+}
+func (m *UtxoProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UtxoProof.Marshal(b, m, deterministic)
+}
+func (m *UtxoProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UtxoProof.Merge(m, src)
+}
+func (m *UtxoProof) XXX_Size() int {
+	return xxx_messageInfo_UtxoProof.Size(m)
+}
+func (m *UtxoProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_UtxoProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UtxoProof proto.InternalMessageInfo
+
+func (m *UtxoProof) GetRefTxid() []byte {
+	if m != nil {
+		return m.RefTxid
+	}
+	return nil
+}
+
+func (m *UtxoProof) GetRefOffset() int32 {
+	if m != nil {
+		return m.RefOffset
+	}
+	return 0
+}
+
+func (m *UtxoProof) GetAmount() []byte {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *UtxoProof) GetFrozenHeight() int64 {
+	if m != nil {
+		return m.FrozenHeight
+	}
+	return 0
+}
+
+func (m *UtxoProof) GetSiblings() [][]byte {
+	if m != nil {
+		return m.Siblings
+	}
+	return nil
+}
+
+type UtxoProofResponse struct {
+	Header  *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname  string  `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Address string  `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// block header without transactions and merkle tree, the proofs are made
+	// against its state root
+	Block *InternalBlock `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`
+	// all the utxos of address, siblings of each proof are not set
+	Proofs []*UtxoProof `protobuf:"bytes,5,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	// siblings from root along the utxo prefix of address, which proves that
+	// proofs contain all the utxos of address
+	Siblings [][]byte `protobuf:"bytes,6,rep,name=siblings,proto3" json:"siblings,omitempty"`
+	// leaf at the end of the path, only set if address has no utxo and the
+	// path ends at the leaf of other key
+	Leaf                 []byte   `protobuf:"bytes,7,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UtxoProofResponse) Reset()         { *m = UtxoProofResponse{} }
+func (m *UtxoProofResponse) String() string { return proto.CompactTextString(m) }
+func (*UtxoProofResponse) ProtoMessage()    {}
+func (*UtxoProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{25}
+}
+
+func (m *UtxoProofResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UtxoProofResponse.Unmarshal(m, b)
+}
+func (m *UtxoProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UtxoProofResponse.Marshal(b, m, deterministic)
+}
+func (m *UtxoProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UtxoProofResponse.Merge(m, src)
+}
+func (m *UtxoProofResponse) XXX_Size() int {
+	return xxx_messageInfo_UtxoProofResponse.Size(m)
+}
+func (m *UtxoProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UtxoProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UtxoProofResponse proto.InternalMessageInfo
+
+func (m *UtxoProofResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *UtxoProofResponse) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *UtxoProofResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *UtxoProofResponse) GetBlock() *InternalBlock {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *UtxoProofResponse) GetProofs() []*UtxoProof {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
 }
 
-var xxx_messageInfo_CommonReply proto.InternalMessageInfo
+func (m *UtxoProofResponse) GetSiblings() [][]byte {
+	if m != nil {
+		return m.Siblings
+	}
+	return nil
+}
 
-func (m *CommonReply) GetHeader() *Header {
+func (m *UtxoProofResponse) GetLeaf() []byte {
 	if m != nil {
-		return m.Header
+		return m.Leaf
 	}
 	return nil
 }
@@ -942,7 +2065,7 @@ func (m *CommonIn) Reset()         { *m = CommonIn{} }
 func (m *CommonIn) String() string { return proto.CompactTextString(m) }
 func (*CommonIn) ProtoMessage()    {}
 func (*CommonIn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{9}
+	return fileDescriptor_db0991b9525664ca, []int{26}
 }
 
 func (m *CommonIn) XXX_Unmarshal(b []byte) error {
@@ -990,7 +2113,7 @@ func (m *TokenDetail) Reset()         { *m = TokenDetail{} }
 func (m *TokenDetail) String() string { return proto.CompactTextString(m) }
 func (*TokenDetail) ProtoMessage()    {}
 func (*TokenDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{10}
+	return fileDescriptor_db0991b9525664ca, []int{27}
 }
 
 func (m *TokenDetail) XXX_Unmarshal(b []byte) error {
@@ -1045,7 +2168,7 @@ func (m *AddressStatus) Reset()         { *m = AddressStatus{} }
 func (m *AddressStatus) String() string { return proto.CompactTextString(m) }
 func (*AddressStatus) ProtoMessage()    {}
 func (*AddressStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{11}
+	return fileDescriptor_db0991b9525664ca, []int{28}
 }
 
 func (m *AddressStatus) XXX_Unmarshal(b []byte) error {
@@ -1099,7 +2222,7 @@ func (m *TokenFrozenDetail) Reset()         { *m = TokenFrozenDetail{} }
 func (m *TokenFrozenDetail) String() string { return proto.CompactTextString(m) }
 func (*TokenFrozenDetail) ProtoMessage()    {}
 func (*TokenFrozenDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{12}
+	return fileDescriptor_db0991b9525664ca, []int{29}
 }
 
 func (m *TokenFrozenDetail) XXX_Unmarshal(b []byte) error {
@@ -1147,7 +2270,7 @@ func (m *TokenFrozenDetails) Reset()         { *m = TokenFrozenDetails{} }
 func (m *TokenFrozenDetails) String() string { return proto.CompactTextString(m) }
 func (*TokenFrozenDetails) ProtoMessage()    {}
 func (*TokenFrozenDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{13}
+	return fileDescriptor_db0991b9525664ca, []int{30}
 }
 
 func (m *TokenFrozenDetails) XXX_Unmarshal(b []byte) error {
@@ -1202,7 +2325,7 @@ func (m *AddressBalanceStatus) Reset()         { *m = AddressBalanceStatus{} }
 func (m *AddressBalanceStatus) String() string { return proto.CompactTextString(m) }
 func (*AddressBalanceStatus) ProtoMessage()    {}
 func (*AddressBalanceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{14}
+	return fileDescriptor_db0991b9525664ca, []int{31}
 }
 
 func (m *AddressBalanceStatus) XXX_Unmarshal(b []byte) error {
@@ -1265,7 +2388,7 @@ func (m *TxInput) Reset()         { *m = TxInput{} }
 func (m *TxInput) String() string { return proto.CompactTextString(m) }
 func (*TxInput) ProtoMessage()    {}
 func (*TxInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{15}
+	return fileDescriptor_db0991b9525664ca, []int{32}
 }
 
 func (m *TxInput) XXX_Unmarshal(b []byte) error {
@@ -1338,7 +2461,7 @@ func (m *TxOutput) Reset()         { *m = TxOutput{} }
 func (m *TxOutput) String() string { return proto.CompactTextString(m) }
 func (*TxOutput) ProtoMessage()    {}
 func (*TxOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{16}
+	return fileDescriptor_db0991b9525664ca, []int{33}
 }
 
 func (m *TxOutput) XXX_Unmarshal(b []byte) error {
@@ -1393,7 +2516,7 @@ func (m *XuperSignature) Reset()         { *m = XuperSignature{} }
 func (m *XuperSignature) String() string { return proto.CompactTextString(m) }
 func (*XuperSignature) ProtoMessage()    {}
 func (*XuperSignature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{17}
+	return fileDescriptor_db0991b9525664ca, []int{34}
 }
 
 func (m *XuperSignature) XXX_Unmarshal(b []byte) error {
@@ -1479,7 +2602,7 @@ func (m *Transaction) Reset()         { *m = Transaction{} }
 func (m *Transaction) String() string { return proto.CompactTextString(m) }
 func (*Transaction) ProtoMessage()    {}
 func (*Transaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{18}
+	return fileDescriptor_db0991b9525664ca, []int{35}
 }
 
 func (m *Transaction) XXX_Unmarshal(b []byte) error {
@@ -1664,7 +2787,7 @@ func (m *LedgerMeta) Reset()         { *m = LedgerMeta{} }
 func (m *LedgerMeta) String() string { return proto.CompactTextString(m) }
 func (*LedgerMeta) ProtoMessage()    {}
 func (*LedgerMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{19}
+	return fileDescriptor_db0991b9525664ca, []int{36}
 }
 
 func (m *LedgerMeta) XXX_Unmarshal(b []byte) error {
@@ -1730,7 +2853,7 @@ func (m *UtxoMeta) Reset()         { *m = UtxoMeta{} }
 func (m *UtxoMeta) String() string { return proto.CompactTextString(m) }
 func (*UtxoMeta) ProtoMessage()    {}
 func (*UtxoMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{20}
+	return fileDescriptor_db0991b9525664ca, []int{37}
 }
 
 func (m *UtxoMeta) XXX_Unmarshal(b []byte) error {
@@ -1847,6 +2970,7 @@ type GasPrice struct {
 	MemRate              int64    `protobuf:"varint,2,opt,name=mem_rate,json=memRate,proto3" json:"mem_rate,omitempty"`
 	DiskRate             int64    `protobuf:"varint,3,opt,name=disk_rate,json=diskRate,proto3" json:"disk_rate,omitempty"`
 	XfeeRate             int64    `protobuf:"varint,4,opt,name=xfee_rate,json=xfeeRate,proto3" json:"xfee_rate,omitempty"`
+	RentRate             int64    `protobuf:"varint,5,opt,name=rent_rate,json=rentRate,proto3" json:"rent_rate,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1856,7 +2980,7 @@ func (m *GasPrice) Reset()         { *m = GasPrice{} }
 func (m *GasPrice) String() string { return proto.CompactTextString(m) }
 func (*GasPrice) ProtoMessage()    {}
 func (*GasPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{21}
+	return fileDescriptor_db0991b9525664ca, []int{38}
 }
 
 func (m *GasPrice) XXX_Unmarshal(b []byte) error {
@@ -1905,6 +3029,13 @@ func (m *GasPrice) GetXfeeRate() int64 {
 	return 0
 }
 
+func (m *GasPrice) GetRentRate() int64 {
+	if m != nil {
+		return m.RentRate
+	}
+	return 0
+}
+
 // The internal block struct
 type InternalBlock struct {
 	// block version
@@ -1940,6 +3071,21 @@ type InternalBlock struct {
 	TargetBits  int32             `protobuf:"varint,19,opt,name=targetBits,proto3" json:"targetBits,omitempty"`
 	// Justify used in chained-bft
 	Justify *QuorumCert `protobuf:"bytes,20,opt,name=Justify,proto3" json:"Justify,omitempty"`
+	// state_root is the root of state tree over xmodel data and utxo set
+	// after executing the block, only set when enabled in genesis config
+	StateRoot []byte `protobuf:"bytes,21,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	// vrf_proof is the VRF proof of proposer over the randomness of pre block,
+	// only set when the vrf election mode of tdpos is enabled
+	VrfProof []byte `protobuf:"bytes,22,opt,name=vrf_proof,json=vrfProof,proto3" json:"vrf_proof,omitempty"`
+	// next_validators is the validators taking effect after this block in the
+	// json format defined by consensus, only set on the block changing the
+	// validators when state root is enabled, so that light nodes can follow the
+	// validators by block headers
+	NextValidators []byte `protobuf:"bytes,23,opt,name=next_validators,json=nextValidators,proto3" json:"next_validators,omitempty"`
+	// timeout_cert is the chained-bft timeout certificate which allows the proposer
+	// rotated from the timed out one to propose, the proposer is derived from the
+	// view of certificate and the pre block
+	TimeoutCert *TimeoutCert `protobuf:"bytes,24,opt,name=timeout_cert,json=timeoutCert,proto3" json:"timeout_cert,omitempty"`
 	// 下面的属性会动态变化
 	// If the block is on the trunk
 	InTrunk bool `protobuf:"varint,14,opt,name=in_trunk,json=inTrunk,proto3" json:"in_trunk,omitempty"`
@@ -1954,7 +3100,7 @@ func (m *InternalBlock) Reset()         { *m = InternalBlock{} }
 func (m *InternalBlock) String() string { return proto.CompactTextString(m) }
 func (*InternalBlock) ProtoMessage()    {}
 func (*InternalBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{22}
+	return fileDescriptor_db0991b9525664ca, []int{39}
 }
 
 func (m *InternalBlock) XXX_Unmarshal(b []byte) error {
@@ -2101,6 +3247,34 @@ func (m *InternalBlock) GetJustify() *QuorumCert {
 	return nil
 }
 
+func (m *InternalBlock) GetStateRoot() []byte {
+	if m != nil {
+		return m.StateRoot
+	}
+	return nil
+}
+
+func (m *InternalBlock) GetVrfProof() []byte {
+	if m != nil {
+		return m.VrfProof
+	}
+	return nil
+}
+
+func (m *InternalBlock) GetNextValidators() []byte {
+	if m != nil {
+		return m.NextValidators
+	}
+	return nil
+}
+
+func (m *InternalBlock) GetTimeoutCert() *TimeoutCert {
+	if m != nil {
+		return m.TimeoutCert
+	}
+	return nil
+}
+
 func (m *InternalBlock) GetInTrunk() bool {
 	if m != nil {
 		return m.InTrunk
@@ -2127,17 +3301,19 @@ type BCStatus struct {
 	// Utox information
 	UtxoMeta *UtxoMeta `protobuf:"bytes,5,opt,name=utxoMeta,proto3" json:"utxoMeta,omitempty"`
 	// Branch info
-	BranchBlockid        []string `protobuf:"bytes,6,rep,name=branchBlockid,proto3" json:"branchBlockid,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	BranchBlockid []string `protobuf:"bytes,6,rep,name=branchBlockid,proto3" json:"branchBlockid,omitempty"`
+	// progress of block sync
+	SyncStatus           *SyncStatus `protobuf:"bytes,7,opt,name=sync_status,json=syncStatus,proto3" json:"sync_status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *BCStatus) Reset()         { *m = BCStatus{} }
 func (m *BCStatus) String() string { return proto.CompactTextString(m) }
 func (*BCStatus) ProtoMessage()    {}
 func (*BCStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{23}
+	return fileDescriptor_db0991b9525664ca, []int{40}
 }
 
 func (m *BCStatus) XXX_Unmarshal(b []byte) error {
@@ -2200,6 +3376,87 @@ func (m *BCStatus) GetBranchBlockid() []string {
 	return nil
 }
 
+func (m *BCStatus) GetSyncStatus() *SyncStatus {
+	if m != nil {
+		return m.SyncStatus
+	}
+	return nil
+}
+
+// SyncStatus is the progress of pipelined block sync
+type SyncStatus struct {
+	Syncing     bool  `protobuf:"varint,1,opt,name=syncing,proto3" json:"syncing,omitempty"`
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// the highest confirmed height
+	CurrentHeight int64 `protobuf:"varint,3,opt,name=current_height,json=currentHeight,proto3" json:"current_height,omitempty"`
+	TargetHeight  int64 `protobuf:"varint,4,opt,name=target_height,json=targetHeight,proto3" json:"target_height,omitempty"`
+	// peers used to download blocks
+	Peers                []string `protobuf:"bytes,5,rep,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncStatus) Reset()         { *m = SyncStatus{} }
+func (m *SyncStatus) String() string { return proto.CompactTextString(m) }
+func (*SyncStatus) ProtoMessage()    {}
+func (*SyncStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{41}
+}
+
+func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncStatus.Unmarshal(m, b)
+}
+func (m *SyncStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncStatus.Marshal(b, m, deterministic)
+}
+func (m *SyncStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncStatus.Merge(m, src)
+}
+func (m *SyncStatus) XXX_Size() int {
+	return xxx_messageInfo_SyncStatus.Size(m)
+}
+func (m *SyncStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncStatus proto.InternalMessageInfo
+
+func (m *SyncStatus) GetSyncing() bool {
+	if m != nil {
+		return m.Syncing
+	}
+	return false
+}
+
+func (m *SyncStatus) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *SyncStatus) GetCurrentHeight() int64 {
+	if m != nil {
+		return m.CurrentHeight
+	}
+	return 0
+}
+
+func (m *SyncStatus) GetTargetHeight() int64 {
+	if m != nil {
+		return m.TargetHeight
+	}
+	return 0
+}
+
+func (m *SyncStatus) GetPeers() []string {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
 type BCTipStatus struct {
 	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	IsTrunkTip           bool     `protobuf:"varint,2,opt,name=is_trunk_tip,json=isTrunkTip,proto3" json:"is_trunk_tip,omitempty"`
@@ -2212,7 +3469,7 @@ func (m *BCTipStatus) Reset()         { *m = BCTipStatus{} }
 func (m *BCTipStatus) String() string { return proto.CompactTextString(m) }
 func (*BCTipStatus) ProtoMessage()    {}
 func (*BCTipStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{24}
+	return fileDescriptor_db0991b9525664ca, []int{42}
 }
 
 func (m *BCTipStatus) XXX_Unmarshal(b []byte) error {
@@ -2259,7 +3516,7 @@ func (m *BlockChains) Reset()         { *m = BlockChains{} }
 func (m *BlockChains) String() string { return proto.CompactTextString(m) }
 func (*BlockChains) ProtoMessage()    {}
 func (*BlockChains) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{25}
+	return fileDescriptor_db0991b9525664ca, []int{43}
 }
 
 func (m *BlockChains) XXX_Unmarshal(b []byte) error {
@@ -2306,7 +3563,7 @@ func (m *Speeds) Reset()         { *m = Speeds{} }
 func (m *Speeds) String() string { return proto.CompactTextString(m) }
 func (*Speeds) ProtoMessage()    {}
 func (*Speeds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{26}
+	return fileDescriptor_db0991b9525664ca, []int{44}
 }
 
 func (m *Speeds) XXX_Unmarshal(b []byte) error {
@@ -2352,7 +3609,7 @@ func (m *BCSpeeds) Reset()         { *m = BCSpeeds{} }
 func (m *BCSpeeds) String() string { return proto.CompactTextString(m) }
 func (*BCSpeeds) ProtoMessage()    {}
 func (*BCSpeeds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{27}
+	return fileDescriptor_db0991b9525664ca, []int{45}
 }
 
 func (m *BCSpeeds) XXX_Unmarshal(b []byte) error {
@@ -2394,7 +3651,7 @@ func (m *SystemsStatus) Reset()         { *m = SystemsStatus{} }
 func (m *SystemsStatus) String() string { return proto.CompactTextString(m) }
 func (*SystemsStatus) ProtoMessage()    {}
 func (*SystemsStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{28}
+	return fileDescriptor_db0991b9525664ca, []int{46}
 }
 
 func (m *SystemsStatus) XXX_Unmarshal(b []byte) error {
@@ -2455,7 +3712,7 @@ func (m *SystemsStatusReply) Reset()         { *m = SystemsStatusReply{} }
 func (m *SystemsStatusReply) String() string { return proto.CompactTextString(m) }
 func (*SystemsStatusReply) ProtoMessage()    {}
 func (*SystemsStatusReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{29}
+	return fileDescriptor_db0991b9525664ca, []int{47}
 }
 
 func (m *SystemsStatusReply) XXX_Unmarshal(b []byte) error {
@@ -2503,7 +3760,7 @@ func (m *RawUrl) Reset()         { *m = RawUrl{} }
 func (m *RawUrl) String() string { return proto.CompactTextString(m) }
 func (*RawUrl) ProtoMessage()    {}
 func (*RawUrl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{30}
+	return fileDescriptor_db0991b9525664ca, []int{48}
 }
 
 func (m *RawUrl) XXX_Unmarshal(b []byte) error {
@@ -2538,6 +3795,165 @@ func (m *RawUrl) GetRawUrl() string {
 	return ""
 }
 
+type BannedPeer struct {
+	PeerId string `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	// 导致封禁的最后一个事件
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// 封禁开始和结束的时间戳, 单位秒
+	BannedAt             int64    `protobuf:"varint,3,opt,name=banned_at,json=bannedAt,proto3" json:"banned_at,omitempty"`
+	ExpireAt             int64    `protobuf:"varint,4,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BannedPeer) Reset()         { *m = BannedPeer{} }
+func (m *BannedPeer) String() string { return proto.CompactTextString(m) }
+func (*BannedPeer) ProtoMessage()    {}
+func (*BannedPeer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{49}
+}
+
+func (m *BannedPeer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BannedPeer.Unmarshal(m, b)
+}
+func (m *BannedPeer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BannedPeer.Marshal(b, m, deterministic)
+}
+func (m *BannedPeer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BannedPeer.Merge(m, src)
+}
+func (m *BannedPeer) XXX_Size() int {
+	return xxx_messageInfo_BannedPeer.Size(m)
+}
+func (m *BannedPeer) XXX_DiscardUnknown() {
+	xxx_messageInfo_BannedPeer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BannedPeer proto.InternalMessageInfo
+
+func (m *BannedPeer) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
+func (m *BannedPeer) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *BannedPeer) GetBannedAt() int64 {
+	if m != nil {
+		return m.BannedAt
+	}
+	return 0
+}
+
+func (m *BannedPeer) GetExpireAt() int64 {
+	if m != nil {
+		return m.ExpireAt
+	}
+	return 0
+}
+
+type BannedPeersResponse struct {
+	Header               *Header       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Peers                []*BannedPeer `protobuf:"bytes,2,rep,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *BannedPeersResponse) Reset()         { *m = BannedPeersResponse{} }
+func (m *BannedPeersResponse) String() string { return proto.CompactTextString(m) }
+func (*BannedPeersResponse) ProtoMessage()    {}
+func (*BannedPeersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{50}
+}
+
+func (m *BannedPeersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BannedPeersResponse.Unmarshal(m, b)
+}
+func (m *BannedPeersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BannedPeersResponse.Marshal(b, m, deterministic)
+}
+func (m *BannedPeersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BannedPeersResponse.Merge(m, src)
+}
+func (m *BannedPeersResponse) XXX_Size() int {
+	return xxx_messageInfo_BannedPeersResponse.Size(m)
+}
+func (m *BannedPeersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BannedPeersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BannedPeersResponse proto.InternalMessageInfo
+
+func (m *BannedPeersResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BannedPeersResponse) GetPeers() []*BannedPeer {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+type ClearBannedPeersRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	PeerIds              []string `protobuf:"bytes,2,rep,name=peer_ids,json=peerIds,proto3" json:"peer_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClearBannedPeersRequest) Reset()         { *m = ClearBannedPeersRequest{} }
+func (m *ClearBannedPeersRequest) String() string { return proto.CompactTextString(m) }
+func (*ClearBannedPeersRequest) ProtoMessage()    {}
+func (*ClearBannedPeersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{51}
+}
+
+func (m *ClearBannedPeersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClearBannedPeersRequest.Unmarshal(m, b)
+}
+func (m *ClearBannedPeersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClearBannedPeersRequest.Marshal(b, m, deterministic)
+}
+func (m *ClearBannedPeersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearBannedPeersRequest.Merge(m, src)
+}
+func (m *ClearBannedPeersRequest) XXX_Size() int {
+	return xxx_messageInfo_ClearBannedPeersRequest.Size(m)
+}
+func (m *ClearBannedPeersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearBannedPeersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClearBannedPeersRequest proto.InternalMessageInfo
+
+func (m *ClearBannedPeersRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ClearBannedPeersRequest) GetPeerIds() []string {
+	if m != nil {
+		return m.PeerIds
+	}
+	return nil
+}
+
 type Utxo struct {
 	Amount               []byte   `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
 	ToAddr               []byte   `protobuf:"bytes,2,opt,name=toAddr,proto3" json:"toAddr,omitempty"`
@@ -2553,7 +3969,7 @@ func (m *Utxo) Reset()         { *m = Utxo{} }
 func (m *Utxo) String() string { return proto.CompactTextString(m) }
 func (*Utxo) ProtoMessage()    {}
 func (*Utxo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{31}
+	return fileDescriptor_db0991b9525664ca, []int{52}
 }
 
 func (m *Utxo) XXX_Unmarshal(b []byte) error {
@@ -2633,7 +4049,7 @@ func (m *UtxoInput) Reset()         { *m = UtxoInput{} }
 func (m *UtxoInput) String() string { return proto.CompactTextString(m) }
 func (*UtxoInput) ProtoMessage()    {}
 func (*UtxoInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{32}
+	return fileDescriptor_db0991b9525664ca, []int{53}
 }
 
 func (m *UtxoInput) XXX_Unmarshal(b []byte) error {
@@ -2721,7 +4137,7 @@ func (m *UtxoOutput) Reset()         { *m = UtxoOutput{} }
 func (m *UtxoOutput) String() string { return proto.CompactTextString(m) }
 func (*UtxoOutput) ProtoMessage()    {}
 func (*UtxoOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{33}
+	return fileDescriptor_db0991b9525664ca, []int{54}
 }
 
 func (m *UtxoOutput) XXX_Unmarshal(b []byte) error {
@@ -2783,7 +4199,7 @@ func (m *NativeCodeDesc) Reset()         { *m = NativeCodeDesc{} }
 func (m *NativeCodeDesc) String() string { return proto.CompactTextString(m) }
 func (*NativeCodeDesc) ProtoMessage()    {}
 func (*NativeCodeDesc) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{34}
+	return fileDescriptor_db0991b9525664ca, []int{55}
 }
 
 func (m *NativeCodeDesc) XXX_Unmarshal(b []byte) error {
@@ -2854,7 +4270,7 @@ func (m *WasmCodeDesc) Reset()         { *m = WasmCodeDesc{} }
 func (m *WasmCodeDesc) String() string { return proto.CompactTextString(m) }
 func (*WasmCodeDesc) ProtoMessage()    {}
 func (*WasmCodeDesc) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{35}
+	return fileDescriptor_db0991b9525664ca, []int{56}
 }
 
 func (m *WasmCodeDesc) XXX_Unmarshal(b []byte) error {
@@ -2923,7 +4339,7 @@ func (m *NativeCodeStatus) Reset()         { *m = NativeCodeStatus{} }
 func (m *NativeCodeStatus) String() string { return proto.CompactTextString(m) }
 func (*NativeCodeStatus) ProtoMessage()    {}
 func (*NativeCodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{36}
+	return fileDescriptor_db0991b9525664ca, []int{57}
 }
 
 func (m *NativeCodeStatus) XXX_Unmarshal(b []byte) error {
@@ -2978,7 +4394,7 @@ func (m *DposCandidatesRequest) Reset()         { *m = DposCandidatesRequest{} }
 func (m *DposCandidatesRequest) String() string { return proto.CompactTextString(m) }
 func (*DposCandidatesRequest) ProtoMessage()    {}
 func (*DposCandidatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{37}
+	return fileDescriptor_db0991b9525664ca, []int{58}
 }
 
 func (m *DposCandidatesRequest) XXX_Unmarshal(b []byte) error {
@@ -3026,7 +4442,7 @@ func (m *DposCandidatesResponse) Reset()         { *m = DposCandidatesResponse{}
 func (m *DposCandidatesResponse) String() string { return proto.CompactTextString(m) }
 func (*DposCandidatesResponse) ProtoMessage()    {}
 func (*DposCandidatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{38}
+	return fileDescriptor_db0991b9525664ca, []int{59}
 }
 
 func (m *DposCandidatesResponse) XXX_Unmarshal(b []byte) error {
@@ -3075,7 +4491,7 @@ func (m *DposNominateRecordsRequest) Reset()         { *m = DposNominateRecordsR
 func (m *DposNominateRecordsRequest) String() string { return proto.CompactTextString(m) }
 func (*DposNominateRecordsRequest) ProtoMessage()    {}
 func (*DposNominateRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{39}
+	return fileDescriptor_db0991b9525664ca, []int{60}
 }
 
 func (m *DposNominateRecordsRequest) XXX_Unmarshal(b []byte) error {
@@ -3130,7 +4546,7 @@ func (m *DposNominateInfo) Reset()         { *m = DposNominateInfo{} }
 func (m *DposNominateInfo) String() string { return proto.CompactTextString(m) }
 func (*DposNominateInfo) ProtoMessage()    {}
 func (*DposNominateInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{40}
+	return fileDescriptor_db0991b9525664ca, []int{61}
 }
 
 func (m *DposNominateInfo) XXX_Unmarshal(b []byte) error {
@@ -3178,7 +4594,7 @@ func (m *DposNominateRecordsResponse) Reset()         { *m = DposNominateRecords
 func (m *DposNominateRecordsResponse) String() string { return proto.CompactTextString(m) }
 func (*DposNominateRecordsResponse) ProtoMessage()    {}
 func (*DposNominateRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{41}
+	return fileDescriptor_db0991b9525664ca, []int{62}
 }
 
 func (m *DposNominateRecordsResponse) XXX_Unmarshal(b []byte) error {
@@ -3227,7 +4643,7 @@ func (m *DposNomineeRecordsRequest) Reset()         { *m = DposNomineeRecordsReq
 func (m *DposNomineeRecordsRequest) String() string { return proto.CompactTextString(m) }
 func (*DposNomineeRecordsRequest) ProtoMessage()    {}
 func (*DposNomineeRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{42}
+	return fileDescriptor_db0991b9525664ca, []int{63}
 }
 
 func (m *DposNomineeRecordsRequest) XXX_Unmarshal(b []byte) error {
@@ -3282,7 +4698,7 @@ func (m *DposNomineeRecordsResponse) Reset()         { *m = DposNomineeRecordsRe
 func (m *DposNomineeRecordsResponse) String() string { return proto.CompactTextString(m) }
 func (*DposNomineeRecordsResponse) ProtoMessage()    {}
 func (*DposNomineeRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{43}
+	return fileDescriptor_db0991b9525664ca, []int{64}
 }
 
 func (m *DposNomineeRecordsResponse) XXX_Unmarshal(b []byte) error {
@@ -3331,7 +4747,7 @@ func (m *DposVoteRecordsRequest) Reset()         { *m = DposVoteRecordsRequest{}
 func (m *DposVoteRecordsRequest) String() string { return proto.CompactTextString(m) }
 func (*DposVoteRecordsRequest) ProtoMessage()    {}
 func (*DposVoteRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{44}
+	return fileDescriptor_db0991b9525664ca, []int{65}
 }
 
 func (m *DposVoteRecordsRequest) XXX_Unmarshal(b []byte) error {
@@ -3386,7 +4802,7 @@ func (m *VoteRecord) Reset()         { *m = VoteRecord{} }
 func (m *VoteRecord) String() string { return proto.CompactTextString(m) }
 func (*VoteRecord) ProtoMessage()    {}
 func (*VoteRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{45}
+	return fileDescriptor_db0991b9525664ca, []int{66}
 }
 
 func (m *VoteRecord) XXX_Unmarshal(b []byte) error {
@@ -3435,7 +4851,7 @@ func (m *DposVoteRecordsResponse) Reset()         { *m = DposVoteRecordsResponse
 func (m *DposVoteRecordsResponse) String() string { return proto.CompactTextString(m) }
 func (*DposVoteRecordsResponse) ProtoMessage()    {}
 func (*DposVoteRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{46}
+	return fileDescriptor_db0991b9525664ca, []int{67}
 }
 
 func (m *DposVoteRecordsResponse) XXX_Unmarshal(b []byte) error {
@@ -3484,7 +4900,7 @@ func (m *DposVotedRecordsRequest) Reset()         { *m = DposVotedRecordsRequest
 func (m *DposVotedRecordsRequest) String() string { return proto.CompactTextString(m) }
 func (*DposVotedRecordsRequest) ProtoMessage()    {}
 func (*DposVotedRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{47}
+	return fileDescriptor_db0991b9525664ca, []int{68}
 }
 
 func (m *DposVotedRecordsRequest) XXX_Unmarshal(b []byte) error {
@@ -3539,7 +4955,7 @@ func (m *VotedRecord) Reset()         { *m = VotedRecord{} }
 func (m *VotedRecord) String() string { return proto.CompactTextString(m) }
 func (*VotedRecord) ProtoMessage()    {}
 func (*VotedRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{48}
+	return fileDescriptor_db0991b9525664ca, []int{69}
 }
 
 func (m *VotedRecord) XXX_Unmarshal(b []byte) error {
@@ -3588,7 +5004,7 @@ func (m *DposVotedRecordsResponse) Reset()         { *m = DposVotedRecordsRespon
 func (m *DposVotedRecordsResponse) String() string { return proto.CompactTextString(m) }
 func (*DposVotedRecordsResponse) ProtoMessage()    {}
 func (*DposVotedRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{49}
+	return fileDescriptor_db0991b9525664ca, []int{70}
 }
 
 func (m *DposVotedRecordsResponse) XXX_Unmarshal(b []byte) error {
@@ -3637,7 +5053,7 @@ func (m *DposCheckResultsRequest) Reset()         { *m = DposCheckResultsRequest
 func (m *DposCheckResultsRequest) String() string { return proto.CompactTextString(m) }
 func (*DposCheckResultsRequest) ProtoMessage()    {}
 func (*DposCheckResultsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{50}
+	return fileDescriptor_db0991b9525664ca, []int{71}
 }
 
 func (m *DposCheckResultsRequest) XXX_Unmarshal(b []byte) error {
@@ -3693,7 +5109,7 @@ func (m *DposCheckResultsResponse) Reset()         { *m = DposCheckResultsRespon
 func (m *DposCheckResultsResponse) String() string { return proto.CompactTextString(m) }
 func (*DposCheckResultsResponse) ProtoMessage()    {}
 func (*DposCheckResultsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{51}
+	return fileDescriptor_db0991b9525664ca, []int{72}
 }
 
 func (m *DposCheckResultsResponse) XXX_Unmarshal(b []byte) error {
@@ -3748,7 +5164,7 @@ func (m *DposStatusRequest) Reset()         { *m = DposStatusRequest{} }
 func (m *DposStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*DposStatusRequest) ProtoMessage()    {}
 func (*DposStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{52}
+	return fileDescriptor_db0991b9525664ca, []int{73}
 }
 
 func (m *DposStatusRequest) XXX_Unmarshal(b []byte) error {
@@ -3796,7 +5212,7 @@ func (m *DposStatusResponse) Reset()         { *m = DposStatusResponse{} }
 func (m *DposStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*DposStatusResponse) ProtoMessage()    {}
 func (*DposStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{53}
+	return fileDescriptor_db0991b9525664ca, []int{74}
 }
 
 func (m *DposStatusResponse) XXX_Unmarshal(b []byte) error {
@@ -3846,7 +5262,7 @@ func (m *DposStatus) Reset()         { *m = DposStatus{} }
 func (m *DposStatus) String() string { return proto.CompactTextString(m) }
 func (*DposStatus) ProtoMessage()    {}
 func (*DposStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{54}
+	return fileDescriptor_db0991b9525664ca, []int{75}
 }
 
 func (m *DposStatus) XXX_Unmarshal(b []byte) error {
@@ -3917,7 +5333,7 @@ func (m *InvokeRPCRequest) Reset()         { *m = InvokeRPCRequest{} }
 func (m *InvokeRPCRequest) String() string { return proto.CompactTextString(m) }
 func (*InvokeRPCRequest) ProtoMessage()    {}
 func (*InvokeRPCRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{55}
+	return fileDescriptor_db0991b9525664ca, []int{76}
 }
 
 func (m *InvokeRPCRequest) XXX_Unmarshal(b []byte) error {
@@ -3986,7 +5402,7 @@ func (m *InvokeRPCResponse) Reset()         { *m = InvokeRPCResponse{} }
 func (m *InvokeRPCResponse) String() string { return proto.CompactTextString(m) }
 func (*InvokeRPCResponse) ProtoMessage()    {}
 func (*InvokeRPCResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{56}
+	return fileDescriptor_db0991b9525664ca, []int{77}
 }
 
 func (m *InvokeRPCResponse) XXX_Unmarshal(b []byte) error {
@@ -4047,7 +5463,7 @@ func (m *InvokeRequest) Reset()         { *m = InvokeRequest{} }
 func (m *InvokeRequest) String() string { return proto.CompactTextString(m) }
 func (*InvokeRequest) ProtoMessage()    {}
 func (*InvokeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{57}
+	return fileDescriptor_db0991b9525664ca, []int{78}
 }
 
 func (m *InvokeRequest) XXX_Unmarshal(b []byte) error {
@@ -4129,7 +5545,7 @@ func (m *InvokeResponse) Reset()         { *m = InvokeResponse{} }
 func (m *InvokeResponse) String() string { return proto.CompactTextString(m) }
 func (*InvokeResponse) ProtoMessage()    {}
 func (*InvokeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{58}
+	return fileDescriptor_db0991b9525664ca, []int{79}
 }
 
 func (m *InvokeResponse) XXX_Unmarshal(b []byte) error {
@@ -4221,7 +5637,7 @@ func (m *TxInputExt) Reset()         { *m = TxInputExt{} }
 func (m *TxInputExt) String() string { return proto.CompactTextString(m) }
 func (*TxInputExt) ProtoMessage()    {}
 func (*TxInputExt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{59}
+	return fileDescriptor_db0991b9525664ca, []int{80}
 }
 
 func (m *TxInputExt) XXX_Unmarshal(b []byte) error {
@@ -4284,7 +5700,7 @@ func (m *TxOutputExt) Reset()         { *m = TxOutputExt{} }
 func (m *TxOutputExt) String() string { return proto.CompactTextString(m) }
 func (*TxOutputExt) ProtoMessage()    {}
 func (*TxOutputExt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{60}
+	return fileDescriptor_db0991b9525664ca, []int{81}
 }
 
 func (m *TxOutputExt) XXX_Unmarshal(b []byte) error {
@@ -4339,7 +5755,7 @@ func (m *SignatureInfo) Reset()         { *m = SignatureInfo{} }
 func (m *SignatureInfo) String() string { return proto.CompactTextString(m) }
 func (*SignatureInfo) ProtoMessage()    {}
 func (*SignatureInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{61}
+	return fileDescriptor_db0991b9525664ca, []int{82}
 }
 
 func (m *SignatureInfo) XXX_Unmarshal(b []byte) error {
@@ -4386,7 +5802,7 @@ func (m *PermissionModel) Reset()         { *m = PermissionModel{} }
 func (m *PermissionModel) String() string { return proto.CompactTextString(m) }
 func (*PermissionModel) ProtoMessage()    {}
 func (*PermissionModel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{62}
+	return fileDescriptor_db0991b9525664ca, []int{83}
 }
 
 func (m *PermissionModel) XXX_Unmarshal(b []byte) error {
@@ -4433,7 +5849,7 @@ func (m *AkSet) Reset()         { *m = AkSet{} }
 func (m *AkSet) String() string { return proto.CompactTextString(m) }
 func (*AkSet) ProtoMessage()    {}
 func (*AkSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{63}
+	return fileDescriptor_db0991b9525664ca, []int{84}
 }
 
 func (m *AkSet) XXX_Unmarshal(b []byte) error {
@@ -4473,7 +5889,7 @@ func (m *AkSets) Reset()         { *m = AkSets{} }
 func (m *AkSets) String() string { return proto.CompactTextString(m) }
 func (*AkSets) ProtoMessage()    {}
 func (*AkSets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{64}
+	return fileDescriptor_db0991b9525664ca, []int{85}
 }
 
 func (m *AkSets) XXX_Unmarshal(b []byte) error {
@@ -4508,11 +5924,84 @@ func (m *AkSets) GetExpression() string {
 	return ""
 }
 
+// 时间锁，限定Acl生效的区块高度和区块时间范围，0表示不限制
+type TimeLock struct {
+	Rule                 PermissionRule `protobuf:"varint,1,opt,name=rule,proto3,enum=pb.PermissionRule" json:"rule,omitempty"`
+	NotBeforeHeight      int64          `protobuf:"varint,2,opt,name=notBeforeHeight,proto3" json:"notBeforeHeight,omitempty"`
+	NotAfterHeight       int64          `protobuf:"varint,3,opt,name=notAfterHeight,proto3" json:"notAfterHeight,omitempty"`
+	NotBeforeTimestamp   int64          `protobuf:"varint,4,opt,name=notBeforeTimestamp,proto3" json:"notBeforeTimestamp,omitempty"`
+	NotAfterTimestamp    int64          `protobuf:"varint,5,opt,name=notAfterTimestamp,proto3" json:"notAfterTimestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *TimeLock) Reset()         { *m = TimeLock{} }
+func (m *TimeLock) String() string { return proto.CompactTextString(m) }
+func (*TimeLock) ProtoMessage()    {}
+func (*TimeLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{86}
+}
+
+func (m *TimeLock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TimeLock.Unmarshal(m, b)
+}
+func (m *TimeLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TimeLock.Marshal(b, m, deterministic)
+}
+func (m *TimeLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeLock.Merge(m, src)
+}
+func (m *TimeLock) XXX_Size() int {
+	return xxx_messageInfo_TimeLock.Size(m)
+}
+func (m *TimeLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeLock proto.InternalMessageInfo
+
+func (m *TimeLock) GetRule() PermissionRule {
+	if m != nil {
+		return m.Rule
+	}
+	return PermissionRule_NULL
+}
+
+func (m *TimeLock) GetNotBeforeHeight() int64 {
+	if m != nil {
+		return m.NotBeforeHeight
+	}
+	return 0
+}
+
+func (m *TimeLock) GetNotAfterHeight() int64 {
+	if m != nil {
+		return m.NotAfterHeight
+	}
+	return 0
+}
+
+func (m *TimeLock) GetNotBeforeTimestamp() int64 {
+	if m != nil {
+		return m.NotBeforeTimestamp
+	}
+	return 0
+}
+
+func (m *TimeLock) GetNotAfterTimestamp() int64 {
+	if m != nil {
+		return m.NotAfterTimestamp
+	}
+	return 0
+}
+
 // Acl实际使用的结构
 type Acl struct {
 	Pm                   *PermissionModel   `protobuf:"bytes,1,opt,name=pm,proto3" json:"pm,omitempty"`
 	AksWeight            map[string]float64 `protobuf:"bytes,2,rep,name=aksWeight,proto3" json:"aksWeight,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
 	AkSets               *AkSets            `protobuf:"bytes,3,opt,name=akSets,proto3" json:"akSets,omitempty"`
+	TimeLock             *TimeLock          `protobuf:"bytes,4,opt,name=timeLock,proto3" json:"timeLock,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -4522,7 +6011,7 @@ func (m *Acl) Reset()         { *m = Acl{} }
 func (m *Acl) String() string { return proto.CompactTextString(m) }
 func (*Acl) ProtoMessage()    {}
 func (*Acl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{65}
+	return fileDescriptor_db0991b9525664ca, []int{87}
 }
 
 func (m *Acl) XXX_Unmarshal(b []byte) error {
@@ -4564,6 +6053,13 @@ func (m *Acl) GetAkSets() *AkSets {
 	return nil
 }
 
+func (m *Acl) GetTimeLock() *TimeLock {
+	if m != nil {
+		return m.TimeLock
+	}
+	return nil
+}
+
 // 查询Acl
 type AclStatus struct {
 	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
@@ -4582,7 +6078,7 @@ func (m *AclStatus) Reset()         { *m = AclStatus{} }
 func (m *AclStatus) String() string { return proto.CompactTextString(m) }
 func (*AclStatus) ProtoMessage()    {}
 func (*AclStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{66}
+	return fileDescriptor_db0991b9525664ca, []int{88}
 }
 
 func (m *AclStatus) XXX_Unmarshal(b []byte) error {
@@ -4668,7 +6164,7 @@ func (m *IdentityAuth) Reset()         { *m = IdentityAuth{} }
 func (m *IdentityAuth) String() string { return proto.CompactTextString(m) }
 func (*IdentityAuth) ProtoMessage()    {}
 func (*IdentityAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{67}
+	return fileDescriptor_db0991b9525664ca, []int{89}
 }
 
 func (m *IdentityAuth) XXX_Unmarshal(b []byte) error {
@@ -4736,7 +6232,7 @@ func (m *IdentityAuths) Reset()         { *m = IdentityAuths{} }
 func (m *IdentityAuths) String() string { return proto.CompactTextString(m) }
 func (*IdentityAuths) ProtoMessage()    {}
 func (*IdentityAuths) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{68}
+	return fileDescriptor_db0991b9525664ca, []int{90}
 }
 
 func (m *IdentityAuths) XXX_Unmarshal(b []byte) error {
@@ -4776,7 +6272,7 @@ func (m *ResourceLimit) Reset()         { *m = ResourceLimit{} }
 func (m *ResourceLimit) String() string { return proto.CompactTextString(m) }
 func (*ResourceLimit) ProtoMessage()    {}
 func (*ResourceLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{69}
+	return fileDescriptor_db0991b9525664ca, []int{91}
 }
 
 func (m *ResourceLimit) XXX_Unmarshal(b []byte) error {
@@ -4824,7 +6320,7 @@ func (m *AK2AccountRequest) Reset()         { *m = AK2AccountRequest{} }
 func (m *AK2AccountRequest) String() string { return proto.CompactTextString(m) }
 func (*AK2AccountRequest) ProtoMessage()    {}
 func (*AK2AccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{70}
+	return fileDescriptor_db0991b9525664ca, []int{92}
 }
 
 func (m *AK2AccountRequest) XXX_Unmarshal(b []byte) error {
@@ -4879,7 +6375,7 @@ func (m *AK2AccountResponse) Reset()         { *m = AK2AccountResponse{} }
 func (m *AK2AccountResponse) String() string { return proto.CompactTextString(m) }
 func (*AK2AccountResponse) ProtoMessage()    {}
 func (*AK2AccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{71}
+	return fileDescriptor_db0991b9525664ca, []int{93}
 }
 
 func (m *AK2AccountResponse) XXX_Unmarshal(b []byte) error {
@@ -4935,7 +6431,7 @@ func (m *GetAccountContractsRequest) Reset()         { *m = GetAccountContractsR
 func (m *GetAccountContractsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAccountContractsRequest) ProtoMessage()    {}
 func (*GetAccountContractsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{72}
+	return fileDescriptor_db0991b9525664ca, []int{94}
 }
 
 func (m *GetAccountContractsRequest) XXX_Unmarshal(b []byte) error {
@@ -4990,7 +6486,7 @@ func (m *GetAccountContractsResponse) Reset()         { *m = GetAccountContracts
 func (m *GetAccountContractsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetAccountContractsResponse) ProtoMessage()    {}
 func (*GetAccountContractsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{73}
+	return fileDescriptor_db0991b9525664ca, []int{95}
 }
 
 func (m *GetAccountContractsResponse) XXX_Unmarshal(b []byte) error {
@@ -5042,7 +6538,7 @@ func (m *ContractStatus) Reset()         { *m = ContractStatus{} }
 func (m *ContractStatus) String() string { return proto.CompactTextString(m) }
 func (*ContractStatus) ProtoMessage()    {}
 func (*ContractStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{74}
+	return fileDescriptor_db0991b9525664ca, []int{96}
 }
 
 func (m *ContractStatus) XXX_Unmarshal(b []byte) error {
@@ -5123,7 +6619,7 @@ func (m *PreExecWithSelectUTXORequest) Reset()         { *m = PreExecWithSelectU
 func (m *PreExecWithSelectUTXORequest) String() string { return proto.CompactTextString(m) }
 func (*PreExecWithSelectUTXORequest) ProtoMessage()    {}
 func (*PreExecWithSelectUTXORequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{75}
+	return fileDescriptor_db0991b9525664ca, []int{97}
 }
 
 func (m *PreExecWithSelectUTXORequest) XXX_Unmarshal(b []byte) error {
@@ -5209,7 +6705,7 @@ func (m *PreExecWithSelectUTXOResponse) Reset()         { *m = PreExecWithSelect
 func (m *PreExecWithSelectUTXOResponse) String() string { return proto.CompactTextString(m) }
 func (*PreExecWithSelectUTXOResponse) ProtoMessage()    {}
 func (*PreExecWithSelectUTXOResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{76}
+	return fileDescriptor_db0991b9525664ca, []int{98}
 }
 
 func (m *PreExecWithSelectUTXOResponse) XXX_Unmarshal(b []byte) error {
@@ -5272,7 +6768,7 @@ func (m *ContractResponse) Reset()         { *m = ContractResponse{} }
 func (m *ContractResponse) String() string { return proto.CompactTextString(m) }
 func (*ContractResponse) ProtoMessage()    {}
 func (*ContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{77}
+	return fileDescriptor_db0991b9525664ca, []int{99}
 }
 
 func (m *ContractResponse) XXX_Unmarshal(b []byte) error {
@@ -5334,7 +6830,7 @@ func (m *ModifyBlock) Reset()         { *m = ModifyBlock{} }
 func (m *ModifyBlock) String() string { return proto.CompactTextString(m) }
 func (*ModifyBlock) ProtoMessage()    {}
 func (*ModifyBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{78}
+	return fileDescriptor_db0991b9525664ca, []int{100}
 }
 
 func (m *ModifyBlock) XXX_Unmarshal(b []byte) error {
@@ -5404,7 +6900,7 @@ func (m *HDInfo) Reset()         { *m = HDInfo{} }
 func (m *HDInfo) String() string { return proto.CompactTextString(m) }
 func (*HDInfo) ProtoMessage()    {}
 func (*HDInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{79}
+	return fileDescriptor_db0991b9525664ca, []int{101}
 }
 
 func (m *HDInfo) XXX_Unmarshal(b []byte) error {
@@ -5456,7 +6952,7 @@ func (m *UtxoRecordDetail) Reset()         { *m = UtxoRecordDetail{} }
 func (m *UtxoRecordDetail) String() string { return proto.CompactTextString(m) }
 func (*UtxoRecordDetail) ProtoMessage()    {}
 func (*UtxoRecordDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{80}
+	return fileDescriptor_db0991b9525664ca, []int{102}
 }
 
 func (m *UtxoRecordDetail) XXX_Unmarshal(b []byte) error {
@@ -5539,7 +7035,7 @@ func (m *UtxoRecord) Reset()         { *m = UtxoRecord{} }
 func (m *UtxoRecord) String() string { return proto.CompactTextString(m) }
 func (*UtxoRecord) ProtoMessage()    {}
 func (*UtxoRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{81}
+	return fileDescriptor_db0991b9525664ca, []int{103}
 }
 
 func (m *UtxoRecord) XXX_Unmarshal(b []byte) error {
@@ -5594,7 +7090,7 @@ func (m *UtxoKey) Reset()         { *m = UtxoKey{} }
 func (m *UtxoKey) String() string { return proto.CompactTextString(m) }
 func (*UtxoKey) ProtoMessage()    {}
 func (*UtxoKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{82}
+	return fileDescriptor_db0991b9525664ca, []int{104}
 }
 
 func (m *UtxoKey) XXX_Unmarshal(b []byte) error {
@@ -5648,7 +7144,7 @@ func (m *ContractStatDataRequest) Reset()         { *m = ContractStatDataRequest
 func (m *ContractStatDataRequest) String() string { return proto.CompactTextString(m) }
 func (*ContractStatDataRequest) ProtoMessage()    {}
 func (*ContractStatDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{83}
+	return fileDescriptor_db0991b9525664ca, []int{105}
 }
 
 func (m *ContractStatDataRequest) XXX_Unmarshal(b []byte) error {
@@ -5696,7 +7192,7 @@ func (m *ContractStatDataResponse) Reset()         { *m = ContractStatDataRespon
 func (m *ContractStatDataResponse) String() string { return proto.CompactTextString(m) }
 func (*ContractStatDataResponse) ProtoMessage()    {}
 func (*ContractStatDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{84}
+	return fileDescriptor_db0991b9525664ca, []int{106}
 }
 
 func (m *ContractStatDataResponse) XXX_Unmarshal(b []byte) error {
@@ -5750,7 +7246,7 @@ func (m *ContractStatData) Reset()         { *m = ContractStatData{} }
 func (m *ContractStatData) String() string { return proto.CompactTextString(m) }
 func (*ContractStatData) ProtoMessage()    {}
 func (*ContractStatData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{85}
+	return fileDescriptor_db0991b9525664ca, []int{107}
 }
 
 func (m *ContractStatData) XXX_Unmarshal(b []byte) error {
@@ -5800,7 +7296,7 @@ func (m *AddressContractsRequest) Reset()         { *m = AddressContractsRequest
 func (m *AddressContractsRequest) String() string { return proto.CompactTextString(m) }
 func (*AddressContractsRequest) ProtoMessage()    {}
 func (*AddressContractsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{86}
+	return fileDescriptor_db0991b9525664ca, []int{108}
 }
 
 func (m *AddressContractsRequest) XXX_Unmarshal(b []byte) error {
@@ -5860,7 +7356,7 @@ func (m *ContractList) Reset()         { *m = ContractList{} }
 func (m *ContractList) String() string { return proto.CompactTextString(m) }
 func (*ContractList) ProtoMessage()    {}
 func (*ContractList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{87}
+	return fileDescriptor_db0991b9525664ca, []int{109}
 }
 
 func (m *ContractList) XXX_Unmarshal(b []byte) error {
@@ -5901,7 +7397,7 @@ func (m *AddressContractsResponse) Reset()         { *m = AddressContractsRespon
 func (m *AddressContractsResponse) String() string { return proto.CompactTextString(m) }
 func (*AddressContractsResponse) ProtoMessage()    {}
 func (*AddressContractsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{88}
+	return fileDescriptor_db0991b9525664ca, []int{110}
 }
 
 func (m *AddressContractsResponse) XXX_Unmarshal(b []byte) error {
@@ -5951,7 +7447,7 @@ func (m *CrossQueryRequest) Reset()         { *m = CrossQueryRequest{} }
 func (m *CrossQueryRequest) String() string { return proto.CompactTextString(m) }
 func (*CrossQueryRequest) ProtoMessage()    {}
 func (*CrossQueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{89}
+	return fileDescriptor_db0991b9525664ca, []int{111}
 }
 
 func (m *CrossQueryRequest) XXX_Unmarshal(b []byte) error {
@@ -6018,7 +7514,7 @@ func (m *CrossQueryResponse) Reset()         { *m = CrossQueryResponse{} }
 func (m *CrossQueryResponse) String() string { return proto.CompactTextString(m) }
 func (*CrossQueryResponse) ProtoMessage()    {}
 func (*CrossQueryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{90}
+	return fileDescriptor_db0991b9525664ca, []int{112}
 }
 
 func (m *CrossQueryResponse) XXX_Unmarshal(b []byte) error {
@@ -6058,7 +7554,7 @@ func (m *CrossChainMeta) Reset()         { *m = CrossChainMeta{} }
 func (m *CrossChainMeta) String() string { return proto.CompactTextString(m) }
 func (*CrossChainMeta) ProtoMessage()    {}
 func (*CrossChainMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{91}
+	return fileDescriptor_db0991b9525664ca, []int{113}
 }
 
 func (m *CrossChainMeta) XXX_Unmarshal(b []byte) error {
@@ -6107,7 +7603,7 @@ func (m *CrossEndorsor) Reset()         { *m = CrossEndorsor{} }
 func (m *CrossEndorsor) String() string { return proto.CompactTextString(m) }
 func (*CrossEndorsor) ProtoMessage()    {}
 func (*CrossEndorsor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{92}
+	return fileDescriptor_db0991b9525664ca, []int{114}
 }
 
 func (m *CrossEndorsor) XXX_Unmarshal(b []byte) error {
@@ -6161,7 +7657,7 @@ func (m *CrossQueryMeta) Reset()         { *m = CrossQueryMeta{} }
 func (m *CrossQueryMeta) String() string { return proto.CompactTextString(m) }
 func (*CrossQueryMeta) ProtoMessage()    {}
 func (*CrossQueryMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{93}
+	return fileDescriptor_db0991b9525664ca, []int{115}
 }
 
 func (m *CrossQueryMeta) XXX_Unmarshal(b []byte) error {
@@ -6209,7 +7705,7 @@ func (m *CrossQueryInfo) Reset()         { *m = CrossQueryInfo{} }
 func (m *CrossQueryInfo) String() string { return proto.CompactTextString(m) }
 func (*CrossQueryInfo) ProtoMessage()    {}
 func (*CrossQueryInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{94}
+	return fileDescriptor_db0991b9525664ca, []int{116}
 }
 
 func (m *CrossQueryInfo) XXX_Unmarshal(b []byte) error {
@@ -6264,7 +7760,7 @@ func (m *ContractEvent) Reset()         { *m = ContractEvent{} }
 func (m *ContractEvent) String() string { return proto.CompactTextString(m) }
 func (*ContractEvent) ProtoMessage()    {}
 func (*ContractEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{95}
+	return fileDescriptor_db0991b9525664ca, []int{117}
 }
 
 func (m *ContractEvent) XXX_Unmarshal(b []byte) error {
@@ -6320,8 +7816,25 @@ func init() {
 	proto.RegisterType((*BatchTxs)(nil), "pb.BatchTxs")
 	proto.RegisterType((*Block)(nil), "pb.Block")
 	proto.RegisterType((*BlockID)(nil), "pb.BlockID")
+	proto.RegisterType((*BlockHeadersRequest)(nil), "pb.BlockHeadersRequest")
+	proto.RegisterType((*BlockHeadersResponse)(nil), "pb.BlockHeadersResponse")
+	proto.RegisterType((*CompactBlock)(nil), "pb.CompactBlock")
+	proto.RegisterType((*PrefilledTx)(nil), "pb.PrefilledTx")
+	proto.RegisterType((*BlockTxsRequest)(nil), "pb.BlockTxsRequest")
+	proto.RegisterType((*BlockTxsResponse)(nil), "pb.BlockTxsResponse")
 	proto.RegisterType((*BlockHeight)(nil), "pb.BlockHeight")
 	proto.RegisterType((*CommonReply)(nil), "pb.CommonReply")
+	proto.RegisterType((*TxProofRequest)(nil), "pb.TxProofRequest")
+	proto.RegisterType((*TxProof)(nil), "pb.TxProof")
+	proto.RegisterType((*TxProofResponse)(nil), "pb.TxProofResponse")
+	proto.RegisterType((*StateProofRequest)(nil), "pb.StateProofRequest")
+	proto.RegisterType((*StateProof)(nil), "pb.StateProof")
+	proto.RegisterType((*StateProofResponse)(nil), "pb.StateProofResponse")
+	proto.RegisterType((*TraceTxRequest)(nil), "pb.TraceTxRequest")
+	proto.RegisterType((*TraceResponse)(nil), "pb.TraceResponse")
+	proto.RegisterType((*UtxoProofRequest)(nil), "pb.UtxoProofRequest")
+	proto.RegisterType((*UtxoProof)(nil), "pb.UtxoProof")
+	proto.RegisterType((*UtxoProofResponse)(nil), "pb.UtxoProofResponse")
 	proto.RegisterType((*CommonIn)(nil), "pb.CommonIn")
 	proto.RegisterType((*TokenDetail)(nil), "pb.TokenDetail")
 	proto.RegisterType((*AddressStatus)(nil), "pb.AddressStatus")
@@ -6338,6 +7851,7 @@ func init() {
 	proto.RegisterType((*InternalBlock)(nil), "pb.InternalBlock")
 	proto.RegisterMapType((map[string]string)(nil), "pb.InternalBlock.FailedTxsEntry")
 	proto.RegisterType((*BCStatus)(nil), "pb.BCStatus")
+	proto.RegisterType((*SyncStatus)(nil), "pb.SyncStatus")
 	proto.RegisterType((*BCTipStatus)(nil), "pb.BCTipStatus")
 	proto.RegisterType((*BlockChains)(nil), "pb.BlockChains")
 	proto.RegisterType((*Speeds)(nil), "pb.Speeds")
@@ -6348,6 +7862,9 @@ func init() {
 	proto.RegisterType((*SystemsStatus)(nil), "pb.SystemsStatus")
 	proto.RegisterType((*SystemsStatusReply)(nil), "pb.SystemsStatusReply")
 	proto.RegisterType((*RawUrl)(nil), "pb.RawUrl")
+	proto.RegisterType((*BannedPeer)(nil), "pb.BannedPeer")
+	proto.RegisterType((*BannedPeersResponse)(nil), "pb.BannedPeersResponse")
+	proto.RegisterType((*ClearBannedPeersRequest)(nil), "pb.ClearBannedPeersRequest")
 	proto.RegisterType((*Utxo)(nil), "pb.Utxo")
 	proto.RegisterType((*UtxoInput)(nil), "pb.UtxoInput")
 	proto.RegisterType((*UtxoOutput)(nil), "pb.UtxoOutput")
@@ -6384,6 +7901,7 @@ func init() {
 	proto.RegisterType((*AkSet)(nil), "pb.AkSet")
 	proto.RegisterType((*AkSets)(nil), "pb.AkSets")
 	proto.RegisterMapType((map[string]*AkSet)(nil), "pb.AkSets.SetsEntry")
+	proto.RegisterType((*TimeLock)(nil), "pb.TimeLock")
 	proto.RegisterType((*Acl)(nil), "pb.Acl")
 	proto.RegisterMapType((map[string]float64)(nil), "pb.Acl.AksWeightEntry")
 	proto.RegisterType((*AclStatus)(nil), "pb.AclStatus")
@@ -6422,379 +7940,435 @@ func init() {
 func init() { proto.RegisterFile("xchain.proto", fileDescriptor_db0991b9525664ca) }
 
 var fileDescriptor_db0991b9525664ca = []byte{
-	// 5948 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7c, 0xcd, 0x6f, 0x23, 0xc9,
-	0x75, 0xf8, 0x36, 0x29, 0xf1, 0xe3, 0xf1, 0x43, 0x54, 0xcd, 0x48, 0xc3, 0xa1, 0xb4, 0xf3, 0xd1,
-	0x3b, 0xde, 0x95, 0x67, 0x7f, 0xd6, 0xfc, 0x56, 0xb6, 0xb3, 0x8b, 0xb1, 0xbd, 0x0e, 0x45, 0x51,
-	0x33, 0xb4, 0x34, 0xa4, 0xb6, 0x49, 0xce, 0x68, 0xe1, 0x00, 0xed, 0x16, 0x59, 0x92, 0xda, 0x22,
-	0xbb, 0xe9, 0xee, 0xa6, 0x86, 0x5a, 0x1b, 0xc9, 0xc6, 0xc8, 0xc9, 0xb7, 0x24, 0x40, 0x6e, 0x31,
-	0x82, 0x1c, 0x03, 0xe4, 0x12, 0x04, 0xc8, 0x21, 0x40, 0x80, 0x04, 0x41, 0x8e, 0xb9, 0x04, 0x39,
-	0x24, 0x40, 0x4e, 0x0e, 0xf2, 0x1f, 0xe4, 0x1e, 0xd4, 0xab, 0xaa, 0xee, 0x6a, 0x7e, 0xcc, 0x8e,
-	0xbc, 0xb3, 0x7b, 0x19, 0xb1, 0x5e, 0xbd, 0x7a, 0x55, 0xef, 0x55, 0xd5, 0xfb, 0xaa, 0xd7, 0x03,
-	0xf9, 0x49, 0xef, 0xdc, 0xb2, 0x9d, 0xed, 0x91, 0xe7, 0x06, 0x2e, 0x49, 0x8c, 0x4e, 0x2a, 0x9b,
-	0x67, 0xae, 0x7b, 0x36, 0xa0, 0x8f, 0xac, 0x91, 0xfd, 0xc8, 0x72, 0x1c, 0x37, 0xb0, 0x02, 0xdb,
-	0x75, 0x7c, 0x8e, 0x51, 0x29, 0x21, 0x3a, 0xed, 0x9f, 0x9c, 0x06, 0x1c, 0xa2, 0x9f, 0x42, 0xea,
-	0x29, 0xb5, 0xfa, 0xd4, 0x23, 0x37, 0x61, 0x79, 0xe0, 0x9e, 0xd9, 0xfd, 0xb2, 0x76, 0x4f, 0xdb,
-	0xca, 0x1a, 0xbc, 0x41, 0x36, 0x20, 0x7b, 0xea, 0xb9, 0x43, 0xd3, 0x71, 0xfb, 0xb4, 0x9c, 0xc0,
-	0x9e, 0x0c, 0x03, 0x34, 0xdd, 0x3e, 0x25, 0xdf, 0x84, 0x65, 0xea, 0x79, 0xae, 0x57, 0x4e, 0xde,
-	0xd3, 0xb6, 0x8a, 0x3b, 0x37, 0xb6, 0x47, 0x27, 0xdb, 0xc7, 0x35, 0x36, 0x45, 0x9d, 0x81, 0xeb,
-	0xce, 0x78, 0x68, 0x70, 0x0c, 0xfd, 0x14, 0x0a, 0x9d, 0xc9, 0x9e, 0x15, 0x58, 0xd5, 0x5e, 0xcf,
-	0x1d, 0x3b, 0x01, 0x29, 0x43, 0xda, 0xea, 0xf7, 0x3d, 0xea, 0xfb, 0x62, 0x42, 0xd9, 0x24, 0xeb,
-	0x90, 0xb2, 0x86, 0x0c, 0x47, 0xcc, 0x27, 0x5a, 0xe4, 0x1d, 0x28, 0x9c, 0x7a, 0xee, 0x67, 0xd4,
-	0x31, 0xcf, 0xa9, 0x7d, 0x76, 0x1e, 0xe0, 0xac, 0x49, 0x23, 0xcf, 0x81, 0x4f, 0x11, 0xa6, 0xff,
-	0x26, 0x01, 0x29, 0x3e, 0x11, 0xd1, 0x21, 0x75, 0x8e, 0xac, 0x95, 0x0b, 0xf7, 0xb4, 0xad, 0xdc,
-	0x0e, 0xb0, 0xe5, 0x71, 0x66, 0x0d, 0xd1, 0x43, 0x08, 0x2c, 0x05, 0x13, 0xc1, 0x73, 0xde, 0xc0,
-	0xdf, 0x6c, 0xfe, 0x93, 0x9e, 0x63, 0x0d, 0x25, 0xbf, 0xa2, 0x15, 0x8a, 0x82, 0xad, 0x13, 0xe7,
-	0x16, 0xa2, 0xa8, 0xf6, 0xfb, 0x1e, 0xb9, 0x0b, 0x39, 0xec, 0x1c, 0x8d, 0x4f, 0x2e, 0xe8, 0x55,
-	0x79, 0x09, 0xbb, 0x81, 0x81, 0x8e, 0x10, 0x12, 0x22, 0xf8, 0x3d, 0x8f, 0x21, 0x2c, 0x47, 0x08,
-	0x6d, 0x84, 0x30, 0xf2, 0x63, 0x9f, 0x7a, 0xa6, 0x6f, 0x9f, 0x39, 0xe5, 0x22, 0xae, 0x27, 0xc3,
-	0x00, 0x6d, 0xfb, 0xcc, 0x21, 0xef, 0x43, 0xda, 0xe2, 0x82, 0x2b, 0xa7, 0xee, 0x25, 0xb7, 0x72,
-	0x3b, 0xab, 0x8c, 0x99, 0x98, 0x44, 0x0d, 0x89, 0xc1, 0x76, 0xd2, 0x71, 0x9d, 0x1e, 0x2d, 0x67,
-	0xf8, 0x4e, 0x62, 0x83, 0x6c, 0x42, 0x36, 0xb0, 0x87, 0xd4, 0x0f, 0xac, 0xe1, 0xa8, 0x9c, 0x45,
-	0xd1, 0x45, 0x00, 0x26, 0x88, 0x3e, 0xf5, 0x7b, 0xe5, 0x3c, 0x17, 0x04, 0xfb, 0xcd, 0xb6, 0xe8,
-	0x92, 0x7a, 0xbe, 0xed, 0x3a, 0xe5, 0x95, 0x7b, 0xda, 0xd6, 0xb2, 0x21, 0x9b, 0xfa, 0xbf, 0x68,
-	0x90, 0xe9, 0x4c, 0xda, 0x81, 0x15, 0x8c, 0x7d, 0x45, 0xce, 0xda, 0x42, 0x39, 0x2f, 0x92, 0xa9,
-	0x94, 0x7f, 0x52, 0x91, 0xff, 0xb7, 0x20, 0xe5, 0x23, 0x65, 0x94, 0x62, 0x71, 0x67, 0x0d, 0x59,
-	0xf5, 0x2c, 0xc7, 0xb7, 0x7a, 0xec, 0x30, 0xf3, 0x69, 0x0d, 0x81, 0x44, 0x2a, 0x90, 0xe9, 0xdb,
-	0x7e, 0x60, 0x31, 0x86, 0x97, 0x91, 0xad, 0xb0, 0x4d, 0xee, 0x42, 0x22, 0x98, 0x94, 0xd3, 0xb8,
-	0xac, 0x95, 0x29, 0x32, 0x46, 0x22, 0x98, 0xe8, 0x4d, 0xc8, 0xec, 0x5a, 0x41, 0xef, 0xbc, 0x33,
-	0x79, 0x3d, 0x3e, 0xee, 0x40, 0xb2, 0x33, 0xf1, 0xcb, 0x09, 0xdc, 0x83, 0x3c, 0xdf, 0x03, 0xb1,
-	0x1e, 0xd6, 0xa1, 0xff, 0xaf, 0x06, 0xcb, 0xbb, 0x03, 0xb7, 0x77, 0xf1, 0xa5, 0xa4, 0x52, 0x86,
-	0xf4, 0x09, 0x23, 0x12, 0x0a, 0x46, 0x36, 0xc9, 0xf6, 0x94, 0x6c, 0xd6, 0x19, 0x55, 0x9c, 0x70,
-	0xbb, 0x8e, 0x7f, 0xa6, 0x84, 0xf3, 0x1e, 0x2c, 0xe3, 0x50, 0x94, 0x8c, 0x38, 0x35, 0x0d, 0x27,
-	0xa0, 0x9e, 0x63, 0x0d, 0x10, 0xdf, 0xe0, 0xfd, 0xfa, 0x0f, 0x20, 0xaf, 0x12, 0x20, 0x59, 0x58,
-	0xae, 0x1b, 0x46, 0xcb, 0x28, 0xbd, 0xc5, 0x7e, 0x76, 0x8c, 0x6e, 0xf3, 0xa0, 0xa4, 0x11, 0x80,
-	0xd4, 0xae, 0x51, 0x6d, 0xd6, 0x9e, 0x96, 0x12, 0x24, 0x07, 0xe9, 0x66, 0xab, 0x7e, 0xdc, 0x68,
-	0x77, 0x4a, 0x49, 0xfd, 0x97, 0x1a, 0xa4, 0x71, 0x78, 0x63, 0x4f, 0xe1, 0x7c, 0xe9, 0x35, 0x38,
-	0xd7, 0x16, 0x71, 0x9e, 0x88, 0x73, 0x7e, 0x1f, 0xf2, 0x0e, 0xa5, 0x7d, 0xb3, 0xe7, 0x3a, 0x01,
-	0x75, 0xf8, 0xe5, 0xcf, 0x18, 0x39, 0x06, 0xab, 0x71, 0x90, 0x6e, 0x41, 0x0e, 0xd7, 0xc0, 0x55,
-	0x81, 0xb2, 0x8e, 0xe4, 0xb5, 0xd7, 0xb1, 0xce, 0xc6, 0xa2, 0x92, 0x49, 0xe0, 0x91, 0x12, 0x2d,
-	0xfd, 0x03, 0xc8, 0xd5, 0xdc, 0xe1, 0xd0, 0x75, 0x0c, 0x3a, 0x1a, 0x5c, 0xbd, 0xce, 0x26, 0xeb,
-	0x26, 0x64, 0xf8, 0x90, 0x86, 0xf3, 0x5a, 0x87, 0xe2, 0x11, 0xe4, 0x2e, 0x6d, 0xfa, 0xd2, 0x74,
-	0x47, 0xec, 0x94, 0xe2, 0xfc, 0xc5, 0x9d, 0x22, 0x43, 0x7c, 0x6e, 0xd3, 0x97, 0x2d, 0x84, 0x1a,
-	0x70, 0x19, 0xfe, 0xd6, 0x7f, 0x0a, 0xb9, 0x8e, 0x7b, 0x41, 0x9d, 0x3d, 0x1a, 0x58, 0xf6, 0xe0,
-	0x95, 0xa2, 0xb5, 0x06, 0x78, 0x4d, 0xf8, 0x69, 0x93, 0xcd, 0xeb, 0xa8, 0xf1, 0x11, 0x14, 0xaa,
-	0x5c, 0x4d, 0x5f, 0xe3, 0xf2, 0x2b, 0xaa, 0x3e, 0x11, 0x57, 0xf5, 0xf7, 0x21, 0x79, 0xd2, 0xf3,
-	0xcb, 0x49, 0xbc, 0x4e, 0xfc, 0x82, 0x46, 0x9c, 0x18, 0xac, 0x4f, 0x6f, 0xc0, 0x2a, 0xc2, 0xf6,
-	0x51, 0xcb, 0x0b, 0x1e, 0x15, 0x5e, 0xb4, 0x38, 0x2f, 0x15, 0xc8, 0xd8, 0x3e, 0xc7, 0xc5, 0xc9,
-	0x32, 0x46, 0xd8, 0xd6, 0x3f, 0xd7, 0x80, 0xcc, 0xd0, 0xf2, 0x17, 0x0a, 0xec, 0x3d, 0x48, 0x06,
-	0xa7, 0x7d, 0x71, 0xd7, 0xd7, 0xc2, 0xc5, 0xa9, 0x83, 0x0d, 0x86, 0x71, 0x1d, 0xf9, 0x7d, 0xae,
-	0xc1, 0x4d, 0x21, 0xc0, 0x5d, 0xbe, 0xe2, 0x37, 0x22, 0xc7, 0x87, 0xb0, 0x14, 0x9c, 0xf6, 0xa5,
-	0x20, 0xd7, 0xe7, 0xae, 0xd5, 0x37, 0x10, 0x47, 0xff, 0x73, 0x0d, 0xd2, 0x9d, 0x49, 0xc3, 0x19,
-	0x8d, 0x03, 0x72, 0x1b, 0x32, 0x1e, 0x3d, 0x35, 0x15, 0x13, 0x98, 0xf6, 0xe8, 0x69, 0x87, 0x69,
-	0xe1, 0xb7, 0x01, 0x58, 0x97, 0x7b, 0x7a, 0xea, 0x53, 0x7e, 0x0b, 0x96, 0x8d, 0xac, 0x47, 0x4f,
-	0x5b, 0x08, 0x88, 0x1b, 0xc3, 0x65, 0x6e, 0xad, 0x42, 0x63, 0x18, 0x59, 0xf0, 0x14, 0xf6, 0x2c,
-	0xb4, 0xe0, 0xe9, 0x39, 0x16, 0xfc, 0x27, 0xcc, 0xb4, 0xb4, 0xc6, 0x01, 0x5b, 0x5f, 0x44, 0x48,
-	0x8b, 0x11, 0xba, 0x05, 0xe9, 0xc0, 0xe5, 0x73, 0x73, 0x35, 0x91, 0x0a, 0x5c, 0x9c, 0x79, 0x66,
-	0x86, 0xa5, 0x39, 0x33, 0xb4, 0xa0, 0x78, 0x3c, 0x1e, 0x71, 0xcb, 0x6a, 0x05, 0x63, 0x8f, 0xd9,
-	0x89, 0xdc, 0x68, 0x7c, 0x32, 0xb0, 0x7b, 0xe6, 0x05, 0xbd, 0x62, 0x0e, 0x49, 0x72, 0x2b, 0x6f,
-	0x00, 0x07, 0x1d, 0xd0, 0x2b, 0x9f, 0x19, 0x4f, 0x5f, 0x62, 0x8b, 0x29, 0x23, 0x80, 0xfe, 0xaf,
-	0x29, 0xc8, 0x29, 0x96, 0x65, 0xae, 0x57, 0xb1, 0x58, 0xb3, 0x6d, 0x41, 0x36, 0x98, 0x98, 0x36,
-	0xdb, 0x10, 0xb9, 0x83, 0x39, 0x6e, 0x59, 0x70, 0x93, 0x8c, 0x4c, 0xc0, 0x7f, 0xf8, 0xe4, 0x7d,
-	0x80, 0x60, 0x62, 0xba, 0x28, 0x1b, 0x66, 0x01, 0x14, 0x23, 0xc4, 0x05, 0x66, 0x64, 0x03, 0xf1,
-	0xcb, 0x0f, 0x2d, 0x7a, 0x4a, 0xb1, 0xe8, 0x15, 0xc8, 0xf4, 0x5c, 0xdb, 0x39, 0xb1, 0x7c, 0x8a,
-	0xb2, 0xcf, 0x18, 0x61, 0xfb, 0xb7, 0xf2, 0x1a, 0x14, 0x0f, 0x01, 0x62, 0x1e, 0x02, 0x9e, 0xd5,
-	0x71, 0xe0, 0x9e, 0x51, 0xa7, 0x9c, 0xc3, 0x89, 0x64, 0x93, 0xec, 0x40, 0x21, 0x64, 0xd7, 0xa4,
-	0x93, 0xa0, 0x7c, 0x0b, 0xf9, 0x28, 0x2a, 0x2c, 0xd7, 0x27, 0x81, 0x91, 0x93, 0x5c, 0xd7, 0x27,
-	0x01, 0xf9, 0x2e, 0x14, 0x23, 0xc6, 0x71, 0x50, 0x59, 0x51, 0x19, 0x82, 0x65, 0x36, 0x2a, 0x1f,
-	0xf2, 0xcf, 0x86, 0x7d, 0x0c, 0xab, 0xcc, 0x5c, 0x78, 0x56, 0x2f, 0x30, 0x3d, 0xfa, 0xb3, 0x31,
-	0xf5, 0x03, 0xbf, 0x7c, 0x3b, 0xf2, 0x9f, 0x1a, 0xce, 0xa5, 0x7b, 0x41, 0x0d, 0xde, 0x63, 0x94,
-	0x24, 0xae, 0x00, 0xe0, 0xae, 0xdb, 0x8e, 0x1d, 0xd8, 0x56, 0xe0, 0x7a, 0xe5, 0x0a, 0x8a, 0x25,
-	0x02, 0x30, 0x8b, 0x64, 0x8d, 0x83, 0x73, 0xa4, 0x6c, 0x7b, 0xb4, 0xbc, 0x71, 0x2f, 0xb9, 0x95,
-	0x35, 0x72, 0x0c, 0x66, 0x70, 0x10, 0x79, 0x0c, 0x2b, 0x21, 0x3e, 0x3a, 0x76, 0x7e, 0x79, 0x33,
-	0x9a, 0x3e, 0x3c, 0x7f, 0x0d, 0xe7, 0xd4, 0x35, 0x8a, 0x21, 0x26, 0x83, 0xfb, 0xe4, 0x87, 0x40,
-	0x54, 0xf2, 0x62, 0xf8, 0xdb, 0x8b, 0x86, 0x97, 0x94, 0x79, 0x39, 0x81, 0x6f, 0x01, 0xf1, 0x68,
-	0x8f, 0xda, 0x97, 0xb4, 0x6f, 0x46, 0x7b, 0x78, 0x07, 0xf7, 0x70, 0x55, 0xf6, 0x74, 0xc2, 0xbd,
-	0xfc, 0x00, 0x60, 0xc2, 0x6e, 0x05, 0x77, 0x40, 0xef, 0xa2, 0x16, 0x22, 0xa8, 0xca, 0x62, 0x77,
-	0xc5, 0xc8, 0x4e, 0x64, 0x9b, 0xec, 0x40, 0x7e, 0xe8, 0xf6, 0xed, 0xd3, 0x2b, 0x93, 0x3b, 0x19,
-	0xf7, 0x22, 0x47, 0xeb, 0x19, 0xc2, 0xb9, 0x8b, 0x91, 0x1b, 0x46, 0x0d, 0xf2, 0x0e, 0xa4, 0x9f,
-	0xee, 0x99, 0xb6, 0x73, 0xea, 0x96, 0xef, 0x2b, 0x9a, 0x6e, 0x0f, 0x99, 0x48, 0xf1, 0xbf, 0xba,
-	0x0f, 0x70, 0x48, 0xfb, 0x67, 0xd4, 0x7b, 0x46, 0x03, 0x8b, 0x09, 0xda, 0x73, 0xdd, 0xc0, 0x94,
-	0xf7, 0x87, 0x5f, 0xab, 0x1c, 0x83, 0xed, 0x8a, 0x3b, 0x74, 0x17, 0x72, 0x81, 0x3d, 0x32, 0xe3,
-	0x37, 0x0c, 0x02, 0x7b, 0xb4, 0x1b, 0xb9, 0x0f, 0x81, 0x37, 0x76, 0x2e, 0xe2, 0xb1, 0x43, 0x0e,
-	0x61, 0x42, 0x2d, 0xfc, 0x6a, 0x19, 0x32, 0xdd, 0x60, 0xe2, 0xe2, 0x9c, 0xdf, 0x80, 0xe2, 0xc0,
-	0x0a, 0xa8, 0x3f, 0x3d, 0x6b, 0x81, 0x43, 0x25, 0x59, 0x1d, 0x0a, 0xec, 0x17, 0x53, 0x1b, 0xe6,
-	0xc0, 0xf6, 0x03, 0xb4, 0x16, 0x59, 0x23, 0xc7, 0x80, 0x07, 0xf4, 0xea, 0xd0, 0xf6, 0x03, 0xa6,
-	0x49, 0xc7, 0xc1, 0xc4, 0x35, 0x03, 0x37, 0xb0, 0x06, 0x22, 0x70, 0xc8, 0x32, 0x48, 0x87, 0x01,
-	0xd8, 0x9d, 0xb4, 0x2e, 0xcf, 0xf6, 0xe8, 0xc0, 0xba, 0x12, 0xda, 0x2a, 0x6c, 0x93, 0xff, 0x07,
-	0xab, 0x63, 0xa7, 0xe7, 0x3a, 0xa7, 0xb6, 0x37, 0xec, 0x4c, 0xaa, 0x5c, 0x15, 0x72, 0x27, 0x77,
-	0xb6, 0x83, 0x3c, 0x80, 0xe2, 0xd0, 0x9a, 0xf0, 0x05, 0x9b, 0xbe, 0xfd, 0x19, 0xc5, 0xbb, 0x9f,
-	0x34, 0xf2, 0x43, 0x6b, 0xc2, 0x7d, 0x3b, 0xfb, 0x33, 0x4a, 0x7e, 0x97, 0x1d, 0x0b, 0x9f, 0x7a,
-	0x97, 0xc2, 0x99, 0x62, 0x27, 0xde, 0x2f, 0xa7, 0x17, 0xdd, 0x8a, 0x55, 0x89, 0x5c, 0x93, 0xb8,
-	0x8c, 0xc2, 0xa9, 0xeb, 0x9d, 0xd8, 0xfd, 0x3e, 0x75, 0x42, 0x12, 0xa8, 0x36, 0xe6, 0x53, 0x08,
-	0x91, 0x25, 0x09, 0xf2, 0x03, 0xd8, 0x70, 0xe8, 0x4b, 0x53, 0x04, 0x2c, 0xa6, 0x47, 0x7d, 0x77,
-	0xec, 0xf5, 0xa8, 0x29, 0x94, 0x3d, 0xd7, 0x33, 0x65, 0x87, 0xbe, 0x94, 0xb1, 0x8d, 0x40, 0x10,
-	0x8c, 0x7e, 0x04, 0xb7, 0x6c, 0xcf, 0xa3, 0xa8, 0x6b, 0x4e, 0x06, 0x54, 0x71, 0xfa, 0x50, 0x0d,
-	0x25, 0x8d, 0x45, 0xdd, 0xd3, 0x23, 0xdb, 0x03, 0xbb, 0x4f, 0x5f, 0xd8, 0x4e, 0xdf, 0x7d, 0x89,
-	0x6a, 0x6a, 0x6a, 0xa4, 0xd2, 0x4d, 0xb6, 0x20, 0x73, 0x66, 0xf9, 0x47, 0x9e, 0xdd, 0xa3, 0x18,
-	0x24, 0x09, 0xcd, 0xfb, 0x44, 0xc0, 0x8c, 0xb0, 0x97, 0xd4, 0xe0, 0xe6, 0x99, 0xe7, 0x8e, 0x47,
-	0x26, 0x06, 0xdb, 0x91, 0x80, 0x0a, 0x8b, 0x04, 0x44, 0x10, 0x1d, 0x1d, 0x06, 0x29, 0x21, 0xfd,
-	0x33, 0xc8, 0x48, 0xd2, 0xcc, 0x4a, 0xf7, 0x46, 0x63, 0xd3, 0xb3, 0x02, 0xee, 0xa2, 0x24, 0x8d,
-	0x74, 0x6f, 0x34, 0x36, 0xac, 0x00, 0xbb, 0x86, 0x74, 0xc8, 0xbb, 0xb8, 0xa7, 0x9a, 0x1e, 0xd2,
-	0x21, 0x76, 0x6d, 0x40, 0xb6, 0x6f, 0xfb, 0x17, 0xbc, 0x2f, 0x19, 0x06, 0x46, 0x17, 0xb2, 0x73,
-	0x72, 0x4a, 0x29, 0xef, 0x14, 0xa7, 0x8e, 0x01, 0x58, 0xa7, 0xfe, 0x8f, 0xcb, 0x50, 0x88, 0x05,
-	0x09, 0xaa, 0x9e, 0xd7, 0xe2, 0x7a, 0x3e, 0xb4, 0x1a, 0xdc, 0x43, 0x10, 0x56, 0x63, 0x71, 0x00,
-	0x73, 0x1b, 0x32, 0x23, 0x8f, 0x9a, 0xe7, 0x96, 0x7f, 0x8e, 0xf3, 0xe6, 0x8d, 0xf4, 0xc8, 0xa3,
-	0x4f, 0x2d, 0xff, 0x9c, 0x5d, 0x84, 0x91, 0xe7, 0x8e, 0x5c, 0x9f, 0x86, 0x1e, 0x85, 0x6c, 0x33,
-	0x63, 0x86, 0x6a, 0x49, 0x18, 0x33, 0xf6, 0x9b, 0x39, 0x07, 0x22, 0xda, 0x4e, 0x73, 0x1f, 0x60,
-	0x14, 0x46, 0xda, 0x43, 0xea, 0x5d, 0x0c, 0xa8, 0xc9, 0x34, 0x04, 0x9e, 0xcb, 0xbc, 0x01, 0x1c,
-	0x64, 0xb8, 0x6e, 0xa0, 0x38, 0xf7, 0x59, 0xd5, 0xb9, 0x8f, 0xdb, 0x3a, 0x98, 0xb6, 0x75, 0xdf,
-	0x66, 0x1a, 0x24, 0xb4, 0xf1, 0x7e, 0x39, 0xa7, 0x58, 0x20, 0x25, 0xaa, 0x8c, 0x21, 0x31, 0x76,
-	0x83, 0x89, 0xc9, 0x03, 0xf7, 0x3c, 0x97, 0x5c, 0x30, 0xa9, 0xe1, 0x21, 0x8e, 0x96, 0x19, 0x78,
-	0x94, 0x96, 0x0b, 0xdc, 0xe7, 0xe0, 0xa0, 0x8e, 0x47, 0x51, 0x88, 0xbd, 0xb1, 0xd7, 0xa1, 0xde,
-	0xb0, 0x5c, 0x12, 0xbb, 0xce, 0x9b, 0xe4, 0x1e, 0xe4, 0x7a, 0x63, 0x0f, 0xb7, 0xa6, 0x39, 0x1e,
-	0x96, 0x57, 0xb9, 0x2e, 0x53, 0x40, 0xe4, 0x87, 0x00, 0xa7, 0x96, 0x3d, 0x60, 0x9a, 0x7f, 0xe2,
-	0x97, 0x09, 0x2e, 0xf5, 0xde, 0x4c, 0xf0, 0xb7, 0xbd, 0x8f, 0x38, 0x9d, 0x89, 0x5f, 0x77, 0x02,
-	0xef, 0xca, 0xc8, 0x9e, 0xca, 0x36, 0xb9, 0x03, 0x10, 0x58, 0xde, 0x19, 0x0d, 0x76, 0xed, 0xc0,
-	0x2f, 0xdf, 0xc0, 0xa5, 0x2b, 0x10, 0xb2, 0x05, 0xe9, 0x1f, 0x8d, 0xfd, 0xc0, 0x3e, 0xbd, 0x2a,
-	0xdf, 0xc4, 0x73, 0x8d, 0xf6, 0xfb, 0x93, 0xb1, 0xeb, 0x8d, 0x87, 0x35, 0xea, 0x05, 0x86, 0xec,
-	0x66, 0x22, 0xb0, 0x1d, 0x13, 0x15, 0x2d, 0xa6, 0x35, 0x32, 0x46, 0xda, 0x76, 0x3a, 0xac, 0xc9,
-	0x4e, 0xa1, 0x43, 0x27, 0x01, 0x3f, 0x0d, 0x2b, 0x7c, 0xcb, 0x19, 0x80, 0x1d, 0x87, 0xca, 0xf7,
-	0xa1, 0x18, 0x5f, 0x1e, 0x29, 0x41, 0x92, 0xed, 0x36, 0xf7, 0xd2, 0xd9, 0x4f, 0x76, 0xfa, 0x2e,
-	0xad, 0xc1, 0x58, 0x46, 0x34, 0xbc, 0xf1, 0x38, 0xf1, 0x91, 0xa6, 0xff, 0x46, 0x83, 0xcc, 0x6e,
-	0xed, 0x0d, 0x64, 0x28, 0x74, 0x58, 0x1a, 0xd2, 0xc0, 0x12, 0x31, 0x24, 0x72, 0x19, 0x99, 0x26,
-	0x03, 0xfb, 0xa2, 0x28, 0x7b, 0xe9, 0xd5, 0x51, 0x36, 0x53, 0x22, 0x63, 0x61, 0x61, 0x44, 0x44,
-	0x8e, 0x4a, 0x44, 0x5a, 0x1d, 0x23, 0xec, 0x25, 0x0f, 0xa0, 0x70, 0xe2, 0x59, 0x4e, 0xef, 0x5c,
-	0x58, 0x1a, 0x4c, 0xfb, 0x64, 0x8d, 0x38, 0x50, 0x6f, 0x43, 0x6e, 0xb7, 0xd6, 0xb1, 0x47, 0xd7,
-	0xe0, 0xf3, 0x1e, 0xe4, 0x6d, 0x9f, 0x6f, 0x87, 0x19, 0xd8, 0x23, 0x11, 0x24, 0x81, 0xed, 0xe3,
-	0x96, 0x74, 0xec, 0x11, 0x12, 0x65, 0xf4, 0x51, 0x21, 0xbd, 0x2e, 0xd1, 0x1c, 0x32, 0x88, 0x1a,
-	0xcf, 0x97, 0x46, 0x50, 0x01, 0xe9, 0x9f, 0x27, 0x20, 0xd5, 0x1e, 0x51, 0xda, 0xf7, 0xc9, 0x87,
-	0x90, 0x6d, 0x8f, 0x87, 0xbc, 0x81, 0xae, 0x76, 0x6e, 0xe7, 0x36, 0xfa, 0x33, 0x08, 0xd9, 0x0e,
-	0xfb, 0xc4, 0x99, 0x0c, 0xdb, 0xe4, 0x3b, 0x90, 0xd9, 0xed, 0x89, 0x71, 0x3c, 0x2a, 0x2b, 0x2b,
-	0xe3, 0x64, 0x17, 0x1f, 0x16, 0x62, 0xb2, 0x73, 0x14, 0x27, 0xf9, 0x45, 0xe7, 0x48, 0x53, 0xce,
-	0x51, 0xa5, 0x01, 0x85, 0x18, 0xe1, 0x39, 0x83, 0x75, 0x75, 0xb0, 0xd8, 0xd1, 0xdd, 0x1a, 0x1f,
-	0xa3, 0x1e, 0xc9, 0x9f, 0xe3, 0x89, 0xe4, 0xac, 0x7c, 0x1b, 0xd2, 0x82, 0xac, 0x2a, 0x01, 0xd9,
-	0x2d, 0x79, 0xe1, 0xac, 0x48, 0xcc, 0xca, 0x63, 0xc8, 0xab, 0x1d, 0xd7, 0xe1, 0x43, 0xff, 0x0b,
-	0x0d, 0x0a, 0xed, 0x2b, 0x3f, 0xa0, 0xc3, 0xeb, 0x44, 0xee, 0xef, 0x03, 0x9c, 0xf4, 0x7c, 0x53,
-	0xa4, 0x9c, 0x94, 0xac, 0x97, 0xbc, 0x5a, 0x46, 0xf6, 0xa4, 0xa7, 0x10, 0xf4, 0xf9, 0xe6, 0x28,
-	0xf9, 0x16, 0x21, 0x06, 0xd1, 0x83, 0x3a, 0x9e, 0x52, 0xaf, 0xeb, 0x0d, 0x78, 0xfc, 0x92, 0x35,
-	0xc2, 0xb6, 0xee, 0x01, 0x89, 0xad, 0xf0, 0xb5, 0x53, 0x2c, 0xe4, 0x23, 0x28, 0xfa, 0x7c, 0x64,
-	0xb4, 0xd4, 0xf0, 0x22, 0xc6, 0x69, 0x16, 0x7c, 0xb5, 0xa9, 0xef, 0x41, 0xca, 0xb0, 0x5e, 0x76,
-	0xbd, 0xc1, 0xeb, 0xea, 0x08, 0x0f, 0xb1, 0xa5, 0x8e, 0xe0, 0x2d, 0xfd, 0x57, 0x1a, 0x2c, 0xb1,
-	0x3b, 0xbc, 0x30, 0x5e, 0x5d, 0x07, 0x11, 0xa0, 0x4e, 0x85, 0xab, 0x15, 0xc8, 0x04, 0x2e, 0x4f,
-	0x10, 0x0b, 0x43, 0x19, 0xb6, 0x99, 0xfa, 0x17, 0xb1, 0xb8, 0x34, 0x94, 0x32, 0x34, 0xdf, 0x84,
-	0x28, 0x10, 0x47, 0x35, 0xa2, 0x46, 0xe6, 0xfa, 0xbf, 0x6b, 0x90, 0x65, 0x8b, 0xe1, 0x11, 0xfe,
-	0x97, 0x4c, 0x43, 0xca, 0x7c, 0x43, 0x32, 0x9e, 0x6f, 0xd8, 0x84, 0x2c, 0x0f, 0x8e, 0xa3, 0x5c,
-	0x77, 0x04, 0x40, 0x3b, 0xca, 0x5c, 0xdb, 0x26, 0x3b, 0xde, 0x3c, 0xd1, 0x1d, 0x01, 0x18, 0xcf,
-	0x32, 0xad, 0x2d, 0x0c, 0x77, 0x94, 0xe6, 0xae, 0x40, 0xc6, 0xa1, 0xb4, 0x7f, 0xc8, 0x74, 0x69,
-	0x86, 0xc7, 0xa7, 0xb2, 0xad, 0xff, 0x02, 0x80, 0xb1, 0x25, 0x32, 0x03, 0xaf, 0xc3, 0xd7, 0x03,
-	0xae, 0x6d, 0x0f, 0xa5, 0x5f, 0x9e, 0xdb, 0xc9, 0x48, 0x6d, 0x6b, 0x84, 0x3d, 0x4c, 0xd3, 0xe2,
-	0xe2, 0xda, 0x74, 0x40, 0x7b, 0x01, 0xed, 0x0b, 0x5e, 0xe3, 0x40, 0xfd, 0x2f, 0x35, 0x28, 0x36,
-	0xad, 0xc0, 0xbe, 0xa4, 0x35, 0xb7, 0x4f, 0xf7, 0x58, 0x30, 0x4d, 0x60, 0x49, 0xc9, 0x1a, 0x2d,
-	0x49, 0x91, 0x49, 0x47, 0x49, 0xa4, 0x68, 0xa4, 0xa3, 0xb4, 0x0e, 0xa9, 0xbe, 0x7d, 0x46, 0xfd,
-	0x40, 0x6c, 0xb4, 0x68, 0x31, 0xd5, 0x39, 0xf2, 0xe8, 0xe5, 0x73, 0x31, 0x8a, 0x0b, 0x53, 0x05,
-	0x91, 0x2d, 0x58, 0xc1, 0x90, 0xab, 0x3a, 0xb2, 0x25, 0x16, 0xdf, 0xf4, 0x69, 0x30, 0x5b, 0x64,
-	0xfe, 0x85, 0xe5, 0x0f, 0xc3, 0x25, 0xb2, 0x33, 0x34, 0x76, 0x98, 0x0f, 0x23, 0xf3, 0x64, 0xa2,
-	0xc9, 0x33, 0x01, 0xc3, 0x91, 0x3d, 0xa0, 0x9e, 0x7c, 0xd6, 0x91, 0xed, 0x85, 0x4b, 0xbd, 0x0b,
-	0xb9, 0xcb, 0xa1, 0x19, 0x0e, 0x13, 0x6f, 0x1c, 0x97, 0xc3, 0x9a, 0x1c, 0xf8, 0x0e, 0x14, 0xc2,
-	0x78, 0x3b, 0xb8, 0x1a, 0x51, 0xb1, 0xf9, 0x79, 0x09, 0xec, 0x5c, 0x8d, 0xa8, 0x3e, 0x80, 0x52,
-	0x24, 0x48, 0xa1, 0x3a, 0xde, 0x15, 0xb9, 0x0a, 0x2d, 0x8a, 0x3a, 0xe3, 0xc2, 0x16, 0xf9, 0x8b,
-	0xf5, 0x30, 0xfd, 0xcd, 0xdd, 0x4d, 0x99, 0xe6, 0x2e, 0x43, 0xfa, 0x9c, 0x5a, 0x83, 0xe0, 0xfc,
-	0x4a, 0xe4, 0x85, 0x65, 0x53, 0x6f, 0xc3, 0xda, 0xde, 0xc8, 0xf5, 0x6b, 0x96, 0xd3, 0xb7, 0xfb,
-	0x2c, 0x74, 0x13, 0x4e, 0xf7, 0x97, 0xb9, 0x18, 0x7a, 0x1f, 0xd6, 0xa7, 0x89, 0xfa, 0x23, 0xd7,
-	0xf1, 0xe9, 0x6b, 0x51, 0x7d, 0x17, 0x8a, 0xbd, 0x70, 0x24, 0x0b, 0x77, 0x85, 0xbd, 0x9c, 0x82,
-	0xea, 0x1e, 0x54, 0xd8, 0x2c, 0x4d, 0x77, 0x68, 0x3b, 0x56, 0x40, 0x0d, 0xda, 0x73, 0xbd, 0xfe,
-	0x9b, 0x58, 0xff, 0xe2, 0x8b, 0xad, 0xef, 0x41, 0x49, 0x9d, 0x93, 0xad, 0x83, 0x5d, 0xe7, 0x70,
-	0x65, 0xe2, 0x18, 0x45, 0x80, 0x30, 0xd7, 0xc5, 0x67, 0xc0, 0xdf, 0xfa, 0x1f, 0x6a, 0xb0, 0x31,
-	0x77, 0xe9, 0xd7, 0x90, 0xd2, 0xc7, 0xb0, 0xe2, 0xc4, 0x87, 0x8b, 0x3b, 0x7c, 0x93, 0x21, 0x4f,
-	0x2f, 0xd2, 0x98, 0x46, 0xd6, 0x7f, 0x06, 0xb7, 0x43, 0x24, 0xfa, 0xf5, 0x08, 0xaf, 0xa3, 0x6c,
-	0x18, 0xfd, 0xad, 0x98, 0x9e, 0x27, 0x4c, 0x87, 0x1f, 0xb6, 0xe7, 0xee, 0xd7, 0x74, 0x04, 0x3e,
-	0x06, 0xb8, 0x0c, 0xe7, 0xfa, 0x2d, 0x36, 0xff, 0x25, 0xdc, 0x9a, 0x59, 0xef, 0x35, 0x44, 0xf0,
-	0x11, 0xac, 0xb0, 0xe9, 0x99, 0xa1, 0x8b, 0xef, 0x3b, 0xba, 0xde, 0xd1, 0xca, 0x8c, 0x69, 0x34,
-	0xdd, 0x8d, 0x26, 0xee, 0x7f, 0x2d, 0x92, 0xfa, 0x10, 0x72, 0x97, 0xd1, 0x64, 0xe8, 0x7c, 0xb9,
-	0x81, 0x98, 0x83, 0x05, 0x23, 0xac, 0x31, 0x57, 0x44, 0x3f, 0x87, 0xf2, 0xec, 0x4a, 0xaf, 0x21,
-	0xa3, 0xef, 0x41, 0x09, 0x27, 0x9e, 0x15, 0xd2, 0x8a, 0x14, 0x92, 0x80, 0x1b, 0x33, 0x88, 0xba,
-	0xcd, 0xc5, 0x54, 0x3b, 0xa7, 0xbd, 0x0b, 0x83, 0xfa, 0xe3, 0x41, 0xf0, 0x46, 0xc4, 0xc4, 0xf8,
-	0x64, 0xa1, 0x2a, 0xcf, 0x34, 0xe0, 0x6f, 0x3d, 0xe0, 0x7c, 0xc6, 0xa7, 0xba, 0xe6, 0x75, 0x60,
-	0x34, 0x13, 0x11, 0x4d, 0x8c, 0x7d, 0x23, 0x7a, 0x98, 0x2f, 0xcf, 0x1a, 0x2a, 0x48, 0x6f, 0xc1,
-	0x2a, 0x9b, 0x55, 0x3a, 0x91, 0x5f, 0x5e, 0xdd, 0xff, 0x04, 0x88, 0x4a, 0xf0, 0x5a, 0xaa, 0x3e,
-	0x15, 0x73, 0x48, 0x8b, 0x52, 0x77, 0xc5, 0x9f, 0x69, 0xf5, 0x5f, 0x6b, 0x00, 0x11, 0x38, 0xe4,
-	0x5b, 0x53, 0xf8, 0xde, 0x80, 0x2c, 0x4f, 0xec, 0x39, 0x63, 0x29, 0x90, 0xcc, 0x89, 0x0c, 0xf7,
-	0xd5, 0xd4, 0x89, 0xa8, 0x4c, 0x08, 0x53, 0x27, 0xf7, 0x21, 0x2f, 0x7f, 0xe3, 0x58, 0x9e, 0xed,
-	0xc9, 0x49, 0x18, 0x1b, 0x3e, 0x25, 0xd3, 0xe5, 0x59, 0x99, 0xfe, 0x83, 0x06, 0x25, 0x91, 0xb4,
-	0x3a, 0xaa, 0xbd, 0x89, 0xe3, 0xf2, 0x2d, 0xc8, 0x84, 0x19, 0xf9, 0xe4, 0xa2, 0xdc, 0x63, 0x88,
-	0x12, 0xcf, 0xc4, 0x2f, 0x7d, 0x51, 0x26, 0x7e, 0x79, 0x26, 0x13, 0xaf, 0xff, 0x01, 0xac, 0x2a,
-	0xeb, 0xbf, 0xc6, 0x16, 0x2e, 0x62, 0x60, 0x9b, 0x31, 0xc0, 0xe9, 0x88, 0x78, 0x87, 0xa8, 0x0c,
-	0xf0, 0x1e, 0x23, 0xc4, 0xd1, 0xff, 0x36, 0x01, 0x85, 0x18, 0x77, 0x98, 0x00, 0x72, 0xfb, 0xe3,
-	0x01, 0x35, 0x15, 0x37, 0x12, 0x38, 0xa8, 0xc9, 0xa6, 0x50, 0xdd, 0x29, 0x65, 0x05, 0xa1, 0x3b,
-	0x85, 0x48, 0x98, 0x46, 0x0a, 0xce, 0xdd, 0x3e, 0x47, 0x49, 0x0a, 0x2a, 0x08, 0x42, 0x84, 0x47,
-	0xb0, 0x64, 0x79, 0x67, 0xf2, 0xb9, 0x68, 0x63, 0x46, 0xca, 0xdb, 0x55, 0xef, 0x4c, 0x04, 0xcd,
-	0x88, 0x48, 0x1e, 0xc3, 0x4a, 0x98, 0x90, 0x1d, 0xd8, 0x43, 0x3b, 0xf0, 0x51, 0xa0, 0x62, 0x87,
-	0x64, 0x2a, 0xf6, 0x90, 0xf5, 0x18, 0x45, 0x4f, 0x6d, 0xfa, 0x53, 0x2f, 0x7f, 0x61, 0xed, 0x4e,
-	0xe5, 0x43, 0xc8, 0x86, 0xd3, 0x7c, 0x51, 0xdc, 0x9a, 0x57, 0xe3, 0xd6, 0xff, 0x4c, 0x40, 0x31,
-	0x2e, 0x53, 0x76, 0xa9, 0xc4, 0x63, 0x99, 0x36, 0xf7, 0xe5, 0x48, 0xf4, 0x92, 0x6f, 0x42, 0x5a,
-	0x3e, 0x95, 0x25, 0xe6, 0xbf, 0x16, 0xc9, 0x7e, 0x76, 0x7f, 0x94, 0xcd, 0x4c, 0xb2, 0x98, 0x44,
-	0xb6, 0xc9, 0x6d, 0x4c, 0xfc, 0x9a, 0x63, 0x9f, 0xf6, 0xc5, 0xdd, 0x49, 0x9f, 0x59, 0x7e, 0xd7,
-	0xa7, 0xfd, 0xd8, 0x21, 0x5e, 0xfe, 0xe2, 0x43, 0xbc, 0xc3, 0xe2, 0x36, 0x4e, 0xd5, 0x17, 0x65,
-	0x3c, 0xe8, 0xcc, 0xd4, 0xc2, 0x77, 0x27, 0x71, 0x6a, 0x22, 0x34, 0x16, 0x81, 0x8f, 0x65, 0x30,
-	0x27, 0xb3, 0xf4, 0xb1, 0xd7, 0x41, 0xa5, 0x9b, 0x6c, 0x43, 0x6e, 0x1c, 0x86, 0x48, 0x7e, 0x39,
-	0x33, 0xe7, 0x81, 0x50, 0x45, 0xd0, 0x47, 0x00, 0x91, 0xdc, 0xf0, 0xa4, 0x8f, 0x7b, 0x17, 0x34,
-	0x08, 0xdf, 0xc1, 0xb1, 0x25, 0xb7, 0x8b, 0x6f, 0x0d, 0x6e, 0x97, 0xfa, 0x6c, 0x9c, 0x7c, 0xd5,
-	0xb3, 0xf1, 0xd2, 0x74, 0x70, 0xfa, 0x0c, 0x72, 0xca, 0x06, 0x5c, 0x63, 0xca, 0xf0, 0x84, 0x24,
-	0x95, 0x13, 0xa2, 0x57, 0xa1, 0x10, 0x7b, 0x05, 0x63, 0x7a, 0xe2, 0x48, 0xbe, 0xda, 0x4a, 0x77,
-	0x25, 0x04, 0x30, 0xbd, 0x8a, 0x61, 0x27, 0xa7, 0x8b, 0xbf, 0xf5, 0x1f, 0xc3, 0xca, 0x11, 0xf5,
-	0x86, 0xb6, 0xcf, 0x22, 0xa8, 0x67, 0x6e, 0x9f, 0x0e, 0x58, 0x34, 0xe2, 0x8d, 0x07, 0xfc, 0x46,
-	0x16, 0xf9, 0xb5, 0x8e, 0x50, 0x8c, 0xf1, 0x80, 0x1a, 0xd8, 0xcf, 0xd4, 0xa6, 0xd5, 0xeb, 0xd1,
-	0x51, 0xf0, 0x5c, 0xc9, 0xb9, 0xa8, 0x20, 0xfd, 0x36, 0x2c, 0x57, 0x2f, 0xda, 0x9c, 0x21, 0xeb,
-	0x82, 0x1f, 0xd8, 0xac, 0xc1, 0x7e, 0xea, 0x7f, 0xa6, 0x41, 0x0a, 0xfb, 0x7c, 0xb2, 0x05, 0x4b,
-	0x3e, 0x0d, 0x8f, 0x33, 0x1e, 0x09, 0xde, 0xb3, 0xcd, 0xfe, 0x11, 0x57, 0x93, 0x61, 0x90, 0x3b,
-	0x00, 0x74, 0x32, 0x62, 0xce, 0x47, 0x14, 0x61, 0x2a, 0x90, 0xca, 0x2e, 0x64, 0xc3, 0x21, 0x73,
-	0xae, 0xd9, 0xdd, 0x78, 0xa6, 0x2a, 0x1b, 0xce, 0xa4, 0xde, 0xb8, 0x7f, 0xd2, 0x20, 0x59, 0xed,
-	0x0d, 0xc8, 0x3b, 0x90, 0x18, 0x0d, 0x85, 0x62, 0xbc, 0x11, 0x97, 0x01, 0x8a, 0xc9, 0x48, 0x8c,
-	0x86, 0xe4, 0x3b, 0x90, 0xb5, 0x2e, 0xfc, 0x17, 0xb2, 0x54, 0x26, 0xac, 0x3e, 0xa8, 0xf6, 0x06,
-	0xdb, 0x55, 0xd9, 0x21, 0x12, 0x79, 0x21, 0x22, 0xd3, 0xbb, 0x16, 0x32, 0xa8, 0x66, 0x8a, 0x38,
-	0xcb, 0x86, 0xe8, 0xa9, 0x7c, 0x1f, 0x8a, 0x71, 0x02, 0xd7, 0x4a, 0x77, 0xfd, 0x8f, 0x06, 0xd9,
-	0x6a, 0x6f, 0xf0, 0x06, 0xf2, 0xbf, 0x7c, 0x93, 0x99, 0x12, 0x6b, 0x46, 0xfa, 0x55, 0x05, 0x11,
-	0x1d, 0x62, 0x1a, 0x59, 0x98, 0xa7, 0xb8, 0x96, 0xbe, 0x03, 0x8a, 0x4a, 0x96, 0xc5, 0x7f, 0x8a,
-	0x92, 0x66, 0x6e, 0x36, 0x7f, 0xcd, 0xa3, 0x7d, 0x54, 0x9d, 0x19, 0x23, 0x02, 0x90, 0xdb, 0x90,
-	0xb4, 0x7a, 0x03, 0x51, 0xc7, 0x96, 0x16, 0xf2, 0x35, 0x18, 0x4c, 0xff, 0x23, 0x0d, 0xf2, 0x8d,
-	0x3e, 0x75, 0x02, 0x3b, 0xb8, 0xaa, 0x8e, 0x83, 0xf3, 0xf0, 0xa5, 0x44, 0x9b, 0xfb, 0x52, 0x92,
-	0x88, 0xbd, 0x94, 0x10, 0x58, 0x52, 0x8a, 0x19, 0xf1, 0x37, 0xe2, 0x52, 0xea, 0x35, 0xf6, 0x04,
-	0x1f, 0xa2, 0x15, 0x7f, 0x1c, 0x91, 0x49, 0x1d, 0x09, 0xd0, 0xbf, 0x0b, 0x05, 0x75, 0x15, 0x3e,
-	0x79, 0x00, 0x4b, 0xcc, 0xfc, 0x8a, 0x33, 0x5d, 0x42, 0xb5, 0xa8, 0x20, 0x18, 0xd8, 0xab, 0x1f,
-	0x40, 0x21, 0x66, 0x4f, 0xd8, 0x30, 0x4c, 0x1c, 0xf0, 0xab, 0x57, 0x52, 0x0d, 0x4e, 0xe7, 0x6a,
-	0x44, 0x0d, 0xec, 0xc5, 0x52, 0x55, 0x86, 0x2e, 0xfc, 0x20, 0xde, 0xd0, 0x6d, 0x58, 0xad, 0x1e,
-	0xec, 0x84, 0x2f, 0x86, 0x5f, 0xa5, 0xe7, 0xff, 0x53, 0x20, 0xea, 0x54, 0x6f, 0xc0, 0x9d, 0x28,
-	0x47, 0x05, 0x9e, 0xdc, 0xa5, 0x95, 0x4d, 0xdd, 0x83, 0xca, 0x13, 0x1a, 0x88, 0xb9, 0xc2, 0x47,
-	0xd8, 0x37, 0xc5, 0x5f, 0x38, 0xa7, 0xa6, 0xce, 0xf9, 0xb9, 0x06, 0x1b, 0x73, 0x27, 0xbd, 0x06,
-	0xa7, 0x3f, 0x80, 0xb0, 0xa0, 0x62, 0x2a, 0x83, 0x4c, 0x54, 0xa3, 0x27, 0x3c, 0xe1, 0x95, 0x10,
-	0x57, 0x64, 0x66, 0xff, 0x46, 0x83, 0x62, 0x1c, 0x67, 0xd6, 0x1f, 0xd2, 0xe6, 0xdc, 0xb4, 0x39,
-	0xf1, 0x56, 0x58, 0x0a, 0x93, 0x54, 0x4a, 0x61, 0x36, 0x20, 0x6b, 0xfb, 0xe6, 0x89, 0xe5, 0x38,
-	0xc2, 0xae, 0x63, 0xa5, 0xd8, 0x2e, 0xb6, 0x67, 0x0f, 0xfb, 0x74, 0xd5, 0x8b, 0xcc, 0xaa, 0xa5,
-	0x62, 0x59, 0x35, 0xfd, 0x8f, 0x13, 0xb0, 0x79, 0xe4, 0xd1, 0xfa, 0x84, 0xf6, 0x5e, 0xd8, 0xc1,
-	0x39, 0xcf, 0x1e, 0x76, 0x3b, 0xc7, 0xad, 0xaf, 0xf4, 0x38, 0x32, 0x1d, 0x85, 0xd9, 0x4a, 0x51,
-	0x20, 0x20, 0x3c, 0x7c, 0x05, 0xc4, 0x3c, 0x15, 0xa6, 0x09, 0x30, 0xdb, 0x94, 0x52, 0x72, 0xe3,
-	0xb1, 0x12, 0x92, 0x10, 0x25, 0x96, 0x87, 0x4d, 0xc7, 0xf3, 0xb0, 0x64, 0x1b, 0xd2, 0xc2, 0xa3,
-	0x11, 0x4f, 0x58, 0x37, 0x15, 0x9f, 0x27, 0x0c, 0x0e, 0x0c, 0x89, 0xa4, 0xff, 0xbd, 0x06, 0x6f,
-	0x2f, 0x90, 0xc9, 0xd7, 0xef, 0x86, 0x93, 0x6d, 0xee, 0x4f, 0x71, 0x17, 0x44, 0xbc, 0xd7, 0x15,
-	0x65, 0x56, 0x58, 0xf8, 0x48, 0x0a, 0x86, 0x7e, 0x0c, 0xa5, 0x69, 0xf7, 0x4c, 0xc9, 0x42, 0x6a,
-	0xd3, 0x59, 0xc8, 0x21, 0xf5, 0x7d, 0xeb, 0x2c, 0xac, 0xb0, 0x14, 0x4d, 0x76, 0x00, 0x4f, 0xdc,
-	0xbe, 0xcc, 0xf1, 0xe3, 0x6f, 0xfd, 0xaf, 0x34, 0xc8, 0x29, 0x55, 0x32, 0xe4, 0x1b, 0x50, 0xa4,
-	0xa7, 0xa7, 0xb4, 0x17, 0xd8, 0x97, 0x34, 0xaa, 0xc8, 0xcb, 0x1a, 0x85, 0x10, 0xda, 0x11, 0xd5,
-	0xe9, 0x43, 0xcb, 0xbb, 0xa0, 0x7d, 0xf1, 0x72, 0x27, 0x5a, 0xe4, 0x9b, 0x50, 0x8a, 0x86, 0xc7,
-	0x8a, 0x5c, 0x56, 0x42, 0xb8, 0x28, 0x82, 0x78, 0x1b, 0x20, 0xaa, 0x76, 0x8b, 0xa7, 0xef, 0x85,
-	0x97, 0x84, 0x16, 0x84, 0x2b, 0x79, 0xfc, 0xad, 0x7f, 0x02, 0xa2, 0x34, 0x87, 0xe8, 0x50, 0x38,
-	0xef, 0x9b, 0xca, 0x78, 0x51, 0x8d, 0x73, 0xde, 0x8f, 0xfc, 0xac, 0x77, 0xa0, 0xe0, 0x7a, 0xf6,
-	0x99, 0xed, 0x58, 0x03, 0xfe, 0xb6, 0xcb, 0xcd, 0x4e, 0x5e, 0x02, 0x9f, 0x5a, 0xfe, 0xb9, 0xfe,
-	0xcf, 0x09, 0x28, 0x61, 0x2a, 0x1e, 0xf3, 0x12, 0xa2, 0xb0, 0xf3, 0xab, 0xb5, 0xd4, 0xbf, 0x03,
-	0x45, 0x77, 0x44, 0x9d, 0x68, 0xd6, 0xe9, 0x03, 0x20, 0x92, 0x26, 0x53, 0x58, 0xe4, 0x31, 0x94,
-	0xd8, 0x16, 0xd1, 0xbe, 0x32, 0x72, 0x79, 0xee, 0xc8, 0x19, 0x3c, 0x36, 0x96, 0x17, 0x1f, 0x2a,
-	0x63, 0x53, 0xf3, 0xc7, 0x4e, 0xe3, 0x31, 0xcf, 0xa2, 0x6f, 0xfb, 0xa3, 0x81, 0x75, 0x85, 0x25,
-	0x03, 0xb2, 0x5c, 0x52, 0x85, 0xe9, 0x17, 0xfc, 0x59, 0x24, 0x4a, 0xd7, 0xb1, 0xc3, 0x5b, 0x0b,
-	0xdf, 0xa0, 0x44, 0xa9, 0x11, 0x2f, 0x39, 0xb8, 0xc3, 0x0f, 0x7f, 0x55, 0xfd, 0xba, 0x42, 0x81,
-	0x90, 0xbb, 0xb0, 0x64, 0x07, 0x74, 0xa8, 0x16, 0x21, 0x32, 0xda, 0x07, 0xf4, 0xca, 0xc0, 0x0e,
-	0xbd, 0x0d, 0x69, 0x01, 0x50, 0x9f, 0xa7, 0xe4, 0xd3, 0x82, 0x08, 0x01, 0xd6, 0x21, 0xa5, 0x54,
-	0x8d, 0x66, 0x0d, 0xd1, 0x52, 0x62, 0xc3, 0xa4, 0x1a, 0x1b, 0xea, 0x5d, 0xb8, 0xa5, 0x2a, 0xfa,
-	0x3d, 0x2b, 0xb0, 0xde, 0x44, 0xd6, 0xe6, 0x73, 0x0d, 0xca, 0xb3, 0x74, 0xdf, 0x80, 0xca, 0xd9,
-	0x82, 0xa5, 0xbe, 0x15, 0x56, 0x04, 0xdc, 0x9c, 0x36, 0x66, 0x38, 0x0f, 0x62, 0xe8, 0xbf, 0x17,
-	0x29, 0x0f, 0xd9, 0xc3, 0xf6, 0xd4, 0x92, 0x66, 0x55, 0x6e, 0x52, 0xd2, 0x88, 0xc1, 0xc8, 0x83,
-	0xc8, 0xd0, 0xd5, 0xc2, 0xad, 0x4a, 0x1a, 0x71, 0xa0, 0xfe, 0x27, 0x1a, 0xdc, 0x12, 0xb5, 0xc4,
-	0x6f, 0xdc, 0x2d, 0x98, 0x6f, 0x67, 0xa6, 0x6b, 0xf0, 0x97, 0x66, 0x6b, 0xf0, 0x0f, 0x20, 0x2f,
-	0x17, 0x83, 0xaf, 0x6b, 0xdf, 0x83, 0xd0, 0xb2, 0x9b, 0xa1, 0xd2, 0x5c, 0xe4, 0x04, 0x14, 0x7b,
-	0xb1, 0xb6, 0xfe, 0x1f, 0x1a, 0x94, 0x67, 0x39, 0xbc, 0xc6, 0x16, 0x36, 0xd0, 0xad, 0x16, 0x25,
-	0x6e, 0xdc, 0xf9, 0x78, 0x1f, 0xdd, 0xe7, 0x05, 0x44, 0xc3, 0x05, 0xc9, 0xe2, 0x83, 0x70, 0x74,
-	0xa5, 0x19, 0xb9, 0x23, 0x0b, 0xe3, 0xab, 0x77, 0xe3, 0xf1, 0x55, 0x49, 0x65, 0x91, 0x49, 0x43,
-	0x8d, 0x50, 0xfe, 0x4e, 0x83, 0xd5, 0x9a, 0xe7, 0xfa, 0xfe, 0x27, 0x63, 0xea, 0x5d, 0xc9, 0x7d,
-	0x5b, 0x54, 0x8b, 0x1e, 0x73, 0x48, 0x12, 0xd3, 0x0e, 0x49, 0x2c, 0x3b, 0x96, 0xfc, 0xa2, 0xec,
-	0xd8, 0xd2, 0x6c, 0x9d, 0xea, 0xfb, 0xd3, 0x36, 0x7d, 0x4e, 0x1e, 0x23, 0x34, 0xe8, 0xfb, 0x40,
-	0xd4, 0x85, 0x8b, 0xed, 0xf8, 0xff, 0x8a, 0x21, 0xd6, 0x66, 0x6f, 0xc6, 0x9c, 0x8c, 0x18, 0x93,
-	0x28, 0xa3, 0x83, 0x75, 0x26, 0x58, 0xf4, 0x42, 0x14, 0xef, 0x3f, 0x2b, 0x7c, 0xfd, 0x2d, 0x28,
-	0x0d, 0x6d, 0xc7, 0xa4, 0x4e, 0xdf, 0xf5, 0x7c, 0xd7, 0x53, 0xd2, 0x9f, 0xc5, 0xa1, 0xed, 0xd4,
-	0x05, 0xb8, 0x39, 0x1e, 0xea, 0xcf, 0xa1, 0x80, 0xf4, 0x24, 0xec, 0x15, 0x9f, 0x98, 0xdd, 0x82,
-	0xf4, 0x68, 0x7c, 0x62, 0xca, 0x88, 0x28, 0x8b, 0x11, 0x91, 0xb0, 0x7d, 0xe7, 0xae, 0x2f, 0x35,
-	0x14, 0xfe, 0xd6, 0x03, 0xb1, 0x4e, 0xe4, 0x17, 0xd7, 0xf9, 0x01, 0x00, 0xaf, 0xed, 0xc3, 0xca,
-	0x20, 0xe5, 0xd1, 0x32, 0xce, 0x8f, 0x91, 0xed, 0x85, 0xac, 0x3d, 0x82, 0xac, 0x64, 0x41, 0x9e,
-	0xc4, 0xd5, 0x70, 0x84, 0x5c, 0xb1, 0x11, 0xe1, 0xe8, 0xbf, 0xd6, 0xd4, 0x69, 0xd1, 0xf4, 0x3e,
-	0x8a, 0x76, 0x89, 0xcf, 0xb9, 0x16, 0x52, 0x50, 0x0f, 0x51, 0xb8, 0x53, 0x64, 0x47, 0xd9, 0x13,
-	0x7e, 0x24, 0xd7, 0xa7, 0x47, 0xcc, 0x38, 0x48, 0xef, 0xc1, 0x32, 0xaf, 0x34, 0x4e, 0x2e, 0xaa,
-	0x34, 0xe6, 0xfd, 0x7a, 0x1b, 0x0a, 0x72, 0x73, 0xeb, 0x97, 0xd4, 0x09, 0xf8, 0x93, 0xb2, 0xa8,
-	0x75, 0xd4, 0xe4, 0x93, 0xb2, 0x28, 0xf8, 0x94, 0x6f, 0xe5, 0x09, 0xe5, 0xad, 0x7c, 0x8e, 0x53,
-	0xf4, 0xf0, 0xaf, 0x53, 0xb0, 0x32, 0xf5, 0xe9, 0x04, 0xc9, 0x41, 0xba, 0xdd, 0xad, 0xd5, 0xea,
-	0xed, 0x76, 0xe9, 0x2d, 0x52, 0x82, 0x7c, 0xb7, 0x79, 0xd0, 0x6c, 0xbd, 0x30, 0xf9, 0xe7, 0x49,
-	0x1a, 0x21, 0x50, 0xac, 0xb5, 0x9a, 0xcd, 0x7a, 0xad, 0x63, 0x1a, 0xf5, 0xfd, 0x6e, 0xbb, 0x5e,
-	0x4a, 0x90, 0xdb, 0xb0, 0xd6, 0x6c, 0x75, 0xcc, 0x7a, 0xb3, 0xd5, 0x7d, 0xf2, 0xd4, 0x64, 0xce,
-	0xa6, 0x40, 0x4f, 0x12, 0x1d, 0xee, 0xb0, 0xf6, 0xf3, 0x67, 0x66, 0xf5, 0xd0, 0xa8, 0x57, 0xf7,
-	0x3e, 0x35, 0xbb, 0xcd, 0x5a, 0xab, 0xb9, 0xdf, 0x30, 0x9e, 0x09, 0x9c, 0x25, 0x52, 0x81, 0x75,
-	0x81, 0xc3, 0xa8, 0xec, 0xb7, 0xba, 0xcd, 0x3d, 0xd1, 0xb7, 0x4c, 0xee, 0xc1, 0x66, 0xa3, 0x79,
-	0xd4, 0xed, 0x98, 0xad, 0x6e, 0x87, 0xfd, 0xc1, 0x79, 0x3e, 0xe9, 0x56, 0x0f, 0x05, 0x46, 0x8a,
-	0xac, 0x03, 0xe9, 0x1c, 0xcf, 0x8c, 0x4c, 0x93, 0x55, 0x28, 0x74, 0x8e, 0xcd, 0x76, 0xe3, 0x49,
-	0x53, 0x80, 0x32, 0xe4, 0x16, 0xdc, 0xd8, 0x3d, 0x6c, 0xd5, 0x0e, 0x6a, 0x4f, 0xab, 0x8d, 0x26,
-	0x1b, 0xc2, 0xbf, 0xa7, 0xca, 0x32, 0xa6, 0x9e, 0x57, 0x0f, 0x1b, 0x7b, 0xd5, 0x4e, 0x5d, 0x20,
-	0x03, 0xd9, 0x80, 0x5b, 0xb5, 0x6a, 0x93, 0xd1, 0x6d, 0x7f, 0xda, 0xac, 0x99, 0x38, 0x50, 0x74,
-	0xe6, 0x18, 0x25, 0xc9, 0x85, 0xda, 0x91, 0x27, 0x6b, 0xb0, 0x2a, 0x78, 0x39, 0x3a, 0xac, 0x7e,
-	0x2a, 0xc0, 0x05, 0x52, 0x04, 0x78, 0x51, 0x3d, 0x94, 0x68, 0x45, 0x72, 0x03, 0x56, 0x18, 0x65,
-	0x2e, 0x11, 0x0e, 0x5c, 0x61, 0x63, 0x05, 0x31, 0xb6, 0x2c, 0x01, 0x2e, 0x31, 0xf1, 0x18, 0xad,
-	0x56, 0xc7, 0x9c, 0xed, 0x5b, 0x15, 0xcc, 0xef, 0x75, 0x8f, 0x0e, 0x1b, 0xb5, 0x68, 0xf1, 0x37,
-	0xd8, 0x8e, 0xb4, 0xeb, 0xc6, 0xf3, 0x46, 0xad, 0x2e, 0x76, 0x49, 0xca, 0xe5, 0x26, 0x9b, 0xa5,
-	0x73, 0xbc, 0x57, 0xed, 0x54, 0x55, 0xd9, 0xac, 0xb1, 0x9d, 0x66, 0xe2, 0x3a, 0x94, 0x34, 0x6e,
-	0x33, 0x01, 0x74, 0x8e, 0xcd, 0xfd, 0x7a, 0xdd, 0x54, 0x36, 0x97, 0x77, 0x56, 0x18, 0x03, 0xb8,
-	0xcf, 0x0a, 0x8d, 0x4d, 0x72, 0x13, 0x4a, 0x7b, 0x47, 0xad, 0xb6, 0xf9, 0x49, 0xb7, 0x6e, 0x48,
-	0xb6, 0xee, 0x32, 0x59, 0x19, 0x2f, 0xda, 0xf5, 0x8e, 0xd9, 0x68, 0xa2, 0x90, 0x45, 0xc7, 0x7d,
-	0xde, 0x51, 0xad, 0x1d, 0x4e, 0x75, 0xe8, 0xa4, 0x0c, 0x37, 0x9f, 0x54, 0xdb, 0xb3, 0xd3, 0xbe,
-	0x43, 0x36, 0xa1, 0xdc, 0x39, 0x36, 0x9f, 0xd7, 0x8d, 0x76, 0xa3, 0xd5, 0x9c, 0x1a, 0xf7, 0x80,
-	0xdc, 0x87, 0xb7, 0x6b, 0xad, 0x67, 0x47, 0x87, 0x8d, 0x6a, 0xb3, 0x56, 0x37, 0x6b, 0x4f, 0xeb,
-	0xb5, 0x03, 0x24, 0x52, 0x3d, 0x3a, 0x32, 0x5a, 0xcf, 0xeb, 0x7b, 0xa5, 0x6f, 0x30, 0x94, 0x6a,
-	0xad, 0xd6, 0xea, 0x36, 0x3b, 0x66, 0xad, 0xd5, 0xec, 0x18, 0xd5, 0x5a, 0xc7, 0x6c, 0x77, 0xaa,
-	0x9d, 0x6e, 0x5b, 0x50, 0x79, 0x97, 0xc9, 0x8e, 0xcf, 0xd1, 0xd8, 0x67, 0x42, 0x65, 0x13, 0xf1,
-	0xae, 0xad, 0x87, 0x14, 0x56, 0x67, 0xbe, 0x8c, 0x24, 0x79, 0xc8, 0x74, 0x9b, 0x7b, 0xf5, 0xfd,
-	0x46, 0xb3, 0x5e, 0x7a, 0x4b, 0xfd, 0x4e, 0x4f, 0x63, 0x0d, 0x71, 0x4c, 0x4a, 0x09, 0x52, 0x80,
-	0xec, 0x7e, 0xd7, 0xe0, 0x14, 0x4b, 0x49, 0xd6, 0x0c, 0xaf, 0x42, 0x69, 0x89, 0x00, 0xa4, 0xf6,
-	0xab, 0x8d, 0xc3, 0xfa, 0x5e, 0x69, 0xf9, 0xe1, 0x01, 0x40, 0xf4, 0xf1, 0x19, 0xc9, 0xc0, 0x52,
-	0xb3, 0x85, 0xb4, 0x01, 0x52, 0x87, 0xf5, 0xbd, 0x27, 0x75, 0x76, 0x0f, 0xd9, 0xac, 0x9d, 0xe3,
-	0x56, 0xa3, 0xb9, 0xdf, 0x2a, 0x25, 0xd8, 0xf9, 0xe2, 0x5f, 0x0a, 0x62, 0x3b, 0x49, 0xb2, 0xb0,
-	0x7c, 0x54, 0xaf, 0x1b, 0xed, 0xd2, 0xd2, 0xc3, 0xdf, 0x87, 0x62, 0x3c, 0x9d, 0x8a, 0x04, 0xbb,
-	0x87, 0x87, 0xa5, 0xb7, 0xd8, 0xb9, 0xc7, 0x0d, 0xec, 0x3c, 0x35, 0xea, 0xed, 0xa7, 0xad, 0xc3,
-	0xbd, 0x92, 0xc6, 0x48, 0x21, 0xac, 0x7a, 0xd0, 0xae, 0x77, 0xf8, 0xb2, 0xb1, 0x6d, 0x54, 0x3b,
-	0xf5, 0x52, 0x92, 0xcd, 0x8b, 0xcd, 0x76, 0x97, 0xad, 0xba, 0x00, 0xd9, 0x5a, 0xd5, 0x64, 0x47,
-	0xad, 0xce, 0x6e, 0x2b, 0x2a, 0x87, 0x67, 0xcf, 0xba, 0xcd, 0x46, 0xe7, 0x53, 0xf3, 0x79, 0xab,
-	0x53, 0x2f, 0xa5, 0x1e, 0x7e, 0x08, 0x79, 0x35, 0xa7, 0x44, 0xd2, 0x90, 0xac, 0x1d, 0x75, 0x39,
-	0x37, 0xcf, 0xea, 0xcf, 0x5a, 0xc6, 0xa7, 0x25, 0x8d, 0x2d, 0x69, 0xaf, 0xd1, 0x3e, 0x28, 0x25,
-	0xd8, 0xaf, 0xe3, 0xfd, 0x7a, 0xbd, 0x94, 0xdc, 0xf9, 0x2f, 0x02, 0xa9, 0x63, 0x54, 0xe9, 0xa4,
-	0x0b, 0xa5, 0x28, 0x90, 0xdd, 0xbd, 0xc2, 0xc2, 0xfa, 0x82, 0xf4, 0x97, 0x31, 0xa3, 0x5e, 0x99,
-	0x8a, 0x2a, 0x75, 0xfd, 0x97, 0xff, 0xf6, 0xdf, 0x7f, 0x9a, 0xd8, 0xd4, 0x6f, 0x3d, 0xba, 0xfc,
-	0xe0, 0x91, 0x8f, 0x83, 0x4d, 0xfc, 0x2e, 0xe0, 0xe4, 0x0a, 0x8b, 0xf5, 0x1f, 0x6b, 0x0f, 0xc9,
-	0x0f, 0x21, 0x75, 0xe4, 0xfa, 0x41, 0x67, 0x42, 0x62, 0xdf, 0x96, 0x56, 0x56, 0xb8, 0x29, 0x0d,
-	0x3f, 0x3c, 0xd4, 0xd7, 0x91, 0x58, 0x49, 0xcf, 0x31, 0x62, 0x23, 0xd7, 0x0f, 0xcc, 0x60, 0xc2,
-	0x08, 0xec, 0x42, 0x06, 0x15, 0x7b, 0xb5, 0x76, 0xc8, 0xd7, 0x13, 0x26, 0x41, 0x2b, 0xf1, 0xa6,
-	0x5e, 0x46, 0x0a, 0x44, 0x2f, 0x30, 0x0a, 0x3f, 0x63, 0x63, 0x4c, 0xab, 0x37, 0x60, 0x34, 0x4c,
-	0x58, 0x41, 0x1a, 0x4a, 0x58, 0x71, 0x33, 0x1e, 0xaa, 0xf0, 0x60, 0xad, 0x32, 0x17, 0xaa, 0xdf,
-	0x43, 0xc2, 0x15, 0x7d, 0x2d, 0x22, 0x8c, 0x6c, 0x7a, 0x88, 0xc4, 0x26, 0xf8, 0x39, 0xac, 0xe1,
-	0x04, 0x33, 0xbe, 0xf1, 0xc6, 0x5c, 0x5f, 0x9a, 0x1b, 0xb3, 0xca, 0xe6, 0xfc, 0x4e, 0xe1, 0x4c,
-	0xbc, 0x87, 0xb3, 0xde, 0xd7, 0x37, 0xa3, 0x59, 0x63, 0x7e, 0xa7, 0xc9, 0x1c, 0x72, 0x36, 0xf9,
-	0x2f, 0xe0, 0xc6, 0x9c, 0xcc, 0x16, 0xb9, 0x83, 0xc5, 0xfc, 0x0b, 0xf3, 0x6c, 0x95, 0xbb, 0x0b,
-	0xfb, 0xc5, 0x02, 0x1e, 0xe0, 0x02, 0xee, 0xe8, 0xb7, 0xd9, 0x02, 0xce, 0x68, 0x10, 0x7e, 0xdc,
-	0x10, 0xba, 0x90, 0x6c, 0xf6, 0x8f, 0x21, 0x8d, 0xac, 0xcf, 0xec, 0x70, 0xac, 0xa5, 0xdf, 0x42,
-	0x62, 0xab, 0x7a, 0x3e, 0xe2, 0x86, 0xef, 0x6f, 0x13, 0xe0, 0x09, 0x0d, 0xc4, 0xa7, 0x83, 0x64,
-	0x55, 0xf1, 0x65, 0x05, 0x9d, 0x59, 0x90, 0x5e, 0x41, 0x62, 0x37, 0xf5, 0x15, 0xb9, 0x32, 0xf1,
-	0xad, 0x24, 0xa3, 0x67, 0x43, 0x29, 0xa2, 0x27, 0x3f, 0xae, 0x54, 0x48, 0xc4, 0x3e, 0x52, 0xac,
-	0x2c, 0xec, 0xd1, 0xef, 0xe3, 0x1c, 0x1b, 0xfa, 0xfa, 0xd4, 0x1c, 0x66, 0x1f, 0x69, 0xb2, 0xa9,
-	0x7e, 0x8c, 0x53, 0xf1, 0x2f, 0x12, 0xaf, 0xc7, 0xc0, 0x0c, 0x71, 0xf1, 0x89, 0x9f, 0xc2, 0xc7,
-	0xf7, 0x21, 0xc3, 0xf8, 0xc0, 0x44, 0x4a, 0x2e, 0xfc, 0x26, 0xba, 0xb1, 0x57, 0xc9, 0x86, 0x8d,
-	0xf8, 0x89, 0xc7, 0x35, 0x32, 0x30, 0x1b, 0x6d, 0x70, 0x29, 0xb0, 0xe6, 0xee, 0x95, 0x48, 0x92,
-	0xac, 0x84, 0x03, 0x39, 0x40, 0xa5, 0x14, 0xbb, 0xca, 0x21, 0x25, 0x76, 0x91, 0x79, 0xe2, 0x85,
-	0xef, 0xd4, 0x0d, 0x49, 0x13, 0xfd, 0x19, 0xa9, 0x9b, 0xd5, 0xea, 0xd9, 0x4a, 0xac, 0xa5, 0x6f,
-	0x20, 0xd9, 0x35, 0xbd, 0x14, 0x92, 0xed, 0xf1, 0x90, 0x89, 0xd1, 0x6b, 0x40, 0x31, 0x46, 0x4f,
-	0x90, 0x92, 0x9f, 0x16, 0x57, 0xa2, 0xf5, 0xf2, 0x6e, 0xc9, 0x2e, 0x51, 0xa8, 0xf1, 0x5a, 0x6c,
-	0xd2, 0x85, 0x95, 0x27, 0x34, 0xe0, 0x75, 0xb1, 0xea, 0xb2, 0x42, 0x5a, 0xeb, 0xb3, 0x75, 0xb3,
-	0xa8, 0x75, 0x36, 0x91, 0xe4, 0xba, 0xbe, 0x2a, 0x49, 0xfa, 0x57, 0x7e, 0xb4, 0xc2, 0xf7, 0x20,
-	0xfb, 0x84, 0x06, 0x4d, 0x1a, 0x74, 0x8d, 0xc3, 0x29, 0x82, 0x18, 0x9b, 0xf1, 0x42, 0x5b, 0xfd,
-	0x2d, 0x72, 0x00, 0x10, 0x29, 0xcf, 0x2f, 0x52, 0x9b, 0x77, 0x70, 0xce, 0xb2, 0x7e, 0x63, 0x4a,
-	0x6d, 0xfa, 0xe6, 0xe5, 0x0e, 0x9b, 0xf5, 0x73, 0x0d, 0xd6, 0xe6, 0xa6, 0x17, 0x09, 0x7e, 0xef,
-	0xf0, 0xaa, 0x6c, 0x6c, 0xe5, 0xfe, 0x2b, 0x30, 0xc4, 0xb5, 0x8e, 0x6d, 0xf5, 0xc8, 0xa3, 0x74,
-	0x42, 0x7b, 0xa6, 0xb2, 0x0c, 0xb6, 0x84, 0x27, 0x50, 0x8c, 0x97, 0x03, 0x92, 0xdb, 0xb2, 0xce,
-	0x63, 0xa6, 0xee, 0xb0, 0x52, 0x99, 0xd7, 0x25, 0x7c, 0xef, 0xe7, 0x70, 0x63, 0x4e, 0xd9, 0x1c,
-	0xd7, 0x4d, 0x8b, 0x4b, 0x01, 0xb9, 0x6e, 0x7a, 0x55, 0xbd, 0x5d, 0x9b, 0x17, 0xb0, 0xc4, 0x0b,
-	0xd3, 0xc8, 0xdb, 0xb1, 0x61, 0xd3, 0x35, 0x72, 0x95, 0x3b, 0x8b, 0xba, 0x05, 0xd1, 0x1f, 0xc1,
-	0xca, 0x54, 0x9d, 0x17, 0x09, 0x79, 0x9b, 0x2d, 0x56, 0xab, 0x6c, 0xcc, 0xed, 0x13, 0xb4, 0x9e,
-	0xf1, 0xb2, 0x43, 0xb5, 0x20, 0x8a, 0xc4, 0x06, 0x4c, 0x15, 0x74, 0x71, 0x63, 0xb0, 0xb0, 0x86,
-	0x4a, 0x90, 0x53, 0xeb, 0x8e, 0x22, 0x72, 0x73, 0x0a, 0x9f, 0x22, 0x72, 0x73, 0x4b, 0x95, 0xbe,
-	0x17, 0x2b, 0xce, 0x59, 0x9b, 0xaa, 0xe1, 0x11, 0x24, 0xd6, 0xa7, 0xc1, 0x62, 0xb0, 0x85, 0xf7,
-	0x56, 0x98, 0x8d, 0xdd, 0xab, 0xea, 0x01, 0x27, 0x30, 0xf3, 0x52, 0xc5, 0x09, 0xcc, 0xbe, 0x2a,
-	0xc5, 0xed, 0xa9, 0x6a, 0x58, 0x4e, 0xae, 0x4c, 0x0b, 0xd5, 0xd7, 0x25, 0x37, 0x69, 0x53, 0x39,
-	0x0d, 0xce, 0xf1, 0x82, 0x04, 0x11, 0xe7, 0x78, 0x51, 0x1a, 0x64, 0x8e, 0x31, 0xe3, 0x98, 0x71,
-	0x63, 0xd6, 0x84, 0xb4, 0xb8, 0x3c, 0x64, 0xee, 0x1b, 0x40, 0x65, 0x6d, 0x0a, 0x2a, 0xa8, 0xc7,
-	0x9d, 0x17, 0x7e, 0xa7, 0x1e, 0x6b, 0x0f, 0x4f, 0x52, 0xf8, 0x5f, 0xd2, 0x7c, 0xfb, 0xff, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0xd8, 0xc2, 0xbd, 0x8e, 0xd6, 0x46, 0x00, 0x00,
+	// 6841 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7c, 0x4b, 0x6c, 0x23, 0x49,
+	0x72, 0xe8, 0x14, 0x29, 0xf1, 0x13, 0xfc, 0x88, 0xaa, 0x96, 0xd4, 0x6c, 0xb6, 0xa6, 0x5b, 0x5d,
+	0xf3, 0xd3, 0xcc, 0xbc, 0x55, 0xbf, 0xe9, 0xdd, 0x7d, 0x33, 0x6f, 0x76, 0x77, 0xf6, 0x51, 0x14,
+	0xbb, 0x9b, 0x2b, 0x35, 0xa9, 0x29, 0x92, 0x3d, 0x3d, 0xd8, 0x87, 0x57, 0x5b, 0x22, 0x93, 0x52,
+	0xad, 0xc8, 0x2a, 0x6e, 0x55, 0x51, 0x4d, 0xed, 0x07, 0x6f, 0xde, 0xe2, 0x9d, 0xd6, 0xa7, 0xb5,
+	0x0d, 0xfb, 0x64, 0xc3, 0xf0, 0xd1, 0x86, 0x2f, 0x86, 0x01, 0x03, 0x36, 0xe0, 0x93, 0xe1, 0xa3,
+	0x2f, 0xc6, 0x1e, 0xbc, 0x47, 0x7b, 0x61, 0xc0, 0x80, 0xaf, 0xbe, 0x1b, 0x91, 0xbf, 0xca, 0xe2,
+	0xa7, 0xa7, 0xb5, 0xa3, 0x99, 0x8b, 0xc4, 0x88, 0xc8, 0x8c, 0xcc, 0x88, 0xcc, 0x8c, 0x8c, 0x8c,
+	0x8c, 0x2c, 0xc8, 0x4f, 0x7b, 0x67, 0xb6, 0xe3, 0xee, 0x8d, 0x7d, 0x2f, 0xf4, 0xf4, 0xc4, 0xf8,
+	0xa4, 0xb2, 0x7d, 0xea, 0x79, 0xa7, 0x43, 0x72, 0xdf, 0x1e, 0x3b, 0xf7, 0x6d, 0xd7, 0xf5, 0x42,
+	0x3b, 0x74, 0x3c, 0x37, 0x60, 0x25, 0x2a, 0x25, 0x5a, 0x9c, 0xf4, 0x4f, 0x06, 0x21, 0xc3, 0x18,
+	0x03, 0x48, 0x3d, 0x26, 0x76, 0x9f, 0xf8, 0xfa, 0x06, 0xac, 0x0e, 0xbd, 0x53, 0xa7, 0x5f, 0xd6,
+	0x76, 0xb4, 0xdd, 0xac, 0xc9, 0x00, 0xfd, 0x36, 0x64, 0x07, 0xbe, 0x37, 0xb2, 0x5c, 0xaf, 0x4f,
+	0xca, 0x09, 0x4a, 0xc9, 0x20, 0xa2, 0xe9, 0xf5, 0x89, 0xfe, 0x36, 0xac, 0x12, 0xdf, 0xf7, 0xfc,
+	0x72, 0x72, 0x47, 0xdb, 0x2d, 0x3e, 0xb8, 0xb1, 0x37, 0x3e, 0xd9, 0x7b, 0x56, 0xc3, 0x26, 0xea,
+	0x88, 0xae, 0xbb, 0x93, 0x91, 0xc9, 0x4a, 0x18, 0x03, 0x28, 0x74, 0xa6, 0x07, 0x76, 0x68, 0x57,
+	0x7b, 0x3d, 0x6f, 0xe2, 0x86, 0x7a, 0x19, 0xd2, 0x76, 0xbf, 0xef, 0x93, 0x20, 0xe0, 0x0d, 0x0a,
+	0x50, 0xdf, 0x82, 0x94, 0x3d, 0xc2, 0x32, 0xbc, 0x3d, 0x0e, 0xe9, 0xaf, 0x41, 0x61, 0xe0, 0x7b,
+	0x3f, 0x26, 0xae, 0x75, 0x46, 0x9c, 0xd3, 0xb3, 0x90, 0xb6, 0x9a, 0x34, 0xf3, 0x0c, 0xf9, 0x98,
+	0xe2, 0x8c, 0x7f, 0x4d, 0x40, 0x8a, 0x35, 0xa4, 0x1b, 0x90, 0x3a, 0xa3, 0xa2, 0x95, 0x0b, 0x3b,
+	0xda, 0x6e, 0xee, 0x01, 0x60, 0xf7, 0x98, 0xb0, 0x26, 0xa7, 0xe8, 0x3a, 0xac, 0x84, 0x53, 0x2e,
+	0x73, 0xde, 0xa4, 0xbf, 0xb1, 0xfd, 0x93, 0x9e, 0x6b, 0x8f, 0x84, 0xbc, 0x1c, 0x92, 0xaa, 0xc0,
+	0x7e, 0x96, 0x93, 0x91, 0x2a, 0xaa, 0xfd, 0xbe, 0xaf, 0xdf, 0x85, 0x1c, 0x25, 0x8e, 0x27, 0x27,
+	0xe7, 0xe4, 0xb2, 0xbc, 0x42, 0xc9, 0x80, 0xa8, 0x63, 0x8a, 0x91, 0x05, 0x82, 0x9e, 0x8f, 0x05,
+	0x56, 0xa3, 0x02, 0x6d, 0x8a, 0x41, 0xf6, 0x93, 0x80, 0xf8, 0x56, 0xe0, 0x9c, 0xba, 0xe5, 0x22,
+	0xed, 0x4f, 0x06, 0x11, 0x6d, 0xe7, 0xd4, 0xd5, 0xdf, 0x85, 0xb4, 0xcd, 0x14, 0x57, 0x4e, 0xed,
+	0x24, 0x77, 0x73, 0x0f, 0xd6, 0x51, 0x98, 0x98, 0x46, 0x4d, 0x51, 0x02, 0x47, 0xd2, 0xf5, 0xdc,
+	0x1e, 0x29, 0x67, 0xd8, 0x48, 0x52, 0x40, 0xdf, 0x86, 0x6c, 0xe8, 0x8c, 0x48, 0x10, 0xda, 0xa3,
+	0x71, 0x39, 0x4b, 0x55, 0x17, 0x21, 0x50, 0x11, 0x7d, 0x12, 0xf4, 0xca, 0x79, 0xa6, 0x08, 0xfc,
+	0x8d, 0x43, 0x74, 0x41, 0xfc, 0xc0, 0xf1, 0xdc, 0xf2, 0xda, 0x8e, 0xb6, 0xbb, 0x6a, 0x0a, 0xd0,
+	0xf8, 0x07, 0x0d, 0x32, 0x9d, 0x69, 0x3b, 0xb4, 0xc3, 0x49, 0xa0, 0xe8, 0x59, 0x5b, 0xaa, 0xe7,
+	0x65, 0x3a, 0x15, 0xfa, 0x4f, 0x2a, 0xfa, 0xff, 0x1a, 0xa4, 0x02, 0xca, 0x99, 0x6a, 0xb1, 0xf8,
+	0x60, 0x93, 0x8a, 0xea, 0xdb, 0x6e, 0x60, 0xf7, 0x70, 0x32, 0xb3, 0x66, 0x4d, 0x5e, 0x48, 0xaf,
+	0x40, 0xa6, 0xef, 0x04, 0xa1, 0x8d, 0x02, 0xaf, 0x52, 0xb1, 0x24, 0xac, 0xdf, 0x85, 0x44, 0x38,
+	0x2d, 0xa7, 0x69, 0xb7, 0xd6, 0x66, 0xd8, 0x98, 0x89, 0x70, 0x6a, 0x34, 0x21, 0xb3, 0x6f, 0x87,
+	0xbd, 0xb3, 0xce, 0xf4, 0xe5, 0xe4, 0xb8, 0x03, 0xc9, 0xce, 0x34, 0x28, 0x27, 0xe8, 0x18, 0xe4,
+	0xd9, 0x18, 0xf0, 0xfe, 0x20, 0xc1, 0xf8, 0x4f, 0x0d, 0x56, 0xf7, 0x87, 0x5e, 0xef, 0xfc, 0x0b,
+	0x69, 0xa5, 0x0c, 0xe9, 0x13, 0x64, 0x22, 0x15, 0x23, 0x40, 0x7d, 0x6f, 0x46, 0x37, 0x5b, 0xc8,
+	0x95, 0x36, 0xb8, 0x57, 0xa7, 0xff, 0x66, 0x94, 0xf3, 0x16, 0xac, 0xd2, 0xaa, 0x54, 0x33, 0x7c,
+	0xd6, 0x34, 0xdc, 0x90, 0xf8, 0xae, 0x3d, 0xa4, 0xe5, 0x4d, 0x46, 0x37, 0xbe, 0x03, 0x79, 0x95,
+	0x81, 0x9e, 0x85, 0xd5, 0xba, 0x69, 0xb6, 0xcc, 0xd2, 0x2b, 0xf8, 0xb3, 0x63, 0x76, 0x9b, 0x87,
+	0x25, 0x4d, 0x07, 0x48, 0xed, 0x9b, 0xd5, 0x66, 0xed, 0x71, 0x29, 0xa1, 0xe7, 0x20, 0xdd, 0x6c,
+	0xd5, 0x9f, 0x35, 0xda, 0x9d, 0x52, 0xd2, 0xf8, 0xb9, 0x06, 0x69, 0x5a, 0xbd, 0x71, 0xa0, 0x48,
+	0xbe, 0xf2, 0x12, 0x92, 0x6b, 0xcb, 0x24, 0x4f, 0xc4, 0x25, 0xbf, 0x07, 0x79, 0x97, 0x90, 0xbe,
+	0xd5, 0xf3, 0xdc, 0x90, 0xb8, 0x6c, 0xf1, 0x67, 0xcc, 0x1c, 0xe2, 0x6a, 0x0c, 0x65, 0xfc, 0x0c,
+	0x6e, 0xd0, 0x3e, 0xb0, 0xb6, 0x02, 0x93, 0xfc, 0x68, 0x42, 0x82, 0xf0, 0x0b, 0x8d, 0xc4, 0x16,
+	0xd6, 0x55, 0x8c, 0x0d, 0x87, 0x70, 0xde, 0x06, 0xce, 0x8f, 0x09, 0x95, 0x30, 0x69, 0xd2, 0xdf,
+	0xc6, 0xcf, 0x60, 0x23, 0xde, 0x7c, 0x30, 0xf6, 0xdc, 0x80, 0x7c, 0xa1, 0xf6, 0xdf, 0x86, 0x14,
+	0x55, 0x40, 0x50, 0x4e, 0xee, 0x24, 0x17, 0x0f, 0x20, 0x2f, 0x60, 0xfc, 0x46, 0x83, 0x7c, 0xcd,
+	0x1b, 0x8d, 0xed, 0x5e, 0xf8, 0x65, 0xce, 0x40, 0x39, 0xa3, 0x56, 0x5e, 0x3c, 0xa3, 0xd0, 0xe0,
+	0x05, 0x67, 0x9e, 0x1f, 0x5a, 0xb8, 0xa8, 0x83, 0xf2, 0xea, 0x4e, 0x72, 0x37, 0x65, 0x02, 0x45,
+	0x75, 0x10, 0xa3, 0x7f, 0x03, 0x0a, 0x63, 0x9f, 0x0c, 0x9c, 0xe1, 0x90, 0xf4, 0xad, 0x70, 0x1a,
+	0x70, 0xcb, 0x46, 0xd7, 0xe9, 0xb1, 0x20, 0x74, 0xa6, 0x66, 0x7e, 0x1c, 0x01, 0x81, 0x71, 0x00,
+	0x39, 0x85, 0x88, 0xb6, 0xce, 0x71, 0xfb, 0x64, 0x4a, 0x65, 0x5c, 0x35, 0x19, 0xc0, 0xd7, 0x7d,
+	0x62, 0xf9, 0xba, 0xff, 0x7f, 0x1a, 0xac, 0xd1, 0xde, 0x76, 0xa6, 0xd7, 0x32, 0x4f, 0x96, 0xeb,
+	0xab, 0x0c, 0x69, 0xda, 0x27, 0x82, 0x4b, 0x36, 0x89, 0x46, 0x94, 0x83, 0xc6, 0xef, 0x68, 0x50,
+	0x8a, 0xfa, 0x70, 0x0d, 0x93, 0x65, 0x79, 0x27, 0xee, 0x41, 0x32, 0x9c, 0xb2, 0x0e, 0x2c, 0x50,
+	0x08, 0xd2, 0x0c, 0x1b, 0x72, 0x7c, 0xf6, 0xd2, 0x09, 0x1e, 0xf5, 0x23, 0x79, 0xe5, 0x45, 0x1c,
+	0x2d, 0x9a, 0x84, 0xba, 0x68, 0x8c, 0xf7, 0x20, 0x57, 0xf3, 0x46, 0x23, 0xcf, 0x35, 0xc9, 0x78,
+	0x78, 0xf9, 0x32, 0xa2, 0x1a, 0x3f, 0x80, 0x62, 0x67, 0x7a, 0xec, 0x7b, 0xde, 0xe0, 0x3a, 0x46,
+	0x69, 0xc1, 0x6e, 0x63, 0xfc, 0x42, 0x83, 0x34, 0x6f, 0x22, 0x9a, 0xdb, 0xda, 0xe7, 0xce, 0xed,
+	0x17, 0xcf, 0xaf, 0x68, 0x5a, 0x26, 0xe3, 0xd3, 0x32, 0x37, 0x22, 0xfe, 0xf9, 0x90, 0x58, 0x63,
+	0x3b, 0x3c, 0xa3, 0xc3, 0x91, 0x37, 0x81, 0xa1, 0x8e, 0xed, 0xf0, 0xcc, 0x18, 0xc3, 0x9a, 0x14,
+	0xf7, 0x1a, 0x26, 0xc4, 0x3d, 0x58, 0x1d, 0x23, 0x33, 0x3e, 0x86, 0x39, 0xb6, 0x5f, 0x31, 0xfe,
+	0x8c, 0x62, 0xfc, 0x52, 0x83, 0x75, 0x34, 0xf9, 0xe4, 0xda, 0x94, 0x8c, 0xf8, 0x49, 0xef, 0x9c,
+	0x84, 0xdc, 0x47, 0xe2, 0x90, 0x5e, 0x82, 0xa4, 0xf0, 0x8c, 0xf2, 0x26, 0xfe, 0x54, 0xe6, 0xc9,
+	0x6a, 0x6c, 0x9e, 0xfc, 0x4a, 0x03, 0x88, 0xfa, 0xf4, 0xf2, 0xa3, 0x12, 0xb5, 0x9c, 0x58, 0xd4,
+	0x72, 0x32, 0x6a, 0x79, 0x03, 0x56, 0xc9, 0xd4, 0x09, 0x42, 0xda, 0x9b, 0x8c, 0xc9, 0x00, 0xc4,
+	0x5e, 0xd8, 0xc3, 0x09, 0x73, 0x23, 0xf2, 0x26, 0x03, 0x54, 0x2f, 0x28, 0xc5, 0x1c, 0x55, 0x0e,
+	0xa2, 0xe7, 0x11, 0x38, 0x27, 0x43, 0xc7, 0x3d, 0x0d, 0xca, 0x69, 0x3a, 0x96, 0x12, 0xc6, 0xa9,
+	0x36, 0x24, 0xf6, 0x80, 0xba, 0x60, 0x79, 0x93, 0xfe, 0x36, 0x2e, 0x40, 0x57, 0x55, 0x7d, 0x0d,
+	0x03, 0xfc, 0x7a, 0x7c, 0x80, 0x8b, 0x58, 0x55, 0x69, 0x82, 0x8f, 0x31, 0x2e, 0x22, 0xdf, 0xee,
+	0x91, 0xce, 0xf4, 0xcb, 0x5a, 0x44, 0x36, 0x14, 0x68, 0x0b, 0xd7, 0x22, 0xd4, 0x06, 0xac, 0x86,
+	0xc8, 0x8c, 0xcf, 0x1f, 0x06, 0x18, 0x67, 0x50, 0xea, 0x86, 0x53, 0xef, 0xda, 0xa6, 0xa9, 0x72,
+	0xfe, 0x48, 0xc6, 0xce, 0x1f, 0xc6, 0x1f, 0x6b, 0x90, 0x95, 0x4d, 0xe9, 0xb7, 0x20, 0xe3, 0x93,
+	0x81, 0xa5, 0x9c, 0x12, 0xd2, 0x3e, 0x19, 0xe0, 0x0e, 0xa6, 0xbf, 0x0a, 0x80, 0x24, 0x6f, 0x30,
+	0x08, 0xf8, 0x9c, 0x5b, 0x35, 0xb3, 0x3e, 0x19, 0xb4, 0x28, 0x42, 0x39, 0xc7, 0x30, 0x55, 0x2d,
+	0x3d, 0xc7, 0xac, 0xcc, 0x9f, 0x63, 0x62, 0x73, 0x6b, 0x35, 0x3e, 0xb7, 0x8c, 0x7f, 0xd7, 0x60,
+	0x5d, 0xd1, 0xc5, 0xf5, 0xec, 0x1c, 0x8b, 0x95, 0xf1, 0xf2, 0xdb, 0xfd, 0x1b, 0x90, 0xa2, 0xb3,
+	0x8d, 0x75, 0x37, 0xf7, 0xa0, 0x80, 0x25, 0xa3, 0x5e, 0x72, 0x62, 0x4c, 0xae, 0xd4, 0x92, 0x35,
+	0x93, 0x56, 0xd6, 0x8c, 0x05, 0x19, 0xb6, 0x67, 0x34, 0xdc, 0x97, 0x92, 0xf0, 0x3e, 0xe4, 0x2e,
+	0x1c, 0xf2, 0xdc, 0xf2, 0xc6, 0x68, 0x8b, 0xa9, 0x98, 0x45, 0xb6, 0x2e, 0x9e, 0x3a, 0xe4, 0x79,
+	0x8b, 0x62, 0x4d, 0xb8, 0x90, 0xbf, 0x8d, 0x1f, 0x42, 0xae, 0xe3, 0x9d, 0x13, 0xf7, 0x80, 0x84,
+	0xb6, 0x33, 0x7c, 0xa1, 0x63, 0x6a, 0x0f, 0xe9, 0x21, 0x83, 0xa9, 0x4e, 0x80, 0x57, 0x39, 0x04,
+	0x8f, 0xa1, 0x50, 0x65, 0x7a, 0xbd, 0xc2, 0xd1, 0x49, 0x19, 0x9b, 0x44, 0x7c, 0x6c, 0xee, 0x41,
+	0xf2, 0xa4, 0x27, 0x3c, 0x43, 0xb6, 0x0d, 0x45, 0x92, 0x98, 0x48, 0x33, 0x1a, 0xb0, 0x4e, 0x71,
+	0x0f, 0xe9, 0xdc, 0xe2, 0x32, 0x2a, 0xb2, 0x68, 0x71, 0x59, 0x2a, 0x90, 0x71, 0x02, 0x56, 0x96,
+	0x36, 0x96, 0x31, 0x25, 0x6c, 0x7c, 0xa6, 0x81, 0x3e, 0xc7, 0x2b, 0x58, 0xaa, 0xb0, 0xb7, 0x20,
+	0x19, 0x0e, 0xfa, 0xfc, 0xa4, 0xb4, 0x29, 0x3b, 0xa7, 0x56, 0x36, 0xb1, 0xc4, 0x55, 0xf4, 0xf7,
+	0x99, 0x06, 0x1b, 0x5c, 0x81, 0xfb, 0xac, 0xc7, 0xd7, 0xa2, 0xc7, 0x77, 0x60, 0x25, 0x1c, 0xf4,
+	0x85, 0x22, 0xb7, 0x16, 0xf6, 0x35, 0x30, 0x69, 0x19, 0xe3, 0x8f, 0xa8, 0xbb, 0xd0, 0x70, 0xc7,
+	0x93, 0xf0, 0x0b, 0x98, 0x86, 0x58, 0x28, 0x81, 0xed, 0x36, 0x51, 0x28, 0x21, 0xb2, 0x1b, 0xa9,
+	0x17, 0xdb, 0x8d, 0xf4, 0x82, 0xf8, 0xc7, 0x0f, 0xf0, 0x60, 0xde, 0x9a, 0x84, 0xd8, 0xbf, 0x88,
+	0x91, 0x16, 0x63, 0x74, 0x13, 0xd2, 0xa1, 0xc7, 0xda, 0x66, 0x87, 0xac, 0x54, 0xe8, 0xd1, 0x96,
+	0x5f, 0xc6, 0x32, 0x19, 0x2d, 0x28, 0x3e, 0x9b, 0x8c, 0x59, 0x5c, 0xc2, 0x0e, 0x27, 0x3e, 0x9e,
+	0xb2, 0x73, 0xe3, 0xc9, 0xc9, 0xd0, 0xe9, 0x59, 0xe7, 0xe4, 0x12, 0xc3, 0x39, 0xd4, 0xad, 0x61,
+	0xa8, 0x43, 0x72, 0x19, 0x60, 0xe8, 0x21, 0x10, 0xa5, 0x79, 0x93, 0x11, 0xc2, 0xf8, 0xc7, 0x14,
+	0xe4, 0x14, 0xff, 0x69, 0x61, 0x4c, 0x66, 0xf9, 0xb9, 0x70, 0x17, 0xb2, 0xe1, 0xd4, 0x72, 0x70,
+	0x40, 0xc4, 0x08, 0x72, 0x3f, 0x87, 0x0e, 0x92, 0x99, 0x09, 0xd9, 0x8f, 0x40, 0x7f, 0x17, 0x20,
+	0x9c, 0x5a, 0x1e, 0xd5, 0x8d, 0xf0, 0x85, 0xf9, 0x11, 0x9e, 0x29, 0xcc, 0xcc, 0x86, 0xfc, 0x57,
+	0x20, 0xe3, 0x21, 0x29, 0x25, 0x1e, 0x52, 0x81, 0x4c, 0xcf, 0x73, 0xdc, 0x13, 0x3b, 0x20, 0x54,
+	0xf7, 0x19, 0x53, 0xc2, 0xbf, 0x55, 0xcc, 0x45, 0xf1, 0x2c, 0x20, 0x16, 0x5f, 0x41, 0x8a, 0x3d,
+	0x09, 0xbd, 0x53, 0xe2, 0x96, 0x73, 0xb4, 0x21, 0x01, 0xea, 0x0f, 0xa0, 0x20, 0xc5, 0xb5, 0xc8,
+	0x34, 0x2c, 0xdf, 0xa4, 0x72, 0x14, 0x15, 0x91, 0xeb, 0xd3, 0xd0, 0xcc, 0x09, 0xa9, 0xeb, 0xd3,
+	0x50, 0xff, 0x26, 0x14, 0x23, 0xc1, 0x69, 0xa5, 0xb2, 0x62, 0x32, 0xb8, 0xc8, 0x58, 0x2b, 0x2f,
+	0xe5, 0xc7, 0x6a, 0x1f, 0xc1, 0x3a, 0x1e, 0xb6, 0x7d, 0xbb, 0x17, 0x5a, 0x3e, 0xdb, 0x71, 0x83,
+	0xf2, 0x2d, 0xf5, 0x18, 0x7a, 0xe1, 0x9d, 0x13, 0xbe, 0x17, 0x9b, 0x25, 0x51, 0x96, 0x23, 0xe8,
+	0xa8, 0x3b, 0xae, 0x13, 0x3a, 0x76, 0xe8, 0xf9, 0xe5, 0x0a, 0x55, 0x4b, 0x84, 0xc0, 0xf3, 0xbc,
+	0x3d, 0x09, 0xcf, 0x28, 0x67, 0xc7, 0x27, 0xe5, 0xdb, 0x3b, 0xc9, 0xdd, 0xac, 0x99, 0x43, 0x9c,
+	0xc9, 0x50, 0xfa, 0x87, 0xb0, 0x26, 0xcb, 0xd3, 0xb0, 0x58, 0x50, 0xde, 0x8e, 0x9a, 0x97, 0xf3,
+	0xaf, 0xe1, 0x0e, 0x3c, 0xb3, 0x28, 0x4b, 0x22, 0x3e, 0xd0, 0xbf, 0x0b, 0xba, 0xca, 0x9e, 0x57,
+	0x7f, 0x75, 0x59, 0xf5, 0x92, 0xd2, 0x2e, 0x63, 0xf0, 0x35, 0xd0, 0x7d, 0xd2, 0x23, 0xce, 0x05,
+	0x1e, 0x4e, 0xe5, 0x18, 0xde, 0xa1, 0x63, 0xb8, 0x2e, 0x28, 0x1d, 0x39, 0x96, 0xef, 0x01, 0x4c,
+	0x71, 0x55, 0xd0, 0x86, 0xca, 0x77, 0xa9, 0x15, 0xd2, 0xa9, 0x29, 0x8b, 0xad, 0x15, 0x33, 0x3b,
+	0x15, 0xb0, 0xfe, 0x00, 0xf2, 0x23, 0xaf, 0xef, 0x0c, 0x2e, 0x2d, 0xb6, 0xc3, 0xee, 0x44, 0xc7,
+	0x89, 0x27, 0x14, 0xcf, 0xf6, 0xd7, 0xdc, 0x28, 0x02, 0xf4, 0xd7, 0x20, 0xfd, 0xf8, 0xc0, 0x72,
+	0xdc, 0x81, 0x57, 0xbe, 0xa7, 0x58, 0xba, 0x03, 0x2a, 0x44, 0x8a, 0xfd, 0x37, 0x02, 0x80, 0x23,
+	0xd2, 0x3f, 0x25, 0xfe, 0x13, 0x12, 0xda, 0xa8, 0x68, 0xdf, 0xf3, 0x42, 0x4b, 0xac, 0x1f, 0xb6,
+	0xac, 0x72, 0x88, 0xdb, 0x67, 0x28, 0x5c, 0xc0, 0xa1, 0x33, 0xb6, 0xe2, 0x2b, 0x0c, 0x42, 0x67,
+	0xbc, 0x1f, 0x05, 0x5f, 0x42, 0x7f, 0xe2, 0x9e, 0xc7, 0x23, 0xaf, 0x39, 0x8a, 0xe3, 0x66, 0xe1,
+	0x17, 0xab, 0x90, 0xc1, 0xed, 0x9e, 0xb6, 0xf9, 0x06, 0x14, 0x87, 0x76, 0x48, 0x82, 0xd9, 0x56,
+	0x0b, 0x0c, 0x2b, 0xd8, 0x1a, 0x50, 0xc0, 0x5f, 0x68, 0x36, 0xac, 0x21, 0xba, 0xe3, 0x09, 0x36,
+	0x09, 0x10, 0x79, 0x48, 0x2e, 0x8f, 0xd0, 0x29, 0x7f, 0x15, 0x60, 0x12, 0x4e, 0x3d, 0x2b, 0xf4,
+	0x42, 0x7b, 0xc8, 0xbd, 0x93, 0x2c, 0x62, 0x3a, 0x88, 0xc0, 0x35, 0x69, 0x5f, 0x9c, 0x1e, 0x90,
+	0xa1, 0x7d, 0xc9, 0xad, 0x95, 0x84, 0xf5, 0xff, 0x06, 0xeb, 0x13, 0xb7, 0xe7, 0xb9, 0x03, 0xc7,
+	0x1f, 0x75, 0xa6, 0x55, 0x66, 0x0a, 0xd9, 0x51, 0x63, 0x9e, 0xa0, 0xbf, 0x0e, 0xc5, 0x91, 0x3d,
+	0x65, 0x1d, 0xb6, 0x68, 0x70, 0x27, 0xc5, 0xac, 0xdf, 0xc8, 0x9e, 0xb2, 0xc8, 0x98, 0xf3, 0x63,
+	0xa2, 0xff, 0x2f, 0x9c, 0x16, 0x01, 0xf1, 0x2f, 0x78, 0x28, 0x0a, 0x67, 0x3c, 0xf3, 0xfe, 0x17,
+	0xae, 0x8a, 0x75, 0x51, 0xb8, 0x26, 0xca, 0x22, 0x87, 0x81, 0xe7, 0x9f, 0x38, 0xfd, 0x3e, 0x71,
+	0x25, 0x0b, 0x6a, 0x36, 0x16, 0x73, 0x90, 0x85, 0x05, 0x0b, 0xfd, 0x3b, 0x70, 0xdb, 0x25, 0xcf,
+	0x2d, 0x1e, 0xee, 0xb5, 0x7c, 0x12, 0x78, 0x13, 0xbf, 0x47, 0x2c, 0x6e, 0xec, 0x99, 0x9d, 0x29,
+	0xbb, 0xe4, 0xb9, 0x88, 0x0c, 0xf3, 0x02, 0x5c, 0xd0, 0x0f, 0xe0, 0xa6, 0xe3, 0xfb, 0x84, 0xda,
+	0x9a, 0x93, 0x21, 0x51, 0x4e, 0xfd, 0xd4, 0x0c, 0x25, 0xcd, 0x65, 0xe4, 0xd9, 0x9a, 0xed, 0xa1,
+	0xd3, 0x27, 0x9f, 0x38, 0x6e, 0xdf, 0x7b, 0x5e, 0xce, 0xcd, 0xd7, 0x54, 0xc8, 0xfa, 0x2e, 0x64,
+	0x4e, 0xed, 0xe0, 0xd8, 0x77, 0x7a, 0x84, 0x86, 0x98, 0xb9, 0xe5, 0x7d, 0xc4, 0x71, 0xa6, 0xa4,
+	0xea, 0x35, 0xd8, 0x38, 0xf5, 0xbd, 0xc9, 0xd8, 0xa2, 0x57, 0x15, 0x91, 0x82, 0x0a, 0xcb, 0x14,
+	0xa4, 0xd3, 0xe2, 0xd4, 0x61, 0x10, 0x1a, 0x32, 0xfe, 0x50, 0x83, 0x8c, 0xe0, 0x8d, 0xdb, 0x74,
+	0x6f, 0x3c, 0xb1, 0x7c, 0x3b, 0x64, 0x3e, 0x4a, 0xd2, 0x4c, 0xf7, 0xc6, 0x13, 0xd3, 0x0e, 0x29,
+	0x69, 0x44, 0x46, 0x8c, 0xc4, 0x62, 0x15, 0xe9, 0x11, 0x19, 0x51, 0xd2, 0x6d, 0xc8, 0xf6, 0x9d,
+	0xe0, 0x9c, 0xd1, 0x92, 0x32, 0xae, 0x7c, 0x2e, 0x88, 0xd3, 0x01, 0x21, 0x8c, 0xc8, 0xa7, 0x1d,
+	0x22, 0x04, 0xd1, 0x27, 0x6e, 0xc8, 0x88, 0x3c, 0x22, 0x8d, 0x08, 0x24, 0x1a, 0xbf, 0x4e, 0x41,
+	0x21, 0xe6, 0x3f, 0xab, 0xbb, 0x80, 0x16, 0xdf, 0x05, 0xe4, 0x9e, 0xc2, 0xfc, 0x07, 0x06, 0xbc,
+	0x20, 0xca, 0x73, 0x0b, 0x32, 0x63, 0x9f, 0x58, 0x67, 0x76, 0x70, 0xc6, 0x8f, 0xd9, 0xe9, 0xb1,
+	0x4f, 0x1e, 0xdb, 0xc1, 0x19, 0x2e, 0x93, 0xb1, 0xef, 0x8d, 0xbd, 0x80, 0x48, 0x7f, 0x43, 0xc0,
+	0x2c, 0x96, 0x79, 0xea, 0x8a, 0xad, 0x0e, 0x7f, 0xa3, 0xeb, 0xc0, 0x6f, 0x32, 0x98, 0x33, 0xce,
+	0x21, 0x25, 0x82, 0x81, 0xf6, 0x83, 0x9f, 0x6e, 0x79, 0x04, 0xc3, 0xf4, 0xbc, 0x50, 0x39, 0xd3,
+	0x67, 0x63, 0x01, 0xd3, 0xd8, 0x4e, 0x08, 0xb3, 0x3b, 0xe1, 0xd7, 0xd1, 0xbe, 0x48, 0x0f, 0x20,
+	0x28, 0xe7, 0x16, 0x07, 0xaa, 0x62, 0x85, 0x50, 0xdc, 0x70, 0x6a, 0xb1, 0x4b, 0x91, 0x3c, 0xd3,
+	0x5c, 0x38, 0xad, 0x21, 0xa8, 0x74, 0x33, 0xf4, 0x09, 0x29, 0x17, 0xd4, 0x40, 0x4b, 0xc7, 0x27,
+	0x54, 0x89, 0xbd, 0x89, 0xdf, 0x21, 0xfe, 0xa8, 0x5c, 0xe2, 0x53, 0x82, 0x81, 0xfa, 0x0e, 0xe4,
+	0x7a, 0x13, 0x9f, 0x0e, 0x4d, 0x73, 0x32, 0x2a, 0xaf, 0x33, 0x4b, 0xa7, 0xa0, 0xf4, 0xef, 0x02,
+	0x0c, 0x6c, 0x47, 0x04, 0x2d, 0x75, 0xda, 0xd5, 0x9d, 0xb9, 0x73, 0xd1, 0xde, 0x43, 0x5a, 0xa6,
+	0x33, 0x0d, 0xea, 0x6e, 0xe8, 0x5f, 0x9a, 0xd9, 0x81, 0x80, 0xf5, 0x3b, 0x00, 0xa1, 0xed, 0x9f,
+	0x92, 0x70, 0xdf, 0x09, 0x83, 0xf2, 0x0d, 0xda, 0x75, 0x05, 0xa3, 0xef, 0x42, 0xfa, 0x7b, 0x93,
+	0x20, 0x74, 0x06, 0x97, 0xe5, 0x8d, 0xe8, 0x5c, 0xff, 0xf1, 0xc4, 0xf3, 0x27, 0xa3, 0x1a, 0xf1,
+	0x43, 0x53, 0x90, 0xd1, 0x38, 0x06, 0xa1, 0x1d, 0xf2, 0xd1, 0xd8, 0xe4, 0x9e, 0x15, 0x62, 0xe8,
+	0x60, 0xdc, 0x86, 0xec, 0x85, 0x3f, 0xb0, 0x58, 0x88, 0x60, 0x8b, 0x0d, 0xfb, 0x85, 0x3f, 0x10,
+	0x61, 0x95, 0x35, 0x97, 0x4c, 0x43, 0xeb, 0xc2, 0x1e, 0x3a, 0x7d, 0xdc, 0x38, 0x83, 0xf2, 0x4d,
+	0x5a, 0xa4, 0x88, 0xe8, 0xa7, 0x12, 0x8b, 0xfb, 0x14, 0x8e, 0x94, 0x37, 0x09, 0xad, 0x1e, 0xf1,
+	0xd1, 0x79, 0x88, 0xc2, 0x5e, 0x0c, 0x4f, 0x3b, 0x95, 0x0b, 0x23, 0x00, 0xc7, 0xc6, 0x71, 0x2d,
+	0xba, 0x3f, 0xd0, 0xbb, 0xac, 0x0c, 0x86, 0x3d, 0x3b, 0x08, 0x62, 0xa7, 0x68, 0xbb, 0x74, 0x9a,
+	0xae, 0xb1, 0x4e, 0x21, 0x02, 0xe7, 0x69, 0xe5, 0xdb, 0x50, 0x8c, 0xeb, 0x4d, 0x04, 0x6f, 0xd8,
+	0xe1, 0x42, 0x04, 0x6f, 0x58, 0x98, 0x86, 0xb9, 0xf1, 0x0c, 0xf8, 0x30, 0xf1, 0x81, 0x66, 0xfc,
+	0x7e, 0x02, 0x32, 0xfb, 0xb5, 0x6b, 0xb8, 0x96, 0x32, 0x60, 0x65, 0x44, 0x42, 0x5b, 0x0d, 0xab,
+	0x44, 0x3b, 0xaa, 0x49, 0x69, 0x2f, 0x7f, 0x32, 0xde, 0x85, 0xcc, 0x84, 0x6f, 0x8c, 0xe5, 0xd5,
+	0xc8, 0xf6, 0x89, 0xcd, 0xd2, 0x94, 0x54, 0xfd, 0x75, 0x28, 0x9c, 0xf8, 0xb6, 0xdb, 0x3b, 0xe3,
+	0x1b, 0x24, 0x3d, 0x21, 0x67, 0xcd, 0x38, 0x12, 0x8f, 0xb8, 0xc1, 0xa5, 0xdb, 0xb3, 0xf8, 0x45,
+	0x50, 0x5a, 0x09, 0xfd, 0x5c, 0xba, 0x3d, 0x26, 0xbd, 0x09, 0x81, 0xfc, 0x6d, 0xfc, 0x39, 0xc6,
+	0xd3, 0x24, 0x88, 0x73, 0x1f, 0x89, 0x8e, 0x7b, 0x4a, 0x35, 0x93, 0x31, 0x05, 0x88, 0xdb, 0x7c,
+	0x10, 0xda, 0x7e, 0x68, 0xc5, 0xc2, 0xb7, 0x39, 0x8a, 0xe3, 0x5b, 0xc0, 0x1b, 0x50, 0xec, 0x4d,
+	0x7c, 0x6a, 0xdf, 0x62, 0xbe, 0x40, 0x81, 0x63, 0x79, 0xb1, 0xd7, 0xa0, 0xc0, 0x26, 0xf4, 0xcc,
+	0x49, 0x82, 0x21, 0x79, 0xa1, 0x0d, 0x58, 0x1d, 0x13, 0xe2, 0xb3, 0x88, 0x41, 0xd6, 0x64, 0x80,
+	0xd1, 0x86, 0xdc, 0x7e, 0xad, 0xe3, 0x8c, 0xaf, 0x30, 0x8c, 0x3b, 0x90, 0x77, 0x02, 0x36, 0xdb,
+	0xac, 0xd0, 0x19, 0xf3, 0xa3, 0x2b, 0x38, 0x01, 0x9d, 0x71, 0x1d, 0x67, 0x4c, 0x99, 0xa2, 0xfa,
+	0xe8, 0x36, 0xf1, 0xb2, 0x4c, 0x73, 0x74, 0xfc, 0xe8, 0x3e, 0x14, 0x08, 0xd7, 0x44, 0x41, 0x19,
+	0x9f, 0x25, 0x20, 0xd5, 0x1e, 0x13, 0xd2, 0x0f, 0xf4, 0xf7, 0x21, 0xdb, 0x9e, 0x8c, 0x18, 0x40,
+	0x0f, 0x40, 0xb9, 0x07, 0xb7, 0xe8, 0x88, 0x50, 0xcc, 0x9e, 0xa4, 0x71, 0x5b, 0x20, 0x61, 0xfd,
+	0x1b, 0x90, 0xd9, 0xef, 0xf1, 0x7a, 0xec, 0xac, 0x5c, 0x56, 0xea, 0xed, 0xf7, 0xd4, 0x6a, 0xb2,
+	0x24, 0x2e, 0x93, 0x38, 0xcb, 0xcf, 0x5b, 0x26, 0x9a, 0xb2, 0x4c, 0x2a, 0x0d, 0x28, 0xec, 0xf7,
+	0x5e, 0x5c, 0xd9, 0x50, 0x2b, 0xf3, 0x09, 0xbb, 0x5f, 0x63, 0x75, 0xd4, 0x15, 0xf7, 0x13, 0xc8,
+	0x08, 0xb4, 0xfe, 0x75, 0x48, 0x73, 0xb6, 0xaa, 0x06, 0xf6, 0x6b, 0x71, 0x59, 0x98, 0x28, 0xa2,
+	0x64, 0xe5, 0x43, 0xc8, 0xab, 0x84, 0xab, 0xc8, 0x61, 0xfc, 0x89, 0x06, 0x85, 0xf6, 0x65, 0x10,
+	0x92, 0xd1, 0x55, 0xe2, 0x29, 0xef, 0x02, 0x9c, 0xf4, 0x02, 0xb1, 0x7a, 0x94, 0x9b, 0x5c, 0x61,
+	0x39, 0xcc, 0xec, 0x49, 0x4f, 0x61, 0x18, 0xb0, 0xc1, 0x51, 0xae, 0x41, 0xb8, 0x1a, 0x38, 0x85,
+	0xee, 0xad, 0x84, 0xf8, 0x5d, 0x7f, 0xc8, 0x4e, 0x95, 0x59, 0x53, 0xc2, 0x86, 0x0f, 0x7a, 0xac,
+	0x87, 0x2f, 0x7d, 0xf3, 0xa1, 0x7f, 0x00, 0xc5, 0x80, 0xd5, 0x8c, 0xba, 0x2a, 0xed, 0x4c, 0x9c,
+	0x67, 0x21, 0x50, 0x41, 0xe3, 0x00, 0x52, 0xa6, 0xfd, 0xbc, 0xeb, 0x0f, 0x5f, 0xd6, 0x04, 0xfa,
+	0xb4, 0xb4, 0x30, 0x81, 0x0c, 0x32, 0x9e, 0x03, 0xec, 0xdb, 0xae, 0x4b, 0xfa, 0xc7, 0x84, 0xf8,
+	0x18, 0x32, 0x40, 0x99, 0x2c, 0x99, 0x1e, 0x92, 0x42, 0xb0, 0x41, 0x93, 0x25, 0x7c, 0x62, 0x07,
+	0x9e, 0x2b, 0xab, 0x53, 0x08, 0xad, 0xfc, 0x09, 0xad, 0x6e, 0xd9, 0xc2, 0x44, 0x64, 0x18, 0xa2,
+	0x4a, 0xf7, 0x25, 0x32, 0x1d, 0xe3, 0xb1, 0xcc, 0x16, 0x96, 0x21, 0xc3, 0x10, 0xd5, 0xd0, 0xb0,
+	0xe0, 0x46, 0xd4, 0xf0, 0xd5, 0x2e, 0xc6, 0x5e, 0x17, 0x06, 0x25, 0x11, 0x1d, 0x8a, 0x23, 0x5e,
+	0xc2, 0xc0, 0x3c, 0x83, 0x9b, 0xb5, 0x21, 0xb1, 0xfd, 0x58, 0x2b, 0x2f, 0x1f, 0x50, 0xbe, 0xc5,
+	0x86, 0xdb, 0x72, 0xfa, 0xc2, 0x28, 0xa4, 0x99, 0x2e, 0x02, 0xbc, 0x4b, 0x5a, 0x41, 0xb3, 0xbe,
+	0x34, 0xf2, 0xb2, 0x05, 0x3c, 0xd4, 0x32, 0x13, 0x78, 0xa9, 0x40, 0x26, 0xf4, 0x58, 0xa2, 0x08,
+	0x77, 0xea, 0x24, 0x8c, 0xe6, 0x9a, 0x47, 0x95, 0x84, 0x53, 0xc7, 0x41, 0xf4, 0xa9, 0x64, 0x48,
+	0xa9, 0xbc, 0x3a, 0x13, 0x63, 0x32, 0x7e, 0xc5, 0xc3, 0xd8, 0x2c, 0x56, 0xf5, 0xe5, 0x44, 0x87,
+	0xb7, 0x21, 0xcb, 0xc2, 0x3c, 0x51, 0xce, 0x4b, 0x84, 0x40, 0x2a, 0x3d, 0xb5, 0x35, 0xd1, 0x24,
+	0xb0, 0x84, 0x97, 0x08, 0x81, 0x32, 0x8b, 0xf4, 0x16, 0xee, 0x64, 0x4a, 0x18, 0x69, 0x2e, 0x21,
+	0xfd, 0x23, 0xdc, 0x5e, 0x33, 0x2c, 0xd2, 0x22, 0x60, 0xe3, 0xa7, 0x00, 0x28, 0x16, 0x8f, 0x71,
+	0xbd, 0xdc, 0xb4, 0xa0, 0x5b, 0xec, 0x91, 0x38, 0x61, 0xe6, 0x1e, 0x64, 0xc4, 0x06, 0x6c, 0x4a,
+	0x0a, 0x6e, 0xbe, 0xb4, 0x73, 0x6d, 0x32, 0x24, 0xbd, 0x90, 0xf4, 0xb9, 0xac, 0x71, 0xa4, 0xf1,
+	0xa7, 0x1a, 0x14, 0x9b, 0x76, 0xe8, 0x5c, 0x90, 0x9a, 0xd7, 0x27, 0x07, 0x18, 0x16, 0xd2, 0x61,
+	0x45, 0x89, 0x7f, 0xae, 0x08, 0x95, 0x09, 0xa7, 0x3e, 0x11, 0xbf, 0x34, 0xda, 0x82, 0x54, 0xdf,
+	0x39, 0x25, 0x81, 0xbc, 0x15, 0x60, 0x10, 0x6e, 0x37, 0x63, 0x9f, 0x5c, 0x3c, 0xe5, 0xb5, 0x98,
+	0x32, 0x55, 0x94, 0xbe, 0x0b, 0x6b, 0x34, 0x78, 0x50, 0x1d, 0x3b, 0xa2, 0x14, 0x1b, 0xf4, 0x59,
+	0x34, 0x76, 0x32, 0xff, 0x89, 0x1d, 0x8c, 0x64, 0x17, 0x71, 0x0e, 0x4d, 0xdc, 0xd0, 0x91, 0xbd,
+	0x14, 0x20, 0x8b, 0x69, 0x8d, 0xc6, 0xce, 0x90, 0xf8, 0x22, 0xbd, 0x4b, 0xc0, 0x4b, 0xbb, 0x7a,
+	0x17, 0x72, 0x17, 0x23, 0x4b, 0x56, 0x63, 0x5d, 0x85, 0x8b, 0x51, 0x4d, 0x54, 0x7c, 0x0d, 0x0a,
+	0x32, 0x72, 0x14, 0x5e, 0x8e, 0x09, 0x1f, 0xfc, 0xbc, 0x40, 0x76, 0x2e, 0xc7, 0xc4, 0x18, 0x42,
+	0x29, 0x52, 0x24, 0x37, 0xb7, 0x6f, 0xf2, 0xa8, 0x9b, 0x16, 0xc5, 0x4f, 0xe2, 0xca, 0xe6, 0x91,
+	0xb8, 0x2d, 0x99, 0x06, 0xc3, 0x8e, 0x46, 0x1c, 0x42, 0x39, 0xcf, 0x88, 0x3d, 0x0c, 0xcf, 0x2e,
+	0x79, 0x7e, 0x88, 0x00, 0x8d, 0x36, 0x6c, 0x1e, 0x8c, 0xbd, 0xa0, 0x66, 0xbb, 0x7d, 0xf4, 0x6b,
+	0xc9, 0x75, 0xdc, 0xfa, 0x1b, 0x7d, 0xd8, 0x9a, 0x65, 0x7a, 0x05, 0x6b, 0xf5, 0x26, 0x14, 0x7b,
+	0xb2, 0x26, 0x06, 0x6e, 0xb8, 0x39, 0x99, 0xc1, 0x1a, 0x3e, 0x54, 0xb0, 0x95, 0xa6, 0x37, 0x72,
+	0x5c, 0xf4, 0xec, 0x49, 0xcf, 0xf3, 0xfb, 0xc1, 0x97, 0x7b, 0x07, 0x76, 0x00, 0x25, 0xb5, 0x4d,
+	0xec, 0x07, 0x2e, 0x67, 0xd9, 0x33, 0x3e, 0x8d, 0x22, 0x84, 0x8c, 0xda, 0xb2, 0x16, 0xe8, 0x6f,
+	0xcc, 0xb2, 0xb8, 0xbd, 0xb0, 0xeb, 0x57, 0xd0, 0xd2, 0x47, 0xb0, 0xe6, 0xc6, 0xab, 0xf3, 0x35,
+	0xbc, 0x81, 0x85, 0x67, 0x3b, 0x69, 0xce, 0x16, 0x36, 0x7e, 0x04, 0xb7, 0x64, 0x21, 0xf2, 0xd5,
+	0x28, 0xaf, 0x03, 0x95, 0x45, 0x4d, 0x5e, 0x41, 0xe8, 0x45, 0xca, 0x74, 0xd9, 0x64, 0x7b, 0xea,
+	0x7d, 0x45, 0x53, 0xe0, 0x23, 0x80, 0x0b, 0xd9, 0xd6, 0x6f, 0x31, 0xf8, 0xcf, 0xe1, 0xe6, 0x5c,
+	0x7f, 0xaf, 0xa0, 0x82, 0x0f, 0x60, 0x0d, 0x9b, 0xc7, 0x8d, 0x2e, 0x3e, 0xee, 0x74, 0x57, 0x8f,
+	0x7a, 0x66, 0xce, 0x16, 0x33, 0xbc, 0xa8, 0xe1, 0xfe, 0x57, 0xa2, 0xa9, 0xf7, 0x21, 0x77, 0x11,
+	0x35, 0x46, 0x1d, 0x56, 0x2f, 0xe4, 0x6d, 0x64, 0x4d, 0x06, 0x2c, 0x54, 0xd1, 0x4f, 0xa0, 0x3c,
+	0xdf, 0xd3, 0x2b, 0xe8, 0xe8, 0x5b, 0x50, 0xa2, 0x0d, 0xcf, 0x2b, 0x69, 0x4d, 0x28, 0x89, 0xe3,
+	0xcd, 0xb9, 0x82, 0x86, 0xc3, 0xd4, 0x54, 0x3b, 0x23, 0xbd, 0x73, 0x93, 0x04, 0x93, 0x61, 0x18,
+	0x5c, 0x57, 0x7a, 0x00, 0x86, 0x55, 0x98, 0xcf, 0x47, 0x7f, 0x1b, 0x21, 0x94, 0xe7, 0x9b, 0xba,
+	0xe2, 0x72, 0x40, 0x9e, 0x89, 0x88, 0x27, 0x8d, 0xd3, 0x44, 0xfc, 0xe8, 0xcd, 0x4f, 0xd6, 0x54,
+	0x51, 0x46, 0x0b, 0xd6, 0xb1, 0x55, 0xe1, 0x78, 0x7f, 0x71, 0x73, 0xff, 0x03, 0xd0, 0x55, 0x86,
+	0x57, 0x32, 0xf5, 0xa9, 0x98, 0x13, 0x5f, 0x14, 0xb6, 0x2b, 0x9e, 0xae, 0x89, 0xa9, 0x07, 0x10,
+	0xa1, 0xa5, 0xdc, 0x9a, 0x22, 0x37, 0x3a, 0xd6, 0x34, 0x44, 0xed, 0x4e, 0x84, 0x42, 0x32, 0x27,
+	0x22, 0x34, 0xa5, 0x86, 0xf9, 0x78, 0x86, 0xb2, 0x80, 0xf1, 0x70, 0x2f, 0x7e, 0xd3, 0xba, 0xcc,
+	0xef, 0xce, 0x09, 0x5c, 0x73, 0x32, 0xa7, 0xd3, 0xd5, 0x79, 0x9d, 0xfe, 0x9d, 0x06, 0x25, 0x1e,
+	0x7e, 0x3d, 0xae, 0x5d, 0xc7, 0x74, 0xf9, 0x1a, 0xde, 0xa1, 0xf2, 0xbb, 0xa5, 0xe4, 0xb2, 0x28,
+	0xba, 0x2c, 0x12, 0xbf, 0x53, 0x5a, 0xf9, 0xbc, 0x3b, 0xa5, 0xd5, 0xb9, 0x3b, 0x25, 0xe3, 0xff,
+	0xc2, 0xba, 0xd2, 0xff, 0x6b, 0x48, 0x9d, 0xd8, 0x43, 0x01, 0x18, 0x9f, 0x72, 0x32, 0x72, 0x5b,
+	0x84, 0x00, 0x8c, 0x62, 0xca, 0x32, 0xc6, 0x5f, 0x25, 0xa0, 0x20, 0x88, 0x4c, 0x7d, 0x18, 0xac,
+	0xf4, 0xfa, 0x93, 0x21, 0xb1, 0x14, 0x37, 0x12, 0x18, 0xaa, 0x89, 0x4d, 0xa8, 0xee, 0x94, 0xd2,
+	0x03, 0xe9, 0x4e, 0xd1, 0x42, 0xc8, 0x85, 0x84, 0x67, 0x5e, 0x9f, 0x15, 0x49, 0x72, 0x2e, 0x14,
+	0x45, 0x0b, 0xdc, 0x87, 0x15, 0xdb, 0x3f, 0x15, 0x17, 0x9f, 0xb7, 0xe7, 0xb4, 0xbc, 0x57, 0xf5,
+	0x4f, 0x79, 0xa0, 0x81, 0x16, 0xc4, 0xeb, 0x37, 0x79, 0xb5, 0x30, 0x74, 0x46, 0x18, 0xab, 0x5c,
+	0x8d, 0x46, 0x48, 0x5c, 0x2a, 0x1c, 0x21, 0xc5, 0x2c, 0xfa, 0x2a, 0x18, 0xcc, 0xdc, 0x61, 0xcb,
+	0x1c, 0xfe, 0xca, 0xfb, 0x90, 0x95, 0xcd, 0x7c, 0xde, 0x59, 0x3f, 0xaf, 0x9e, 0xf5, 0x7f, 0x9d,
+	0x80, 0x62, 0x5c, 0xa7, 0xb8, 0xa8, 0xf8, 0xb5, 0xaf, 0xb6, 0xf0, 0x0e, 0x94, 0x53, 0xf5, 0xb7,
+	0x21, 0x2d, 0x2e, 0x7d, 0x13, 0x8b, 0xef, 0x3d, 0x05, 0x1d, 0xd7, 0x8f, 0x32, 0x98, 0x34, 0x3b,
+	0x45, 0xc0, 0x78, 0xee, 0x3b, 0xb5, 0x03, 0x6b, 0x12, 0x90, 0x3e, 0x5f, 0x3b, 0xe9, 0x53, 0x3b,
+	0xe8, 0x06, 0xa4, 0x1f, 0x9b, 0xc4, 0xab, 0x9f, 0x3f, 0x89, 0x1f, 0x40, 0x56, 0x70, 0x15, 0x49,
+	0xaf, 0xd4, 0x99, 0xa9, 0xc9, 0x1b, 0x54, 0x46, 0x34, 0xa3, 0x62, 0x18, 0xb5, 0x98, 0x88, 0xc3,
+	0x9c, 0xb8, 0x6f, 0x8a, 0xdd, 0x73, 0x2b, 0x64, 0x7d, 0x0f, 0x72, 0x13, 0x79, 0x44, 0x0a, 0xca,
+	0x99, 0x05, 0x57, 0xdd, 0x6a, 0x01, 0x63, 0x0c, 0x10, 0xe9, 0x4d, 0x49, 0xa3, 0xd3, 0x16, 0xa5,
+	0xd1, 0x25, 0xa2, 0x34, 0x3a, 0x35, 0x01, 0x22, 0xf9, 0xa2, 0x04, 0x88, 0x95, 0xd9, 0xc3, 0xe9,
+	0x13, 0xc8, 0x29, 0x03, 0x70, 0x85, 0x26, 0xe5, 0x0c, 0x49, 0x2a, 0x33, 0xc4, 0xa8, 0x42, 0x21,
+	0x76, 0x9f, 0x8b, 0x76, 0xe2, 0x58, 0xe4, 0x1f, 0x08, 0x77, 0x45, 0x22, 0xd0, 0xae, 0x62, 0x71,
+	0xce, 0x97, 0xfe, 0x36, 0xbe, 0x0f, 0x6b, 0xc7, 0xc4, 0x1f, 0x39, 0x01, 0x9e, 0xa0, 0x9e, 0x78,
+	0x7d, 0x32, 0xc4, 0xd3, 0x88, 0x3f, 0x19, 0xb2, 0x15, 0x59, 0x64, 0xcb, 0x3a, 0x2a, 0x62, 0x4e,
+	0x86, 0xc4, 0xa4, 0x74, 0x34, 0x9b, 0x76, 0xaf, 0x47, 0xc6, 0xe1, 0x53, 0x39, 0x77, 0x35, 0x53,
+	0x45, 0x19, 0xb7, 0x60, 0xb5, 0x7a, 0xde, 0x66, 0x02, 0xd9, 0xe7, 0x6c, 0xc2, 0x66, 0x4d, 0xfc,
+	0x69, 0xfc, 0x81, 0x06, 0x29, 0x4a, 0xc3, 0xb8, 0xff, 0x4a, 0x40, 0xe4, 0x74, 0xa6, 0x53, 0x82,
+	0x51, 0xf6, 0xf0, 0x0f, 0x5f, 0x9a, 0x58, 0x02, 0x6f, 0x10, 0xc8, 0x74, 0x8c, 0xce, 0x47, 0x74,
+	0xc2, 0x54, 0x30, 0x95, 0x7d, 0xc8, 0xca, 0x2a, 0x0b, 0x96, 0xd9, 0xdd, 0x78, 0x74, 0x2f, 0x2b,
+	0x5b, 0x52, 0x57, 0xdc, 0xbf, 0xe0, 0x1b, 0x0f, 0x67, 0x44, 0xf0, 0xd0, 0xfd, 0xd2, 0xaa, 0xd8,
+	0x45, 0x6f, 0x3d, 0xdc, 0x27, 0x03, 0xcf, 0x27, 0x8f, 0xd5, 0x20, 0xf2, 0x2c, 0x1a, 0x4f, 0x3f,
+	0xae, 0x17, 0x56, 0x07, 0x21, 0xf1, 0x1f, 0xab, 0x81, 0xe4, 0x19, 0xac, 0xbe, 0x07, 0xba, 0xac,
+	0x2a, 0xaf, 0xdb, 0xf9, 0x02, 0x5c, 0x40, 0xc1, 0x4b, 0x5f, 0xc1, 0x21, 0x2a, 0xce, 0x2f, 0x7d,
+	0xe7, 0x08, 0xc6, 0x7f, 0x68, 0x90, 0xac, 0xf6, 0x86, 0xfa, 0x6b, 0x90, 0x18, 0x8f, 0xb8, 0xf5,
+	0xbf, 0x11, 0x97, 0x8e, 0xce, 0x05, 0x33, 0x31, 0x1e, 0xe9, 0xdf, 0x80, 0xac, 0x7d, 0x1e, 0x7c,
+	0x22, 0xc4, 0x92, 0xc9, 0x42, 0xd5, 0xde, 0x70, 0xaf, 0x2a, 0x08, 0x3c, 0xc2, 0x2b, 0x0b, 0xe2,
+	0xe6, 0x62, 0xd3, 0x51, 0x54, 0x43, 0x88, 0x6c, 0x5c, 0x4d, 0x4e, 0xc1, 0x2b, 0x82, 0x90, 0xab,
+	0x9a, 0x5f, 0x27, 0xe4, 0xc5, 0xf5, 0x0a, 0xe2, 0x4c, 0x49, 0xc5, 0xc8, 0x6f, 0xbc, 0xa9, 0x2b,
+	0x45, 0x4c, 0xff, 0x4d, 0x83, 0x6c, 0xb5, 0x37, 0xbc, 0x86, 0x1b, 0x12, 0x36, 0xe7, 0xd1, 0xa6,
+	0x37, 0xa3, 0xed, 0x46, 0x45, 0xe9, 0x06, 0xc4, 0x36, 0x28, 0xbe, 0x5b, 0xc7, 0x70, 0x38, 0x8f,
+	0xa3, 0x1d, 0x4a, 0xbc, 0x89, 0x8a, 0x30, 0xf4, 0xd4, 0xc1, 0xae, 0xe9, 0x49, 0x9f, 0xee, 0x24,
+	0x19, 0x33, 0x42, 0xe8, 0xb7, 0x20, 0x69, 0xf7, 0x86, 0xfc, 0x02, 0x24, 0xcd, 0x47, 0xc2, 0x44,
+	0x9c, 0xf1, 0xff, 0x35, 0xc8, 0x37, 0xfa, 0xc4, 0x0d, 0x9d, 0xf0, 0xb2, 0x3a, 0x09, 0xcf, 0xe4,
+	0x25, 0xa7, 0xb6, 0xf0, 0x92, 0x33, 0x11, 0xbb, 0xe4, 0xd4, 0x61, 0x45, 0x79, 0xe3, 0x45, 0x7f,
+	0xd3, 0xb2, 0x18, 0xe5, 0x3b, 0xe0, 0x72, 0x70, 0x28, 0x7e, 0xaf, 0x29, 0x62, 0x5c, 0x72, 0x7a,
+	0x7d, 0x13, 0x0a, 0x6a, 0x2f, 0x02, 0xfd, 0x75, 0x58, 0x41, 0x6f, 0x84, 0x2f, 0xf1, 0x12, 0xdd,
+	0x25, 0x94, 0x02, 0x26, 0xa5, 0x1a, 0x87, 0x50, 0x88, 0x6d, 0xaf, 0x58, 0x8d, 0xc6, 0x51, 0xd8,
+	0xf2, 0x2b, 0xa9, 0xfb, 0x2f, 0xc6, 0x52, 0x4c, 0x4a, 0xa5, 0x2f, 0xf8, 0xb0, 0x38, 0x5f, 0x72,
+	0x0c, 0x30, 0x1c, 0x58, 0xaf, 0x1e, 0x3e, 0x90, 0xa9, 0x00, 0x5f, 0xe6, 0x41, 0xe8, 0x87, 0xa0,
+	0xab, 0x4d, 0x5d, 0x53, 0x62, 0x2a, 0x63, 0xc7, 0x3d, 0x7c, 0x01, 0x62, 0x54, 0xe4, 0x11, 0x09,
+	0x79, 0x5b, 0x32, 0xbb, 0xe2, 0xba, 0xe4, 0x93, 0x6d, 0x6a, 0x6a, 0x9b, 0x9f, 0x69, 0x70, 0x7b,
+	0x61, 0xa3, 0x57, 0x90, 0xf4, 0x3b, 0x20, 0x33, 0xa5, 0x66, 0x2e, 0x21, 0x74, 0xd5, 0x07, 0xe0,
+	0x07, 0x83, 0x35, 0x59, 0x96, 0x21, 0x8c, 0xbf, 0xd4, 0xa0, 0x18, 0x2f, 0x33, 0xef, 0x1e, 0x6a,
+	0x0b, 0x56, 0xda, 0x82, 0xe3, 0xa7, 0xcc, 0x71, 0x4b, 0x2a, 0x39, 0x6e, 0xb7, 0x21, 0xeb, 0x04,
+	0x16, 0x8b, 0xd4, 0xf3, 0xec, 0xf8, 0x8c, 0x13, 0xb0, 0x50, 0xf9, 0xfc, 0x64, 0x9f, 0x4d, 0x67,
+	0x13, 0x41, 0xc6, 0x54, 0x2c, 0xc8, 0x68, 0xfc, 0x32, 0x01, 0xdb, 0xc7, 0x3e, 0xa9, 0x4f, 0x49,
+	0xef, 0x13, 0x27, 0x3c, 0x63, 0xc1, 0xd4, 0x6e, 0xe7, 0x59, 0xeb, 0x4b, 0x9d, 0x8e, 0x68, 0xa3,
+	0x68, 0xf0, 0x96, 0x67, 0xfe, 0xf0, 0x03, 0x8f, 0x82, 0x42, 0xc7, 0x0d, 0x2d, 0x01, 0x0d, 0xbe,
+	0xa5, 0x94, 0xeb, 0x95, 0x58, 0x6e, 0x98, 0x2c, 0x12, 0x0b, 0x4b, 0xa7, 0xe3, 0x61, 0x69, 0x7d,
+	0x0f, 0xc3, 0xf4, 0x54, 0x1a, 0x7e, 0xc9, 0xbb, 0xa1, 0xb8, 0x80, 0xf2, 0xac, 0x64, 0x8a, 0x42,
+	0xc6, 0xdf, 0x6a, 0xf0, 0xea, 0x12, 0x9d, 0x7c, 0xf5, 0xa7, 0x12, 0x7d, 0x8f, 0xb9, 0x97, 0xcc,
+	0x23, 0xe3, 0x5b, 0x50, 0x51, 0x04, 0xc9, 0x19, 0xd6, 0x54, 0x4a, 0x18, 0xcf, 0xa0, 0x34, 0xeb,
+	0xad, 0x2a, 0x41, 0x59, 0x6d, 0x36, 0x28, 0x3b, 0x22, 0x41, 0x60, 0x9f, 0xca, 0xd4, 0x69, 0x0e,
+	0xe2, 0x04, 0x3c, 0xf1, 0xfa, 0xe2, 0xca, 0x83, 0xfe, 0x36, 0xfe, 0x4c, 0x83, 0x9c, 0x92, 0xfe,
+	0x86, 0x17, 0xce, 0x64, 0x30, 0x20, 0x3d, 0x8c, 0x02, 0x47, 0xa9, 0xb6, 0x59, 0xb3, 0x20, 0xb1,
+	0x1d, 0xfe, 0x68, 0x77, 0x64, 0xfb, 0xe7, 0xa4, 0xcf, 0x2f, 0x7f, 0x39, 0xa4, 0xbf, 0x0d, 0xa5,
+	0xa8, 0x7a, 0xec, 0xc6, 0x7a, 0x4d, 0xe2, 0xb9, 0xa7, 0xf1, 0x2a, 0x40, 0x94, 0xc6, 0x1a, 0xbf,
+	0xcd, 0xe0, 0x4e, 0x23, 0xdd, 0x41, 0x98, 0x91, 0xa7, 0xbf, 0x8d, 0x8f, 0x81, 0xe7, 0xdc, 0x61,
+	0x2a, 0xdb, 0x59, 0xdf, 0x52, 0xea, 0xf3, 0x34, 0xbb, 0xb3, 0x7e, 0xe4, 0x76, 0xbe, 0x06, 0x05,
+	0xcf, 0x77, 0x4e, 0x1d, 0xd7, 0x1e, 0xb2, 0xec, 0x07, 0xb6, 0xed, 0xe4, 0x05, 0x12, 0x33, 0x20,
+	0x8c, 0xbf, 0x4f, 0xb0, 0x87, 0x0e, 0x2c, 0x4c, 0xc3, 0x33, 0xb6, 0xbf, 0xdc, 0x9d, 0xfa, 0x7f,
+	0x40, 0xd1, 0x1b, 0x13, 0x37, 0x6a, 0x75, 0x76, 0x02, 0x30, 0xac, 0x39, 0x53, 0x4a, 0xff, 0x10,
+	0x4a, 0x38, 0x44, 0xa4, 0xaf, 0xd4, 0x5c, 0x5d, 0x58, 0x73, 0xae, 0x1c, 0xd6, 0x65, 0x59, 0xc5,
+	0x4a, 0xdd, 0xd4, 0xe2, 0xba, 0xb3, 0xe5, 0xd0, 0xb3, 0xe8, 0x3b, 0xc1, 0x78, 0x68, 0x5f, 0xd2,
+	0x6c, 0x1f, 0x91, 0x07, 0xad, 0xe2, 0x8c, 0x73, 0x00, 0xa5, 0xc6, 0x36, 0xd0, 0x94, 0xc1, 0x9a,
+	0xbc, 0x92, 0xcb, 0x9a, 0x11, 0x02, 0xbd, 0x10, 0x04, 0xaa, 0xea, 0xa3, 0x73, 0x05, 0xa3, 0xdf,
+	0x85, 0x15, 0x27, 0x24, 0x23, 0x35, 0xbb, 0x18, 0x79, 0x1f, 0x92, 0x4b, 0x93, 0x12, 0x8c, 0x36,
+	0xa4, 0x39, 0x42, 0xbd, 0xad, 0x13, 0x37, 0x2d, 0x0c, 0xc4, 0xf1, 0x51, 0xd2, 0xc1, 0xb3, 0x26,
+	0x87, 0x66, 0x9e, 0x89, 0xc8, 0xa3, 0xb2, 0xd1, 0x85, 0x9b, 0xaa, 0xa1, 0xc7, 0x97, 0xde, 0xd7,
+	0x11, 0xc4, 0xfa, 0x4c, 0x83, 0xf2, 0x3c, 0xdf, 0x6b, 0x30, 0x39, 0xbb, 0xb0, 0xd2, 0xb7, 0x65,
+	0xce, 0xcc, 0xc6, 0xec, 0x66, 0x46, 0xdb, 0xa1, 0x25, 0x8c, 0xff, 0x0d, 0xa5, 0x59, 0x0a, 0x8e,
+	0xa9, 0x2d, 0xb6, 0x55, 0x31, 0x48, 0x49, 0x33, 0x86, 0xc3, 0x1b, 0x3a, 0xb1, 0xa7, 0xd5, 0xe4,
+	0x50, 0x25, 0xcd, 0x38, 0xd2, 0xf8, 0x5d, 0x0d, 0x6e, 0xf2, 0x47, 0x02, 0xd7, 0xee, 0x16, 0x2c,
+	0xde, 0x67, 0x66, 0x9f, 0x26, 0xaf, 0xcc, 0x3f, 0x4d, 0x3e, 0x84, 0xbc, 0xe8, 0x0c, 0xbd, 0x6c,
+	0xfc, 0x16, 0xc8, 0x9d, 0xdd, 0x92, 0x46, 0x73, 0x99, 0x13, 0x50, 0xec, 0xc5, 0x60, 0xe3, 0x9f,
+	0x35, 0x28, 0xcf, 0x4b, 0x78, 0x85, 0x21, 0x6c, 0x50, 0xb7, 0x9a, 0x55, 0xe4, 0xce, 0xc7, 0xbb,
+	0xd4, 0x7d, 0x5e, 0xc2, 0x54, 0x76, 0x48, 0xe4, 0xaf, 0xc8, 0xda, 0x95, 0x26, 0x14, 0xe3, 0xc4,
+	0x05, 0xe7, 0x91, 0x37, 0xe3, 0xc7, 0xcd, 0x92, 0x2a, 0x22, 0x6a, 0x43, 0x3d, 0xa1, 0xfc, 0xb5,
+	0x06, 0xeb, 0x35, 0xdf, 0x0b, 0x82, 0x8f, 0x27, 0xc4, 0xbf, 0x14, 0xe3, 0xb6, 0xec, 0x91, 0x49,
+	0xcc, 0x21, 0x49, 0xcc, 0x3a, 0x24, 0xb1, 0x60, 0x61, 0xf2, 0xf3, 0x82, 0x85, 0x2b, 0xf3, 0x09,
+	0xe8, 0xef, 0xce, 0xee, 0xe9, 0x0b, 0xc2, 0x3a, 0x72, 0x43, 0x7f, 0x08, 0xba, 0xda, 0x71, 0x3e,
+	0x1c, 0xff, 0x5d, 0xd9, 0x88, 0xb5, 0xf9, 0x95, 0xb1, 0x20, 0x40, 0x88, 0x1a, 0x45, 0x3e, 0x34,
+	0x55, 0x89, 0xa6, 0x85, 0xe9, 0x8a, 0xf7, 0x9f, 0xe5, 0xbe, 0xfe, 0x2e, 0x94, 0x46, 0x8e, 0x6b,
+	0x11, 0xb7, 0xef, 0xf9, 0x81, 0xe7, 0x2b, 0xd1, 0xe0, 0xe2, 0xc8, 0x71, 0xeb, 0x1c, 0xdd, 0x9c,
+	0x8c, 0x8c, 0xa7, 0x50, 0xa0, 0xfc, 0x04, 0xee, 0x05, 0x5f, 0xde, 0xc0, 0x2c, 0x8f, 0xc9, 0x89,
+	0x25, 0x4e, 0x44, 0x59, 0x7a, 0x22, 0xe2, 0x7b, 0xdf, 0x99, 0x17, 0x08, 0x0b, 0x45, 0x7f, 0x1b,
+	0x21, 0x14, 0x23, 0x79, 0x69, 0x3f, 0xdf, 0x03, 0x60, 0x49, 0xbb, 0x34, 0x77, 0x4e, 0xb9, 0xc3,
+	0x8d, 0xcb, 0x63, 0x66, 0x7b, 0x52, 0xb4, 0xfb, 0x90, 0x15, 0x22, 0x88, 0x99, 0xb8, 0x2e, 0x6b,
+	0x88, 0x1e, 0x9b, 0x51, 0x19, 0x8c, 0x90, 0x2b, 0xcd, 0xd2, 0xad, 0xf7, 0x7e, 0x34, 0x4a, 0xac,
+	0xcd, 0x4d, 0xc9, 0x41, 0x9d, 0x44, 0x72, 0xa4, 0xf4, 0x07, 0xca, 0x98, 0xb0, 0x29, 0xb9, 0x35,
+	0x5b, 0x63, 0xce, 0x41, 0x7a, 0x0b, 0x56, 0xd9, 0x13, 0x82, 0xe4, 0xb2, 0x27, 0x04, 0x8c, 0x6e,
+	0xb4, 0xa1, 0x20, 0x06, 0xb7, 0x7e, 0x41, 0xdc, 0x90, 0xdd, 0xb0, 0x33, 0x04, 0xd7, 0xb7, 0x84,
+	0x65, 0xea, 0x40, 0x42, 0x49, 0x1d, 0x58, 0xe0, 0x14, 0xbd, 0xf3, 0x17, 0x29, 0x58, 0x9b, 0x79,
+	0x13, 0x85, 0xdf, 0x5f, 0x68, 0x77, 0x6b, 0xb5, 0x7a, 0xbb, 0x5d, 0x7a, 0x45, 0x2f, 0x41, 0xbe,
+	0xdb, 0x3c, 0x6c, 0xb6, 0x3e, 0xb1, 0xd8, 0x57, 0x1b, 0x34, 0x5d, 0x87, 0x62, 0xad, 0xd5, 0x6c,
+	0xd6, 0x6b, 0x1d, 0xcb, 0xac, 0x3f, 0xec, 0xb6, 0xeb, 0xa5, 0x84, 0x7e, 0x0b, 0x36, 0x9b, 0xad,
+	0x8e, 0x55, 0x6f, 0xb6, 0xba, 0x8f, 0x1e, 0x5b, 0xe8, 0x6c, 0xf2, 0xe2, 0x49, 0xdd, 0x80, 0x3b,
+	0x08, 0x3f, 0x7d, 0x62, 0x55, 0x8f, 0xcc, 0x7a, 0xf5, 0xe0, 0x53, 0xab, 0xdb, 0xac, 0xb5, 0x9a,
+	0x0f, 0x1b, 0xe6, 0x13, 0x5e, 0x66, 0x45, 0xaf, 0xc0, 0x16, 0x2f, 0x83, 0x5c, 0x1e, 0xb6, 0xba,
+	0xcd, 0x03, 0x4e, 0x5b, 0xd5, 0x77, 0x60, 0xbb, 0xd1, 0x3c, 0xee, 0x76, 0xac, 0x56, 0xb7, 0x83,
+	0xff, 0x68, 0x3b, 0x1f, 0x77, 0xab, 0x47, 0xbc, 0x44, 0x4a, 0xdf, 0x02, 0xbd, 0xf3, 0x6c, 0xae,
+	0x66, 0x5a, 0x5f, 0x87, 0x42, 0xe7, 0x99, 0xd5, 0x6e, 0x3c, 0x6a, 0x72, 0x54, 0x46, 0xbf, 0x09,
+	0x37, 0xf6, 0x8f, 0x5a, 0xb5, 0xc3, 0xda, 0xe3, 0x6a, 0xa3, 0x89, 0x55, 0xd8, 0x67, 0x26, 0xb2,
+	0x28, 0xd4, 0xd3, 0xea, 0x51, 0xe3, 0xa0, 0xda, 0xa9, 0xf3, 0xc2, 0xa0, 0xdf, 0x86, 0x9b, 0xb5,
+	0x6a, 0x13, 0xf9, 0xb6, 0x3f, 0x6d, 0xd6, 0x2c, 0x5a, 0x91, 0x13, 0x73, 0xc8, 0x49, 0x48, 0xa1,
+	0x12, 0xf2, 0xfa, 0x26, 0xac, 0x73, 0x59, 0x8e, 0x8f, 0xaa, 0x9f, 0x72, 0x74, 0x41, 0x2f, 0x02,
+	0x7c, 0x52, 0x3d, 0x12, 0xc5, 0x8a, 0xfa, 0x0d, 0x58, 0x43, 0xce, 0x4c, 0x23, 0x0c, 0xb9, 0x86,
+	0x75, 0x39, 0x33, 0xec, 0x16, 0x47, 0x97, 0x50, 0x3d, 0x66, 0xab, 0xd5, 0xb1, 0xe6, 0x69, 0xeb,
+	0x5c, 0xf8, 0x83, 0xee, 0xf1, 0x51, 0xa3, 0x16, 0x75, 0xfe, 0x06, 0x8e, 0x48, 0xbb, 0x6e, 0x3e,
+	0x6d, 0xd4, 0xea, 0x7c, 0x94, 0x84, 0x5e, 0x36, 0xb0, 0x95, 0xce, 0xb3, 0x83, 0x6a, 0xa7, 0xaa,
+	0xea, 0x66, 0x13, 0x47, 0x1a, 0xd5, 0x75, 0x24, 0x78, 0xdc, 0x42, 0x05, 0x74, 0x9e, 0x59, 0x0f,
+	0xeb, 0x75, 0x4b, 0x19, 0x5c, 0x46, 0xac, 0xa0, 0x00, 0x74, 0x9c, 0x15, 0x1e, 0xdb, 0xfa, 0x06,
+	0x94, 0x0e, 0x8e, 0x5b, 0x6d, 0xeb, 0xe3, 0x6e, 0xdd, 0x14, 0x62, 0xdd, 0x45, 0x5d, 0x99, 0x9f,
+	0xb4, 0xeb, 0x1d, 0xab, 0xd1, 0xa4, 0x4a, 0xe6, 0x84, 0x7b, 0x8c, 0x50, 0xad, 0x1d, 0xcd, 0x10,
+	0x0c, 0xbd, 0x0c, 0x1b, 0x8f, 0xaa, 0xed, 0xf9, 0x66, 0x5f, 0xd3, 0xb7, 0xa1, 0xdc, 0x79, 0x66,
+	0x3d, 0xad, 0x9b, 0xed, 0x46, 0xab, 0x39, 0x53, 0xef, 0x75, 0xfd, 0x1e, 0xbc, 0x5a, 0x6b, 0x3d,
+	0x39, 0x3e, 0x6a, 0x54, 0x9b, 0xb5, 0xba, 0x55, 0x7b, 0x5c, 0xaf, 0x1d, 0x52, 0x26, 0xd5, 0xe3,
+	0x63, 0xb3, 0xf5, 0xb4, 0x7e, 0x50, 0x7a, 0x03, 0x8b, 0x54, 0x6b, 0xb5, 0x56, 0xb7, 0xd9, 0xb1,
+	0x6a, 0xad, 0x66, 0xc7, 0xac, 0xd6, 0x3a, 0x56, 0xbb, 0x53, 0xed, 0x74, 0xdb, 0x9c, 0xcb, 0x9b,
+	0xa8, 0x3b, 0xd6, 0x46, 0xe3, 0x21, 0x2a, 0x15, 0x1b, 0x62, 0xa4, 0xdd, 0x77, 0x08, 0xac, 0xcf,
+	0x7d, 0x30, 0x46, 0xcf, 0x43, 0xa6, 0xdb, 0x3c, 0xa8, 0x3f, 0x6c, 0x34, 0xeb, 0xa5, 0x57, 0xd4,
+	0xcf, 0x97, 0x68, 0x08, 0xf0, 0x69, 0x52, 0x4a, 0xe8, 0x05, 0xc8, 0x3e, 0xec, 0x9a, 0x8c, 0x63,
+	0x29, 0x89, 0xa0, 0x5c, 0x0a, 0xa5, 0x15, 0xfc, 0x04, 0xca, 0xc3, 0x6a, 0xe3, 0xa8, 0x7e, 0x50,
+	0x5a, 0x7d, 0xe7, 0x10, 0x20, 0x7a, 0x55, 0xaa, 0x67, 0x60, 0xa5, 0xd9, 0xa2, 0xbc, 0x01, 0x52,
+	0x47, 0xf5, 0x83, 0x47, 0x75, 0x5c, 0x87, 0xd8, 0x6a, 0xe7, 0x59, 0xab, 0xd1, 0x7c, 0xd8, 0x2a,
+	0x25, 0x70, 0x7e, 0xb1, 0x0f, 0xa8, 0x50, 0x38, 0x89, 0xdf, 0x56, 0x39, 0xae, 0xd7, 0xcd, 0x76,
+	0x69, 0xe5, 0x9d, 0x5f, 0x6a, 0x50, 0x8c, 0xc7, 0x54, 0x29, 0xc7, 0xee, 0xd1, 0x51, 0xe9, 0x15,
+	0x9c, 0xf8, 0x74, 0x04, 0x3b, 0x8f, 0xcd, 0x7a, 0xfb, 0x71, 0xeb, 0xe8, 0xa0, 0xa4, 0x21, 0x2f,
+	0x8a, 0xab, 0x1e, 0xb6, 0xeb, 0x1d, 0xd6, 0x6f, 0x0a, 0x9b, 0xd5, 0x4e, 0xbd, 0x94, 0xc4, 0x86,
+	0x29, 0xd8, 0xee, 0x62, 0xb7, 0x0b, 0x90, 0xad, 0x55, 0x2d, 0x9c, 0x6b, 0x75, 0x5c, 0xae, 0xd4,
+	0x3a, 0x3c, 0x79, 0xd2, 0x6d, 0x36, 0x3a, 0x9f, 0x5a, 0x4f, 0x5b, 0x9d, 0x7a, 0x29, 0x85, 0x0b,
+	0x91, 0xb5, 0xd1, 0x78, 0x52, 0xc7, 0x29, 0x5c, 0x4a, 0xbf, 0xf3, 0x3e, 0xe4, 0xd5, 0x38, 0x93,
+	0x9e, 0x86, 0x64, 0xed, 0xb8, 0xcb, 0x24, 0x7c, 0x52, 0x7f, 0xd2, 0x32, 0x3f, 0x2d, 0x69, 0xd8,
+	0xcb, 0x83, 0x46, 0xfb, 0xb0, 0x94, 0xc0, 0x5f, 0xcf, 0x1e, 0xd6, 0xeb, 0xa5, 0xe4, 0x83, 0xbf,
+	0xd9, 0x82, 0xd4, 0x33, 0x6a, 0xe6, 0xf5, 0x2e, 0x94, 0xa2, 0xc3, 0xed, 0xfe, 0x25, 0x7d, 0x45,
+	0x23, 0x1f, 0x07, 0xd3, 0x4b, 0x87, 0xca, 0xcc, 0x49, 0xd3, 0x30, 0x7e, 0xfe, 0x4f, 0xbf, 0xf9,
+	0xbd, 0xc4, 0xb6, 0x71, 0xf3, 0xfe, 0xc5, 0x7b, 0xf7, 0x03, 0x5a, 0xd9, 0xa2, 0x8f, 0x80, 0x4e,
+	0x2e, 0xe9, 0xcb, 0x9c, 0x0f, 0xb5, 0x77, 0xf4, 0xef, 0x42, 0xea, 0xd8, 0x0b, 0xc2, 0xce, 0x54,
+	0x8f, 0x7d, 0x86, 0xa7, 0xb2, 0xc6, 0xb6, 0x57, 0xf9, 0x99, 0x09, 0x63, 0x8b, 0x32, 0x2b, 0x19,
+	0x39, 0x64, 0x36, 0xf6, 0x02, 0xfc, 0x20, 0x09, 0x32, 0xd8, 0x87, 0x0c, 0x35, 0xf6, 0xd5, 0xda,
+	0x11, 0xeb, 0x8f, 0x0c, 0x8c, 0x56, 0xe2, 0xa0, 0x51, 0xa6, 0x1c, 0x74, 0xa3, 0x80, 0x1c, 0x7e,
+	0x84, 0x75, 0x2c, 0xbb, 0x37, 0x44, 0x1e, 0x16, 0xac, 0x51, 0x1e, 0xca, 0x51, 0x63, 0x23, 0x7e,
+	0x7c, 0x61, 0x07, 0xb8, 0xca, 0x42, 0xac, 0xb1, 0x43, 0x19, 0x57, 0x8c, 0xcd, 0x88, 0x31, 0x15,
+	0xd3, 0xa7, 0x85, 0xb0, 0x81, 0x9f, 0xc0, 0x26, 0x6d, 0x60, 0xce, 0x5f, 0xbe, 0xbd, 0xd0, 0xbf,
+	0x66, 0x1b, 0x5c, 0x65, 0x7b, 0x31, 0x91, 0x3b, 0x18, 0x6f, 0xd1, 0x56, 0xef, 0x19, 0xdb, 0x51,
+	0xab, 0x31, 0x5f, 0xd4, 0x42, 0x27, 0x1d, 0x1b, 0xff, 0x29, 0xdc, 0x58, 0x10, 0xed, 0xd2, 0xef,
+	0xd0, 0x97, 0x3b, 0x4b, 0x63, 0x6f, 0x95, 0xbb, 0x4b, 0xe9, 0xbc, 0x03, 0xaf, 0xd3, 0x0e, 0xdc,
+	0x31, 0x6e, 0x61, 0x07, 0x30, 0x47, 0x5c, 0xbc, 0x64, 0x92, 0x6e, 0x25, 0xb6, 0xfe, 0x11, 0xa4,
+	0xa9, 0xe8, 0x73, 0x23, 0x1c, 0x83, 0x8c, 0x9b, 0x94, 0xd9, 0xba, 0x91, 0x8f, 0xa4, 0x61, 0xe3,
+	0xdb, 0x04, 0x78, 0x44, 0x42, 0xfe, 0x4e, 0x58, 0x5f, 0x57, 0xfc, 0x5b, 0xce, 0x67, 0x1e, 0x65,
+	0x54, 0x28, 0xb3, 0x0d, 0x63, 0x4d, 0xf4, 0x8c, 0x3f, 0x8c, 0x46, 0x7e, 0x0e, 0x94, 0x22, 0x7e,
+	0xe2, 0x25, 0xb5, 0xc2, 0x22, 0xf6, 0x22, 0xb9, 0xb2, 0x94, 0x62, 0xdc, 0xa3, 0x6d, 0xdc, 0x36,
+	0xb6, 0x66, 0xda, 0xb0, 0xfa, 0x94, 0x27, 0x36, 0xf5, 0x7d, 0xda, 0x14, 0x7b, 0x7e, 0x7c, 0x35,
+	0x01, 0xe6, 0x98, 0xf3, 0xf7, 0xbc, 0x8a, 0x1c, 0xdf, 0x86, 0x0c, 0xca, 0x41, 0x83, 0x2b, 0x39,
+	0xf9, 0xf9, 0xa8, 0xc6, 0x41, 0x25, 0x2b, 0x81, 0xf8, 0x8c, 0xa7, 0x7d, 0x44, 0x34, 0xd6, 0x36,
+	0x99, 0x16, 0x10, 0xdc, 0xbf, 0xe4, 0x81, 0x93, 0x35, 0x59, 0x91, 0x21, 0x54, 0x4e, 0xb1, 0xa5,
+	0x2c, 0x39, 0xe1, 0x42, 0x66, 0xc1, 0x18, 0x36, 0x52, 0x37, 0x04, 0x4f, 0xea, 0xe3, 0x08, 0x7b,
+	0xad, 0x26, 0x65, 0x57, 0x62, 0x90, 0x71, 0x9b, 0xb2, 0xdd, 0x34, 0x4a, 0x92, 0x6d, 0x8f, 0x1d,
+	0xa3, 0x90, 0x5f, 0x03, 0x8a, 0x31, 0x7e, 0x9c, 0x95, 0xf8, 0x8e, 0x40, 0x25, 0xea, 0x2f, 0x23,
+	0x0b, 0x71, 0x75, 0x85, 0x1b, 0x4b, 0xf1, 0xd7, 0xbb, 0xb0, 0xf6, 0x88, 0x84, 0x2c, 0xdd, 0x5a,
+	0xed, 0x96, 0xe4, 0xb5, 0x35, 0x9f, 0x8e, 0x4d, 0xad, 0xce, 0x36, 0x65, 0xb9, 0x65, 0xac, 0x0b,
+	0x96, 0xc1, 0x65, 0x10, 0xf5, 0xf0, 0x2d, 0xc8, 0x3e, 0x22, 0x61, 0x93, 0x84, 0x5d, 0xf3, 0x68,
+	0x86, 0x21, 0x3d, 0xaf, 0xb1, 0xfc, 0x6d, 0xe3, 0x15, 0xfd, 0x7f, 0x32, 0x51, 0xa2, 0x4c, 0xe5,
+	0x99, 0xd2, 0x37, 0xe3, 0x29, 0xce, 0xd1, 0x1a, 0x7b, 0x45, 0xff, 0x1e, 0x94, 0x66, 0xd3, 0x9c,
+	0xb9, 0xd5, 0x58, 0x9c, 0xfc, 0xfc, 0x22, 0x5e, 0x87, 0x00, 0x91, 0x0d, 0xff, 0x3c, 0xeb, 0x7d,
+	0x87, 0x8a, 0x5e, 0x36, 0x6e, 0xcc, 0x58, 0xef, 0xc0, 0xba, 0x78, 0x80, 0xc2, 0x7f, 0xa6, 0xc1,
+	0xe6, 0xc2, 0xc8, 0xa7, 0xbe, 0xc3, 0x3f, 0xfd, 0xb4, 0x34, 0x50, 0x5c, 0xb9, 0xf7, 0x82, 0x12,
+	0xbc, 0xb7, 0xb1, 0x19, 0x37, 0xf6, 0x09, 0x99, 0x92, 0x9e, 0xa5, 0x74, 0x03, 0xbb, 0xf0, 0x08,
+	0x8a, 0xf1, 0xc4, 0x4d, 0xfd, 0x96, 0xc8, 0xc8, 0x99, 0xcb, 0x10, 0xad, 0x54, 0x16, 0x91, 0x58,
+	0x63, 0xfa, 0x53, 0xb8, 0xb1, 0x20, 0xc1, 0x91, 0x99, 0xc8, 0xe5, 0x49, 0x9b, 0x95, 0xbb, 0x4b,
+	0xe9, 0x9c, 0x6f, 0x1b, 0x74, 0x49, 0x96, 0x29, 0x84, 0xfa, 0xab, 0xb1, 0x6a, 0xb3, 0xd9, 0x8c,
+	0x95, 0x3b, 0xcb, 0xc8, 0x9c, 0xe9, 0xf7, 0x60, 0x6d, 0x26, 0x23, 0x4f, 0x97, 0xb2, 0xcd, 0xa7,
+	0x15, 0x56, 0x6e, 0x2f, 0xa4, 0x71, 0x5e, 0x4f, 0xa0, 0x24, 0x48, 0x22, 0xa3, 0x4c, 0x8f, 0x55,
+	0x98, 0x49, 0xbd, 0xab, 0x6c, 0x2f, 0x26, 0xc6, 0xd9, 0xa9, 0x19, 0x62, 0x11, 0xbb, 0x05, 0x29,
+	0x6a, 0x95, 0xed, 0xc5, 0x44, 0xce, 0xee, 0x5b, 0xb1, 0x34, 0xaa, 0xcd, 0x99, 0x6c, 0x2b, 0xce,
+	0x62, 0x6b, 0x16, 0xcd, 0x2b, 0xdb, 0x50, 0x8c, 0x76, 0xaf, 0xfd, 0xcb, 0xea, 0x21, 0x63, 0x30,
+	0x77, 0x89, 0x56, 0xd9, 0x9a, 0x45, 0xf3, 0x19, 0x18, 0xdb, 0xd6, 0xd5, 0xfd, 0xed, 0xe4, 0xd2,
+	0xb2, 0xa9, 0x15, 0xbd, 0x60, 0x3b, 0xeb, 0x4c, 0xb8, 0x85, 0x49, 0xbc, 0x24, 0x76, 0x55, 0xd9,
+	0x5e, 0x4c, 0x5c, 0xba, 0xa7, 0xb2, 0x92, 0xf1, 0x3d, 0xb5, 0x09, 0x69, 0xbe, 0x78, 0xf4, 0x85,
+	0xd7, 0x13, 0x95, 0xcd, 0x19, 0x2c, 0xe7, 0x1e, 0xf7, 0xa1, 0xd8, 0x9a, 0x62, 0xbb, 0x01, 0xee,
+	0xb1, 0xe2, 0xf3, 0x59, 0xba, 0xfa, 0x7d, 0x29, 0xce, 0xf0, 0x46, 0x0c, 0xc7, 0xd9, 0xcd, 0x59,
+	0xef, 0x70, 0xca, 0x1e, 0x29, 0x22, 0xcf, 0xff, 0x03, 0x05, 0x34, 0xb9, 0xd1, 0xf7, 0x9f, 0x36,
+	0x67, 0xbe, 0x6a, 0xa4, 0x6a, 0x7f, 0xfe, 0x7b, 0x4a, 0x71, 0xf3, 0x43, 0x2d, 0x2f, 0x96, 0x89,
+	0xf8, 0x37, 0x20, 0xcd, 0xbf, 0x86, 0xc4, 0x3b, 0x1c, 0xfb, 0x34, 0x52, 0x65, 0x5d, 0xe2, 0x24,
+	0xc7, 0x98, 0x8b, 0x81, 0xca, 0x24, 0xdc, 0xc5, 0xe8, 0x42, 0x9e, 0x96, 0x7c, 0xb1, 0x4e, 0x17,
+	0x70, 0x8c, 0xed, 0x0e, 0x8c, 0x63, 0xa4, 0xd5, 0x93, 0x14, 0xfd, 0x34, 0xeb, 0xd7, 0xff, 0x6b,
+	0x00, 0xb6, 0x41, 0xf5, 0x90, 0xde, 0x55, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -6843,6 +8417,11 @@ type XchainClient interface {
 	GetSystemStatus(ctx context.Context, in *CommonIn, opts ...grpc.CallOption) (*SystemsStatusReply, error)
 	// GetNetURL return net url
 	GetNetURL(ctx context.Context, in *CommonIn, opts ...grpc.CallOption) (*RawUrl, error)
+	// GetBannedPeers 查询因评分过低被临时封禁的p2p节点
+	GetBannedPeers(ctx context.Context, in *CommonIn, opts ...grpc.CallOption) (*BannedPeersResponse, error)
+	// ClearBannedPeers 解除p2p节点的封禁, peer_ids为空时解除全部,
+	// 只接受本机发起的请求
+	ClearBannedPeers(ctx context.Context, in *ClearBannedPeersRequest, opts ...grpc.CallOption) (*BannedPeersResponse, error)
 	// 新的Select utxos接口, 不需要签名，可以支持选择账户的utxo
 	SelectUTXO(ctx context.Context, in *UtxoInput, opts ...grpc.CallOption) (*UtxoOutput, error)
 	// PreExecWithSelectUTXO preExec & selectUtxo
@@ -6867,6 +8446,16 @@ type XchainClient interface {
 	GetAddressContracts(ctx context.Context, in *AddressContractsRequest, opts ...grpc.CallOption) (*AddressContractsResponse, error)
 	//预执行合约
 	PreExec(ctx context.Context, in *InvokeRPCRequest, opts ...grpc.CallOption) (*InvokeRPCResponse, error)
+	// GetTxProof get the merkle path from a confirmed tx to the merkle root of
+	// its block
+	GetTxProof(ctx context.Context, in *TxProofRequest, opts ...grpc.CallOption) (*TxProofResponse, error)
+	// GetStateProof get the proof of a xmodel key to the state root of the trunk
+	// block at height
+	GetStateProof(ctx context.Context, in *StateProofRequest, opts ...grpc.CallOption) (*StateProofResponse, error)
+	// TraceTx re-execute the contract requests of a tx and return the call tree
+	TraceTx(ctx context.Context, in *TraceTxRequest, opts ...grpc.CallOption) (*TraceResponse, error)
+	// TracePreExec pre-execute the contract requests and return the call tree
+	TracePreExec(ctx context.Context, in *InvokeRPCRequest, opts ...grpc.CallOption) (*TraceResponse, error)
 }
 
 type xchainClient struct {
@@ -7021,6 +8610,24 @@ func (c *xchainClient) GetNetURL(ctx context.Context, in *CommonIn, opts ...grpc
 	return out, nil
 }
 
+func (c *xchainClient) GetBannedPeers(ctx context.Context, in *CommonIn, opts ...grpc.CallOption) (*BannedPeersResponse, error) {
+	out := new(BannedPeersResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/GetBannedPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) ClearBannedPeers(ctx context.Context, in *ClearBannedPeersRequest, opts ...grpc.CallOption) (*BannedPeersResponse, error) {
+	out := new(BannedPeersResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/ClearBannedPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *xchainClient) SelectUTXO(ctx context.Context, in *UtxoInput, opts ...grpc.CallOption) (*UtxoOutput, error) {
 	out := new(UtxoOutput)
 	err := c.cc.Invoke(ctx, "/pb.Xchain/SelectUTXO", in, out, opts...)
@@ -7129,6 +8736,42 @@ func (c *xchainClient) PreExec(ctx context.Context, in *InvokeRPCRequest, opts .
 	return out, nil
 }
 
+func (c *xchainClient) GetTxProof(ctx context.Context, in *TxProofRequest, opts ...grpc.CallOption) (*TxProofResponse, error) {
+	out := new(TxProofResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/GetTxProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) GetStateProof(ctx context.Context, in *StateProofRequest, opts ...grpc.CallOption) (*StateProofResponse, error) {
+	out := new(StateProofResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/GetStateProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) TraceTx(ctx context.Context, in *TraceTxRequest, opts ...grpc.CallOption) (*TraceResponse, error) {
+	out := new(TraceResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/TraceTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) TracePreExec(ctx context.Context, in *InvokeRPCRequest, opts ...grpc.CallOption) (*TraceResponse, error) {
+	out := new(TraceResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/TracePreExec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // XchainServer is the server API for Xchain service.
 type XchainServer interface {
 	// SelectUTXOBySize merge many utxos into a few of utxos
@@ -7165,6 +8808,11 @@ type XchainServer interface {
 	GetSystemStatus(context.Context, *CommonIn) (*SystemsStatusReply, error)
 	// GetNetURL return net url
 	GetNetURL(context.Context, *CommonIn) (*RawUrl, error)
+	// GetBannedPeers 查询因评分过低被临时封禁的p2p节点
+	GetBannedPeers(context.Context, *CommonIn) (*BannedPeersResponse, error)
+	// ClearBannedPeers 解除p2p节点的封禁, peer_ids为空时解除全部,
+	// 只接受本机发起的请求
+	ClearBannedPeers(context.Context, *ClearBannedPeersRequest) (*BannedPeersResponse, error)
 	// 新的Select utxos接口, 不需要签名，可以支持选择账户的utxo
 	SelectUTXO(context.Context, *UtxoInput) (*UtxoOutput, error)
 	// PreExecWithSelectUTXO preExec & selectUtxo
@@ -7189,6 +8837,16 @@ type XchainServer interface {
 	GetAddressContracts(context.Context, *AddressContractsRequest) (*AddressContractsResponse, error)
 	//预执行合约
 	PreExec(context.Context, *InvokeRPCRequest) (*InvokeRPCResponse, error)
+	// GetTxProof get the merkle path from a confirmed tx to the merkle root of
+	// its block
+	GetTxProof(context.Context, *TxProofRequest) (*TxProofResponse, error)
+	// GetStateProof get the proof of a xmodel key to the state root of the trunk
+	// block at height
+	GetStateProof(context.Context, *StateProofRequest) (*StateProofResponse, error)
+	// TraceTx re-execute the contract requests of a tx and return the call tree
+	TraceTx(context.Context, *TraceTxRequest) (*TraceResponse, error)
+	// TracePreExec pre-execute the contract requests and return the call tree
+	TracePreExec(context.Context, *InvokeRPCRequest) (*TraceResponse, error)
 }
 
 // UnimplementedXchainServer can be embedded to have forward compatible implementations.
@@ -7243,6 +8901,12 @@ func (*UnimplementedXchainServer) GetSystemStatus(ctx context.Context, req *Comm
 func (*UnimplementedXchainServer) GetNetURL(ctx context.Context, req *CommonIn) (*RawUrl, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNetURL not implemented")
 }
+func (*UnimplementedXchainServer) GetBannedPeers(ctx context.Context, req *CommonIn) (*BannedPeersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBannedPeers not implemented")
+}
+func (*UnimplementedXchainServer) ClearBannedPeers(ctx context.Context, req *ClearBannedPeersRequest) (*BannedPeersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearBannedPeers not implemented")
+}
 func (*UnimplementedXchainServer) SelectUTXO(ctx context.Context, req *UtxoInput) (*UtxoOutput, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SelectUTXO not implemented")
 }
@@ -7279,6 +8943,18 @@ func (*UnimplementedXchainServer) GetAddressContracts(ctx context.Context, req *
 func (*UnimplementedXchainServer) PreExec(ctx context.Context, req *InvokeRPCRequest) (*InvokeRPCResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PreExec not implemented")
 }
+func (*UnimplementedXchainServer) GetTxProof(ctx context.Context, req *TxProofRequest) (*TxProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTxProof not implemented")
+}
+func (*UnimplementedXchainServer) GetStateProof(ctx context.Context, req *StateProofRequest) (*StateProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateProof not implemented")
+}
+func (*UnimplementedXchainServer) TraceTx(ctx context.Context, req *TraceTxRequest) (*TraceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceTx not implemented")
+}
+func (*UnimplementedXchainServer) TracePreExec(ctx context.Context, req *InvokeRPCRequest) (*TraceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TracePreExec not implemented")
+}
 
 func RegisterXchainServer(s *grpc.Server, srv XchainServer) {
 	s.RegisterService(&_Xchain_serviceDesc, srv)
@@ -7572,6 +9248,42 @@ func _Xchain_GetNetURL_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Xchain_GetBannedPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommonIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).GetBannedPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/GetBannedPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).GetBannedPeers(ctx, req.(*CommonIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xchain_ClearBannedPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearBannedPeersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).ClearBannedPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/ClearBannedPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).ClearBannedPeers(ctx, req.(*ClearBannedPeersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Xchain_SelectUTXO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UtxoInput)
 	if err := dec(in); err != nil {
@@ -7788,6 +9500,78 @@ func _Xchain_PreExec_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Xchain_GetTxProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).GetTxProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/GetTxProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).GetTxProof(ctx, req.(*TxProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xchain_GetStateProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).GetStateProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/GetStateProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).GetStateProof(ctx, req.(*StateProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xchain_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TraceTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).TraceTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/TraceTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).TraceTx(ctx, req.(*TraceTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xchain_TracePreExec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvokeRPCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).TracePreExec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/TracePreExec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).TracePreExec(ctx, req.(*InvokeRPCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Xchain_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pb.Xchain",
 	HandlerType: (*XchainServer)(nil),
@@ -7856,6 +9640,14 @@ var _Xchain_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetNetURL",
 			Handler:    _Xchain_GetNetURL_Handler,
 		},
+		{
+			MethodName: "GetBannedPeers",
+			Handler:    _Xchain_GetBannedPeers_Handler,
+		},
+		{
+			MethodName: "ClearBannedPeers",
+			Handler:    _Xchain_ClearBannedPeers_Handler,
+		},
 		{
 			MethodName: "SelectUTXO",
 			Handler:    _Xchain_SelectUTXO_Handler,
@@ -7904,6 +9696,22 @@ var _Xchain_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PreExec",
 			Handler:    _Xchain_PreExec_Handler,
 		},
+		{
+			MethodName: "GetTxProof",
+			Handler:    _Xchain_GetTxProof_Handler,
+		},
+		{
+			MethodName: "GetStateProof",
+			Handler:    _Xchain_GetStateProof_Handler,
+		},
+		{
+			MethodName: "TraceTx",
+			Handler:    _Xchain_TraceTx_Handler,
+		},
+		{
+			MethodName: "TracePreExec",
+			Handler:    _Xchain_TracePreExec_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "xchain.proto",
@@ -105,8 +105,13 @@ func (mgr *Manager) GetAccountAddresses(accountName string) ([]string, error) {
 func (mgr *Manager) getAddressesByACL(acl *pb.Acl) ([]string, error) {
 	addresses := make([]string, 0)
 
-	switch acl.GetPm().GetRule() {
-	case pb.PermissionRule_SIGN_THRESHOLD:
+	permissionRule := acl.GetPm().GetRule()
+	if permissionRule == pb.PermissionRule_SIGN_TIMELOCK {
+		// members of a time locked ACL are defined by the wrapped rule
+		permissionRule = acl.GetTimeLock().GetRule()
+	}
+	switch permissionRule {
+	case pb.PermissionRule_SIGN_THRESHOLD, pb.PermissionRule_SIGN_RATE, pb.PermissionRule_SIGN_SUM:
 		for ak := range acl.GetAksWeight() {
 			addresses = append(addresses, ak)
 		}
@@ -29,6 +29,13 @@ func IdentifyAK(akuri string, sign *pb.SignatureInfo, msg []byte) (bool, error)
 // IdentifyAccount checks whether the aks could represent the given account via account's ACL strategy.
 // Return true if the signatures match aks and aks could represent the account.
 func IdentifyAccount(account string, aksuri []string, aclMgr acl.ManagerInterface) (bool, error) {
+	return IdentifyAccountAtBlock(account, aksuri, aclMgr, nil)
+}
+
+// IdentifyAccountAtBlock is the same as IdentifyAccount, but block sensitive ACL rules
+// like SIGN_TIMELOCK are evaluated against the given block.
+func IdentifyAccountAtBlock(account string, aksuri []string, aclMgr acl.ManagerInterface,
+	blockCtx *rule.BlockContext) (bool, error) {
 	// aks and signs could have zero length for permission rule Null
 	if aclMgr == nil {
 		return false, fmt.Errorf("Invalid Param, aclMgr=%v", aclMgr)
@@ -40,13 +47,20 @@ func IdentifyAccount(account string, aksuri []string, aclMgr acl.ManagerInterfac
 		return false, err
 	}
 
-	return validatePermTree(pnode, true)
+	return validatePermTree(pnode, true, blockCtx)
 }
 
 // CheckContractMethodPerm checks whether the aks satisfy the ACL of a contract method <contractName, methodName>.
 // Return true if the signatures match aks and satisfy the ACL.
 func CheckContractMethodPerm(aksuri []string, contractName string, methodName string,
 	aclMgr acl.ManagerInterface) (bool, error) {
+	return CheckContractMethodPermAtBlock(aksuri, contractName, methodName, aclMgr, nil)
+}
+
+// CheckContractMethodPermAtBlock is the same as CheckContractMethodPerm, but block sensitive
+// ACL rules like SIGN_TIMELOCK are evaluated against the given block.
+func CheckContractMethodPermAtBlock(aksuri []string, contractName string, methodName string,
+	aclMgr acl.ManagerInterface, blockCtx *rule.BlockContext) (bool, error) {
 	// aks and signs could have zero length for permission rule Null
 	if aclMgr == nil {
 		return false, fmt.Errorf("Invalid Param, aclMgr=%v", aclMgr)
@@ -59,10 +73,10 @@ func CheckContractMethodPerm(aksuri []string, contractName string, methodName st
 	}
 
 	// validate perm tree
-	return validatePermTree(pnode, false)
+	return validatePermTree(pnode, false, blockCtx)
 }
 
-func validatePermTree(root *ptree.PermNode, isAccount bool) (bool, error) {
+func validatePermTree(root *ptree.PermNode, isAccount bool, blockCtx *rule.BlockContext) (bool, error) {
 	if root == nil {
 		return false, errors.New("Root is null")
 	}
@@ -73,7 +87,9 @@ func validatePermTree(root *ptree.PermNode, isAccount bool) (bool, error) {
 		return false, err
 	}
 	listlen := len(plist)
-	vf := &rule.ACLValidatorFactory{}
+	vf := &rule.ACLValidatorFactory{
+		BlockCtx: blockCtx,
+	}
 
 	// reverse travel the perm tree
 	for i := listlen - 1; i >= 0; i-- {
@@ -13,8 +13,16 @@ type ACLValidator interface {
 	Validate(pnode *ptree.PermNode) (bool, error)
 }
 
+// BlockContext is the block against which block sensitive permission rules are evaluated
+type BlockContext struct {
+	Height    int64 // height of the block being verified
+	Timestamp int64 // timestamp of the block being verified, in nanoseconds
+}
+
 // ACLValidatorFactory create ACLValidator for specified permission model
 type ACLValidatorFactory struct {
+	// BlockCtx is required by SIGN_TIMELOCK, nil means the block is unknown
+	BlockCtx *BlockContext
 }
 
 // GetACLValidator returns ACLValidator for specified permission model
@@ -27,13 +35,15 @@ func (vf *ACLValidatorFactory) GetACLValidator(pr pb.PermissionRule) (ACLValidat
 	case pb.PermissionRule_SIGN_AKSET:
 		return NewAKSetsValidator(), nil
 	case pb.PermissionRule_SIGN_RATE:
-		return vf.notImplementedValidator()
+		return NewRateValidator(), nil
 	case pb.PermissionRule_SIGN_SUM:
-		return vf.notImplementedValidator()
+		return NewSumValidator(), nil
 	case pb.PermissionRule_CA_SERVER:
 		return vf.notImplementedValidator()
 	case pb.PermissionRule_COMMUNITY_VOTE:
 		return vf.notImplementedValidator()
+	case pb.PermissionRule_SIGN_TIMELOCK:
+		return NewTimeLockValidator(vf.BlockCtx), nil
 	}
 	return nil, errors.New("Unknown permission rule")
 }
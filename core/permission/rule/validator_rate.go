@@ -0,0 +1,54 @@
+package rule
+
+import (
+	"errors"
+
+	"github.com/xuperchain/xuperchain/core/pb"
+	"github.com/xuperchain/xuperchain/core/permission/ptree"
+)
+
+// RateValidator is Valiator for SignRate permission model,
+// the ACL passes if the rate of signed members in AksWeight is no less than AcceptValue
+type RateValidator struct{}
+
+// NewRateValidator return instance of RateValidator
+func NewRateValidator() *RateValidator {
+	return &RateValidator{}
+}
+
+// Validate implements the interface of ACLValidator
+func (rv *RateValidator) Validate(pnode *ptree.PermNode) (bool, error) {
+	if pnode == nil {
+		return false, errors.New("Validate: Invalid Param")
+	}
+
+	// empty ACL means everyone can pass the validation
+	if pnode.ACL == nil {
+		return true, nil
+	}
+
+	// empty member list means no one can pass the validation
+	if pnode.ACL.Pm == nil || len(pnode.ACL.AksWeight) == 0 {
+		return false, nil
+	}
+
+	signedCount := 0
+	for _, node := range pnode.Children {
+		// the child account/ak must be passed the validation before
+		if node.Status != ptree.Success {
+			continue
+		}
+
+		// the child account/ak should be member in ACL list
+		if rv.isMemberOfACL(node.Name, pnode.ACL) {
+			signedCount++
+		}
+	}
+	rate := float64(signedCount) / float64(len(pnode.ACL.AksWeight))
+	return (rate >= pnode.ACL.Pm.AcceptValue), nil
+}
+
+func (rv *RateValidator) isMemberOfACL(name string, acl *pb.Acl) bool {
+	_, ok := acl.AksWeight[name]
+	return ok
+}
@@ -0,0 +1,54 @@
+package rule
+
+import (
+	"errors"
+	"math"
+
+	"github.com/xuperchain/xuperchain/core/pb"
+	"github.com/xuperchain/xuperchain/core/permission/ptree"
+)
+
+// SumValidator is Valiator for SignSum permission model,
+// the ACL passes if the sum of absolute weights of signed members is no less than AcceptValue
+type SumValidator struct{}
+
+// NewSumValidator return instance of SumValidator
+func NewSumValidator() *SumValidator {
+	return &SumValidator{}
+}
+
+// Validate implements the interface of ACLValidator
+func (sv *SumValidator) Validate(pnode *ptree.PermNode) (bool, error) {
+	if pnode == nil {
+		return false, errors.New("Validate: Invalid Param")
+	}
+
+	// empty ACL means everyone can pass the validation
+	if pnode.ACL == nil {
+		return true, nil
+	}
+
+	if pnode.ACL.Pm == nil || len(pnode.ACL.AksWeight) == 0 {
+		return false, nil
+	}
+
+	var weightSum float64
+	for _, node := range pnode.Children {
+		// the child account/ak must be passed the validation before
+		if node.Status != ptree.Success {
+			continue
+		}
+
+		// the child account/ak should be member in ACL list
+		weightSum += sv.findAbsWeightInACL(node.Name, pnode.ACL)
+	}
+	return (weightSum >= pnode.ACL.Pm.AcceptValue), nil
+}
+
+func (sv *SumValidator) findAbsWeightInACL(name string, acl *pb.Acl) float64 {
+	weight, ok := acl.AksWeight[name]
+	if !ok {
+		return 0
+	}
+	return math.Abs(weight)
+}
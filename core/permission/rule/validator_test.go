@@ -5,6 +5,7 @@ import (
 	"github.com/xuperchain/xuperchain/core/permission/ptree"
 
 	"testing"
+	"time"
 )
 
 func Test_ValidatorFactory(t *testing.T) {
@@ -149,3 +150,157 @@ func Test_AkSetsValidator(t *testing.T) {
 		return
 	}
 }
+
+func Test_RateValidator(t *testing.T) {
+	vf := ACLValidatorFactory{}
+	rv, err := vf.GetACLValidator(pb.PermissionRule_SIGN_RATE)
+	if err != nil {
+		t.Error("SIGN_RATE create failed")
+		return
+	}
+	pm := &pb.PermissionModel{
+		Rule:        pb.PermissionRule_SIGN_RATE,
+		AcceptValue: 0.6,
+	}
+	aclObj := &pb.Acl{
+		Pm:        pm,
+		AksWeight: make(map[string]float64),
+	}
+
+	aclObj.AksWeight["ak1"] = 1
+	aclObj.AksWeight["ak2"] = 1
+	aclObj.AksWeight["ak3"] = 1
+
+	// build perm tree
+	rootNode := ptree.NewPermNode("Alice", aclObj)
+	ak1Node := ptree.NewPermNode("ak1", nil)
+	ak1Node.Status = ptree.Success
+	ak4Node := ptree.NewPermNode("ak4", nil)
+	ak4Node.Status = ptree.Success
+	rootNode.Children = append(rootNode.Children, ak1Node, ak4Node)
+	result, err := rv.Validate(rootNode)
+
+	// should failed, ak4 is not a member of ACL
+	if err != nil || result {
+		t.Error("validate failed, should have no error and result is false")
+		return
+	}
+
+	ak2Node := ptree.NewPermNode("ak2", nil)
+	ak2Node.Status = ptree.Success
+	rootNode.Children = append(rootNode.Children, ak2Node)
+	result, err = rv.Validate(rootNode)
+	// should success
+	if err != nil || !result {
+		t.Error("validate failed, should have no error and result is true. result=", result)
+		return
+	}
+}
+
+func Test_SumValidator(t *testing.T) {
+	vf := ACLValidatorFactory{}
+	sv, err := vf.GetACLValidator(pb.PermissionRule_SIGN_SUM)
+	if err != nil {
+		t.Error("SIGN_SUM create failed")
+		return
+	}
+	pm := &pb.PermissionModel{
+		Rule:        pb.PermissionRule_SIGN_SUM,
+		AcceptValue: 3,
+	}
+	aclObj := &pb.Acl{
+		Pm:        pm,
+		AksWeight: make(map[string]float64),
+	}
+
+	aclObj.AksWeight["ak1"] = -2
+	aclObj.AksWeight["ak2"] = 0.5
+	aclObj.AksWeight["ak3"] = 1
+
+	// build perm tree
+	rootNode := ptree.NewPermNode("Alice", aclObj)
+	ak1Node := ptree.NewPermNode("ak1", nil)
+	ak1Node.Status = ptree.Success
+	ak2Node := ptree.NewPermNode("ak2", nil)
+	ak2Node.Status = ptree.Success
+	rootNode.Children = append(rootNode.Children, ak1Node, ak2Node)
+	result, err := sv.Validate(rootNode)
+
+	// should failed
+	if err != nil || result {
+		t.Error("validate failed, should have no error and result is false")
+		return
+	}
+
+	ak3Node := ptree.NewPermNode("ak3", nil)
+	ak3Node.Status = ptree.Success
+	rootNode.Children = append(rootNode.Children, ak3Node)
+	result, err = sv.Validate(rootNode)
+	// should success
+	if err != nil || !result {
+		t.Error("validate failed, should have no error and result is true. result=", result)
+		return
+	}
+}
+
+func Test_TimeLockValidator(t *testing.T) {
+	pm := &pb.PermissionModel{
+		Rule:        pb.PermissionRule_SIGN_TIMELOCK,
+		AcceptValue: 1,
+	}
+	aclObj := &pb.Acl{
+		Pm:        pm,
+		AksWeight: make(map[string]float64),
+		TimeLock: &pb.TimeLock{
+			Rule:              pb.PermissionRule_SIGN_THRESHOLD,
+			NotBeforeHeight:   10,
+			NotAfterHeight:    20,
+			NotAfterTimestamp: 1600000000,
+		},
+	}
+	aclObj.AksWeight["ak1"] = 1
+
+	// build perm tree
+	rootNode := ptree.NewPermNode("Alice", aclObj)
+	ak1Node := ptree.NewPermNode("ak1", nil)
+	ak1Node.Status = ptree.Success
+	rootNode.Children = append(rootNode.Children, ak1Node)
+
+	// should return error without block context
+	vf := ACLValidatorFactory{}
+	tlv, err := vf.GetACLValidator(pb.PermissionRule_SIGN_TIMELOCK)
+	if err != nil {
+		t.Error("SIGN_TIMELOCK create failed")
+		return
+	}
+	if _, err := tlv.Validate(rootNode); err == nil {
+		t.Error("validate failed, should have error without block context")
+		return
+	}
+
+	testCases := []struct {
+		height    int64
+		timestamp int64
+		expect    bool
+	}{
+		{height: 9, timestamp: 1500000000, expect: false},
+		{height: 10, timestamp: 1500000000, expect: true},
+		{height: 20, timestamp: 1600000000, expect: true},
+		{height: 21, timestamp: 1500000000, expect: false},
+		{height: 15, timestamp: 1600000001, expect: false},
+	}
+	for _, tc := range testCases {
+		vf := ACLValidatorFactory{
+			BlockCtx: &BlockContext{
+				Height:    tc.height,
+				Timestamp: tc.timestamp * int64(time.Second),
+			},
+		}
+		tlv, _ := vf.GetACLValidator(pb.PermissionRule_SIGN_TIMELOCK)
+		result, err := tlv.Validate(rootNode)
+		if err != nil || result != tc.expect {
+			t.Error("validate failed, height=", tc.height, "timestamp=", tc.timestamp, "result=", result, "error=", err)
+			return
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package rule
+
+import (
+	"errors"
+	"time"
+
+	"github.com/xuperchain/xuperchain/core/pb"
+	"github.com/xuperchain/xuperchain/core/permission/ptree"
+)
+
+// TimeLockValidator is Valiator for TimeLock permission model,
+// it wraps a SIGN_THRESHOLD or SIGN_AKSET rule which only takes effect
+// while the block being verified is within the height and timestamp bounds
+type TimeLockValidator struct {
+	blockCtx *BlockContext
+}
+
+// NewTimeLockValidator return instance of TimeLockValidator
+func NewTimeLockValidator(blockCtx *BlockContext) *TimeLockValidator {
+	return &TimeLockValidator{
+		blockCtx: blockCtx,
+	}
+}
+
+// Validate implements the interface of ACLValidator
+func (tlv *TimeLockValidator) Validate(pnode *ptree.PermNode) (bool, error) {
+	if pnode == nil {
+		return false, errors.New("Validate: Invalid Param")
+	}
+
+	// empty ACL means everyone can pass the validation
+	if pnode.ACL == nil {
+		return true, nil
+	}
+
+	timeLock := pnode.ACL.TimeLock
+	if timeLock == nil {
+		return false, errors.New("Validate: TimeLock is required by SIGN_TIMELOCK")
+	}
+	if tlv.blockCtx == nil {
+		return false, errors.New("Validate: block context is required by SIGN_TIMELOCK")
+	}
+
+	// out of the effective range, no one can pass the validation
+	if !isTimeLockInEffect(timeLock, tlv.blockCtx) {
+		return false, nil
+	}
+
+	var inner ACLValidator
+	switch timeLock.Rule {
+	case pb.PermissionRule_SIGN_THRESHOLD:
+		inner = NewThresholdValidator()
+	case pb.PermissionRule_SIGN_AKSET:
+		inner = NewAKSetsValidator()
+	default:
+		return false, errors.New("Validate: permission rule wrapped by TimeLock is not supported")
+	}
+	return inner.Validate(pnode)
+}
+
+// isTimeLockInEffect returns whether the block is within the bounds of TimeLock,
+// zero value of a bound means no limitation
+func isTimeLockInEffect(timeLock *pb.TimeLock, blockCtx *BlockContext) bool {
+	if timeLock.NotBeforeHeight > 0 && blockCtx.Height < timeLock.NotBeforeHeight {
+		return false
+	}
+	if timeLock.NotAfterHeight > 0 && blockCtx.Height > timeLock.NotAfterHeight {
+		return false
+	}
+
+	// the bounds of timestamp are in seconds
+	blockTime := blockCtx.Timestamp / int64(time.Second)
+	if timeLock.NotBeforeTimestamp > 0 && blockTime < timeLock.NotBeforeTimestamp {
+		return false
+	}
+	if timeLock.NotAfterTimestamp > 0 && blockTime > timeLock.NotAfterTimestamp {
+		return false
+	}
+	return true
+}
@@ -32,6 +32,8 @@ buildpkg xchain github.com/xuperchain/xuperchain/core/cmd/xchain
 buildpkg xdev github.com/xuperchain/xuperchain/core/cmd/xdev
 buildpkg xchain-httpgw github.com/xuperchain/xuperchain/core/gateway
 buildpkg dump_chain github.com/xuperchain/xuperchain/core/test
+buildpkg kvmigrate github.com/xuperchain/xuperchain/core/cmd/kvmigrate
+buildpkg snapshot github.com/xuperchain/xuperchain/core/cmd/snapshot
 buildpkg relayer github.com/xuperchain/xuperchain/core/cmd/relayer/relayer
 
 # build plugins
@@ -45,9 +47,11 @@ go build --buildmode=plugin -gcflags "${XCHAIN_CUSTOME_BUILD_GCFLAGS}" --tags mu
 go build --buildmode=plugin -gcflags "${XCHAIN_CUSTOME_BUILD_GCFLAGS}" --tags single -o core/plugins/kv/kv-ldb-single.so.1.0.0 github.com/xuperchain/xuperchain/core/kv/kvdb/plugin-ldb
 go build --buildmode=plugin -gcflags "${XCHAIN_CUSTOME_BUILD_GCFLAGS}" --tags cloud -o core/plugins/kv/kv-ldb-cloud.so.1.0.0 github.com/xuperchain/xuperchain/core/kv/kvdb/plugin-ldb
 go build --buildmode=plugin -gcflags "${XCHAIN_CUSTOME_BUILD_GCFLAGS}" -o core/plugins/kv/kv-badger.so.1.0.0 github.com/xuperchain/xuperchain/core/kv/kvdb/plugin-badger
+go build --buildmode=plugin -gcflags "${XCHAIN_CUSTOME_BUILD_GCFLAGS}" -o core/plugins/kv/kv-pebble.so.1.0.0 github.com/xuperchain/xuperchain/core/kv/kvdb/plugin-pebble
 #go build --buildmode=plugin -gcflags "${XCHAIN_CUSTOME_BUILD_GCFLAGS}" -o core/plugins/crypto/crypto-default.so.1.0.0 github.com/xuperchain/xuperchain/core/crypto/client/xchain/plugin_impl
 #go build --buildmode=plugin -gcflags "${XCHAIN_CUSTOME_BUILD_GCFLAGS}" -o core/plugins/crypto/crypto-schnorr.so.1.0.0 github.com/xuperchain/xuperchain/core/crypto/client/schnorr/plugin_impl
 #go build --buildmode=plugin -gcflags "${XCHAIN_CUSTOME_BUILD_GCFLAGS}" -o core/plugins/crypto/crypto-gm.so.1.0.0 github.com/xuperchain/xuperchain/core/crypto/client/gm/gmclient/plugin_impl
+go build --buildmode=plugin -gcflags "${XCHAIN_CUSTOME_BUILD_GCFLAGS}" -o core/plugins/crypto/crypto-bls.so.1.0.0 github.com/xuperchain/xuperchain/core/crypto/client/bls
 go build --buildmode=plugin -gcflags "${XCHAIN_CUSTOME_BUILD_GCFLAGS}" -o core/plugins/consensus/consensus-pow.so.1.0.0 github.com/xuperchain/xuperchain/core/consensus/pow
 go build --buildmode=plugin -gcflags "${XCHAIN_CUSTOME_BUILD_GCFLAGS}" -o core/plugins/consensus/consensus-single.so.1.0.0 github.com/xuperchain/xuperchain/core/consensus/single
 go build --buildmode=plugin -gcflags "${XCHAIN_CUSTOME_BUILD_GCFLAGS}" -o core/plugins/consensus/consensus-tdpos.so.1.0.0 github.com/xuperchain/xuperchain/core/consensus/tdpos/main
@@ -70,6 +74,8 @@ mv xchain-cli xchain ${output_dir}
 mv xchain-httpgw ${output_dir}
 mv wasm2c ${output_dir}
 mv dump_chain ${output_dir}
+mv kvmigrate ${output_dir}
+mv snapshot ${output_dir}
 mv xdev ${output_dir}
 mv relayer ${output_dir}
 cp -rf core/plugins ${output_dir}
@@ -26,6 +26,7 @@ import (
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/reflection"
 
 	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
@@ -391,6 +392,61 @@ func (s *Server) GetNetURL(ctx context.Context, in *pb.CommonIn) (*pb.RawUrl, er
 	return out, nil
 }
 
+// GetBannedPeers get the p2p peers banned because of low score
+func (s *Server) GetBannedPeers(ctx context.Context, in *pb.CommonIn) (*pb.BannedPeersResponse, error) {
+	if in.Header == nil {
+		in.Header = global.GHeader()
+	}
+	out := &pb.BannedPeersResponse{Header: &pb.Header{Logid: in.Header.Logid}}
+	out.Header.Error = pb.XChainErrorEnum_SUCCESS
+	out.Peers = bannedPeersToPB(s.mg.P2pSvr.GetBannedPeers())
+	return out, nil
+}
+
+// ClearBannedPeers clear bans of the given p2p peers, only requests from local host are accepted
+func (s *Server) ClearBannedPeers(ctx context.Context, in *pb.ClearBannedPeersRequest) (*pb.BannedPeersResponse, error) {
+	if in.Header == nil {
+		in.Header = global.GHeader()
+	}
+	out := &pb.BannedPeersResponse{Header: &pb.Header{Logid: in.Header.Logid}}
+	if !isLocalRequest(ctx) {
+		out.Header.Error = pb.XChainErrorEnum_CONNECT_REFUSE
+		s.log.Warn("ClearBannedPeers refused, not from local host", "logid", in.Header.Logid)
+		return out, nil
+	}
+	out.Header.Error = pb.XChainErrorEnum_SUCCESS
+	out.Peers = bannedPeersToPB(s.mg.P2pSvr.UnbanPeers(in.GetPeerIds()))
+	s.log.Info("ClearBannedPeers", "logid", in.Header.Logid, "cleared", len(out.Peers))
+	return out, nil
+}
+
+func bannedPeersToPB(bans []*p2p_base.BannedPeer) []*pb.BannedPeer {
+	peers := make([]*pb.BannedPeer, 0, len(bans))
+	for _, ban := range bans {
+		peers = append(peers, &pb.BannedPeer{
+			PeerId:   ban.PeerID,
+			Reason:   ban.Reason,
+			BannedAt: ban.BannedAt,
+			ExpireAt: ban.ExpireAt,
+		})
+	}
+	return peers
+}
+
+// isLocalRequest check whether the rpc request comes from loopback address
+func isLocalRequest(ctx context.Context) bool {
+	pr, ok := peer.FromContext(ctx)
+	if !ok || pr.Addr == nil {
+		return false
+	}
+	host, _, err := net.SplitHostPort(pr.Addr.String())
+	if err != nil {
+		return false
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
 // SelectUTXOBySize select utxo inputs depending on size
 func (s *Server) SelectUTXOBySize(ctx context.Context, in *pb.UtxoInput) (*pb.UtxoOutput, error) {
 	if in.GetHeader() == nil {
@@ -800,6 +856,76 @@ func (s *Server) GetBlockByHeight(ctx context.Context, in *pb.BlockHeight) (*pb.
 	return out, nil
 }
 
+// GetTxProof get the merkle proof of a tx in trunk
+func (s *Server) GetTxProof(ctx context.Context, in *pb.TxProofRequest) (*pb.TxProofResponse, error) {
+	if in.Header == nil {
+		in.Header = global.GHeader()
+	}
+	bc := s.mg.Get(in.Bcname)
+	if bc == nil {
+		out := pb.TxProofResponse{Header: &pb.Header{}}
+		out.Header.Error = pb.XChainErrorEnum_CONNECT_REFUSE // 拒绝
+		return &out, nil
+	}
+	if bc.QueryTxFromForbidden(in.Txid) {
+		return nil, errors.New("tx has been forbidden")
+	}
+	out := bc.GetTxProof(in)
+	s.log.Trace("GetTxProof result", "logid", in.Header.Logid, "bcname", in.Bcname, "txid", global.F(in.Txid),
+		"error", out.Header.Error)
+	return out, nil
+}
+
+// GetStateProof get the merkle proof of a xmodel key at the trunk block of height
+func (s *Server) GetStateProof(ctx context.Context, in *pb.StateProofRequest) (*pb.StateProofResponse, error) {
+	if in.Header == nil {
+		in.Header = global.GHeader()
+	}
+	bc := s.mg.Get(in.Bcname)
+	if bc == nil {
+		out := pb.StateProofResponse{Header: &pb.Header{}}
+		out.Header.Error = pb.XChainErrorEnum_CONNECT_REFUSE // 拒绝
+		return &out, nil
+	}
+	out := bc.GetStateProof(in)
+	s.log.Trace("GetStateProof result", "logid", in.Header.Logid, "bcname", in.Bcname, "bucket", in.Bucket,
+		"height", in.Height, "error", out.Header.Error)
+	return out, nil
+}
+
+// TraceTx re-execute the contract requests of a tx and return the json encoded call tree
+func (s *Server) TraceTx(ctx context.Context, in *pb.TraceTxRequest) (*pb.TraceResponse, error) {
+	if in.Header == nil {
+		in.Header = global.GHeader()
+	}
+	bc := s.mg.Get(in.Bcname)
+	if bc == nil {
+		out := pb.TraceResponse{Header: &pb.Header{}}
+		out.Header.Error = pb.XChainErrorEnum_CONNECT_REFUSE // 拒绝
+		return &out, nil
+	}
+	out := bc.TraceTx(in)
+	s.log.Trace("TraceTx result", "logid", in.Header.Logid, "bcname", in.Bcname, "txid", global.F(in.Txid),
+		"error", out.Header.Error)
+	return out, nil
+}
+
+// TracePreExec pre-execute the contract requests and return the json encoded call tree
+func (s *Server) TracePreExec(ctx context.Context, in *pb.InvokeRPCRequest) (*pb.TraceResponse, error) {
+	if in.Header == nil {
+		in.Header = global.GHeader()
+	}
+	bc := s.mg.Get(in.Bcname)
+	if bc == nil {
+		out := pb.TraceResponse{Header: &pb.Header{}}
+		out.Header.Error = pb.XChainErrorEnum_CONNECT_REFUSE // 拒绝
+		return &out, nil
+	}
+	out := bc.TracePreExec(in)
+	s.log.Trace("TracePreExec result", "logid", in.Header.Logid, "bcname", in.Bcname, "error", out.Header.Error)
+	return out, nil
+}
+
 // GetAccountByAK get account list with contain ak
 func (s *Server) GetAccountByAK(ctx context.Context, in *pb.AK2AccountRequest) (*pb.AK2AccountResponse, error) {
 	if in.Header == nil {
@@ -0,0 +1,238 @@
+package snapshot
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/xuperchain/xuperchain/core/kv/kvdb"
+	"github.com/xuperchain/xuperchain/core/kv/kvdb/migrate"
+	"github.com/xuperchain/xuperchain/core/ledger"
+	"github.com/xuperchain/xuperchain/core/pb"
+	"github.com/xuperchain/xuperchain/core/xmodel"
+)
+
+// ExportOptions is the options to export a snapshot
+type ExportOptions struct {
+	// Dir is the snapshot directory to create, it must not exist
+	Dir    string
+	Bcname string
+	Ledger *ledger.Ledger
+	// StateDB is the db of utxoVM whose state has been rewound to Block
+	StateDB kvdb.Database
+	// Block is the block of snapshot height with transactions
+	Block *pb.InternalBlock
+	// GenesisPath is the path of xuper.json of the chain
+	GenesisPath string
+}
+
+// Export writes the state of opt.StateDB and the ledger records needed by a new node into opt.Dir.
+// The caller must make sure the state is not changed during export.
+func Export(opt *ExportOptions) (*Manifest, error) {
+	if _, err := os.Stat(opt.Dir); err == nil {
+		return nil, fmt.Errorf("snapshot %s already exists", opt.Dir)
+	}
+	// 先导出到临时目录，全部成功后再改名，避免留下不完整的快照
+	tmpDir := opt.Dir + ".tmp"
+	if err := os.RemoveAll(tmpDir); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(tmpDir, 0755); err != nil {
+		return nil, err
+	}
+	manifest, err := export(tmpDir, opt)
+	if err != nil {
+		os.RemoveAll(tmpDir)
+		return nil, err
+	}
+	if err := os.Rename(tmpDir, opt.Dir); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+func export(dir string, opt *ExportOptions) (*Manifest, error) {
+	meta := opt.Ledger.GetMeta()
+	manifest := &Manifest{
+		Version:     Version,
+		Bcname:      opt.Bcname,
+		Height:      opt.Block.Height,
+		Blockid:     hex.EncodeToString(opt.Block.Blockid),
+		RootBlockid: hex.EncodeToString(meta.RootBlockid),
+		Timestamp:   time.Now().Unix(),
+		Tables:      make(map[string]migrate.Stats),
+	}
+
+	blockBuf, err := proto.Marshal(opt.Block)
+	if err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, blockFile), blockBuf, 0644); err != nil {
+		return nil, err
+	}
+	genesis, err := ioutil.ReadFile(opt.GenesisPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, genesisFile), genesis, 0644); err != nil {
+		return nil, err
+	}
+
+	// xmodel的值保存在写入它的交易中，需要连同状态一起导出这些交易
+	refTxids, stats, err := exportState(filepath.Join(dir, stateDB+recordSuffix), opt.StateDB)
+	if err != nil {
+		return nil, fmt.Errorf("export state error: %s", err)
+	}
+	manifest.Tables[stateDB] = stats
+
+	stats, err = exportLedger(filepath.Join(dir, ledgerDB+recordSuffix), opt, meta.RootBlockid, refTxids)
+	if err != nil {
+		return nil, fmt.Errorf("export ledger error: %s", err)
+	}
+	manifest.Tables[ledgerDB] = stats
+
+	if err := writeManifest(dir, manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// exportState writes all the records of state db except unconfirmed txs,
+// and returns the txids referenced by xmodel versions
+func exportState(path string, db kvdb.Database) ([][]byte, migrate.Stats, error) {
+	rw, err := newRecordWriter(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	var refTxids [][]byte
+	iter := db.NewIteratorWithPrefix(nil)
+	defer iter.Release()
+	for iter.Next() {
+		key, value := iter.Key(), iter.Value()
+		if len(key) > 0 && string(key[:1]) == pb.UnconfirmedTablePrefix {
+			continue
+		}
+		if len(key) > len(pb.ExtUtxoTablePrefix) && string(key[:len(pb.ExtUtxoTablePrefix)]) == pb.ExtUtxoTablePrefix {
+			if txid := xmodel.GetTxidFromVersion(string(value)); len(txid) > 0 {
+				refTxids = append(refTxids, txid)
+			}
+		}
+		if err := rw.write(key, value); err != nil {
+			rw.close()
+			return nil, nil, err
+		}
+	}
+	if iter.Error() != nil {
+		rw.close()
+		return nil, nil, iter.Error()
+	}
+	stats, err := rw.close()
+	if err != nil {
+		return nil, nil, err
+	}
+	return refTxids, stats, nil
+}
+
+// exportLedger writes a ledger whose genesis is the root block and tip is the snapshot block
+func exportLedger(path string, opt *ExportOptions, rootBlockid []byte, refTxids [][]byte) (migrate.Stats, error) {
+	rw, err := newRecordWriter(path)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := writeLedger(rw, opt, rootBlockid, refTxids)
+	if err != nil {
+		rw.close()
+		return nil, err
+	}
+	return stats, nil
+}
+
+func writeLedger(rw *recordWriter, opt *ExportOptions, rootBlockid []byte, refTxids [][]byte) (migrate.Stats, error) {
+	meta := &pb.LedgerMeta{
+		RootBlockid: rootBlockid,
+		TipBlockid:  opt.Block.Blockid,
+		TrunkHeight: opt.Block.Height,
+	}
+	metaBuf, err := proto.Marshal(meta)
+	if err != nil {
+		return nil, err
+	}
+	if err := rw.write([]byte(pb.MetaTablePrefix), metaBuf); err != nil {
+		return nil, err
+	}
+
+	root, err := opt.Ledger.QueryBlock(rootBlockid)
+	if err != nil {
+		return nil, err
+	}
+	blocks := []*pb.InternalBlock{root}
+	if opt.Block.Height > 0 {
+		blocks = append(blocks, opt.Block)
+	}
+	written := make(map[string]bool)
+	for _, block := range blocks {
+		if err := writeBlock(rw, block, opt.Block.Blockid); err != nil {
+			return nil, err
+		}
+		for _, tx := range block.Transactions {
+			if err := writeTx(rw, opt.Ledger, tx.Txid, written); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, txid := range refTxids {
+		if err := writeTx(rw, opt.Ledger, txid, written); err != nil {
+			return nil, err
+		}
+	}
+	// 开启状态根的链需要快照区块的状态树来计算后续区块的状态根
+	if err := opt.Ledger.WalkStateTree(opt.Block.StateRoot, rw.write); err != nil {
+		return nil, fmt.Errorf("export state tree error: %s", err)
+	}
+	return rw.close()
+}
+
+// writeBlock writes the block header, its height index and the branch info if it is the tip
+func writeBlock(rw *recordWriter, block *pb.InternalBlock, tipBlockid []byte) error {
+	header := proto.Clone(block).(*pb.InternalBlock)
+	header.Transactions = nil
+	isTip := string(block.Blockid) == string(tipBlockid)
+	if isTip {
+		header.NextHash = nil
+	}
+	buf, err := proto.Marshal(header)
+	if err != nil {
+		return err
+	}
+	if err := rw.write(append([]byte(pb.BlocksTablePrefix), block.Blockid...), buf); err != nil {
+		return err
+	}
+	sHeight := []byte(fmt.Sprintf("%020d", block.Height))
+	if err := rw.write(append([]byte(pb.BlockHeightPrefix), sHeight...), block.Blockid); err != nil {
+		return err
+	}
+	if !isTip {
+		return nil
+	}
+	return rw.write(append([]byte(pb.BranchInfoPrefix), block.Blockid...), []byte(strconv.FormatInt(block.Height, 10)))
+}
+
+// writeTx copies the confirmed tx from ledger
+func writeTx(rw *recordWriter, l *ledger.Ledger, txid []byte, written map[string]bool) error {
+	if written[string(txid)] {
+		return nil
+	}
+	key := append([]byte(pb.ConfirmedTablePrefix), txid...)
+	value, err := l.GetBaseDB().Get(key)
+	if err != nil {
+		return fmt.Errorf("query tx %x error: %s", txid, err)
+	}
+	written[string(txid)] = true
+	return rw.write(key, value)
+}
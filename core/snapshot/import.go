@@ -0,0 +1,340 @@
+package snapshot
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/xuperchain/xuperchain/core/crypto/client"
+	"github.com/xuperchain/xuperchain/core/kv/kvdb"
+	"github.com/xuperchain/xuperchain/core/kv/kvdb/migrate"
+	"github.com/xuperchain/xuperchain/core/ledger"
+	"github.com/xuperchain/xuperchain/core/pb"
+	"github.com/xuperchain/xuperchain/core/utxo"
+	"github.com/xuperchain/xuperchain/core/utxo/txhash"
+	"github.com/xuperchain/xuperchain/core/xmodel"
+)
+
+// ImportOptions is the options to import a snapshot
+type ImportOptions struct {
+	// Dir is the snapshot directory
+	Dir string
+	// Datapath is the data path of chain to create, such as data/blockchain/xuper
+	Datapath   string
+	OtherPaths []string
+	// KVEngine overrides the kvengine in genesis config if not empty
+	KVEngine string
+	// TrustedBlockid is the hex encoded blockid at snapshot height got from a trusted source,
+	// import fails if the snapshot block is not the same
+	TrustedBlockid string
+	// TrustedGenesis is the path of the genesis config got from a trusted source, import fails if
+	// the genesis config or the genesis block of snapshot is not made from it.
+	// At least one of TrustedBlockid and TrustedGenesis is required
+	TrustedGenesis string
+	// Unsafe allows importing the snapshot of a chain without state root, whose state can't be verified,
+	// TrustedBlockid is still required
+	Unsafe    bool
+	BatchSize int
+}
+
+// Import verifies the snapshot and writes it into the data path of a new chain.
+// After import, the node starts from the snapshot block and syncs the following blocks from peers.
+func Import(opt *ImportOptions) (*Manifest, error) {
+	manifest, err := ReadManifest(opt.Dir)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(filepath.Join(opt.Datapath, ledgerDB)); err == nil {
+		return nil, ErrChainExist
+	}
+	manifest, err = importChain(opt, manifest)
+	if err != nil {
+		// 导入失败时清理已写入的数据，避免节点用不完整的账本启动
+		os.RemoveAll(filepath.Join(opt.Datapath, ledgerDB))
+		os.RemoveAll(filepath.Join(opt.Datapath, stateDB))
+		return nil, err
+	}
+	return manifest, nil
+}
+
+func importChain(opt *ImportOptions, manifest *Manifest) (*Manifest, error) {
+	block, err := verifyBlock(opt, manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	genesis, err := ioutil.ReadFile(filepath.Join(opt.Dir, genesisFile))
+	if err != nil {
+		return nil, err
+	}
+	config, genesis, err := parseGenesis(genesis, opt.KVEngine)
+	if err != nil {
+		return nil, err
+	}
+	var trustedGenesis []byte
+	if opt.TrustedGenesis != "" {
+		if trustedGenesis, err = ioutil.ReadFile(opt.TrustedGenesis); err != nil {
+			return nil, err
+		}
+		if err := matchGenesis(trustedGenesis, config); err != nil {
+			return nil, err
+		}
+	}
+	kvEngine := config.KVEngine
+	if err := os.MkdirAll(opt.Datapath, 0755); err != nil {
+		return nil, err
+	}
+	for _, name := range []string{ledgerDB, stateDB} {
+		if err := importDB(opt, kvEngine, name, manifest.Tables[name]); err != nil {
+			return nil, fmt.Errorf("import %s error: %s", name, err)
+		}
+	}
+
+	// 导入后用账本自身的校验逻辑验证快照区块的签名
+	l, err := ledger.OpenLedger(opt.Datapath, nil, opt.OtherPaths, kvEngine, config.Crypto)
+	if err != nil {
+		return nil, err
+	}
+	defer l.Close()
+	if !bytes.Equal(l.GetMeta().TipBlockid, block.Blockid) {
+		return nil, ErrBlockMismatch
+	}
+	if trustedGenesis != nil {
+		if err := verifyGenesisBlock(l.GetGenesisBlock().GetInternalBlock(), trustedGenesis); err != nil {
+			return nil, err
+		}
+	}
+	if ok, err := l.VerifyBlock(block, "snapshot"); !ok {
+		return nil, fmt.Errorf("verify snapshot block failed: %v", err)
+	}
+	// 由导入的状态重建状态树, 状态根需与快照区块一致
+	if len(block.StateRoot) > 0 {
+		if err := verifyState(opt, l, block); err != nil {
+			return nil, fmt.Errorf("verify snapshot state failed: %v", err)
+		}
+	}
+	if err := ioutil.WriteFile(filepath.Join(opt.Datapath, genesisFile), genesis, 0644); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// verifyBlock checks the snapshot block matches the manifest and the trusted blockid,
+// and its blockid and merkle root are computed from its content
+func verifyBlock(opt *ImportOptions, manifest *Manifest) (*pb.InternalBlock, error) {
+	buf, err := ioutil.ReadFile(filepath.Join(opt.Dir, blockFile))
+	if err != nil {
+		return nil, err
+	}
+	block := &pb.InternalBlock{}
+	if err := proto.Unmarshal(buf, block); err != nil {
+		return nil, err
+	}
+	blockid := hex.EncodeToString(block.Blockid)
+	if blockid != manifest.Blockid || block.Height != manifest.Height {
+		return nil, ErrBlockMismatch
+	}
+	if opt.TrustedBlockid == "" && opt.TrustedGenesis == "" {
+		return nil, ErrUntrusted
+	}
+	if opt.TrustedBlockid != "" && opt.TrustedBlockid != blockid {
+		return nil, fmt.Errorf("%s, trusted %s got %s", ErrBlockMismatch, opt.TrustedBlockid, blockid)
+	}
+	// 未开启状态根的链无法校验导入的状态, 只有明确指定unsafe并给出可信的blockid时才允许导入
+	if len(block.StateRoot) == 0 && (!opt.Unsafe || opt.TrustedBlockid == "") {
+		return nil, ErrStateUnverifiable
+	}
+	realBlockid, err := ledger.MakeBlockID(block)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(realBlockid, block.Blockid) {
+		return nil, fmt.Errorf("%s, blockid is not computed from the block", ErrBlockMismatch)
+	}
+	if err := ledger.VerifyMerkle(block); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+// verifyState rebuilds the state tree from the imported utxo and xmodel records,
+// the values of xmodel are read from the imported txs
+func verifyState(opt *ImportOptions, l *ledger.Ledger, block *pb.InternalBlock) error {
+	builder := ledger.NewStateTreeBuilder()
+	_, err := readRecords(filepath.Join(opt.Dir, stateDB+recordSuffix), func(key, value []byte) error {
+		switch {
+		case bytes.HasPrefix(key, []byte(pb.ExtUtxoTablePrefix)):
+			return putXModelState(builder, l, key[len(pb.ExtUtxoTablePrefix):], string(value))
+		case bytes.HasPrefix(key, []byte(pb.UTXOTablePrefix)):
+			return putUtxoState(builder, key[len(pb.UTXOTablePrefix):], value)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return l.RebuildStateTree(builder, block.StateRoot)
+}
+
+// putUtxoState parses the utxo record whose key is addr_txid_offset
+func putUtxoState(builder *ledger.StateTreeBuilder, key, value []byte) error {
+	fields := strings.Split(string(key), "_")
+	if len(fields) < 3 {
+		return fmt.Errorf("invalid utxo key %s", key)
+	}
+	addr := strings.Join(fields[:len(fields)-2], "_")
+	txid, err := hex.DecodeString(fields[len(fields)-2])
+	if err != nil {
+		return fmt.Errorf("invalid utxo key %s", key)
+	}
+	offset, err := strconv.ParseInt(fields[len(fields)-1], 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid utxo key %s", key)
+	}
+	item := &utxo.UtxoItem{}
+	if err := item.Loads(value); err != nil || item.Amount == nil {
+		return fmt.Errorf("invalid utxo %s", key)
+	}
+	builder.PutUtxo([]byte(addr), txid, int32(offset), item.Amount.Bytes(), item.FrozenHeight)
+	return nil
+}
+
+// putXModelState parses the xmodel record whose key is bucket/key and value is the version
+func putXModelState(builder *ledger.StateTreeBuilder, l *ledger.Ledger, rawKey []byte, version string) error {
+	idx := bytes.Index(rawKey, []byte("/"))
+	if idx < 0 {
+		return fmt.Errorf("invalid xmodel key %s", rawKey)
+	}
+	fields := strings.Split(version, "_")
+	if len(fields) != 2 {
+		return fmt.Errorf("invalid xmodel version %s", version)
+	}
+	txid, err := hex.DecodeString(fields[0])
+	if err != nil {
+		return fmt.Errorf("invalid xmodel version %s", version)
+	}
+	offset, err := strconv.ParseInt(fields[1], 10, 32)
+	if err != nil || xmodel.MakeVersion(txid, int32(offset)) != version {
+		return fmt.Errorf("invalid xmodel version %s", version)
+	}
+	tx, err := l.QueryTransaction(txid)
+	if err != nil {
+		return fmt.Errorf("query tx of xmodel version %s error: %v", version, err)
+	}
+	if offset < 0 || offset >= int64(len(tx.TxOutputsExt)) {
+		return fmt.Errorf("invalid xmodel version %s", version)
+	}
+	output := tx.TxOutputsExt[offset]
+	bucket, key := string(rawKey[:idx]), rawKey[idx+1:]
+	if output.Bucket != bucket || !bytes.Equal(output.Key, key) {
+		return fmt.Errorf("xmodel version %s not match key %s", version, rawKey)
+	}
+	builder.PutXModel(bucket, key, version, output.Value)
+	return nil
+}
+
+// parseGenesis parses the genesis config of snapshot, the default crypto type and kvengine are filled in config.
+// If kvEngine is not empty, it overrides the kvengine in both config and the returned genesis
+func parseGenesis(genesis []byte, kvEngine string) (*ledger.RootConfig, []byte, error) {
+	config := &ledger.RootConfig{}
+	if err := json.Unmarshal(genesis, config); err != nil {
+		return nil, nil, fmt.Errorf("parse %s error: %s", genesisFile, err)
+	}
+	if config.Crypto == "" {
+		config.Crypto = client.CryptoTypeDefault
+	}
+	if kvEngine == "" {
+		if config.KVEngine == "" {
+			config.KVEngine = "default"
+		}
+		return config, genesis, nil
+	}
+	config.KVEngine = kvEngine
+	genesis, err := migrate.SetKVEngine(genesis, kvEngine)
+	return config, genesis, err
+}
+
+// matchGenesis checks the genesis config of snapshot is the same as the trusted one,
+// except the kvengine which is chosen by each node
+func matchGenesis(trusted []byte, config *ledger.RootConfig) error {
+	trustedConfig, _, err := parseGenesis(trusted, config.KVEngine)
+	if err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(trustedConfig, config) {
+		return fmt.Errorf("%s, genesis config is not the trusted one", ErrGenesisMismatch)
+	}
+	return nil
+}
+
+// verifyGenesisBlock checks the genesis block is made from the trusted genesis config
+func verifyGenesisBlock(block *pb.InternalBlock, trusted []byte) error {
+	if len(block.Transactions) != 1 || !bytes.Equal(block.Transactions[0].Desc, trusted) {
+		return fmt.Errorf("%s, genesis block is not made from the trusted genesis", ErrGenesisMismatch)
+	}
+	txid, err := txhash.MakeTransactionID(block.Transactions[0])
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(txid, block.Transactions[0].Txid) {
+		return fmt.Errorf("%s, txid is not computed from the genesis tx", ErrGenesisMismatch)
+	}
+	blockid, err := ledger.MakeBlockID(block)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(blockid, block.Blockid) {
+		return fmt.Errorf("%s, blockid is not computed from the genesis block", ErrGenesisMismatch)
+	}
+	return ledger.VerifyMerkle(block)
+}
+
+// importDB writes the records into a new db and compares their stats with manifest
+func importDB(opt *ImportOptions, kvEngine, name string, expect migrate.Stats) error {
+	db, err := kvdb.NewKVDBInstance(&kvdb.KVParameter{
+		DBPath:                filepath.Join(opt.Datapath, name),
+		KVEngineType:          kvEngine,
+		MemCacheSize:          ledger.MemCacheSize,
+		FileHandlersCacheSize: ledger.FileHandlersCacheSize,
+		OtherPaths:            opt.OtherPaths,
+	})
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	batchSize := opt.BatchSize
+	if batchSize <= 0 {
+		batchSize = migrate.DefaultBatchSize
+	}
+	batch := db.NewBatch()
+	stats, err := readRecords(filepath.Join(opt.Dir, name+recordSuffix), func(key, value []byte) error {
+		if err := batch.Put(key, value); err != nil {
+			return err
+		}
+		if batch.ValueSize() < batchSize {
+			return nil
+		}
+		if err := batch.Write(); err != nil {
+			return err
+		}
+		batch.Reset()
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := expect.Compare(stats); err != nil {
+		return fmt.Errorf("verify failed: %s", err)
+	}
+	return batch.Write()
+}
@@ -0,0 +1,198 @@
+// Package snapshot exports the state of a chain at an irreversible block into a directory,
+// and imports it into the data path of a new node, which continues syncing from that block
+// instead of replaying all the blocks from genesis.
+//
+// A snapshot directory contains:
+//
+//	manifest.json  the height, blockid and the table stats of the record files
+//	block.pb       the block of the snapshot height with transactions
+//	ledger.dat     the ledger records: meta, genesis and snapshot blocks and the referenced transactions
+//	utxoVM.dat     the state records: utxo, xmodel, contract and consensus tables and utxo meta
+//	xuper.json     the genesis config of the chain
+package snapshot
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/xuperchain/xuperchain/core/kv/kvdb/migrate"
+)
+
+const (
+	// Version is the format version of snapshot
+	Version = 1
+
+	manifestFile = "manifest.json"
+	blockFile    = "block.pb"
+	genesisFile  = "xuper.json"
+	ledgerDB     = "ledger"
+	stateDB      = "utxoVM"
+	recordSuffix = ".dat"
+
+	// maxRecordSize limits the size of key or value read from a record file
+	maxRecordSize = 256 * 1024 * 1024
+)
+
+var (
+	// ErrVersionNotSupported returns when the snapshot is exported by an incompatible version
+	ErrVersionNotSupported = errors.New("snapshot version not supported")
+	// ErrBlockMismatch returns when the block of snapshot does not match the manifest or trusted blockid
+	ErrBlockMismatch = errors.New("snapshot block mismatch")
+	// ErrChainExist returns when importing into a data path which already has a chain
+	ErrChainExist = errors.New("chain already exists in data path")
+	// ErrStateUnverifiable returns when importing the snapshot of a chain without state root
+	// but the unsafe option and trusted blockid are not both set
+	ErrStateUnverifiable = errors.New("snapshot state can't be verified without state root, unsafe and trusted blockid required")
+	// ErrUntrusted returns when neither the trusted blockid nor the trusted genesis is set
+	ErrUntrusted = errors.New("snapshot can't be trusted, trusted blockid or genesis required")
+	// ErrGenesisMismatch returns when the genesis config or genesis block of snapshot does not match the trusted genesis
+	ErrGenesisMismatch = errors.New("snapshot genesis mismatch")
+)
+
+// Manifest describes a snapshot
+type Manifest struct {
+	Version int    `json:"version"`
+	Bcname  string `json:"bcname"`
+	Height  int64  `json:"height"`
+	// Blockid is the hex encoded id of the block at Height
+	Blockid string `json:"blockid"`
+	// RootBlockid is the hex encoded id of the genesis block
+	RootBlockid string `json:"root_blockid"`
+	Timestamp   int64  `json:"timestamp"`
+	// Tables are the stats of record files, keyed by db name
+	Tables map[string]migrate.Stats `json:"tables"`
+}
+
+// ReadManifest reads and checks the manifest of snapshot in dir
+func ReadManifest(dir string) (*Manifest, error) {
+	buf, err := ioutil.ReadFile(filepath.Join(dir, manifestFile))
+	if err != nil {
+		return nil, err
+	}
+	manifest := &Manifest{}
+	if err := json.Unmarshal(buf, manifest); err != nil {
+		return nil, fmt.Errorf("parse %s error: %s", manifestFile, err)
+	}
+	if manifest.Version != Version {
+		return nil, ErrVersionNotSupported
+	}
+	return manifest, nil
+}
+
+func writeManifest(dir string, manifest *Manifest) error {
+	buf, err := json.MarshalIndent(manifest, "", "    ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, manifestFile), buf, 0644)
+}
+
+// recordWriter writes length prefixed key values into a record file and stats them
+type recordWriter struct {
+	f     *os.File
+	w     *bufio.Writer
+	stats migrate.Stats
+}
+
+func newRecordWriter(path string) (*recordWriter, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &recordWriter{
+		f:     f,
+		w:     bufio.NewWriter(f),
+		stats: make(migrate.Stats),
+	}, nil
+}
+
+func (rw *recordWriter) write(key, value []byte) error {
+	if err := writeBytes(rw.w, key); err != nil {
+		return err
+	}
+	if err := writeBytes(rw.w, value); err != nil {
+		return err
+	}
+	rw.stats.Add(key, value)
+	return nil
+}
+
+// close flushes the records to disk and returns the stats
+func (rw *recordWriter) close() (migrate.Stats, error) {
+	defer rw.f.Close()
+	if err := rw.w.Flush(); err != nil {
+		return nil, err
+	}
+	if err := rw.f.Sync(); err != nil {
+		return nil, err
+	}
+	return rw.stats.Finish(), nil
+}
+
+func writeBytes(w io.Writer, buf []byte) error {
+	var lenbuf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(lenbuf[:], uint64(len(buf)))
+	if _, err := w.Write(lenbuf[:n]); err != nil {
+		return err
+	}
+	_, err := w.Write(buf)
+	return err
+}
+
+// readRecords calls fn with every key value in the record file,
+// and returns the stats of all records read
+func readRecords(path string, fn func(key, value []byte) error) (migrate.Stats, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	r := bufio.NewReader(f)
+	stats := make(migrate.Stats)
+	for {
+		key, err := readBytes(r)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		value, err := readBytes(r)
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		stats.Add(key, value)
+		if err := fn(key, value); err != nil {
+			return nil, err
+		}
+	}
+	return stats.Finish(), nil
+}
+
+func readBytes(r *bufio.Reader) ([]byte, error) {
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if size > maxRecordSize {
+		return nil, fmt.Errorf("record too large: %d", size)
+	}
+	buf := make([]byte, size)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return buf, nil
+}
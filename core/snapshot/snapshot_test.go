@@ -0,0 +1,194 @@
+package snapshot
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/xuperchain/xuperchain/core/kv/kvdb/migrate"
+	"github.com/xuperchain/xuperchain/core/ledger"
+	"github.com/xuperchain/xuperchain/core/pb"
+	"github.com/xuperchain/xuperchain/core/utxo/txhash"
+)
+
+func TestRecordRoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "snapshot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "state.dat")
+	rw, err := newRecordWriter(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := map[string]string{}
+	for i := 0; i < 100; i++ {
+		key := fmt.Sprintf("U%03d", i)
+		value := fmt.Sprintf("value%d", i)
+		expect[key] = value
+		if err := rw.write([]byte(key), []byte(value)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := rw.write([]byte("ZUempty"), nil); err != nil {
+		t.Fatal(err)
+	}
+	expect["ZUempty"] = ""
+	wstats, err := rw.close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := map[string]string{}
+	rstats, err := readRecords(path, func(key, value []byte) error {
+		got[string(key)] = string(value)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := wstats.Compare(rstats); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(expect) {
+		t.Fatalf("expect %d records got %d", len(expect), len(got))
+	}
+	for k, v := range expect {
+		if got[k] != v {
+			t.Fatalf("key %s expect %q got %q", k, v, got[k])
+		}
+	}
+
+	// 截断的记录文件需要报错
+	buf, _ := ioutil.ReadFile(path)
+	ioutil.WriteFile(path, buf[:len(buf)-3], 0644)
+	if _, err := readRecords(path, func(key, value []byte) error { return nil }); err == nil {
+		t.Fatal("expect error of truncated record file")
+	}
+}
+
+func TestVerifyBlock(t *testing.T) {
+	dir, err := ioutil.TempDir("", "snapshot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tx := &pb.Transaction{Txid: []byte("txid"), Desc: []byte("snapshot")}
+	block := &pb.InternalBlock{
+		Version:      ledger.BlockVersion,
+		Height:       10,
+		PreHash:      []byte("prehash"),
+		Transactions: []*pb.Transaction{tx},
+		TxCount:      1,
+	}
+	block.MerkleTree = ledger.MakeMerkleTree(block.Transactions)
+	block.MerkleRoot = block.MerkleTree[len(block.MerkleTree)-1]
+	block.Blockid, err = ledger.MakeBlockID(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf, _ := proto.Marshal(block)
+	ioutil.WriteFile(filepath.Join(dir, blockFile), buf, 0644)
+
+	manifest := &Manifest{
+		Version: Version,
+		Height:  10,
+		Blockid: hex.EncodeToString(block.Blockid),
+		Tables:  map[string]migrate.Stats{},
+	}
+	// 区块未开启状态根时需指定unsafe和可信的blockid, 可信的创世配置不足以校验状态
+	if _, err := verifyBlock(&ImportOptions{Dir: dir, TrustedGenesis: genesisFile}, manifest); err != ErrStateUnverifiable {
+		t.Fatalf("expect ErrStateUnverifiable got %v", err)
+	}
+	if _, err := verifyBlock(&ImportOptions{Dir: dir, TrustedGenesis: genesisFile, Unsafe: true}, manifest); err != ErrStateUnverifiable {
+		t.Fatalf("expect ErrStateUnverifiable got %v", err)
+	}
+	if _, err := verifyBlock(&ImportOptions{Dir: dir, TrustedBlockid: manifest.Blockid, Unsafe: true}, manifest); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := verifyBlock(&ImportOptions{Dir: dir, TrustedBlockid: "abcd", Unsafe: true}, manifest); err == nil {
+		t.Fatal("expect error of untrusted blockid")
+	}
+
+	// 开启状态根的区块无需unsafe, 但需指定可信的blockid或创世配置
+	block.StateRoot = []byte("state root")
+	block.Blockid, _ = ledger.MakeBlockID(block)
+	buf, _ = proto.Marshal(block)
+	ioutil.WriteFile(filepath.Join(dir, blockFile), buf, 0644)
+	manifest.Blockid = hex.EncodeToString(block.Blockid)
+	if _, err := verifyBlock(&ImportOptions{Dir: dir}, manifest); err != ErrUntrusted {
+		t.Fatalf("expect ErrUntrusted got %v", err)
+	}
+	if _, err := verifyBlock(&ImportOptions{Dir: dir, TrustedGenesis: genesisFile}, manifest); err != nil {
+		t.Fatal(err)
+	}
+
+	// 篡改区块内容后blockid校验失败
+	block.Nonce = 1
+	buf, _ = proto.Marshal(block)
+	ioutil.WriteFile(filepath.Join(dir, blockFile), buf, 0644)
+	if _, err := verifyBlock(&ImportOptions{Dir: dir, TrustedGenesis: genesisFile}, manifest); err == nil {
+		t.Fatal("expect error of tampered block")
+	}
+
+	// 篡改交易后merkle root校验失败
+	block.Nonce = 0
+	block.Transactions[0].Txid = []byte("tampered")
+	buf, _ = proto.Marshal(block)
+	ioutil.WriteFile(filepath.Join(dir, blockFile), buf, 0644)
+	if _, err := verifyBlock(&ImportOptions{Dir: dir, TrustedGenesis: genesisFile}, manifest); err == nil {
+		t.Fatal("expect error of tampered tx")
+	}
+
+	// manifest的版本不兼容
+	manifest.Version = Version + 1
+	writeManifest(dir, manifest)
+	if _, err := ReadManifest(dir); err != ErrVersionNotSupported {
+		t.Fatalf("expect ErrVersionNotSupported got %v", err)
+	}
+}
+
+func TestVerifyGenesis(t *testing.T) {
+	trusted := []byte(`{"version": "1", "crypto": "default", "genesis_consensus": {"name": "tdpos"}}`)
+	// 快照的创世配置只有格式和kvengine不同
+	config, genesis, err := parseGenesis([]byte(`{"version":"1","kvengine":"leveldb","genesis_consensus":{"name":"tdpos"}}`), "badger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.KVEngine != "badger" || config.Crypto != "default" || string(genesis) !=
+		`{"version":"1","kvengine":"badger","genesis_consensus":{"name":"tdpos"}}` {
+		t.Fatalf("unexpected genesis %+v %s", config, genesis)
+	}
+	if err := matchGenesis(trusted, config); err != nil {
+		t.Fatal(err)
+	}
+	config.GenesisConsensus["name"] = "xpoa"
+	if err := matchGenesis(trusted, config); err == nil {
+		t.Fatal("expect error of mismatched genesis config")
+	}
+
+	tx := &pb.Transaction{Desc: trusted, Coinbase: true}
+	tx.Txid, _ = txhash.MakeTransactionID(tx)
+	block := &pb.InternalBlock{Version: ledger.RootBlockVersion, Transactions: []*pb.Transaction{tx}, TxCount: 1}
+	block.MerkleTree = ledger.MakeMerkleTree(block.Transactions)
+	block.MerkleRoot = block.MerkleTree[len(block.MerkleTree)-1]
+	block.Blockid, _ = ledger.MakeBlockID(block)
+	if err := verifyGenesisBlock(block, trusted); err != nil {
+		t.Fatal(err)
+	}
+	if err := verifyGenesisBlock(block, genesis); err == nil {
+		t.Fatal("expect error of genesis block not made from the trusted genesis")
+	}
+	block.Nonce = 1
+	if err := verifyGenesisBlock(block, trusted); err == nil {
+		t.Fatal("expect error of tampered genesis block")
+	}
+}
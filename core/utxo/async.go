@@ -145,6 +145,7 @@ func (uv *UtxoVM) flushTxList(txList []*pb.Transaction) error {
 	}
 	batch := uv.asyncBatch
 	batch.Reset()
+	addedTxs := make([]*pb.Transaction, 0, len(txList))
 	for i, tx := range txList {
 		if uv.asyncBlockMode && pbTxList[i] == nil {
 			uv.asyncResult.Send(tx.Txid, errors.New("marshal failed"))
@@ -163,6 +164,7 @@ func (uv *UtxoVM) flushTxList(txList []*pb.Transaction) error {
 			continue
 		}
 		uv.unconfirmTxInMem.Store(string(tx.Txid), tx)
+		addedTxs = append(addedTxs, tx)
 		if uv.asyncBlockMode {
 			batch.Put(append([]byte(pb.UnconfirmedTablePrefix), tx.Txid...), pbTxList[i])
 		}
@@ -172,6 +174,10 @@ func (uv *UtxoVM) flushTxList(txList []*pb.Transaction) error {
 	if writeErr != nil {
 		uv.ClearCache()
 		uv.xlog.Warn("fail to save to ldb", "writeErr", writeErr)
+	} else {
+		for _, tx := range addedTxs {
+			uv.notifyUnconfirmedTx(tx)
+		}
 	}
 	// 对于异步阻塞模式，有必要在执行完一个交易后同步PostTx，并将结果返回给客户端
 	if uv.asyncBlockMode {
@@ -0,0 +1,52 @@
+package utxo
+
+import (
+	"github.com/xuperchain/xuperchain/core/common/events"
+	"github.com/xuperchain/xuperchain/core/pb"
+)
+
+// ChainReorgEvent is the message of events.ChainReorganized
+type ChainReorgEvent struct {
+	BcName string
+	// UndoBlocks blocks rolled back, from the old tip down to the fork point
+	UndoBlocks []*pb.InternalBlock
+	// RedoBlocks blocks executed on the new trunk, from the fork point up to the new tip
+	RedoBlocks []*pb.InternalBlock
+}
+
+// notifyUnconfirmedTx fires a UnconfirmedTxAdded event after tx entered the unconfirmed pool
+func (uv *UtxoVM) notifyUnconfirmedTx(tx *pb.Transaction) {
+	em := &events.EventMessage{
+		BcName:  uv.bcname,
+		Type:    events.UnconfirmedTxAdded,
+		Sender:  uv,
+		Message: tx,
+	}
+	if err := events.GetEventBus().FireEvent(em); err != nil {
+		uv.xlog.Warn("notifyUnconfirmedTx fire event failed", "error", err)
+	}
+}
+
+// notifyChainReorg fires a ChainReorganized event after utxoVM walked to a new trunk
+func (uv *UtxoVM) notifyChainReorg(undoBlocks, todoBlocks []*pb.InternalBlock) {
+	if len(undoBlocks) == 0 {
+		return
+	}
+	redoBlocks := make([]*pb.InternalBlock, 0, len(todoBlocks))
+	for i := len(todoBlocks) - 1; i >= 0; i-- {
+		redoBlocks = append(redoBlocks, todoBlocks[i])
+	}
+	em := &events.EventMessage{
+		BcName: uv.bcname,
+		Type:   events.ChainReorganized,
+		Sender: uv,
+		Message: &ChainReorgEvent{
+			BcName:     uv.bcname,
+			UndoBlocks: undoBlocks,
+			RedoBlocks: redoBlocks,
+		},
+	}
+	if err := events.GetEventBus().FireEvent(em); err != nil {
+		uv.xlog.Warn("notifyChainReorg fire event failed", "error", err)
+	}
+}
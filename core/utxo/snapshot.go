@@ -0,0 +1,54 @@
+package utxo
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/xuperchain/xuperchain/core/kv/kvdb"
+)
+
+// ExportState rewinds the state of utxoVM to blockid, calls export with the state db,
+// then replays the rewound blocks to restore the state.
+// blockid must be an ancestor of the latest block, unconfirmed txs are rolled back during export
+// and recovered afterwards. The global lock is held until the state is restored.
+func (uv *UtxoVM) ExportState(blockid []byte, export func(stateDB kvdb.Database) error) error {
+	uv.xlog.Info("utxoVM start export state", "dest_block", hex.EncodeToString(blockid),
+		"latest_blockid", hex.EncodeToString(uv.latestBlockid))
+
+	uv.mutex.Lock()
+	defer uv.mutex.Unlock()
+
+	undoDone, undoList, err := uv.RollBackUnconfirmedTx()
+	if err != nil {
+		return fmt.Errorf("export state rollback unconfirm tx fail: %s", err)
+	}
+	defer func() {
+		go uv.recoverUnconfirmedTx(undoList)
+	}()
+	uv.clearBalanceCache()
+
+	undoBlocks, todoBlocks, err := uv.ledger.FindUndoAndTodoBlocks(uv.latestBlockid, blockid)
+	if err != nil {
+		return fmt.Errorf("export state find common parent block fail: %s", err)
+	}
+	if len(todoBlocks) > 0 {
+		return fmt.Errorf("block %x is not an ancestor of the latest block", blockid)
+	}
+	if err := uv.procUndoBlkForWalk(undoBlocks, undoDone, false); err != nil {
+		return fmt.Errorf("export state undo block fail: %s", err)
+	}
+	if !bytes.Equal(uv.latestBlockid, blockid) {
+		return fmt.Errorf("export state rewind to %x fail, latest block is %x", blockid, uv.latestBlockid)
+	}
+
+	exportErr := export(uv.ldb)
+
+	// 重新执行回滚掉的区块, procTodoBlkForWalk从后往前执行
+	if err := uv.procTodoBlkForWalk(undoBlocks); err != nil {
+		return fmt.Errorf("export state redo block fail: %s", err)
+	}
+	uv.xlog.Info("utxoVM export state finish", "dest_block", hex.EncodeToString(blockid),
+		"latest_blockid", hex.EncodeToString(uv.latestBlockid), "export_err", exportErr)
+	return exportErr
+}
@@ -0,0 +1,45 @@
+package utxo
+
+import (
+	"fmt"
+
+	"github.com/xuperchain/xuperchain/core/contract"
+	"github.com/xuperchain/xuperchain/core/xmodel"
+)
+
+// storageRentContext returns the storage rent context of the txs executed in the block at height,
+// nil if storage rent is disabled in genesis
+func (uv *UtxoVM) storageRentContext(height int64) *contract.StorageRentContext {
+	cfg := uv.ledger.GetStorageRent()
+	if cfg == nil {
+		return nil
+	}
+	return &contract.StorageRentContext{
+		StorageRentConfig: *cfg,
+		GasPrice:          uv.GetGasPrice(),
+		Height:            height,
+	}
+}
+
+// checkStorageArchived returns error if the contract to invoke is archived for unpaid storage rent
+func checkStorageArchived(contextConfig *contract.ContextConfig, contractName string) error {
+	if contextConfig.StorageRent == nil || contractName == "" {
+		return nil
+	}
+	rent, err := contextConfig.XMCache.GetStorageRent(contractName)
+	if err != nil {
+		return err
+	}
+	if rent.IsArchived() {
+		return fmt.Errorf("invoke contract %s error: %s, restore it by xkernel.RestoreStorage", contractName, xmodel.ErrStorageArchived)
+	}
+	return nil
+}
+
+// writeStorageUsage updates the storage size of contracts written by the tx if storage rent is enabled
+func writeStorageUsage(contextConfig *contract.ContextConfig) error {
+	if contextConfig.StorageRent == nil {
+		return nil
+	}
+	return contextConfig.XMCache.WriteStorageUsage()
+}
@@ -0,0 +1,183 @@
+package utxo
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/xuperchain/xuperchain/core/contract"
+	"github.com/xuperchain/xuperchain/core/ledger"
+	"github.com/xuperchain/xuperchain/core/pb"
+	"github.com/xuperchain/xuperchain/core/permission/rule"
+	"github.com/xuperchain/xuperchain/core/txn"
+	"github.com/xuperchain/xuperchain/core/xmodel"
+)
+
+// TracePreExec pre-executes the contract requests the same as PreExec,
+// and records the call tree with syscalls, resource usage and rwsets of every contract call
+func (uv *UtxoVM) TracePreExec(req *pb.InvokeRPCRequest) (*contract.TxTrace, error) {
+	reservedRequests, err := uv.getReservedContractRequests(req.GetRequests(), true)
+	if err != nil {
+		return nil, err
+	}
+	requests := append(reservedRequests, req.GetRequests()...)
+	modelCache, err := xmodel.NewXModelCache(uv.GetXModel(), uv)
+	if err != nil {
+		return nil, err
+	}
+	blockCtx := uv.pendingBlockContext()
+	contextConfig := &contract.ContextConfig{
+		XMCache:                  modelCache,
+		Initiator:                req.GetInitiator(),
+		AuthRequire:              req.GetAuthRequire(),
+		NewAccountResourceAmount: uv.meta.GetNewAccountResourceAmount(),
+		Core: contractChainCore{
+			Manager:  uv.aclMgr,
+			UtxoVM:   uv,
+			Ledger:   uv.ledger,
+			blockCtx: blockCtx,
+		},
+		BCName:            uv.bcname,
+		StorageRent:       uv.storageRentContext(blockCtx.Height),
+		EvmResourceLimits: uv.evmResourceLimits(blockCtx.Height),
+		EthGateway:        uv.ethGateway(blockCtx.Height),
+		ContractEvents:    uv.contractEvents(blockCtx.Height),
+	}
+	return uv.traceRequests(contextConfig, requests, requestResourceLimits)
+}
+
+// TraceTx re-executes the contract requests of tx against the versions in its read set,
+// which is the state the tx was executed with, and records the call tree like TracePreExec
+func (uv *UtxoVM) TraceTx(txid []byte) (*contract.TxTrace, error) {
+	blockCtx := uv.pendingBlockContext()
+	tx, err := uv.ledger.QueryTransaction(txid)
+	if err == ledger.ErrTxNotFound {
+		tx, err = uv.QueryTx(txid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(tx.GetBlockid()) > 0 {
+		block, err := uv.ledger.QueryBlockHeader(tx.GetBlockid())
+		if err != nil {
+			return nil, err
+		}
+		blockCtx = &rule.BlockContext{
+			Height:    block.GetHeight(),
+			Timestamp: block.GetTimestamp(),
+		}
+	}
+	env, err := uv.model3.PrepareEnv(tx)
+	if err != nil {
+		return nil, err
+	}
+	contextConfig := &contract.ContextConfig{
+		XMCache:     env.GetModelCache(),
+		Initiator:   tx.GetInitiator(),
+		AuthRequire: tx.GetAuthRequire(),
+		Core: contractChainCore{
+			Manager:  uv.aclMgr,
+			UtxoVM:   uv,
+			Ledger:   uv.ledger,
+			blockCtx: blockCtx,
+		},
+		BCName:            uv.bcname,
+		StorageRent:       uv.storageRentContext(blockCtx.Height),
+		EvmResourceLimits: uv.evmResourceLimits(blockCtx.Height),
+		EthGateway:        uv.ethGateway(blockCtx.Height),
+		ContractEvents:    uv.contractEvents(blockCtx.Height),
+	}
+	trace, err := uv.traceRequests(contextConfig, tx.GetContractRequests(), func(req *pb.InvokeRequest) contract.Limits {
+		return contract.FromPbLimits(req.GetResourceLimits())
+	})
+	if err != nil {
+		return nil, err
+	}
+	trace.Txid = hex.EncodeToString(txid)
+	return trace, nil
+}
+
+// traceRequests runs the requests in order until one of them fails, the failure is recorded
+// in the trace instead of being returned
+func (uv *UtxoVM) traceRequests(contextConfig *contract.ContextConfig, requests []*pb.InvokeRequest,
+	limitsOf func(*pb.InvokeRequest) contract.Limits) (*contract.TxTrace, error) {
+	transContractName, transAmount, err := txn.ParseContractTransferRequest(requests)
+	if err != nil {
+		return nil, err
+	}
+	trace := &contract.TxTrace{
+		Calls: []*contract.CallTrace{},
+	}
+	for _, req := range requests {
+		if req.GetModuleName() == "" && req.GetContractName() == "" && req.GetMethodName() == "" {
+			continue
+		}
+		call := &contract.CallTrace{
+			Module:         req.GetModuleName(),
+			Contract:       req.GetContractName(),
+			Method:         req.GetMethodName(),
+			ResourceLimits: limitsOf(req),
+		}
+		trace.Calls = append(trace.Calls, call)
+		if err := uv.traceRequest(contextConfig, req, call, transContractName, transAmount.String()); err != nil {
+			call.Error = err.Error()
+			trace.Error = fmt.Sprintf("contract %s error: %s", req.GetContractName(), err)
+			break
+		}
+		if call.Status >= contract.StatusErrorThreshold {
+			trace.Error = fmt.Sprintf("contract %s status %d: %s", req.GetContractName(), call.Status, call.Message)
+			break
+		}
+	}
+
+	modelCache := contextConfig.XMCache
+	if trace.Error == "" {
+		if err := writeStorageUsage(contextConfig); err != nil {
+			trace.Error = err.Error()
+		}
+	}
+	if err := modelCache.WriteTransientBucket(); err != nil {
+		return nil, err
+	}
+	inputs, outputs, err := modelCache.GetRWSets()
+	if err != nil {
+		return nil, err
+	}
+	trace.SetRWSets(inputs, outputs)
+	return trace, nil
+}
+
+func (uv *UtxoVM) traceRequest(contextConfig *contract.ContextConfig, req *pb.InvokeRequest,
+	call *contract.CallTrace, transContractName, transAmount string) error {
+	vm, err := uv.vmMgr3.GetVM(req.GetModuleName())
+	if err != nil {
+		return err
+	}
+	contextConfig.ContractName = req.GetContractName()
+	contextConfig.ResourceLimits = call.ResourceLimits
+	contextConfig.TransferAmount = ""
+	if transContractName == req.GetContractName() {
+		contextConfig.TransferAmount = transAmount
+	}
+	contextConfig.Trace = call
+	ctx, err := vm.NewContext(contextConfig)
+	if err != nil {
+		return err
+	}
+	defer ctx.Release()
+
+	// 非bridge实现的虚拟机不会填充调用记录, 此处统一记录调用结果
+	call.TransferAmount = contextConfig.TransferAmount
+	call.Args = make(map[string]contract.TraceBytes, len(req.GetArgs()))
+	for k, v := range req.GetArgs() {
+		call.Args[k] = v
+	}
+	res, err := ctx.Invoke(req.GetMethodName(), req.GetArgs())
+	call.ResourceUsed = ctx.ResourceUsed()
+	if err != nil {
+		return err
+	}
+	call.Status = res.Status
+	call.Message = res.Message
+	call.Body = res.Body
+	return nil
+}
@@ -16,11 +16,13 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/xuperchain/xuperchain/core/contract"
+	bls_sign "github.com/xuperchain/xuperchain/core/crypto/bls"
 	"github.com/xuperchain/xuperchain/core/crypto/client"
 	"github.com/xuperchain/xuperchain/core/pb"
 	pm "github.com/xuperchain/xuperchain/core/permission"
 	"github.com/xuperchain/xuperchain/core/permission/acl"
 	aclu "github.com/xuperchain/xuperchain/core/permission/acl/utils"
+	"github.com/xuperchain/xuperchain/core/permission/rule"
 	"github.com/xuperchain/xuperchain/core/txn"
 	"github.com/xuperchain/xuperchain/core/utxo/txhash"
 	"github.com/xuperchain/xuperchain/core/xmodel"
@@ -40,6 +42,75 @@ import (
 //   6. run contract requests and verify if the RWSet result is the same with preExed RWSet (heavy
 //      operation, keep it at last)
 func (uv *UtxoVM) ImmediateVerifyTx(tx *pb.Transaction, isRootTx bool) (bool, error) {
+	return uv.verifyTxAtBlock(tx, isRootTx, uv.pendingBlockContext())
+}
+
+// pendingBlockContext returns the context of the next block, which is used by block sensitive ACL rules
+// while verifying or pre-executing txs not in a block. The timestamp of the next block is unknown until it's built,
+// so the timestamp of the tip block is used instead of the local clock, and the ACLs of txs are checked again
+// against the block they are packed into
+func (uv *UtxoVM) pendingBlockContext() *rule.BlockContext {
+	meta := uv.ledger.GetMeta()
+	blockCtx := &rule.BlockContext{
+		Height: meta.GetTrunkHeight() + 1,
+	}
+	if tipBlock, err := uv.ledger.QueryBlockHeader(meta.GetTipBlockid()); err == nil {
+		blockCtx.Timestamp = tipBlock.GetTimestamp()
+	}
+	return blockCtx
+}
+
+// newBlockContext returns the context of block for block sensitive ACL rules
+func newBlockContext(block *pb.InternalBlock) *rule.BlockContext {
+	return &rule.BlockContext{
+		Height:    block.GetHeight(),
+		Timestamp: block.GetTimestamp(),
+	}
+}
+
+// verifyTxPermissionAtBlock verifies the ACLs of an unconfirmed tx against the block,
+// which is used to re-check block sensitive ACL rules like SIGN_TIMELOCK when the tx is packed into a block.
+// The signatures have been verified when the tx is received and don't depend on the block, so they are not verified again.
+// The contracts are not executed again since their RWSet has been applied, ACLs checked inside
+// contracts are evaluated against the pending block when the tx is received
+func (uv *UtxoVM) verifyTxPermissionAtBlock(tx *pb.Transaction, blockCtx *rule.BlockContext) (bool, error) {
+	if tx.Version <= RootTxVersion {
+		return true, nil
+	}
+	if isBlsXuperSign(tx) && !uv.blsXuperSign(blockCtx.Height) {
+		return false, ErrBlsXuperSignDisabled
+	}
+	verifiedID, initiatorAddr, err := uv.signedIDs(tx)
+	if err != nil {
+		uv.xlog.Warn("verifyTxPermissionAtBlock: signedIDs failed", "error", err)
+		return false, ErrInvalidSignature
+	}
+	if tx.GetXuperSign() == nil && acl.IsAccount(tx.Initiator) == 1 {
+		if ok, err := pm.IdentifyAccountAtBlock(tx.Initiator, initiatorAddr, uv.aclMgr, blockCtx); !ok {
+			uv.xlog.Warn("verifyTxPermissionAtBlock: initiator permission check failed", "account", tx.Initiator, "error", err)
+			return false, ErrACLNotEnough
+		}
+	}
+	var ok bool
+	authUsers := uv.removeDuplicateUser(tx.GetInitiator(), tx.GetAuthRequire())
+	if ok, err = uv.verifyUTXOPermission(tx, verifiedID, blockCtx); !ok {
+		uv.xlog.Warn("verifyTxPermissionAtBlock: verifyUTXOPermission failed", "error", err)
+		return ok, ErrACLNotEnough
+	}
+	if ok, err = uv.verifyContractPermission(tx, authUsers, blockCtx); !ok {
+		uv.xlog.Warn("verifyTxPermissionAtBlock: verifyContractPermission failed", "error", err)
+		return ok, ErrACLNotEnough
+	}
+	if ok, err = uv.verifyRWSetPermission(tx, verifiedID, blockCtx); !ok {
+		uv.xlog.Warn("verifyTxPermissionAtBlock: verifyRWSetPermission failed", "error", err)
+		return ok, ErrACLNotEnough
+	}
+	return true, nil
+}
+
+// verifyTxAtBlock is the same as ImmediateVerifyTx,
+// but block sensitive ACL rules are evaluated against the given block
+func (uv *UtxoVM) verifyTxAtBlock(tx *pb.Transaction, isRootTx bool, blockCtx *rule.BlockContext) (bool, error) {
 	// Pre processing of tx data
 	if !isRootTx && tx.Version == RootTxVersion {
 		return false, ErrVersionInvalid
@@ -81,7 +152,7 @@ func (uv *UtxoVM) ImmediateVerifyTx(tx *pb.Transaction, isRootTx bool) (bool, er
 		}
 
 		// verify signatures
-		ok, verifiedID, err := uv.verifySignatures(tx, digestHash)
+		ok, verifiedID, err := uv.verifySignatures(tx, digestHash, blockCtx)
 		if !ok {
 			uv.xlog.Warn("ImmediateVerifyTx: verifySignatures failed", "error", err)
 			return ok, ErrInvalidSignature
@@ -91,14 +162,14 @@ func (uv *UtxoVM) ImmediateVerifyTx(tx *pb.Transaction, isRootTx bool) (bool, er
 		authUsers := uv.removeDuplicateUser(tx.GetInitiator(), tx.GetAuthRequire())
 
 		// veify tx UTXO input permission (Account ACL)
-		ok, err = uv.verifyUTXOPermission(tx, verifiedID)
+		ok, err = uv.verifyUTXOPermission(tx, verifiedID, blockCtx)
 		if !ok {
 			uv.xlog.Warn("ImmediateVerifyTx: verifyUTXOPermission failed", "error", err)
 			return ok, ErrACLNotEnough
 		}
 
 		// verify contract requests' permission using ACL
-		ok, err = uv.verifyContractPermission(tx, authUsers)
+		ok, err = uv.verifyContractPermission(tx, authUsers, blockCtx)
 		if !ok {
 			uv.xlog.Warn("ImmediateVerifyTx: verifyContractPermission failed", "error", err)
 			return ok, ErrACLNotEnough
@@ -112,13 +183,13 @@ func (uv *UtxoVM) ImmediateVerifyTx(tx *pb.Transaction, isRootTx bool) (bool, er
 		}
 
 		// verify the permission of RWSet using ACL
-		ok, err = uv.verifyRWSetPermission(tx, verifiedID)
+		ok, err = uv.verifyRWSetPermission(tx, verifiedID, blockCtx)
 		if !ok {
 			uv.xlog.Warn("ImmediateVerifyTx: verifyRWSetPermission failed", "error", err)
 			return ok, ErrACLNotEnough
 		}
 		// verify RWSet(run contracts and compare RWSet)
-		ok, err = uv.verifyTxRWSets(tx)
+		ok, err = uv.verifyTxRWSets(tx, blockCtx)
 		if err != nil {
 			uv.xlog.Warn("ImmediateVerifyTx: verifyTxRWSets failed", "error", err)
 			// reset error message
@@ -137,12 +208,48 @@ func (uv *UtxoVM) ImmediateVerifyTx(tx *pb.Transaction, isRootTx bool) (bool, er
 	return true, nil
 }
 
+// signedIDs returns the IDs verified by the signatures of tx the same as verifySignatures without verifying the signatures,
+// and the signed addresses of the initiator account in the form of account/address
+func (uv *UtxoVM) signedIDs(tx *pb.Transaction) (map[string]bool, []string, error) {
+	verifiedAddr := map[string]bool{
+		tx.Initiator: true,
+	}
+	initiatorAddr := make([]string, 0)
+	if tx.GetXuperSign() == nil && acl.IsAccount(tx.Initiator) == 1 {
+		delete(verifiedAddr, tx.Initiator)
+		for _, sign := range tx.InitiatorSigns {
+			ak, err := uv.cryptoClient.GetEcdsaPublicKeyFromJsonStr(sign.PublicKey)
+			if err != nil {
+				return nil, nil, err
+			}
+			addr, err := uv.cryptoClient.GetAddressFromPublicKey(ak)
+			if err != nil {
+				return nil, nil, err
+			}
+			verifiedAddr[addr] = true
+			initiatorAddr = append(initiatorAddr, tx.Initiator+"/"+addr)
+		}
+	}
+	for _, authReq := range tx.AuthRequire {
+		splitRes := strings.Split(authReq, "/")
+		verifiedAddr[splitRes[len(splitRes)-1]] = true
+	}
+	return verifiedAddr, initiatorAddr, nil
+}
+
+// isBlsXuperSign returns whether the XuperSign of tx is aggregated by BLS public keys
+func isBlsXuperSign(tx *pb.Transaction) bool {
+	pubkeys := tx.GetXuperSign().GetPublicKeys()
+	return len(pubkeys) > 0 && bls_sign.IsPublicKeyJSON(pubkeys[0])
+}
+
 // verify signatures only, from V3.3, we verify all signatures ahead of permission
 // Note that if tx.XuperSign is not nil, the signature verification use XuperSign process
-func (uv *UtxoVM) verifySignatures(tx *pb.Transaction, digestHash []byte) (bool, map[string]bool, error) {
+func (uv *UtxoVM) verifySignatures(tx *pb.Transaction, digestHash []byte,
+	blockCtx *rule.BlockContext) (bool, map[string]bool, error) {
 	// XuperSign is not empty, use XuperSign verify
 	if tx.GetXuperSign() != nil {
-		return uv.verifyXuperSign(tx, digestHash)
+		return uv.verifyXuperSign(tx, digestHash, uv.blsXuperSign(blockCtx.Height))
 	}
 
 	// Not XuperSign(multisig/rignsign etc.), use old signature process
@@ -183,7 +290,7 @@ func (uv *UtxoVM) verifySignatures(tx *pb.Transaction, digestHash []byte) (bool,
 			verifiedAddr[addr] = true
 			initiatorAddr = append(initiatorAddr, tx.Initiator+"/"+addr)
 		}
-		ok, err := pm.IdentifyAccount(tx.Initiator, initiatorAddr, uv.aclMgr)
+		ok, err := pm.IdentifyAccountAtBlock(tx.Initiator, initiatorAddr, uv.aclMgr, blockCtx)
 		if !ok {
 			uv.xlog.Warn("verifySignatures initiator permission check failed",
 				"account", tx.Initiator, "error", err)
@@ -212,7 +319,9 @@ func (uv *UtxoVM) verifySignatures(tx *pb.Transaction, digestHash []byte) (bool,
 	return true, verifiedAddr, nil
 }
 
-func (uv *UtxoVM) verifyXuperSign(tx *pb.Transaction, digestHash []byte) (bool, map[string]bool, error) {
+// verifyXuperSign verifies the multi-signature of XuperSign, the XuperSign aggregated by BLS public keys
+// is only accepted if blsEnabled
+func (uv *UtxoVM) verifyXuperSign(tx *pb.Transaction, digestHash []byte, blsEnabled bool) (bool, map[string]bool, error) {
 	uniqueAddrs := make(map[string]bool)
 	// get all addresses
 	uniqueAddrs[tx.Initiator] = true
@@ -232,6 +341,16 @@ func (uv *UtxoVM) verifyXuperSign(tx *pb.Transaction, digestHash []byte) (bool,
 	if len(addrList) != len(tx.GetXuperSign().GetPublicKeys()) {
 		return false, nil, errors.New("XuperSign: number of address and public key not match")
 	}
+	// BLS聚合签名, 公钥为BLS公钥, 地址由BLS公钥生成
+	if isBlsXuperSign(tx) {
+		if !blsEnabled {
+			return false, nil, ErrBlsXuperSignDisabled
+		}
+		if err := uv.verifyBlsXuperSign(tx, addrList, digestHash); err != nil {
+			return false, nil, err
+		}
+		return true, uniqueAddrs, nil
+	}
 	pubkeys := make([]*ecdsa.PublicKey, 0)
 	for _, pubJSON := range tx.GetXuperSign().GetPublicKeys() {
 		pubkey, err := uv.cryptoClient.GetEcdsaPublicKeyFromJsonStr(string(pubJSON))
@@ -255,11 +374,34 @@ func (uv *UtxoVM) verifyXuperSign(tx *pb.Transaction, digestHash []byte) (bool,
 	return ok, uniqueAddrs, nil
 }
 
+// verifyBlsXuperSign verify the aggregated BLS signature of XuperSign,
+// addrList[i] should be the address of i-th BLS public key
+func (uv *UtxoVM) verifyBlsXuperSign(tx *pb.Transaction, addrList []string, digestHash []byte) error {
+	pubkeys := make([]*bls_sign.PublicKey, 0, len(addrList))
+	for idx, pubJSON := range tx.GetXuperSign().GetPublicKeys() {
+		pubkey, err := bls_sign.ParsePublicKeyJSON(pubJSON)
+		if err != nil {
+			return errors.New("XuperSign: found invalid BLS public key")
+		}
+		if bls_sign.GetAddressFromPublicKey(pubkey) != addrList[idx] {
+			uv.xlog.Warn("XuperSign: address and BLS public key not match", "addr", addrList[idx])
+			return errors.New("XuperSign: address and public key not match")
+		}
+		pubkeys = append(pubkeys, pubkey)
+	}
+	if !bls_sign.VerifyAggregatedSignature(pubkeys, tx.GetXuperSign().GetSignature(), digestHash) {
+		uv.xlog.Warn("XuperSign: aggregated BLS signature verify failed")
+		return errors.New("XuperSign: aggregated BLS signature verify failed")
+	}
+	return nil
+}
+
 // verify utxo inputs, there are three kinds of input validation
 //	1). PKI technology for transferring from address
 //	2). Account ACL for transferring from account
 //	3). Contract logic transferring from contract
-func (uv *UtxoVM) verifyUTXOPermission(tx *pb.Transaction, verifiedID map[string]bool) (bool, error) {
+func (uv *UtxoVM) verifyUTXOPermission(tx *pb.Transaction, verifiedID map[string]bool,
+	blockCtx *rule.BlockContext) (bool, error) {
 	// verify tx input
 	conUtxoInputs, err := xmodel.ParseContractUtxoInputs(tx)
 	if err != nil {
@@ -300,7 +442,7 @@ func (uv *UtxoVM) verifyUTXOPermission(tx *pb.Transaction, verifiedID map[string
 				uv.xlog.Warn("verifyUTXOPermission error, account might not exist", "account", name, "error", err)
 				return false, ErrInvalidAccount
 			}
-			if ok, err := pm.IdentifyAccount(string(name), tx.AuthRequire, uv.aclMgr); !ok {
+			if ok, err := pm.IdentifyAccountAtBlock(string(name), tx.AuthRequire, uv.aclMgr, blockCtx); !ok {
 				uv.xlog.Warn("verifyUTXOPermission error, failed to IdentifyAccount", "error", err)
 				return false, ErrACLNotEnough
 			}
@@ -320,7 +462,7 @@ func (uv *UtxoVM) verifyUTXOPermission(tx *pb.Transaction, verifiedID map[string
 // verifyContractOwnerPermission check if the transaction has the permission of a contract owner.
 // this usually happens in account management operations.
 func (uv *UtxoVM) verifyContractOwnerPermission(contractName string, tx *pb.Transaction,
-	verifiedID map[string]bool) (bool, error) {
+	verifiedID map[string]bool, blockCtx *rule.BlockContext) (bool, error) {
 	versionData, confirmed, err := uv.model3.GetWithTxStatus(aclu.GetContract2AccountBucket(), []byte(contractName))
 	if err != nil || versionData == nil {
 		return false, err
@@ -333,7 +475,7 @@ func (uv *UtxoVM) verifyContractOwnerPermission(contractName string, tx *pb.Tran
 	if verifiedID[accountName] {
 		return true, nil
 	}
-	ok, err := pm.IdentifyAccount(accountName, tx.AuthRequire, uv.aclMgr)
+	ok, err := pm.IdentifyAccountAtBlock(accountName, tx.AuthRequire, uv.aclMgr, blockCtx)
 	if err == nil && ok {
 		verifiedID[accountName] = true
 	}
@@ -341,7 +483,8 @@ func (uv *UtxoVM) verifyContractOwnerPermission(contractName string, tx *pb.Tran
 }
 
 // verifyRWSetPermission verify the permission of RWSet using ACL
-func (uv *UtxoVM) verifyRWSetPermission(tx *pb.Transaction, verifiedID map[string]bool) (bool, error) {
+func (uv *UtxoVM) verifyRWSetPermission(tx *pb.Transaction, verifiedID map[string]bool,
+	blockCtx *rule.BlockContext) (bool, error) {
 	req := tx.GetContractRequests()
 	// if not contract, pass directly
 	if req == nil {
@@ -361,7 +504,7 @@ func (uv *UtxoVM) verifyRWSetPermission(tx *pb.Transaction, verifiedID map[strin
 			if verifiedID[accountName] {
 				continue
 			}
-			ok, err := pm.IdentifyAccount(accountName, tx.AuthRequire, uv.aclMgr)
+			ok, err := pm.IdentifyAccountAtBlock(accountName, tx.AuthRequire, uv.aclMgr, blockCtx)
 			if !ok {
 				uv.xlog.Warn("verifyRWSetPermission check account bucket failed",
 					"account", accountName, "AuthRequire ", tx.AuthRequire, "error", err)
@@ -376,7 +519,7 @@ func (uv *UtxoVM) verifyRWSetPermission(tx *pb.Transaction, verifiedID map[strin
 				return false, errors.New("invalid raw key")
 			}
 			contractName := string(key[:idx])
-			ok, contractErr := uv.verifyContractOwnerPermission(contractName, tx, verifiedID)
+			ok, contractErr := uv.verifyContractOwnerPermission(contractName, tx, verifiedID, blockCtx)
 			if !ok {
 				uv.xlog.Warn("verifyRWSetPermission check contract bucket failed",
 					"contract", contractName, "AuthRequire ", tx.AuthRequire, "error", contractErr)
@@ -393,7 +536,7 @@ func (uv *UtxoVM) verifyRWSetPermission(tx *pb.Transaction, verifiedID map[strin
 			if verifiedID[accountName] {
 				continue
 			}
-			ok, accountErr := pm.IdentifyAccount(accountName, tx.AuthRequire, uv.aclMgr)
+			ok, accountErr := pm.IdentifyAccountAtBlock(accountName, tx.AuthRequire, uv.aclMgr, blockCtx)
 			if !ok {
 				uv.xlog.Warn("verifyRWSetPermission check contract2account bucket failed",
 					"account", accountName, "AuthRequire ", tx.AuthRequire, "error", accountErr)
@@ -406,7 +549,8 @@ func (uv *UtxoVM) verifyRWSetPermission(tx *pb.Transaction, verifiedID map[strin
 }
 
 // verifyContractValid verify the permission of contract requests using ACL
-func (uv *UtxoVM) verifyContractPermission(tx *pb.Transaction, allUsers []string) (bool, error) {
+func (uv *UtxoVM) verifyContractPermission(tx *pb.Transaction, allUsers []string,
+	blockCtx *rule.BlockContext) (bool, error) {
 	req := tx.GetContractRequests()
 	if req == nil {
 		// if no contract requests, no need to verify
@@ -418,7 +562,7 @@ func (uv *UtxoVM) verifyContractPermission(tx *pb.Transaction, allUsers []string
 		contractName := tmpReq.GetContractName()
 		methodName := tmpReq.GetMethodName()
 
-		ok, err := pm.CheckContractMethodPerm(allUsers, contractName, methodName, uv.aclMgr)
+		ok, err := pm.CheckContractMethodPermAtBlock(allUsers, contractName, methodName, uv.aclMgr, blockCtx)
 		if err != nil || !ok {
 			uv.xlog.Warn("verify contract method ACL failed ", "contract", contractName, "method",
 				methodName, "error", err)
@@ -465,7 +609,7 @@ func (uv *UtxoVM) verifyContractTxAmount(tx *pb.Transaction) (bool, error) {
 }
 
 // verifyTxRWSets verify tx read sets and write sets
-func (uv *UtxoVM) verifyTxRWSets(tx *pb.Transaction) (bool, error) {
+func (uv *UtxoVM) verifyTxRWSets(tx *pb.Transaction, blockCtx *rule.BlockContext) (bool, error) {
 	if uv.verifyReservedWhitelist(tx) {
 		uv.xlog.Info("verifyReservedWhitelist true", "txid", fmt.Sprintf("%x", tx.GetTxid()))
 		return true, nil
@@ -506,11 +650,16 @@ func (uv *UtxoVM) verifyTxRWSets(tx *pb.Transaction) (bool, error) {
 		AuthRequire:  tx.GetAuthRequire(),
 		ContractName: "",
 		Core: contractChainCore{
-			Manager: uv.aclMgr,
-			UtxoVM:  uv,
-			Ledger:  uv.ledger,
+			Manager:  uv.aclMgr,
+			UtxoVM:   uv,
+			Ledger:   uv.ledger,
+			blockCtx: blockCtx,
 		},
-		BCName: uv.bcname,
+		BCName:            uv.bcname,
+		StorageRent:       uv.storageRentContext(blockCtx.Height),
+		EvmResourceLimits: uv.evmResourceLimits(blockCtx.Height),
+		EthGateway:        uv.ethGateway(blockCtx.Height),
+		ContractEvents:    uv.contractEvents(blockCtx.Height),
 	}
 	gasLimit, err := getGasLimitFromTx(tx)
 	if err != nil {
@@ -527,6 +676,9 @@ func (uv *UtxoVM) verifyTxRWSets(tx *pb.Transaction) (bool, error) {
 		if err != nil {
 			return false, err
 		}
+		if err := checkStorageArchived(contextConfig, tmpReq.GetContractName()); err != nil {
+			return false, err
+		}
 
 		limits := contract.FromPbLimits(tmpReq.GetResourceLimits())
 		if i >= len(reservedRequests) {
@@ -571,6 +723,11 @@ func (uv *UtxoVM) verifyTxRWSets(tx *pb.Transaction) (bool, error) {
 		ctx.Release()
 	}
 
+	err = writeStorageUsage(contextConfig)
+	if err != nil {
+		return false, err
+	}
+
 	err = env.GetModelCache().WriteTransientBucket()
 	if err != nil {
 		return false, err
@@ -710,12 +867,22 @@ func (uv *UtxoVM) removeDuplicateUser(initiator string, authRequire []string) []
 
 // VerifyContractPermission implement Contract ChainCore, used to verify contract permission while contract running
 func (uv *UtxoVM) VerifyContractPermission(initiator string, authRequire []string, contractName, methodName string) (bool, error) {
+	return uv.verifyContractPermissionAtBlock(initiator, authRequire, contractName, methodName, uv.pendingBlockContext())
+}
+
+func (uv *UtxoVM) verifyContractPermissionAtBlock(initiator string, authRequire []string, contractName, methodName string,
+	blockCtx *rule.BlockContext) (bool, error) {
 	allUsers := uv.removeDuplicateUser(initiator, authRequire)
-	return pm.CheckContractMethodPerm(allUsers, contractName, methodName, uv.aclMgr)
+	return pm.CheckContractMethodPermAtBlock(allUsers, contractName, methodName, uv.aclMgr, blockCtx)
 }
 
 // VerifyContractOwnerPermission implement Contract ChainCore, used to verify contract ownership permisson
 func (uv *UtxoVM) VerifyContractOwnerPermission(contractName string, authRequire []string) error {
+	return uv.verifyContractOwnerPermissionAtBlock(contractName, authRequire, uv.pendingBlockContext())
+}
+
+func (uv *UtxoVM) verifyContractOwnerPermissionAtBlock(contractName string, authRequire []string,
+	blockCtx *rule.BlockContext) error {
 	versionData, confirmed, err := uv.model3.GetWithTxStatus(aclu.GetContract2AccountBucket(), []byte(contractName))
 	if err != nil {
 		return err
@@ -727,7 +894,7 @@ func (uv *UtxoVM) VerifyContractOwnerPermission(contractName string, authRequire
 	if accountName == "" {
 		return errors.New("contract not found")
 	}
-	ok, err := pm.IdentifyAccount(accountName, authRequire, uv.aclMgr)
+	ok, err := pm.IdentifyAccountAtBlock(accountName, authRequire, uv.aclMgr, blockCtx)
 	if err != nil {
 		return err
 	}
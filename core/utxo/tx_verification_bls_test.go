@@ -0,0 +1,56 @@
+package utxo
+
+import (
+	"crypto/rand"
+	"testing"
+
+	log "github.com/xuperchain/log15"
+
+	bls_sign "github.com/xuperchain/xuperchain/core/crypto/bls"
+	"github.com/xuperchain/xuperchain/core/pb"
+)
+
+func TestVerifyBlsXuperSign(t *testing.T) {
+	uv := &UtxoVM{xlog: log.New("module", "utxoVM")}
+	digestHash := []byte("tx digest hash")
+	var pks []*bls_sign.PublicKey
+	var pubJSONs, sigs [][]byte
+	var addrs []string
+	for i := 0; i < 3; i++ {
+		k, err := bls_sign.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pubJSON, _ := k.Public().MarshalJSON()
+		pks = append(pks, k.Public())
+		pubJSONs = append(pubJSONs, pubJSON)
+		sigs = append(sigs, bls_sign.Sign(k, digestHash))
+		addrs = append(addrs, bls_sign.GetAddressFromPublicKey(k.Public()))
+	}
+	aggSign, err := bls_sign.AggregateSignatures(pks, sigs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := &pb.Transaction{
+		Initiator:   addrs[0],
+		AuthRequire: []string{"XC1111111111111111@xuper/" + addrs[1], addrs[2]},
+		XuperSign: &pb.XuperSignature{
+			PublicKeys: pubJSONs,
+			Signature:  aggSign,
+		},
+	}
+	if ok, _, err := uv.verifyXuperSign(tx, digestHash, false); ok || err != ErrBlsXuperSignDisabled {
+		t.Fatal("BLS XuperSign should not pass before bls_xuper_sign fork", err)
+	}
+	ok, verified, err := uv.verifyXuperSign(tx, digestHash, true)
+	if !ok || err != nil || len(verified) != 3 {
+		t.Fatal("verify BLS XuperSign failed", err)
+	}
+	if ok, _, _ := uv.verifyXuperSign(tx, []byte("another digest"), true); ok {
+		t.Fatal("XuperSign of another digest should not pass")
+	}
+	tx.AuthRequire = []string{addrs[2], addrs[1]}
+	if ok, _, _ := uv.verifyXuperSign(tx, digestHash, true); ok {
+		t.Fatal("XuperSign with unmatched addresses should not pass")
+	}
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/xuperchain/xuperchain/core/permission/acl"
 	acli "github.com/xuperchain/xuperchain/core/permission/acl/impl"
 	"github.com/xuperchain/xuperchain/core/permission/acl/utils"
+	"github.com/xuperchain/xuperchain/core/permission/rule"
 	"github.com/xuperchain/xuperchain/core/txn"
 	"github.com/xuperchain/xuperchain/core/utxo/txhash"
 	"github.com/xuperchain/xuperchain/core/vat"
@@ -68,6 +69,7 @@ var (
 	ErrRWSetInvalid            = errors.New("RWSet of transaction invalid")
 	ErrACLNotEnough            = errors.New("ACL not enough")
 	ErrInvalidSignature        = errors.New("the signature is invalid or not match the address")
+	ErrBlsXuperSignDisabled    = errors.New("BLS XuperSign is not enabled at this height")
 
 	ErrGasNotEnough   = errors.New("Gas not enough")
 	ErrInvalidAccount = errors.New("Invalid account")
@@ -192,6 +194,24 @@ type contractChainCore struct {
 	*acli.Manager // ACL manager for read/write acl table
 	*UtxoVM
 	*ledger.Ledger
+	// blockCtx is the block which block sensitive ACL rules are evaluated against
+	blockCtx *rule.BlockContext
+}
+
+// VerifyContractPermission verify permission of calling contract against the block of contractChainCore
+func (c contractChainCore) VerifyContractPermission(initiator string, authRequire []string, contractName, methodName string) (bool, error) {
+	if c.blockCtx == nil {
+		return c.UtxoVM.VerifyContractPermission(initiator, authRequire, contractName, methodName)
+	}
+	return c.UtxoVM.verifyContractPermissionAtBlock(initiator, authRequire, contractName, methodName, c.blockCtx)
+}
+
+// VerifyContractOwnerPermission verify contract ownership permisson against the block of contractChainCore
+func (c contractChainCore) VerifyContractOwnerPermission(contractName string, authRequire []string) error {
+	if c.blockCtx == nil {
+		return c.UtxoVM.VerifyContractOwnerPermission(contractName, authRequire)
+	}
+	return c.UtxoVM.verifyContractOwnerPermissionAtBlock(contractName, authRequire, c.blockCtx)
 }
 
 func genUtxoKey(addr []byte, txid []byte, offset int32) string {
@@ -812,6 +832,46 @@ func (uv *UtxoVM) SelectUtxos(fromAddr string, fromPubKey string, totalNeed *big
 	return txInputs, willLockKeys, utxoTotal, nil
 }
 
+// evmResourceLimits returns whether the resource limits of EVM contracts are enforced in the block at height
+func (uv *UtxoVM) evmResourceLimits(height int64) bool {
+	return ledger.ForkActive(uv.ledger.GetForkHeights().EvmResource, height)
+}
+
+// ethGateway returns whether the ethereum transactions mapped by eth gateway are supported in the block at height
+func (uv *UtxoVM) ethGateway(height int64) bool {
+	return ledger.ForkActive(uv.ledger.GetForkHeights().EthGateway, height)
+}
+
+// contractEvents returns whether the events declaration of contracts is saved in the block at height
+func (uv *UtxoVM) contractEvents(height int64) bool {
+	return ledger.ForkActive(uv.ledger.GetForkHeights().ContractEvents, height)
+}
+
+// blsXuperSign returns whether the XuperSign aggregated by BLS public keys is accepted in the block at height
+func (uv *UtxoVM) blsXuperSign(height int64) bool {
+	return ledger.ForkActive(uv.ledger.GetForkHeights().BlsXuperSign, height)
+}
+
+// requestResourceLimits return the resource limits of invoke request,
+// the limits which are not specified by caller are set to contract.MaxLimits,
+// and the limits larger than contract.MaxLimits are clamped to it
+func requestResourceLimits(req *pb.InvokeRequest) contract.Limits {
+	reqLimits := contract.FromPbLimits(req.GetResourceLimits())
+	return contract.Limits{
+		Cpu:    clampResourceLimit(reqLimits.Cpu, contract.MaxLimits.Cpu),
+		Memory: clampResourceLimit(reqLimits.Memory, contract.MaxLimits.Memory),
+		Disk:   clampResourceLimit(reqLimits.Disk, contract.MaxLimits.Disk),
+		XFee:   clampResourceLimit(reqLimits.XFee, contract.MaxLimits.XFee),
+	}
+}
+
+func clampResourceLimit(limit, max int64) int64 {
+	if limit <= 0 || limit > max {
+		return max
+	}
+	return limit
+}
+
 // PreExec the Xuper3 contract model uses previous execution to generate RWSets
 func (uv *UtxoVM) PreExec(req *pb.InvokeRPCRequest, hd *global.XContext) (*pb.InvokeResponse, error) {
 	// get reserved contracts from chain config
@@ -841,6 +901,7 @@ func (uv *UtxoVM) PreExec(req *pb.InvokeRPCRequest, hd *global.XContext) (*pb.In
 	if err != nil {
 		return nil, err
 	}
+	blockCtx := uv.pendingBlockContext()
 
 	contextConfig := &contract.ContextConfig{
 		XMCache:     modelCache,
@@ -851,11 +912,16 @@ func (uv *UtxoVM) PreExec(req *pb.InvokeRPCRequest, hd *global.XContext) (*pb.In
 		ContractName:             "",
 		ResourceLimits:           contract.MaxLimits,
 		Core: contractChainCore{
-			Manager: uv.aclMgr,
-			UtxoVM:  uv,
-			Ledger:  uv.ledger,
+			Manager:  uv.aclMgr,
+			UtxoVM:   uv,
+			Ledger:   uv.ledger,
+			blockCtx: blockCtx,
 		},
-		BCName: uv.bcname,
+		BCName:            uv.bcname,
+		StorageRent:       uv.storageRentContext(blockCtx.Height),
+		EvmResourceLimits: uv.evmResourceLimits(blockCtx.Height),
+		EthGateway:        uv.ethGateway(blockCtx.Height),
+		ContractEvents:    uv.contractEvents(blockCtx.Height),
 	}
 	gasUesdTotal := int64(0)
 	response := [][]byte{}
@@ -891,8 +957,12 @@ func (uv *UtxoVM) PreExec(req *pb.InvokeRPCRequest, hd *global.XContext) (*pb.In
 		if err != nil {
 			return nil, err
 		}
+		if err := checkStorageArchived(contextConfig, tmpReq.GetContractName()); err != nil {
+			return nil, err
+		}
 
 		contextConfig.ContractName = tmpReq.GetContractName()
+		contextConfig.ResourceLimits = requestResourceLimits(tmpReq)
 		if transContractName == tmpReq.GetContractName() {
 			contextConfig.TransferAmount = transAmount.String()
 		} else {
@@ -912,9 +982,9 @@ func (uv *UtxoVM) PreExec(req *pb.InvokeRPCRequest, hd *global.XContext) (*pb.In
 		}
 		res, err := ctx.Invoke(tmpReq.GetMethodName(), tmpReq.GetArgs())
 		if err != nil {
-			ctx.Release()
 			uv.xlog.Error("PreExec Invoke error", "error", err,
-				"contractName", tmpReq.GetContractName())
+				"contractName", tmpReq.GetContractName(), "resourceUsed", ctx.ResourceUsed())
+			ctx.Release()
 			return nil, err
 		}
 		if res.Status >= 400 && i < len(reservedRequests) {
@@ -935,6 +1005,11 @@ func (uv *UtxoVM) PreExec(req *pb.InvokeRPCRequest, hd *global.XContext) (*pb.In
 		ctx.Release()
 	}
 
+	err = writeStorageUsage(contextConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	utxoInputs, utxoOutputs := modelCache.GetUtxoRWSets()
 
 	err = modelCache.WriteTransientBucket()
@@ -1361,6 +1436,7 @@ func (uv *UtxoVM) doTxSync(tx *pb.Transaction) error {
 	}
 	uv.unconfirmTxInMem.Store(string(tx.Txid), tx)
 	cacheFiller.Commit()
+	uv.notifyUnconfirmedTx(tx)
 	return nil
 }
 
@@ -1865,6 +1941,7 @@ func (uv *UtxoVM) Walk(blockid []byte, ledgerPrune bool) error {
 		return fmt.Errorf("walk todo block fail")
 	}
 	xTimer.Mark("walk_todo_block")
+	uv.notifyChainReorg(undoBlocks, todoBlocks)
 
 	// 异步回放被回滚未确认交易
 	go uv.recoverUnconfirmedTx(undoList)
@@ -2047,7 +2124,7 @@ func (uv *UtxoVM) procTodoBlkForWalk(todoBlocks []*pb.InternalBlock) (err error)
 			showTxId = hex.EncodeToString(tx.Txid)
 			// 校验交易合法性
 			if !tx.Autogen && !tx.Coinbase {
-				if ok, err := uv.ImmediateVerifyTx(tx, false); !ok {
+				if ok, err := uv.verifyTxAtBlock(tx, false, newBlockContext(todoBlk)); !ok {
 					return fmt.Errorf("immediate verify tx error.txid:%s,err:%v", showTxId, err)
 				}
 			}
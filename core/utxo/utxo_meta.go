@@ -393,7 +393,8 @@ func (uv *UtxoVM) LoadGasPrice() (*pb.GasPrice, error) {
 			memRate := gasPrice.MemRate
 			diskRate := gasPrice.DiskRate
 			xfeeRate := gasPrice.XfeeRate
-			if cpuRate < 0 || memRate < 0 || diskRate < 0 || xfeeRate < 0 {
+			rentRate := gasPrice.RentRate
+			if cpuRate < 0 || memRate < 0 || diskRate < 0 || xfeeRate < 0 || rentRate < 0 {
 				return nil, ErrProposalParamsIsNegativeNumber
 			}
 			// To be compatible with the old version v3.3
@@ -404,6 +405,7 @@ func (uv *UtxoVM) LoadGasPrice() (*pb.GasPrice, error) {
 					MemRate:  1000000,
 					DiskRate: 1,
 					XfeeRate: 1,
+					RentRate: rentRate,
 				}
 			}
 			return gasPrice, nil
@@ -419,7 +421,8 @@ func (uv *UtxoVM) UpdateGasPrice(nextGasPrice *pb.GasPrice, batch kvdb.Batch) er
 	memRate := nextGasPrice.GetMemRate()
 	diskRate := nextGasPrice.GetDiskRate()
 	xfeeRate := nextGasPrice.GetXfeeRate()
-	if cpuRate < 0 || memRate < 0 || diskRate < 0 || xfeeRate < 0 {
+	rentRate := nextGasPrice.GetRentRate()
+	if cpuRate < 0 || memRate < 0 || diskRate < 0 || xfeeRate < 0 || rentRate < 0 {
 		return ErrProposalParamsIsNegativeNumber
 	}
 	tmpMeta := &pb.UtxoMeta{}
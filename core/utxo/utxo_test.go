@@ -356,6 +356,38 @@ func TestUtxoWorkWithLedgerBasic(t *testing.T) {
 	ledger.Close()
 }
 
+func TestRequestResourceLimits(t *testing.T) {
+	req := &pb.InvokeRequest{
+		ResourceLimits: []*pb.ResourceLimit{
+			{Type: pb.ResourceType_CPU, Limit: 1000},
+			{Type: pb.ResourceType_DISK, Limit: 0},
+		},
+	}
+	limits := requestResourceLimits(req)
+	expect := contract.MaxLimits
+	expect.Cpu = 1000
+	if limits != expect {
+		t.Errorf("expect limits %v got %v", expect, limits)
+	}
+	if requestResourceLimits(&pb.InvokeRequest{}) != contract.MaxLimits {
+		t.Error("limits not specified should be MaxLimits")
+	}
+
+	// 超过MaxLimits的限制按MaxLimits处理
+	req = &pb.InvokeRequest{
+		ResourceLimits: []*pb.ResourceLimit{
+			{Type: pb.ResourceType_CPU, Limit: contract.MaxLimits.Cpu + 1},
+			{Type: pb.ResourceType_MEMORY, Limit: 1 << 62},
+			{Type: pb.ResourceType_XFEE, Limit: 100},
+		},
+	}
+	expect = contract.MaxLimits
+	expect.XFee = 100
+	if limits := requestResourceLimits(req); limits != expect {
+		t.Errorf("expect limits %v got %v", expect, limits)
+	}
+}
+
 func TestTSort(t *testing.T) {
 	g := TxGraph{}
 	g["tx3"] = []string{"tx1", "tx2"}
@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/xuperchain/xuperchain/core/pb"
+	"github.com/xuperchain/xuperchain/core/permission/rule"
 )
 
 func (uv *UtxoVM) verifyBlockTxs(block *pb.InternalBlock, isRootTx bool, unconfirmToConfirm map[string]bool) error {
@@ -17,7 +18,7 @@ func (uv *UtxoVM) verifyBlockTxs(block *pb.InternalBlock, isRootTx bool, unconfi
 		wg.Add(1)
 		go func(txs []*pb.Transaction) {
 			defer wg.Done()
-			verifyErr := uv.verifyDAGTxs(txs, isRootTx, unconfirmToConfirm)
+			verifyErr := uv.verifyDAGTxs(txs, isRootTx, unconfirmToConfirm, block)
 			onceBody := func() {
 				err = verifyErr
 			}
@@ -31,33 +32,83 @@ func (uv *UtxoVM) verifyBlockTxs(block *pb.InternalBlock, isRootTx bool, unconfi
 	return err
 }
 
-func (uv *UtxoVM) verifyDAGTxs(txs []*pb.Transaction, isRootTx bool, unconfirmToConfirm map[string]bool) error {
+func (uv *UtxoVM) verifyDAGTxs(txs []*pb.Transaction, isRootTx bool, unconfirmToConfirm map[string]bool,
+	block *pb.InternalBlock) error {
 	for _, tx := range txs {
 		if tx == nil {
 			return errors.New("verifyTx error, tx is nil")
 		}
 		txid := string(tx.GetTxid())
-		if unconfirmToConfirm[txid] == false {
-			if !uv.verifyAutogenTx(tx) {
-				return ErrInvalidAutogenTx
+		if unconfirmToConfirm[txid] {
+			// 本地已校验过的交易, 签名无需重新校验, 但ACL需按所在区块重新鉴权
+			if ok, err := uv.verifyTxPermissionAtBlock(tx, newBlockContext(block)); !ok {
+				uv.xlog.Warn("unconfirmed tx failed to verify permission at block", "txid", fmt.Sprintf("%x", tx.Txid), "err", err)
+				return fmt.Errorf("verify permission at block error: %v", err)
 			}
-			if !tx.Autogen && !tx.Coinbase {
-				if ok, err := uv.ImmediateVerifyTx(tx, isRootTx); !ok {
-					uv.xlog.Warn("dotx failed to ImmediateVerifyTx", "txid", fmt.Sprintf("%x", tx.Txid), "err", err)
-					ok, isRelyOnMarkedTx, err := uv.verifyMarked(tx)
-					if isRelyOnMarkedTx {
-						if !ok || err != nil {
-							uv.xlog.Warn("tx verification failed because it is blocked tx", "err", err)
-						} else {
-							uv.xlog.Trace("blocked tx verification succeed")
-						}
-						return err
+			continue
+		}
+		if !uv.verifyAutogenTx(tx) {
+			return ErrInvalidAutogenTx
+		}
+		if !tx.Autogen && !tx.Coinbase {
+			if ok, err := uv.verifyTxAtBlock(tx, isRootTx, newBlockContext(block)); !ok {
+				uv.xlog.Warn("dotx failed to ImmediateVerifyTx", "txid", fmt.Sprintf("%x", tx.Txid), "err", err)
+				ok, isRelyOnMarkedTx, err := uv.verifyMarked(tx)
+				if isRelyOnMarkedTx {
+					if !ok || err != nil {
+						uv.xlog.Warn("tx verification failed because it is blocked tx", "err", err)
+					} else {
+						uv.xlog.Trace("blocked tx verification succeed")
 					}
-					return errors.New("dotx failed to ImmediateVerifyTx error")
+					return err
 				}
+				return errors.New("dotx failed to ImmediateVerifyTx error")
 			}
 		}
 	}
 
 	return nil
 }
+
+// FilterTxsAtBlock 打包区块前按待出区块重新鉴权未确认交易的ACL,
+// 剔除鉴权失败(如TimeLock已过期或未生效)的交易以及依赖它们的交易, txs需按执行的先后顺序排列
+func (uv *UtxoVM) FilterTxsAtBlock(txs []*pb.Transaction, height int64, timestamp int64) []*pb.Transaction {
+	blockCtx := &rule.BlockContext{
+		Height:    height,
+		Timestamp: timestamp,
+	}
+	dropped := map[string]bool{}
+	res := make([]*pb.Transaction, 0, len(txs))
+	for _, tx := range txs {
+		if dependsOnTxs(tx, dropped) {
+			dropped[string(tx.Txid)] = true
+			continue
+		}
+		if ok, err := uv.verifyTxPermissionAtBlock(tx, blockCtx); !ok {
+			uv.xlog.Warn("drop unconfirmed tx failed to verify permission at block", "txid", fmt.Sprintf("%x", tx.Txid),
+				"height", height, "err", err)
+			dropped[string(tx.Txid)] = true
+			continue
+		}
+		res = append(res, tx)
+	}
+	return res
+}
+
+// dependsOnTxs 交易是否引用了txids中交易的输出
+func dependsOnTxs(tx *pb.Transaction, txids map[string]bool) bool {
+	if len(txids) == 0 {
+		return false
+	}
+	for _, input := range tx.TxInputs {
+		if txids[string(input.RefTxid)] {
+			return true
+		}
+	}
+	for _, input := range tx.TxInputsExt {
+		if txids[string(input.RefTxid)] {
+			return true
+		}
+	}
+	return false
+}
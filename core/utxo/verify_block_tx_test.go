@@ -0,0 +1,105 @@
+package utxo
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	crypto_client "github.com/xuperchain/xuperchain/core/crypto/client"
+	ledger_pkg "github.com/xuperchain/xuperchain/core/ledger"
+	"github.com/xuperchain/xuperchain/core/pb"
+	aclu "github.com/xuperchain/xuperchain/core/permission/acl/utils"
+)
+
+const timeLockAccount = "XC1111111111111111@xuper"
+
+// newTimeLockUtxoVM 创建一条链, 创世区块写入timeLockAccount的ACL, 仅在notAfterHeight(含)之前bob可以代表该账户签名
+func newTimeLockUtxoVM(t *testing.T, workspace string, notAfterHeight int64) *UtxoVM {
+	ledger, err := ledger_pkg.NewLedger(workspace, nil, nil, DefaultKVEngine, crypto_client.CryptoTypeDefault)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := GenerateRootTx([]byte(`
+       {
+        "version" : "1"
+        , "consensus" : {
+                "miner" : "0x00000000000"
+        }
+        , "predistribution":[
+                {
+                        "address" : "` + BobAddress + `",
+                        "quota" : "100"
+                }
+        ]
+        , "maxblocksize" : "128"
+        , "period" : "5000"
+        , "award" : "1000"
+		}
+    `))
+	if err != nil {
+		t.Fatal(err)
+	}
+	aclBuf, _ := json.Marshal(&pb.Acl{
+		Pm: &pb.PermissionModel{
+			Rule:        pb.PermissionRule_SIGN_TIMELOCK,
+			AcceptValue: 1,
+		},
+		AksWeight: map[string]float64{BobAddress: 1},
+		TimeLock: &pb.TimeLock{
+			Rule:           pb.PermissionRule_SIGN_THRESHOLD,
+			NotAfterHeight: notAfterHeight,
+		},
+	})
+	tx.TxInputsExt = []*pb.TxInputExt{{Bucket: aclu.GetAccountBucket(), Key: []byte(timeLockAccount)}}
+	tx.TxOutputsExt = []*pb.TxOutputExt{{Bucket: aclu.GetAccountBucket(), Key: []byte(timeLockAccount), Value: aclBuf}}
+	block, _ := ledger.FormatRootBlock([]*pb.Transaction{tx})
+	if confirmStatus := ledger.ConfirmBlock(block, true); !confirmStatus.Succ {
+		t.Fatal("confirm block fail")
+	}
+	utxoVM, err := NewUtxoVM("xuper", ledger, workspace, minerPrivateKey, minerPublicKey, []byte(minerAddress),
+		nil, false, DefaultKVEngine, crypto_client.CryptoTypeDefault)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := utxoVM.Play(block.Blockid); err != nil {
+		t.Fatal(err)
+	}
+	return utxoVM
+}
+
+func TestFilterTxsAtBlock(t *testing.T) {
+	workspace, dirErr := ioutil.TempDir("/tmp", "")
+	if dirErr != nil {
+		t.Fatal(dirErr)
+	}
+	os.RemoveAll(workspace)
+	defer os.RemoveAll(workspace)
+	uv := newTimeLockUtxoVM(t, workspace, 10)
+
+	// tx1由bob代表时间锁账户发起
+	tx1 := &pb.Transaction{
+		Txid:           []byte("tx1"),
+		Version:        1,
+		Initiator:      timeLockAccount,
+		InitiatorSigns: []*pb.SignatureInfo{{PublicKey: BobPubkey}},
+	}
+	// tx2引用了tx1的输出
+	tx2 := &pb.Transaction{Txid: []byte("tx2"), Version: 1, Initiator: BobAddress,
+		TxInputs: []*pb.TxInput{{RefTxid: tx1.Txid, FromAddr: []byte(BobAddress)}}}
+	tx3 := &pb.Transaction{Txid: []byte("tx3"), Version: 1, Initiator: BobAddress}
+	// tx4引用了tx2的输出
+	tx4 := &pb.Transaction{Txid: []byte("tx4"), Version: 1, Initiator: BobAddress,
+		TxInputsExt: []*pb.TxInputExt{{RefTxid: tx2.Txid}}}
+	txs := []*pb.Transaction{tx1, tx2, tx3, tx4}
+
+	// 时间锁生效区间内, 所有交易保留
+	if res := uv.FilterTxsAtBlock(txs, 10, 0); len(res) != len(txs) {
+		t.Fatalf("unexpected txs inside the time lock: %v", res)
+	}
+	// 时间锁过期, tx1及依赖它的交易被剔除
+	res := uv.FilterTxsAtBlock(txs, 11, 0)
+	if len(res) != 1 || res[0] != tx3 {
+		t.Fatalf("unexpected txs after the time lock: %v", res)
+	}
+}
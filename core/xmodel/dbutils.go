@@ -35,6 +35,13 @@ func makeRawKey(bucket string, key []byte) []byte {
 	return append(k, key...)
 }
 
+// makeBucketRange returns the raw key range [start, limit) of all keys in bucket,
+// the limit is the bucket followed by the byte next to BucketSeperator
+func makeBucketRange(bucket string) ([]byte, []byte) {
+	limit := append([]byte(bucket), BucketSeperator[0]+1)
+	return makeRawKey(bucket, nil), limit
+}
+
 func parseRawKey(rawKey []byte) (string, []byte, error) {
 	idx := bytes.Index(rawKey, []byte(BucketSeperator))
 	if idx < 0 {
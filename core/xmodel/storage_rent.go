@@ -0,0 +1,387 @@
+package xmodel
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/syndtr/goleveldb/leveldb/comparer"
+	"github.com/syndtr/goleveldb/leveldb/memdb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/xuperchain/xuperchain/core/common"
+	"github.com/xuperchain/xuperchain/core/crypto/hash"
+	"github.com/xuperchain/xuperchain/core/ledger"
+	"github.com/xuperchain/xuperchain/core/pb"
+	"github.com/xuperchain/xuperchain/core/permission/acl/utils"
+	xmodel_pb "github.com/xuperchain/xuperchain/core/xmodel/pb"
+)
+
+// StorageRentBucket is the bucket of storage rent records, the key is contract name
+const StorageRentBucket = "XCStorageRent"
+
+const storageArchiveKeyPrefix = "StorageArchive/"
+
+var (
+	// ErrStorageArchived is returned when the storage of contract is archived
+	ErrStorageArchived = errors.New("contract storage is archived")
+	// ErrStorageNotArchived is returned when restoring a contract which is not archived
+	ErrStorageNotArchived = errors.New("contract storage is not archived")
+	// ErrStorageSettledHeight is returned when settling the rent before the settled height
+	ErrStorageSettledHeight = errors.New("height is before the settled height of storage rent")
+	// ErrIncompleteArchive is returned when the archived data doesn't match the storage size of contract
+	ErrIncompleteArchive = errors.New("archived data doesn't match the storage size of contract")
+	// ErrArchiveHashMismatch is returned when the data to restore doesn't match the archive hash
+	ErrArchiveHashMismatch = errors.New("data doesn't match the archive hash")
+)
+
+// StorageRent is the storage rent record of a contract, which is saved as json in StorageRentBucket.
+// The rent of a period is charged by the peak size of the contract data in the period,
+// so that deleting data before settlement doesn't reduce the rent.
+//
+// The rent is settled lazily: WriteStorageUsage only tracks the size, and Balance is charged only when
+// a SettleStorageRent or PayStorageRent tx of the contract is sent. All the whole periods since SettledHeight
+// are charged at once by the peak size, so delaying the settlement never reduces the rent, but a contract
+// in arrears can't be archived until someone settles its rent
+type StorageRent struct {
+	// Bytes is the total size of keys and values in the bucket of contract
+	Bytes int64 `json:"bytes"`
+	// PeakBytes is the max of Bytes since SettledHeight
+	PeakBytes int64 `json:"peak_bytes"`
+	// SettledHeight is the height the rent is charged up to, 0 if the rent is never settled
+	SettledHeight int64 `json:"settled_height"`
+	// Balance is the prepaid rent in gas, negative balance is the arrears
+	Balance int64 `json:"balance"`
+	// ArrearsHeight is the height the prepaid rent ran out, 0 if the contract is not in arrears
+	ArrearsHeight int64 `json:"arrears_height,omitempty"`
+	// ArchiveHash is the hash of the last archived chunk, the contract is archived if it's not empty.
+	// The contract is archived by chunks, Bytes is the size left in xmodel
+	ArchiveHash []byte `json:"archive_hash,omitempty"`
+	// ArchiveTxid is the tx of the last archived chunk, empty if it's the tx which wrote the record
+	ArchiveTxid []byte `json:"archive_txid,omitempty"`
+}
+
+// IsArchived returns whether the storage of contract is archived
+func (r *StorageRent) IsArchived() bool {
+	return len(r.ArchiveHash) != 0
+}
+
+// Settle charges the rent of whole periods from SettledHeight up to height and returns the rent charged,
+// rentRate is the number of bytes charged 1 gas for a period, the storage is free if rentRate is 0
+func (r *StorageRent) Settle(height int64, cfg *ledger.StorageRentConfig, rentRate int64) (int64, error) {
+	if height < r.SettledHeight {
+		return 0, ErrStorageSettledHeight
+	}
+	// 首次结算时开始计租
+	if r.SettledHeight == 0 {
+		r.SettledHeight = height
+		r.PeakBytes = r.Bytes
+		return 0, nil
+	}
+	periods := (height - r.SettledHeight) / cfg.Period
+	if periods == 0 {
+		return 0, nil
+	}
+	periodRent := roundupRent(r.PeakBytes, rentRate)
+	rent := periodRent * periods
+	if r.Balance >= 0 && r.Balance < rent {
+		r.ArrearsHeight = r.SettledHeight + (r.Balance/periodRent+1)*cfg.Period
+	}
+	r.Balance -= rent
+	r.SettledHeight += periods * cfg.Period
+	r.PeakBytes = r.Bytes
+	return rent, nil
+}
+
+// Pay adds amount of gas to the balance
+func (r *StorageRent) Pay(amount int64) {
+	r.Balance += amount
+	if r.Balance >= 0 {
+		r.ArrearsHeight = 0
+	}
+}
+
+// Archivable returns whether the contract can be archived at height, which is in arrears for
+// GracePeriod blocks and has data left in xmodel. The rent must be settled up to height first
+func (r *StorageRent) Archivable(height int64, cfg *ledger.StorageRentConfig) bool {
+	if r.IsArchived() && r.Bytes == 0 {
+		return false
+	}
+	return r.Balance < 0 && height-r.ArrearsHeight >= cfg.GracePeriod
+}
+
+func roundupRent(n, rate int64) int64 {
+	if rate == 0 {
+		return 0
+	}
+	return (n + rate - 1) / rate
+}
+
+// GetStorageRent returns the storage rent record of contract, a zero record is returned if it doesn't exist
+func (xc *XMCache) GetStorageRent(contractName string) (*StorageRent, error) {
+	rent := new(StorageRent)
+	vd, err := xc.Get(StorageRentBucket, []byte(contractName))
+	if err == ErrNotFound || err == ErrHasDel {
+		return rent, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(vd.GetPureData().GetValue(), rent)
+	if err != nil {
+		return nil, err
+	}
+	return rent, nil
+}
+
+// PutStorageRent saves the storage rent record of contract
+func (xc *XMCache) PutStorageRent(contractName string, rent *StorageRent) error {
+	value, err := json.Marshal(rent)
+	if err != nil {
+		return err
+	}
+	return xc.Put(StorageRentBucket, []byte(contractName), value)
+}
+
+// isContractBucket returns whether bucket is the storage of a contract owned by an account
+func (xc *XMCache) isContractBucket(bucket string) (bool, error) {
+	if bucket == TransientBucket || common.ValidContractName(bucket) != nil {
+		return false, nil
+	}
+	_, err := xc.Get(utils.GetContract2AccountBucket(), []byte(bucket))
+	if err == ErrNotFound || err == ErrHasDel {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func dataSize(pd *xmodel_pb.PureData) int64 {
+	if isDelFlag(pd.GetValue()) {
+		return 0
+	}
+	return int64(len(pd.GetKey()) + len(pd.GetValue()))
+}
+
+// WriteStorageUsage updates the storage size in the rent records of the contracts written by the tx,
+// it fails if an archived contract is written. It must be called after all the contract requests are executed,
+// the size is counted by comparing the write set with the read set, which is forced by Put
+func (xc *XMCache) WriteStorageUsage() error {
+	var buckets []string
+	deltas := make(map[string]int64)
+	iter := xc.outputsCache.NewIterator(&util.Range{Start: nil, Limit: nil})
+	for iter.Next() {
+		vd := &xmodel_pb.VersionedData{}
+		err := proto.Unmarshal(iter.Value(), vd)
+		if err != nil {
+			iter.Release()
+			return err
+		}
+		bucket := vd.GetPureData().GetBucket()
+		if bucket == TransientBucket || bucket == StorageRentBucket {
+			continue
+		}
+		delta := dataSize(vd.GetPureData())
+		inBuf, err := xc.inputsCache.Get(iter.Key())
+		if err != nil && err != memdb.ErrNotFound {
+			iter.Release()
+			return err
+		}
+		if err == nil {
+			in := &xmodel_pb.VersionedData{}
+			if err := proto.Unmarshal(inBuf, in); err != nil {
+				iter.Release()
+				return err
+			}
+			if !IsEmptyVersionedData(in) {
+				delta -= dataSize(in.GetPureData())
+			}
+		}
+		if _, ok := deltas[bucket]; !ok {
+			buckets = append(buckets, bucket)
+		}
+		deltas[bucket] += delta
+	}
+	iter.Release()
+
+	for _, bucket := range buckets {
+		ok, err := xc.isContractBucket(bucket)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		rent, err := xc.GetStorageRent(bucket)
+		if err != nil {
+			return err
+		}
+		// 归档和恢复交易本身会修改租金记录
+		_, err = xc.getFromOuputsCache(StorageRentBucket, []byte(bucket))
+		if rent.IsArchived() && err == memdb.ErrNotFound {
+			return fmt.Errorf("write contract %s error: %s", bucket, ErrStorageArchived)
+		}
+		if deltas[bucket] == 0 {
+			continue
+		}
+		rent.Bytes += deltas[bucket]
+		if rent.Bytes > rent.PeakBytes {
+			rent.PeakBytes = rent.Bytes
+		}
+		err = xc.PutStorageRent(bucket, rent)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StorageArchiveKey returns the key of archived data of contract in TransientBucket
+func StorageArchiveKey(contractName string) []byte {
+	return []byte(storageArchiveKeyPrefix + contractName)
+}
+
+// StorageArchiveHash returns the hash of archived data
+func StorageArchiveHash(archive []byte) []byte {
+	return hash.DoubleSha256(archive)
+}
+
+// StorageArchive is a chunk of the archived data of contract. The chunks are chained by PrevHash,
+// so that the archive hash in the rent record commits to all of them
+type StorageArchive struct {
+	// PrevHash is the hash of the previous chunk, empty for the first chunk
+	PrevHash []byte
+	// PrevTxid is the tx which archived the previous chunk
+	PrevTxid []byte
+	Datas    []*xmodel_pb.PureData
+}
+
+// Marshal encodes the chunk as PrevHash, PrevTxid and the messages of Datas
+func (a *StorageArchive) Marshal() ([]byte, error) {
+	datas, err := MarshalMessages(a.Datas)
+	if err != nil {
+		return nil, err
+	}
+	var buf proto.Buffer
+	buf.EncodeRawBytes(a.PrevHash)
+	buf.EncodeRawBytes(a.PrevTxid)
+	return append(buf.Bytes(), datas...), nil
+}
+
+// UnmarshalStorageArchive decodes the chunk encoded by StorageArchive.Marshal
+func UnmarshalStorageArchive(p []byte) (*StorageArchive, error) {
+	a := new(StorageArchive)
+	buf := proto.NewBuffer(p)
+	var err error
+	if a.PrevHash, err = buf.DecodeRawBytes(true); err != nil {
+		return nil, err
+	}
+	if a.PrevTxid, err = buf.DecodeRawBytes(true); err != nil {
+		return nil, err
+	}
+	n := proto.SizeVarint(uint64(len(a.PrevHash))) + len(a.PrevHash) +
+		proto.SizeVarint(uint64(len(a.PrevTxid))) + len(a.PrevTxid)
+	if n == len(p) {
+		return a, nil
+	}
+	err = UnmsarshalMessages(p[n:], &a.Datas)
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// ArchiveStorage deletes a chunk of data from the start of the bucket of contract and writes it to
+// TransientBucket of the tx, returns the hash of the chunk which is chained to prevHash.
+// The chunk takes at least one key and at most chunkBytes, so a large contract is archived by several txs.
+// size is the storage size left in the rent record, which is used to check that all the data is archived
+func (xc *XMCache) ArchiveStorage(contractName string, size, chunkBytes int64, prevHash, prevTxid []byte) ([]byte, error) {
+	// 遍历整个bucket, 不能以某个key为上界, 否则更大的key会逃过归档
+	iter, err := xc.newXModelCacheBucketIterator(contractName, comparer.DefaultComparer)
+	if err != nil {
+		return nil, err
+	}
+	var datas []*xmodel_pb.PureData
+	var total int64
+	last := true
+	for iter.Next() {
+		pd := iter.Data().GetPureData()
+		data := &xmodel_pb.PureData{
+			Bucket: contractName,
+			Key:    append([]byte{}, pd.GetKey()...),
+			Value:  append([]byte{}, pd.GetValue()...),
+		}
+		if len(datas) > 0 && total+dataSize(data) > chunkBytes {
+			last = false
+			break
+		}
+		datas = append(datas, data)
+		total += dataSize(data)
+	}
+	err = iter.Error()
+	iter.Release()
+	if err != nil {
+		return nil, err
+	}
+	// 最后一块必须恰好是剩余的数据
+	if (last && total != size) || (!last && total >= size) {
+		return nil, ErrIncompleteArchive
+	}
+
+	for _, data := range datas {
+		err := xc.Del(contractName, data.GetKey())
+		if err != nil {
+			return nil, err
+		}
+	}
+	archive, err := (&StorageArchive{
+		PrevHash: prevHash,
+		PrevTxid: prevTxid,
+		Datas:    datas,
+	}).Marshal()
+	if err != nil {
+		return nil, err
+	}
+	err = xc.Put(TransientBucket, StorageArchiveKey(contractName), archive)
+	if err != nil {
+		return nil, err
+	}
+	return StorageArchiveHash(archive), nil
+}
+
+// RestoreStorage puts back the data of the last archived chunk of contract, archive must match archiveHash.
+// The chunk is returned, whose PrevHash and PrevTxid point to the chunk to restore next
+func (xc *XMCache) RestoreStorage(contractName string, archive []byte, archiveHash []byte) (*StorageArchive, error) {
+	if !bytes.Equal(StorageArchiveHash(archive), archiveHash) {
+		return nil, ErrArchiveHashMismatch
+	}
+	chunk, err := UnmarshalStorageArchive(archive)
+	if err != nil {
+		return nil, err
+	}
+	for _, data := range chunk.Datas {
+		if data.GetBucket() != contractName {
+			return nil, fmt.Errorf("bad bucket %s in archive of contract %s", data.GetBucket(), contractName)
+		}
+		err := xc.Put(contractName, data.GetKey(), data.GetValue())
+		if err != nil {
+			return nil, err
+		}
+	}
+	return chunk, nil
+}
+
+// ParseStorageArchive returns the archived data of contract written by tx
+func ParseStorageArchive(tx *pb.Transaction, contractName string) []byte {
+	key := StorageArchiveKey(contractName)
+	for _, out := range tx.GetTxOutputsExt() {
+		if out.GetBucket() == TransientBucket && bytes.Equal(out.GetKey(), key) {
+			return out.GetValue()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+package xmodel
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/xuperchain/xuperchain/core/ledger"
+	"github.com/xuperchain/xuperchain/core/pb"
+	"github.com/xuperchain/xuperchain/core/permission/acl/utils"
+	xmodel_pb "github.com/xuperchain/xuperchain/core/xmodel/pb"
+)
+
+func versionedData(bucket, key string, value []byte) *xmodel_pb.VersionedData {
+	vd := &xmodel_pb.VersionedData{
+		PureData: &xmodel_pb.PureData{
+			Bucket: bucket,
+			Key:    []byte(key),
+			Value:  value,
+		},
+	}
+	if value != nil {
+		vd.RefTxid = []byte("tx0")
+	}
+	return vd
+}
+
+func rentData(t *testing.T, contractName string, rent *StorageRent) *xmodel_pb.VersionedData {
+	value, err := json.Marshal(rent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return versionedData(StorageRentBucket, contractName, value)
+}
+
+func TestStorageRentSettle(t *testing.T) {
+	cfg := &ledger.StorageRentConfig{
+		Period:      10,
+		GracePeriod: 20,
+	}
+	rent := &StorageRent{
+		Bytes:     100,
+		PeakBytes: 100,
+	}
+	if charged, _ := rent.Settle(5, cfg, 2); charged != 0 || rent.SettledHeight != 5 {
+		t.Fatalf("the first settlement should start the rent, charged %d, %+v", charged, rent)
+	}
+	rent.Pay(100)
+	rent.Bytes = 60
+	if charged, _ := rent.Settle(34, cfg, 2); charged != 100 {
+		t.Fatalf("expect charge 2 periods by peak bytes, charged %d", charged)
+	}
+	if rent.Balance != 0 || rent.SettledHeight != 25 || rent.PeakBytes != 60 {
+		t.Fatalf("unexpected rent %+v", rent)
+	}
+	if charged, _ := rent.Settle(44, cfg, 2); charged != 30 {
+		t.Fatalf("expect charge 1 period, charged %d", charged)
+	}
+	if rent.Balance != -30 || rent.ArrearsHeight != 35 {
+		t.Fatalf("unexpected rent %+v", rent)
+	}
+	if rent.Archivable(54, cfg) || !rent.Archivable(55, cfg) {
+		t.Error("expect archivable after the grace period")
+	}
+	if _, err := rent.Settle(20, cfg, 2); err != ErrStorageSettledHeight {
+		t.Errorf("expect ErrStorageSettledHeight, got %v", err)
+	}
+	rent.Pay(30)
+	if rent.ArrearsHeight != 0 || rent.Archivable(100, cfg) {
+		t.Errorf("expect out of arrears after paid, %+v", rent)
+	}
+}
+
+func TestWriteStorageUsage(t *testing.T) {
+	inputs := []*xmodel_pb.VersionedData{
+		versionedData(utils.GetContract2AccountBucket(), "counter", []byte("XC1111111111111111@xuper")),
+		versionedData(utils.GetContract2AccountBucket(), "other", nil),
+		versionedData("counter", "k1", []byte("v1")),
+		rentData(t, "counter", &StorageRent{Bytes: 4, PeakBytes: 4, SettledHeight: 1}),
+	}
+	xc := NewXModelCacheWithInputs(inputs, nil, nil)
+	xc.Put("counter", []byte("k1"), []byte("value1"))
+	xc.Put("counter", []byte("k2"), []byte("v2"))
+	xc.Put("other", []byte("k1"), []byte("v1"))
+	if err := xc.WriteStorageUsage(); err != nil {
+		t.Fatal(err)
+	}
+	rent, err := xc.GetStorageRent("counter")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rent.Bytes != 12 || rent.PeakBytes != 12 || rent.SettledHeight != 1 {
+		t.Errorf("unexpected rent %+v", rent)
+	}
+	if _, err := xc.getFromOuputsCache(StorageRentBucket, []byte("other")); err == nil {
+		t.Error("expect no rent for bucket not owned by account")
+	}
+
+	inputs[3] = rentData(t, "counter", &StorageRent{Bytes: 4, ArchiveHash: []byte("hash")})
+	xc = NewXModelCacheWithInputs(inputs, nil, nil)
+	xc.Put("counter", []byte("k1"), []byte("v2"))
+	if err := xc.WriteStorageUsage(); err == nil {
+		t.Error("expect error writing archived contract")
+	}
+}
+
+// archiveOf returns the archived chunk written by xc
+func archiveOf(t *testing.T, xc *XMCache) []byte {
+	_, writeSet, err := xc.GetRWSets()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := &pb.Transaction{
+		TxOutputsExt: GetTxOutputs(writeSet),
+	}
+	archive := ParseStorageArchive(tx, "counter")
+	if archive == nil {
+		t.Fatal("archive not found in tx")
+	}
+	return archive
+}
+
+func TestArchiveRestoreStorage(t *testing.T) {
+	inputs := []*xmodel_pb.VersionedData{
+		versionedData(utils.GetContract2AccountBucket(), "counter", []byte("XC1111111111111111@xuper")),
+		versionedData("counter", "k1", []byte("v1")),
+		versionedData("counter", "k2", []byte("value2")),
+		rentData(t, "counter", &StorageRent{Bytes: 12, PeakBytes: 12, SettledHeight: 1, Balance: -1}),
+	}
+	xc := NewXModelCacheWithInputs(inputs, nil, nil)
+	if _, err := xc.ArchiveStorage("counter", 4, 4, nil, nil); err != ErrIncompleteArchive {
+		t.Fatalf("expect ErrIncompleteArchive, got %v", err)
+	}
+	// 每块最多4字节, 第一块只归档k1
+	hash1, err := xc.ArchiveStorage("counter", 12, 4, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rent, _ := xc.GetStorageRent("counter")
+	rent.ArchiveHash = hash1
+	xc.PutStorageRent("counter", rent)
+	if err := xc.WriteStorageUsage(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := xc.Get("counter", []byte("k1")); err != ErrHasDel {
+		t.Errorf("expect data deleted, got %v", err)
+	}
+	rent, _ = xc.GetStorageRent("counter")
+	if rent.Bytes != 8 || !rent.IsArchived() {
+		t.Errorf("unexpected rent %+v", rent)
+	}
+	chunk1 := archiveOf(t, xc)
+
+	// 超过块大小的单个key也能被归档
+	xc = NewXModelCacheWithInputs([]*xmodel_pb.VersionedData{
+		inputs[0], inputs[2], rentData(t, "counter", rent),
+	}, nil, nil)
+	hash2, err := xc.ArchiveStorage("counter", 8, 4, hash1, []byte("archive1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rent.ArchiveHash = hash2
+	xc.PutStorageRent("counter", rent)
+	if err := xc.WriteStorageUsage(); err != nil {
+		t.Fatal(err)
+	}
+	rent, _ = xc.GetStorageRent("counter")
+	if rent.Bytes != 0 {
+		t.Errorf("unexpected rent %+v", rent)
+	}
+	chunk2 := archiveOf(t, xc)
+
+	// 按归档的逆序恢复
+	inputs[1] = versionedData("counter", "k1", []byte(DelFlag))
+	inputs[2] = versionedData("counter", "k2", []byte(DelFlag))
+	inputs[3] = rentData(t, "counter", rent)
+	xc = NewXModelCacheWithInputs(inputs, nil, nil)
+	if _, err := xc.RestoreStorage("counter", chunk1, hash2); err != ErrArchiveHashMismatch {
+		t.Fatalf("expect ErrArchiveHashMismatch, got %v", err)
+	}
+	chunk, err := xc.RestoreStorage("counter", chunk2, hash2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(chunk.PrevHash, hash1) || string(chunk.PrevTxid) != "archive1" {
+		t.Fatalf("unexpected previous chunk %x %s", chunk.PrevHash, chunk.PrevTxid)
+	}
+	chunk, err = xc.RestoreStorage("counter", chunk1, hash1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(chunk.PrevHash) != 0 {
+		t.Fatalf("expect the first chunk, got previous hash %x", chunk.PrevHash)
+	}
+	rent.ArchiveHash = nil
+	rent.PeakBytes = 0
+	xc.PutStorageRent("counter", rent)
+	if err := xc.WriteStorageUsage(); err != nil {
+		t.Fatal(err)
+	}
+	vd, err := xc.Get("counter", []byte("k2"))
+	if err != nil || string(vd.GetPureData().GetValue()) != "value2" {
+		t.Errorf("restore failed, %v %v", vd, err)
+	}
+	rent, _ = xc.GetStorageRent("counter")
+	if rent.Bytes != 12 || rent.PeakBytes != 12 {
+		t.Errorf("unexpected rent %+v", rent)
+	}
+}
+
+func TestArchiveStorageLongKey(t *testing.T) {
+	longKey := string(bytes.Repeat([]byte{0xff}, 65))
+	inputs := []*xmodel_pb.VersionedData{
+		versionedData("counter", "k1", []byte("v1")),
+		versionedData("counter", longKey, []byte("v")),
+		versionedData("counter0", "k1", []byte("v1")),
+	}
+	xc := NewXModelCacheWithInputs(inputs, nil, nil)
+	// 任意长度的key都需要被归档, 其他bucket的数据不受影响
+	if _, err := xc.ArchiveStorage("counter", 4+66, ledger.DefaultArchiveChunkBytes, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := xc.Get("counter", []byte(longKey)); err != ErrHasDel {
+		t.Errorf("expect long key deleted, got %v", err)
+	}
+	if vd, err := xc.Get("counter0", []byte("k1")); err != nil || string(vd.GetPureData().GetValue()) != "v1" {
+		t.Errorf("unexpected data of other bucket, %v %v", vd, err)
+	}
+}
@@ -266,6 +266,17 @@ func (s *XModel) Select(bucket string, startKey []byte, endKey []byte) (Iterator
 	return iter, nil
 }
 
+// selectBucket select all kv from a bucket without key range
+func (s *XModel) selectBucket(bucket string) (Iterator, error) {
+	rawStartKey, rawEndKey := makeBucketRange(bucket)
+	iter := &XMIterator{
+		bucket: bucket,
+		iter:   s.extUtxoTable.NewIteratorWithRange(rawStartKey, rawEndKey),
+		model:  s,
+	}
+	return iter, nil
+}
+
 func (s *XModel) queryTx(txid []byte) (*pb.Transaction, bool, error) {
 	unconfirmTx, err := queryUnconfirmTx(txid, s.unconfirmTable)
 	if err != nil {
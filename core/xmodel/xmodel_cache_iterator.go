@@ -2,6 +2,8 @@
 package xmodel
 
 import (
+	"fmt"
+
 	"github.com/golang/protobuf/proto"
 	"github.com/syndtr/goleveldb/leveldb/comparer"
 	"github.com/syndtr/goleveldb/leveldb/iterator"
@@ -46,13 +48,6 @@ type XMCacheIterator struct {
 
 // NewXModelCacheIterator new an instance of XModel Cache iterator
 func (mc *XMCache) NewXModelCacheIterator(bucket string, startKey []byte, endKey []byte, cmp comparer.Comparer) (*XMCacheIterator, error) {
-	rawStartKey := makeRawKey(bucket, startKey)
-	rawEndKey := makeRawKey(bucket, endKey)
-	var iters []iterator.Iterator
-	mcoi := mc.outputsCache.NewIterator(&util.Range{Start: rawStartKey, Limit: rawEndKey})
-	iters = append(iters, mcoi)
-	mcii := mc.inputsCache.NewIterator(&util.Range{Start: rawStartKey, Limit: rawEndKey})
-	iters = append(iters, mcii)
 	var mi Iterator
 	if mc.isPenetrate {
 		var err error
@@ -61,6 +56,39 @@ func (mc *XMCache) NewXModelCacheIterator(bucket string, startKey []byte, endKey
 			return nil, err
 		}
 	}
+	return mc.newXModelCacheIterator(makeRawKey(bucket, startKey), makeRawKey(bucket, endKey), mi, cmp), nil
+}
+
+// bucketSelector is implemented by the XMReader which can select all kv of a bucket without key range
+type bucketSelector interface {
+	selectBucket(bucket string) (Iterator, error)
+}
+
+// newXModelCacheBucketIterator new an iterator of all kv in bucket, unlike NewXModelCacheIterator
+// the keys are not bounded by an end key
+func (mc *XMCache) newXModelCacheBucketIterator(bucket string, cmp comparer.Comparer) (*XMCacheIterator, error) {
+	var mi Iterator
+	if mc.isPenetrate {
+		selector, ok := mc.model.(bucketSelector)
+		if !ok {
+			return nil, fmt.Errorf("xmodel reader doesn't support selecting bucket %s", bucket)
+		}
+		var err error
+		mi, err = selector.selectBucket(bucket)
+		if err != nil {
+			return nil, err
+		}
+	}
+	rawStartKey, rawEndKey := makeBucketRange(bucket)
+	return mc.newXModelCacheIterator(rawStartKey, rawEndKey, mi, cmp), nil
+}
+
+func (mc *XMCache) newXModelCacheIterator(rawStartKey, rawEndKey []byte, mi Iterator, cmp comparer.Comparer) *XMCacheIterator {
+	var iters []iterator.Iterator
+	mcoi := mc.outputsCache.NewIterator(&util.Range{Start: rawStartKey, Limit: rawEndKey})
+	iters = append(iters, mcoi)
+	mcii := mc.inputsCache.NewIterator(&util.Range{Start: rawStartKey, Limit: rawEndKey})
+	iters = append(iters, mcii)
 	return &XMCacheIterator{
 		mIter:     mi,
 		mc:        mc,
@@ -68,7 +96,7 @@ func (mc *XMCache) NewXModelCacheIterator(bucket string, startKey []byte, endKey
 		cmp:       cmp,
 		keys:      make([][]byte, 3),
 		markedKey: make(map[string]bool),
-	}, nil
+	}
 }
 
 // Data get data pointer to VersionedData for XMCacheIterator
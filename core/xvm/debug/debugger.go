@@ -0,0 +1,400 @@
+package debug
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/xuperchain/xuperchain/core/xvm/debug/instrument"
+	"github.com/xuperchain/xuperchain/core/xvm/exec"
+)
+
+const (
+	prompt = "(xdb) "
+	// maxDumpSize limits the bytes of memory dumped by one command
+	maxDumpSize = 4096
+)
+
+type stepMode int
+
+const (
+	modeContinue stepMode = iota
+	// modeStep pauses at the next source line, or the next instruction without DWARF
+	modeStep
+	modeStepInstr
+	// modeDetached never pauses after the input closed
+	modeDetached
+)
+
+type breakpoint struct {
+	id    int
+	spec  string
+	addrs []uint32
+}
+
+type location struct {
+	fn   *instrument.Function
+	addr uint32
+	line instrument.Line
+	// hasLine is false if the instruction has no source line
+	hasLine bool
+}
+
+// Debugger is an interactive debugger of wasm code running in interpreter mode,
+// it implements exec.DebugHook with the Info returned by exec.NewInterpDebugCode
+type Debugger struct {
+	info *instrument.Info
+	in   *bufio.Reader
+	out  io.Writer
+
+	mode        stepMode
+	breakpoints []*breakpoint
+	bpAddrs     map[uint32]int
+	nextID      int
+	// last is the location of last pause
+	last    location
+	lastCmd string
+	sources map[string][]string
+}
+
+// NewDebugger instances a Debugger, the commands are read from in and the outputs are written to out.
+// Debuggers of different contracts can share the same in
+func NewDebugger(info *instrument.Info, in *bufio.Reader, out io.Writer) *Debugger {
+	return &Debugger{
+		info:    info,
+		in:      in,
+		out:     out,
+		bpAddrs: make(map[uint32]int),
+		nextID:  1,
+		sources: make(map[string][]string),
+	}
+}
+
+// Start is called before running the code, it pauses at the first instruction
+// if there is no breakpoint, otherwise runs until the breakpoint
+func (d *Debugger) Start() {
+	if d.mode == modeDetached {
+		return
+	}
+	d.mode = modeContinue
+	if len(d.breakpoints) == 0 {
+		d.mode = modeStep
+	}
+	d.last = location{}
+}
+
+// AddBreakpoint adds a breakpoint at function name, source line as file:line, or 0x prefixed address
+func (d *Debugger) AddBreakpoint(spec string) error {
+	addrs, err := d.resolve(spec)
+	if err != nil {
+		return err
+	}
+	bp := &breakpoint{
+		id:    d.nextID,
+		spec:  spec,
+		addrs: addrs,
+	}
+	d.nextID++
+	d.breakpoints = append(d.breakpoints, bp)
+	for _, addr := range addrs {
+		d.bpAddrs[addr] = bp.id
+	}
+	fmt.Fprintf(d.out, "Breakpoint %d at %s, %d location(s)\n", bp.id, spec, len(addrs))
+	return nil
+}
+
+func (d *Debugger) resolve(spec string) ([]uint32, error) {
+	if strings.HasPrefix(spec, "0x") {
+		addr, err := strconv.ParseUint(spec[2:], 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("bad address %s", spec)
+		}
+		f := d.info.FunctionAt(uint32(addr))
+		if f == nil || !f.HasSite(uint32(addr)) {
+			return nil, fmt.Errorf("no instruction at %s", spec)
+		}
+		return []uint32{uint32(addr)}, nil
+	}
+	if idx := strings.LastIndex(spec, ":"); idx != -1 {
+		if line, err := strconv.Atoi(spec[idx+1:]); err == nil {
+			if !d.info.HasLines() {
+				return nil, d.noLinesError()
+			}
+			addrs := d.info.LineAddrs(spec[:idx], line)
+			if len(addrs) == 0 {
+				return nil, fmt.Errorf("no code at %s", spec)
+			}
+			return addrs, nil
+		}
+	}
+	f := d.info.FunctionByName(spec)
+	if f == nil {
+		return nil, fmt.Errorf("function %s not found", spec)
+	}
+	return []uint32{f.Entry()}, nil
+}
+
+func (d *Debugger) noLinesError() error {
+	if err := d.info.LineError(); err != nil {
+		return fmt.Errorf("bad DWARF line table: %s", err)
+	}
+	return fmt.Errorf("no DWARF line table, compile the contract with -g")
+}
+
+func (d *Debugger) deleteBreakpoint(id int) error {
+	for i, bp := range d.breakpoints {
+		if bp.id != id {
+			continue
+		}
+		d.breakpoints = append(d.breakpoints[:i], d.breakpoints[i+1:]...)
+		d.bpAddrs = make(map[uint32]int)
+		for _, bp := range d.breakpoints {
+			for _, addr := range bp.addrs {
+				d.bpAddrs[addr] = bp.id
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("breakpoint %d not found", id)
+}
+
+func (d *Debugger) locate(fn, addr uint32) location {
+	loc := location{
+		fn:   d.info.Function(fn),
+		addr: addr,
+	}
+	loc.line, loc.hasLine = d.info.LineAt(addr)
+	return loc
+}
+
+// Step implements exec.DebugHook
+func (d *Debugger) Step(ctx exec.Context, fn, addr uint32) bool {
+	switch d.mode {
+	case modeDetached:
+		return false
+	case modeStepInstr:
+		return true
+	case modeStep:
+		if !d.info.HasLines() {
+			return true
+		}
+		loc := d.locate(fn, addr)
+		if loc.hasLine && (loc.line != d.last.line || loc.fn != d.last.fn) {
+			return true
+		}
+	}
+	_, ok := d.bpAddrs[addr]
+	return ok
+}
+
+// Pause implements exec.DebugHook
+func (d *Debugger) Pause(ctx exec.Context, fn, addr uint32, locals []uint64) {
+	d.last = d.locate(fn, addr)
+	if id, ok := d.bpAddrs[addr]; ok {
+		fmt.Fprintf(d.out, "Breakpoint %d, ", id)
+	}
+	d.printLocation()
+	for {
+		fmt.Fprint(d.out, prompt)
+		line, err := d.in.ReadString('\n')
+		if err != nil && line == "" {
+			fmt.Fprintln(d.out)
+			d.mode = modeDetached
+			return
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			line = d.lastCmd
+		}
+		d.lastCmd = line
+		if d.execute(ctx, line, locals) {
+			return
+		}
+	}
+}
+
+// execute runs the command, returns true if the execution should be resumed
+func (d *Debugger) execute(ctx exec.Context, line string, locals []uint64) bool {
+	args := strings.Fields(line)
+	if len(args) == 0 {
+		return false
+	}
+	var err error
+	switch args[0] {
+	case "c", "continue":
+		d.mode = modeContinue
+		return true
+	case "s", "step":
+		d.mode = modeStep
+		return true
+	case "si", "stepi":
+		d.mode = modeStepInstr
+		return true
+	case "b", "break":
+		if len(args) != 2 {
+			err = fmt.Errorf("usage: break function|file:line|0xaddr")
+			break
+		}
+		err = d.AddBreakpoint(args[1])
+	case "d", "delete":
+		var id int
+		if len(args) == 2 {
+			id, err = strconv.Atoi(args[1])
+		}
+		if len(args) != 2 || err != nil {
+			err = fmt.Errorf("usage: delete id")
+			break
+		}
+		err = d.deleteBreakpoint(id)
+	case "bl", "breakpoints":
+		for _, bp := range d.breakpoints {
+			fmt.Fprintf(d.out, "%d\t%s\t%d location(s)\n", bp.id, bp.spec, len(bp.addrs))
+		}
+	case "l", "locals":
+		d.printLocals(locals)
+	case "x", "memory":
+		err = d.dumpMemory(ctx, args[1:])
+	case "g", "gas":
+		fmt.Fprintf(d.out, "gas used: %d\n", ctx.GasUsed())
+	case "w", "where":
+		d.printLocation()
+	case "funcs":
+		d.printFunctions(args[1:])
+	case "q", "quit":
+		exec.ThrowMessage("quit by debugger")
+	case "h", "help":
+		fmt.Fprint(d.out, helpMessage)
+	default:
+		err = fmt.Errorf("unknown command %s, type help to show commands", args[0])
+	}
+	if err != nil {
+		fmt.Fprintln(d.out, err)
+	}
+	return false
+}
+
+const helpMessage = `c, continue              run until the next breakpoint
+s, step                  run to the next source line, or the next instruction without DWARF
+si, stepi                run to the next instruction
+b, break <location>      set a breakpoint at function, file:line or 0xaddr
+d, delete <id>           delete the breakpoint
+bl, breakpoints          list breakpoints
+l, locals                show params and locals of current function
+x, memory <addr> [size]  dump linear memory, addr can be 0x prefixed
+g, gas                   show the gas used
+w, where                 show current location
+funcs [pattern]          list functions whose name contains pattern
+q, quit                  abort the execution
+h, help                  show this message
+`
+
+func (d *Debugger) printLocation() {
+	loc := d.last
+	name := "<unknown>"
+	if loc.fn != nil {
+		name = loc.fn.Name
+	}
+	if !loc.hasLine {
+		fmt.Fprintf(d.out, "%s (0x%x)\n", name, loc.addr)
+		return
+	}
+	fmt.Fprintf(d.out, "%s (0x%x) at %s\n", name, loc.addr, loc.line)
+	if text, ok := d.sourceLine(loc.line); ok {
+		fmt.Fprintf(d.out, "%d\t%s\n", loc.line.Line, text)
+	}
+}
+
+// sourceLine reads the source line if the source file can be found
+func (d *Debugger) sourceLine(line instrument.Line) (string, bool) {
+	lines, ok := d.sources[line.File]
+	if !ok {
+		buf, err := ioutil.ReadFile(line.File)
+		if err == nil {
+			lines = strings.Split(string(buf), "\n")
+		}
+		d.sources[line.File] = lines
+	}
+	if line.Line <= 0 || line.Line > len(lines) {
+		return "", false
+	}
+	return lines[line.Line-1], true
+}
+
+func (d *Debugger) printLocals(locals []uint64) {
+	f := d.last.fn
+	if f == nil {
+		return
+	}
+	for i, t := range f.Locals {
+		kind := "local"
+		if i < f.NumParams {
+			kind = "param"
+		}
+		if i >= len(locals) {
+			fmt.Fprintf(d.out, "%s %d %s = <unavailable>\n", kind, i, t)
+			continue
+		}
+		fmt.Fprintf(d.out, "%s %d %s = %s\n", kind, i, t, formatValue(t, locals[i]))
+	}
+}
+
+func formatValue(t instrument.ValueType, v uint64) string {
+	switch t {
+	case instrument.ValueTypeI32:
+		return fmt.Sprintf("%d (0x%x)", int32(v), uint32(v))
+	case instrument.ValueTypeI64:
+		return fmt.Sprintf("%d (0x%x)", int64(v), v)
+	case instrument.ValueTypeF32:
+		return fmt.Sprint(math.Float32frombits(uint32(v)))
+	case instrument.ValueTypeF64:
+		return fmt.Sprint(math.Float64frombits(v))
+	default:
+		return fmt.Sprintf("0x%x", v)
+	}
+}
+
+func (d *Debugger) dumpMemory(ctx exec.Context, args []string) error {
+	if len(args) == 0 || len(args) > 2 {
+		return fmt.Errorf("usage: memory addr [size]")
+	}
+	addr, err := strconv.ParseUint(args[0], 0, 32)
+	if err != nil {
+		return fmt.Errorf("bad address %s", args[0])
+	}
+	size := uint64(64)
+	if len(args) == 2 {
+		size, err = strconv.ParseUint(args[1], 0, 32)
+		if err != nil || size > maxDumpSize {
+			return fmt.Errorf("bad size %s, the maximum is %d", args[1], maxDumpSize)
+		}
+	}
+	mem := ctx.Memory()
+	if addr+size > uint64(len(mem)) {
+		return fmt.Errorf("out of memory bound %d", len(mem))
+	}
+	dumper := hex.Dumper(d.out)
+	// hex.Dumper shows offset from 0, print the base address first
+	fmt.Fprintf(d.out, "memory at 0x%x:\n", addr)
+	dumper.Write(mem[addr : addr+size])
+	dumper.Close()
+	return nil
+}
+
+func (d *Debugger) printFunctions(args []string) {
+	var names []string
+	for _, f := range d.info.Functions {
+		if len(args) == 0 || strings.Contains(f.Name, args[0]) {
+			names = append(names, f.Name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintln(d.out, name)
+	}
+}
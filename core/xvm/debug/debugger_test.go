@@ -0,0 +1,78 @@
+package debug
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/xuperchain/xuperchain/core/xvm/debug/instrument"
+	"github.com/xuperchain/xuperchain/core/xvm/exec"
+)
+
+type fakeContext struct {
+	exec.Context
+	memory []byte
+}
+
+func (f *fakeContext) GasUsed() int64 {
+	return 42
+}
+
+func (f *fakeContext) Memory() []byte {
+	return f.memory
+}
+
+func TestDebugger(t *testing.T) {
+	info := &instrument.Info{
+		Functions: []*instrument.Function{
+			{
+				Index:     0,
+				Name:      "add",
+				Locals:    []instrument.ValueType{instrument.ValueTypeI32, instrument.ValueTypeI32, instrument.ValueTypeF64},
+				NumParams: 2,
+				Sites:     []uint32{1, 3, 5, 6},
+			},
+		},
+	}
+	ctx := &fakeContext{memory: []byte("hello world")}
+	out := new(bytes.Buffer)
+	in := bufio.NewReader(strings.NewReader("locals\nx 0 5\ngas\nb 0x6\nb main.c:1\nc\n"))
+	d := NewDebugger(info, in, out)
+	d.Start()
+
+	if !d.Step(ctx, 0, 1) {
+		t.Fatal("expect pause at the first instruction")
+	}
+	d.Pause(ctx, 0, 1, []uint64{1, 2})
+	for _, expect := range []string{
+		"add (0x1)",
+		"param 0 i32 = 1 (0x1)",
+		"local 2 f64 = <unavailable>",
+		"hello",
+		"gas used: 42",
+		"Breakpoint 1 at 0x6",
+		"no DWARF line table",
+	} {
+		if !strings.Contains(out.String(), expect) {
+			t.Errorf("expect %q in output:\n%s", expect, out)
+		}
+	}
+
+	if d.Step(ctx, 0, 3) || d.Step(ctx, 0, 5) {
+		t.Error("expect continue to the breakpoint")
+	}
+	if !d.Step(ctx, 0, 6) {
+		t.Fatal("expect pause at the breakpoint")
+	}
+	out.Reset()
+	d.Pause(ctx, 0, 6, nil)
+	if !strings.Contains(out.String(), "Breakpoint 1, add (0x6)") {
+		t.Errorf("unexpected output %s", out)
+	}
+	// the input is closed
+	d.Start()
+	if d.Step(ctx, 0, 6) {
+		t.Error("expect never pause after the input closed")
+	}
+}
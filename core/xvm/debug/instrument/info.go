@@ -0,0 +1,266 @@
+package instrument
+
+import (
+	"debug/dwarf"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// ValueType is the type of wasm value
+type ValueType byte
+
+// wasm value types
+const (
+	ValueTypeI32 ValueType = 0x7f
+	ValueTypeI64 ValueType = 0x7e
+	ValueTypeF32 ValueType = 0x7d
+	ValueTypeF64 ValueType = 0x7c
+)
+
+func (t ValueType) String() string {
+	switch t {
+	case ValueTypeI32:
+		return "i32"
+	case ValueTypeI64:
+		return "i64"
+	case ValueTypeF32:
+		return "f32"
+	case ValueTypeF64:
+		return "f64"
+	default:
+		return fmt.Sprintf("<0x%x>", byte(t))
+	}
+}
+
+// Function is a function defined in the wasm code
+type Function struct {
+	// Index is the index of function in the function index space of original code
+	Index uint32
+	Name  string
+	// Locals are the types of params followed by the declared locals
+	Locals    []ValueType
+	NumParams int
+	// Sites are the addresses of instructions in order, the address is the offset
+	// in the payload of code section, which is the same as DWARF
+	Sites []uint32
+}
+
+// Entry returns the address of the first instruction
+func (f *Function) Entry() uint32 {
+	return f.Sites[0]
+}
+
+// HasSite returns whether addr is the address of an instruction in the function
+func (f *Function) HasSite(addr uint32) bool {
+	idx := sort.Search(len(f.Sites), func(i int) bool {
+		return f.Sites[i] >= addr
+	})
+	return idx < len(f.Sites) && f.Sites[idx] == addr
+}
+
+// Line is a source line
+type Line struct {
+	File string
+	Line int
+}
+
+func (l Line) String() string {
+	return fmt.Sprintf("%s:%d", l.File, l.Line)
+}
+
+type lineRow struct {
+	addr        uint32
+	line        Line
+	isStmt      bool
+	endSequence bool
+}
+
+// Info is the debug information of instrumented code
+type Info struct {
+	// Functions are the functions defined in the code
+	Functions []*Function
+	// NumImports is the number of imported functions of original code
+	NumImports uint32
+
+	lines   []lineRow
+	lineErr error
+}
+
+// Function returns the function of index in the original code
+func (info *Info) Function(index uint32) *Function {
+	if index < info.NumImports || index-info.NumImports >= uint32(len(info.Functions)) {
+		return nil
+	}
+	return info.Functions[index-info.NumImports]
+}
+
+// FunctionByName returns the function with the name
+func (info *Info) FunctionByName(name string) *Function {
+	for _, f := range info.Functions {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// FunctionAt returns the function containing the instruction at addr
+func (info *Info) FunctionAt(addr uint32) *Function {
+	idx := sort.Search(len(info.Functions), func(i int) bool {
+		f := info.Functions[i]
+		return f.Sites[len(f.Sites)-1] >= addr
+	})
+	if idx == len(info.Functions) || info.Functions[idx].Entry() > addr {
+		return nil
+	}
+	return info.Functions[idx]
+}
+
+// HasLines returns whether the code has DWARF line table
+func (info *Info) HasLines() bool {
+	return len(info.lines) != 0
+}
+
+// LineError returns the error of parsing DWARF line table
+func (info *Info) LineError() error {
+	return info.lineErr
+}
+
+// LineAt returns the source line of instruction at addr
+func (info *Info) LineAt(addr uint32) (Line, bool) {
+	idx := sort.Search(len(info.lines), func(i int) bool {
+		return info.lines[i].addr > addr
+	})
+	if idx == 0 {
+		return Line{}, false
+	}
+	row := info.lines[idx-1]
+	if row.endSequence {
+		return Line{}, false
+	}
+	return row.line, true
+}
+
+// LineAddrs returns the addresses of statements at the source line, file matches
+// the full path or the trailing path elements of source file
+func (info *Info) LineAddrs(file string, line int) []uint32 {
+	var addrs []uint32
+	seen := make(map[uint32]bool)
+	for _, row := range info.lines {
+		if !row.isStmt || row.endSequence || row.line.Line != line || seen[row.addr] {
+			continue
+		}
+		if row.line.File != file && !strings.HasSuffix(row.line.File, "/"+file) {
+			continue
+		}
+		f := info.FunctionAt(row.addr)
+		if f == nil || !f.HasSite(row.addr) {
+			continue
+		}
+		seen[row.addr] = true
+		addrs = append(addrs, row.addr)
+	}
+	return addrs
+}
+
+// parseNames reads the function names from the name section
+func parseNames(payload []byte) (map[uint32]string, error) {
+	names := make(map[uint32]string)
+	r := newReader(payload)
+	for !r.eof() {
+		id, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		size, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+		sub, err := r.bytes(int(size))
+		if err != nil {
+			return nil, err
+		}
+		// 1 is the subsection of function names
+		if id != 1 {
+			continue
+		}
+		sr := newReader(sub)
+		n, err := sr.u32()
+		if err != nil {
+			return nil, err
+		}
+		for i := uint32(0); i < n; i++ {
+			idx, err := sr.u32()
+			if err != nil {
+				return nil, err
+			}
+			name, err := sr.name()
+			if err != nil {
+				return nil, err
+			}
+			names[idx] = name
+		}
+	}
+	return names, nil
+}
+
+// parseLines reads the DWARF line table from the .debug_* custom sections
+func parseLines(sections map[string][]byte) ([]lineRow, error) {
+	if sections[".debug_info"] == nil || sections[".debug_line"] == nil {
+		return nil, nil
+	}
+	data, err := dwarf.New(sections[".debug_abbrev"], sections[".debug_aranges"], nil,
+		sections[".debug_info"], sections[".debug_line"], nil, sections[".debug_ranges"], sections[".debug_str"])
+	if err != nil {
+		return nil, err
+	}
+	var rows []lineRow
+	r := data.Reader()
+	for {
+		entry, err := r.Next()
+		if err != nil {
+			return nil, err
+		}
+		if entry == nil {
+			break
+		}
+		if entry.Tag != dwarf.TagCompileUnit {
+			r.SkipChildren()
+			continue
+		}
+		lr, err := data.LineReader(entry)
+		if err != nil {
+			return nil, err
+		}
+		r.SkipChildren()
+		if lr == nil {
+			continue
+		}
+		var le dwarf.LineEntry
+		for {
+			err := lr.Next(&le)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			row := lineRow{
+				addr:        uint32(le.Address),
+				line:        Line{Line: le.Line},
+				isStmt:      le.IsStmt,
+				endSequence: le.EndSequence,
+			}
+			if le.File != nil {
+				row.line.File = le.File.Name
+			}
+			rows = append(rows, row)
+		}
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i].addr < rows[j].addr
+	})
+	return rows, nil
+}
@@ -0,0 +1,631 @@
+// Package instrument rewrites wasm code for debugging.
+//
+// The instrumented code calls the functions imported from ImportModule before
+// every instruction, which lets the interpreter pause the execution and inspect
+// the locals without any support from the vm:
+//
+//	i32.const func; i32.const addr; call $step
+//	if
+//	    (i32.const index; local.get index; call $local) for every local
+//	    i32.const func; i32.const addr; call $pause
+//	end
+package instrument
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+const (
+	// ImportModule is the module name of functions imported by instrumented code
+	ImportModule = "xvm_debug"
+	// StepFunc is called before every instruction with the function index and the address
+	// of the instruction, the signature is (i32, i32) -> i32, returns non zero to pause
+	StepFunc = "step"
+	// LocalFunc reports the value of local with signature (i32, i64), float values are reinterpreted
+	LocalFunc = "local"
+	// PauseFunc is called after locals being reported with the same arguments as StepFunc,
+	// the signature is (i32, i32)
+	PauseFunc = "pause"
+
+	numHookFuncs = 3
+	// maxLocals limits the size of instrumented code
+	maxLocals = 1 << 12
+)
+
+const (
+	sectionCustom   = 0
+	sectionType     = 1
+	sectionImport   = 2
+	sectionFunction = 3
+	sectionExport   = 7
+	sectionStart    = 8
+	sectionElement  = 9
+	sectionCode     = 10
+
+	externalFunction = 0
+)
+
+var wasmHeader = []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+
+type section struct {
+	id      byte
+	payload []byte
+}
+
+type funcType struct {
+	params  []ValueType
+	results []ValueType
+}
+
+type instrumenter struct {
+	sections   []*section
+	customs    map[string][]byte
+	types      []funcType
+	funcTypes  []uint32
+	numImports uint32
+	exports    map[uint32]string
+}
+
+// Instrument rewrites the wasm code, returns the instrumented code and the
+// debug information of original code
+func Instrument(code []byte) ([]byte, *Info, error) {
+	ins := &instrumenter{
+		customs: make(map[string][]byte),
+		exports: make(map[uint32]string),
+	}
+	if err := ins.parse(code); err != nil {
+		return nil, nil, err
+	}
+	info := &Info{
+		NumImports: ins.numImports,
+	}
+	out, err := ins.rewrite(info)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	names := make(map[uint32]string)
+	if payload, ok := ins.customs["name"]; ok {
+		// the names are only for display, ignore the malformed name section
+		names, _ = parseNames(payload)
+	}
+	for _, f := range info.Functions {
+		switch {
+		case names[f.Index] != "":
+			f.Name = names[f.Index]
+		case ins.exports[f.Index] != "":
+			f.Name = ins.exports[f.Index]
+		default:
+			f.Name = fmt.Sprintf("func[%d]", f.Index)
+		}
+	}
+	info.lines, info.lineErr = parseLines(ins.customs)
+	return out, info, nil
+}
+
+func (ins *instrumenter) parse(code []byte) error {
+	if !bytes.HasPrefix(code, wasmHeader) {
+		return errors.New("bad wasm header")
+	}
+	r := newReader(code[len(wasmHeader):])
+	for !r.eof() {
+		id, err := r.byte()
+		if err != nil {
+			return err
+		}
+		size, err := r.u32()
+		if err != nil {
+			return err
+		}
+		payload, err := r.bytes(int(size))
+		if err != nil {
+			return err
+		}
+		if id == sectionCustom {
+			pr := newReader(payload)
+			name, err := pr.name()
+			if err != nil {
+				return err
+			}
+			ins.customs[name] = payload[pr.off:]
+			continue
+		}
+		ins.sections = append(ins.sections, &section{id: id, payload: payload})
+
+		switch id {
+		case sectionType:
+			err = ins.parseTypes(payload)
+		case sectionImport:
+			err = ins.parseImports(payload)
+		case sectionFunction:
+			err = ins.parseFunctions(payload)
+		case sectionExport:
+			err = ins.parseExports(payload)
+		}
+		if err != nil {
+			return fmt.Errorf("bad section %d: %s", id, err)
+		}
+	}
+	return nil
+}
+
+func readValueTypes(r *reader) ([]ValueType, error) {
+	n, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+	b, err := r.bytes(int(n))
+	if err != nil {
+		return nil, err
+	}
+	types := make([]ValueType, n)
+	for i := range b {
+		types[i] = ValueType(b[i])
+	}
+	return types, nil
+}
+
+func (ins *instrumenter) parseTypes(payload []byte) error {
+	r := newReader(payload)
+	n, err := r.u32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < n; i++ {
+		form, err := r.byte()
+		if err != nil {
+			return err
+		}
+		if form != 0x60 {
+			return fmt.Errorf("bad function type form 0x%x", form)
+		}
+		var t funcType
+		if t.params, err = readValueTypes(r); err != nil {
+			return err
+		}
+		if t.results, err = readValueTypes(r); err != nil {
+			return err
+		}
+		ins.types = append(ins.types, t)
+	}
+	return nil
+}
+
+func (ins *instrumenter) parseImports(payload []byte) error {
+	r := newReader(payload)
+	n, err := r.u32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < n; i++ {
+		if _, err := r.name(); err != nil {
+			return err
+		}
+		if _, err := r.name(); err != nil {
+			return err
+		}
+		kind, err := r.byte()
+		if err != nil {
+			return err
+		}
+		switch kind {
+		case externalFunction:
+			_, err = r.u32()
+			ins.numImports++
+		case 1:
+			// table: element type and limits
+			if _, err = r.byte(); err == nil {
+				err = skipLimits(r)
+			}
+		case 2:
+			err = skipLimits(r)
+		case 3:
+			// global: value type and mutability
+			_, err = r.bytes(2)
+		default:
+			err = fmt.Errorf("bad import kind %d", kind)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func skipLimits(r *reader) error {
+	flags, err := r.byte()
+	if err != nil {
+		return err
+	}
+	if _, err := r.u32(); err != nil {
+		return err
+	}
+	if flags&1 != 0 {
+		_, err = r.u32()
+	}
+	return err
+}
+
+func (ins *instrumenter) parseFunctions(payload []byte) error {
+	r := newReader(payload)
+	n, err := r.u32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < n; i++ {
+		idx, err := r.u32()
+		if err != nil {
+			return err
+		}
+		if idx >= uint32(len(ins.types)) {
+			return fmt.Errorf("bad type index %d", idx)
+		}
+		ins.funcTypes = append(ins.funcTypes, idx)
+	}
+	return nil
+}
+
+func (ins *instrumenter) parseExports(payload []byte) error {
+	r := newReader(payload)
+	n, err := r.u32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < n; i++ {
+		name, err := r.name()
+		if err != nil {
+			return err
+		}
+		kind, err := r.byte()
+		if err != nil {
+			return err
+		}
+		idx, err := r.u32()
+		if err != nil {
+			return err
+		}
+		if _, ok := ins.exports[idx]; kind == externalFunction && !ok {
+			ins.exports[idx] = name
+		}
+	}
+	return nil
+}
+
+// remap maps the function index of original code to instrumented code,
+// the hook functions are appended to the imported functions
+func (ins *instrumenter) remap(idx uint32) uint32 {
+	if idx < ins.numImports {
+		return idx
+	}
+	return idx + numHookFuncs
+}
+
+func (ins *instrumenter) rewrite(info *Info) ([]byte, error) {
+	out := append([]byte{}, wasmHeader...)
+	hasType, hasImport := false, false
+	for _, s := range ins.sections {
+		switch s.id {
+		case sectionType:
+			hasType = true
+		case sectionImport:
+			hasImport = true
+		}
+	}
+	if !hasType {
+		out = appendSection(out, sectionType, ins.rewriteTypes(nil))
+		if !hasImport {
+			payload, _ := ins.rewriteImports(nil)
+			out = appendSection(out, sectionImport, payload)
+			hasImport = true
+		}
+	}
+	for _, s := range ins.sections {
+		payload := s.payload
+		var err error
+		switch s.id {
+		case sectionType:
+			payload = ins.rewriteTypes(payload)
+		case sectionImport:
+			payload, err = ins.rewriteImports(payload)
+		case sectionExport:
+			payload, err = ins.rewriteExports(payload)
+		case sectionStart:
+			payload, err = ins.rewriteStart(payload)
+		case sectionElement:
+			payload, err = ins.rewriteElements(payload)
+		case sectionCode:
+			payload, err = ins.rewriteCode(payload, info)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("bad section %d: %s", s.id, err)
+		}
+		out = appendSection(out, s.id, payload)
+		if s.id == sectionType && !hasImport {
+			payload, _ = ins.rewriteImports(nil)
+			out = appendSection(out, sectionImport, payload)
+		}
+	}
+	return out, nil
+}
+
+func appendSection(out []byte, id byte, payload []byte) []byte {
+	out = append(out, id)
+	out = appendULEB(out, uint64(len(payload)))
+	return append(out, payload...)
+}
+
+// vecItems returns the bytes of vector items after the count
+func vecItems(payload []byte) ([]byte, error) {
+	if payload == nil {
+		return nil, nil
+	}
+	r := newReader(payload)
+	if _, err := r.u32(); err != nil {
+		return nil, err
+	}
+	return payload[r.off:], nil
+}
+
+// hookTypes are the types of StepFunc, LocalFunc and PauseFunc
+var hookTypes = []funcType{
+	{params: []ValueType{ValueTypeI32, ValueTypeI32}, results: []ValueType{ValueTypeI32}},
+	{params: []ValueType{ValueTypeI32, ValueTypeI64}},
+	{params: []ValueType{ValueTypeI32, ValueTypeI32}},
+}
+
+func (ins *instrumenter) rewriteTypes(payload []byte) []byte {
+	// payload has been parsed
+	items, _ := vecItems(payload)
+	out := appendULEB(nil, uint64(len(ins.types)+len(hookTypes)))
+	out = append(out, items...)
+	for _, t := range hookTypes {
+		out = append(out, 0x60)
+		out = appendULEB(out, uint64(len(t.params)))
+		for _, p := range t.params {
+			out = append(out, byte(p))
+		}
+		out = appendULEB(out, uint64(len(t.results)))
+		for _, p := range t.results {
+			out = append(out, byte(p))
+		}
+	}
+	return out
+}
+
+func (ins *instrumenter) rewriteImports(payload []byte) ([]byte, error) {
+	items, err := vecItems(payload)
+	if err != nil {
+		return nil, err
+	}
+	r := newReader(payload)
+	n, _ := r.u32()
+	out := appendULEB(nil, uint64(n+numHookFuncs))
+	out = append(out, items...)
+	for i, name := range []string{StepFunc, LocalFunc, PauseFunc} {
+		out = appendName(out, ImportModule)
+		out = appendName(out, name)
+		out = append(out, externalFunction)
+		out = appendULEB(out, uint64(len(ins.types)+i))
+	}
+	return out, nil
+}
+
+func (ins *instrumenter) rewriteExports(payload []byte) ([]byte, error) {
+	r := newReader(payload)
+	n, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+	out := appendULEB(nil, uint64(n))
+	for i := uint32(0); i < n; i++ {
+		name, err := r.name()
+		if err != nil {
+			return nil, err
+		}
+		kind, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		idx, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+		if kind == externalFunction {
+			idx = ins.remap(idx)
+		}
+		out = appendName(out, name)
+		out = append(out, kind)
+		out = appendULEB(out, uint64(idx))
+	}
+	return out, nil
+}
+
+func (ins *instrumenter) rewriteStart(payload []byte) ([]byte, error) {
+	idx, err := newReader(payload).u32()
+	if err != nil {
+		return nil, err
+	}
+	return appendULEB(nil, uint64(ins.remap(idx))), nil
+}
+
+func (ins *instrumenter) rewriteElements(payload []byte) ([]byte, error) {
+	r := newReader(payload)
+	n, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+	out := appendULEB(nil, uint64(n))
+	for i := uint32(0); i < n; i++ {
+		// only the segments of MVP, whose table index is 0
+		table, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+		if table != 0 {
+			return nil, fmt.Errorf("unsupported element segment %d", table)
+		}
+		out = appendULEB(out, uint64(table))
+		if out, err = copyExpr(r, out, ins.remap); err != nil {
+			return nil, err
+		}
+		count, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+		out = appendULEB(out, uint64(count))
+		for j := uint32(0); j < count; j++ {
+			idx, err := r.u32()
+			if err != nil {
+				return nil, err
+			}
+			out = appendULEB(out, uint64(ins.remap(idx)))
+		}
+	}
+	return out, nil
+}
+
+func (ins *instrumenter) rewriteCode(payload []byte, info *Info) ([]byte, error) {
+	r := newReader(payload)
+	n, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+	if n != uint32(len(ins.funcTypes)) {
+		return nil, errors.New("function and code section have inconsistent lengths")
+	}
+	out := appendULEB(nil, uint64(n))
+	for i := uint32(0); i < n; i++ {
+		size, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+		end := r.off + int(size)
+		if end > len(payload) {
+			return nil, errUnexpectedEOF
+		}
+		f := &Function{
+			Index: ins.numImports + i,
+		}
+		params := ins.types[ins.funcTypes[i]].params
+		f.Locals = append(f.Locals, params...)
+		f.NumParams = len(params)
+
+		body, err := ins.rewriteBody(newReader(payload[:end]), r.off, f)
+		if err != nil {
+			return nil, fmt.Errorf("function %d: %s", f.Index, err)
+		}
+		r.off = end
+		info.Functions = append(info.Functions, f)
+		out = appendULEB(out, uint64(len(body)))
+		out = append(out, body...)
+	}
+	return out, nil
+}
+
+// rewriteBody rewrites the function body starts at offset of r
+func (ins *instrumenter) rewriteBody(r *reader, offset int, f *Function) ([]byte, error) {
+	r.off = offset
+	n, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+	for i := uint32(0); i < n; i++ {
+		count, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+		t, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		if uint64(len(f.Locals))+uint64(count) > maxLocals {
+			return nil, errors.New("too many locals")
+		}
+		for j := uint32(0); j < count; j++ {
+			f.Locals = append(f.Locals, ValueType(t))
+		}
+	}
+	out := append([]byte{}, r.buf[offset:r.off]...)
+
+	reportLocals := appendReportLocals(nil, f.Locals, ins.numImports+1)
+	for !r.eof() {
+		addr := uint32(r.off)
+		f.Sites = append(f.Sites, addr)
+
+		out = appendHookCall(out, f.Index, addr, ins.numImports)
+		out = append(out, opIf, blockTypeEmpty)
+		out = append(out, reportLocals...)
+		out = appendHookCall(out, f.Index, addr, ins.numImports+2)
+		out = append(out, opEnd)
+
+		if out, err = copyInstr(r, out, ins.remap); err != nil {
+			return nil, err
+		}
+	}
+	if len(f.Sites) == 0 {
+		return nil, errors.New("empty function body")
+	}
+	return out, nil
+}
+
+func appendI32Const(out []byte, v uint32) []byte {
+	out = append(out, opI32Const)
+	return appendSLEB(out, int64(int32(v)))
+}
+
+func appendHookCall(out []byte, fn, addr, hook uint32) []byte {
+	out = appendI32Const(out, fn)
+	out = appendI32Const(out, addr)
+	out = append(out, opCall)
+	return appendULEB(out, uint64(hook))
+}
+
+// appendReportLocals calls LocalFunc for every local of number types
+func appendReportLocals(out []byte, locals []ValueType, hook uint32) []byte {
+	for i, t := range locals {
+		var conv []byte
+		switch t {
+		case ValueTypeI32:
+			conv = []byte{opI64ExtendUI32}
+		case ValueTypeI64:
+		case ValueTypeF32:
+			conv = []byte{opI32ReinterpretF, opI64ExtendUI32}
+		case ValueTypeF64:
+			conv = []byte{opI64ReinterpretF}
+		default:
+			continue
+		}
+		out = appendI32Const(out, uint32(i))
+		out = append(out, opLocalGet)
+		out = appendULEB(out, uint64(i))
+		out = append(out, conv...)
+		out = append(out, opCall)
+		out = appendULEB(out, uint64(hook))
+	}
+	return out
+}
+
+// SiteOps returns the names of instructions executed by the instrumentation before
+// an instruction, which are the same as the names in the gas table of interpreter.
+// paused is whether the step function returns non zero at the instruction
+func SiteOps(locals []ValueType, paused bool) []string {
+	ops := []string{"i32.const", "i32.const", "call", "if"}
+	if !paused {
+		return ops
+	}
+	for _, t := range locals {
+		switch t {
+		case ValueTypeI32:
+			ops = append(ops, "i32.const", "get_local", "i64.extend_u/i32", "call")
+		case ValueTypeI64:
+			ops = append(ops, "i32.const", "get_local", "call")
+		case ValueTypeF32:
+			ops = append(ops, "i32.const", "get_local", "i32.reinterpret/f32", "i64.extend_u/i32", "call")
+		case ValueTypeF64:
+			ops = append(ops, "i32.const", "get_local", "i64.reinterpret/f64", "call")
+		}
+	}
+	return append(ops, "i32.const", "i32.const", "call", "end")
+}
@@ -0,0 +1,221 @@
+package instrument
+
+import (
+	"reflect"
+	"testing"
+)
+
+func vec(items ...[]byte) []byte {
+	out := appendULEB(nil, uint64(len(items)))
+	for _, item := range items {
+		out = append(out, item...)
+	}
+	return out
+}
+
+func cat(parts ...[]byte) []byte {
+	var out []byte
+	for _, p := range parts {
+		out = append(out, p...)
+	}
+	return out
+}
+
+func customSection(name string, payload []byte) []byte {
+	return appendSection(nil, sectionCustom, append(appendName(nil, name), payload...))
+}
+
+func funcBody(locals []byte, code ...byte) []byte {
+	body := append(locals, code...)
+	return append(appendULEB(nil, uint64(len(body))), body...)
+}
+
+// testModule has an imported function env.print and three functions,
+// run_impl(f64) -> i32 calls print and helper, helper is also in the table,
+// the last one is the start function
+func testModule() []byte {
+	types := vec(
+		[]byte{0x60, 1, byte(ValueTypeI32), 0},
+		[]byte{0x60, 1, byte(ValueTypeF64), 1, byte(ValueTypeI32)},
+		[]byte{0x60, 0, 0},
+	)
+	imports := vec(cat(appendName(nil, "env"), appendName(nil, "print"), []byte{externalFunction, 0}))
+	functions := vec([]byte{1}, []byte{1}, []byte{2})
+	table := vec([]byte{0x70, 0, 1})
+	exports := vec(cat(appendName(nil, "run"), []byte{externalFunction, 1}))
+	elements := vec([]byte{0, opI32Const, 0, opEnd, 1, 2})
+	code := vec(
+		funcBody(vec([]byte{1, byte(ValueTypeI32)}),
+			opI32Const, 7, 0x21, 1, opLocalGet, 1, opCall, 0, opLocalGet, 0, opCall, 2, opEnd),
+		funcBody(vec(), opI32Const, 1, opEnd),
+		funcBody(vec(), opEnd),
+	)
+	names := vec(
+		cat([]byte{1}, appendName(nil, "run_impl")),
+		cat([]byte{2}, appendName(nil, "helper")),
+	)
+	return cat(wasmHeader,
+		appendSection(nil, sectionType, types),
+		appendSection(nil, sectionImport, imports),
+		appendSection(nil, sectionFunction, functions),
+		appendSection(nil, 4, table),
+		appendSection(nil, sectionExport, exports),
+		appendSection(nil, sectionStart, []byte{3}),
+		appendSection(nil, sectionElement, elements),
+		appendSection(nil, sectionCode, code),
+		customSection("name", cat([]byte{1}, appendULEB(nil, uint64(len(names))), names)),
+	)
+}
+
+func TestLEB128(t *testing.T) {
+	for _, v := range []int64{0, 1, 63, 64, -1, -64, -65, 1 << 31, -1 << 31, 1<<63 - 1} {
+		r := newReader(appendSLEB(nil, v))
+		got, err := r.sleb()
+		if err != nil || got != v || !r.eof() {
+			t.Errorf("sleb %d: got %d %v", v, got, err)
+		}
+	}
+	for _, v := range []uint64{0, 127, 128, 1 << 32, 1<<64 - 1} {
+		r := newReader(appendULEB(nil, v))
+		got, err := r.uleb()
+		if err != nil || got != v || !r.eof() {
+			t.Errorf("uleb %d: got %d %v", v, got, err)
+		}
+	}
+}
+
+func TestInstrument(t *testing.T) {
+	code, info, err := Instrument(testModule())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.NumImports != 1 || len(info.Functions) != 3 {
+		t.Fatalf("unexpected info %+v", info)
+	}
+	run := info.FunctionByName("run_impl")
+	if run == nil || run.Index != 1 || info.Function(2).Name != "helper" {
+		t.Fatal("bad function names")
+	}
+	if !reflect.DeepEqual(run.Locals, []ValueType{ValueTypeF64, ValueTypeI32}) || run.NumParams != 1 {
+		t.Errorf("unexpected locals %v", run.Locals)
+	}
+	if len(run.Sites) != 7 {
+		t.Errorf("expect 7 instructions, got %d", len(run.Sites))
+	}
+	if info.FunctionAt(run.Sites[3]) != run || info.FunctionAt(info.Function(2).Entry()) != info.Function(2) {
+		t.Error("bad function of address")
+	}
+	if info.HasLines() {
+		t.Error("expect no line table")
+	}
+
+	ins := &instrumenter{
+		customs: make(map[string][]byte),
+		exports: make(map[uint32]string),
+	}
+	if err := ins.parse(code); err != nil {
+		t.Fatal(err)
+	}
+	if ins.numImports != 1+numHookFuncs || len(ins.types) != 3+len(hookTypes) {
+		t.Fatalf("expect hook functions imported, got %d imports %d types", ins.numImports, len(ins.types))
+	}
+	if ins.exports[1+numHookFuncs] != "run" {
+		t.Errorf("export is not remapped %v", ins.exports)
+	}
+	if len(ins.customs) != 0 {
+		t.Error("expect custom sections dropped")
+	}
+
+	for _, s := range ins.sections {
+		switch s.id {
+		case sectionStart:
+			if s.payload[0] != 3+numHookFuncs {
+				t.Errorf("start function is not remapped")
+			}
+		case sectionElement:
+			if elems := s.payload[len(s.payload)-1]; elems != 2+numHookFuncs {
+				t.Errorf("element is not remapped, got %d", elems)
+			}
+		case sectionCode:
+			calls := bodyCalls(t, s.payload)
+			// print, step, local, pause and helper
+			for _, idx := range []uint32{0, 1, 2, 3, 2 + numHookFuncs} {
+				if !calls[0][idx] {
+					t.Errorf("expect call %d in run_impl, got %v", idx, calls[0])
+				}
+			}
+			if calls[1][0] || calls[1][2+numHookFuncs] {
+				t.Errorf("unexpected calls in helper %v", calls[1])
+			}
+		}
+	}
+}
+
+// bodyCalls returns the called functions of every body in code section
+func bodyCalls(t *testing.T, payload []byte) []map[uint32]bool {
+	r := newReader(payload)
+	n, _ := r.u32()
+	var result []map[uint32]bool
+	for i := uint32(0); i < n; i++ {
+		size, _ := r.u32()
+		end := r.off + int(size)
+		br := newReader(payload[:end])
+		br.off = r.off
+		count, _ := br.u32()
+		for j := uint32(0); j < count; j++ {
+			br.u32()
+			br.byte()
+		}
+		calls := make(map[uint32]bool)
+		for !br.eof() {
+			var err error
+			_, err = copyInstr(br, nil, func(idx uint32) uint32 {
+				calls[idx] = true
+				return idx
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		result = append(result, calls)
+		r.off = end
+	}
+	return result
+}
+
+func TestLines(t *testing.T) {
+	_, info, err := Instrument(testModule())
+	if err != nil {
+		t.Fatal(err)
+	}
+	run := info.Function(1)
+	info.lines = []lineRow{
+		{addr: run.Sites[0], line: Line{"/src/main.cc", 10}, isStmt: true},
+		{addr: run.Sites[2], line: Line{"/src/main.cc", 11}, isStmt: true},
+		{addr: run.Sites[4], line: Line{"/src/main.cc", 11}, isStmt: true},
+		{addr: run.Sites[6] + 1, line: Line{"/src/main.cc", 11}, endSequence: true},
+	}
+	line, ok := info.LineAt(run.Sites[3])
+	if !ok || line.Line != 11 {
+		t.Errorf("unexpected line %v", line)
+	}
+	if _, ok := info.LineAt(run.Sites[6] + 1); ok {
+		t.Error("expect no line after the end of sequence")
+	}
+	if addrs := info.LineAddrs("main.cc", 11); !reflect.DeepEqual(addrs, []uint32{run.Sites[2], run.Sites[4]}) {
+		t.Errorf("unexpected addresses %v", addrs)
+	}
+	if addrs := info.LineAddrs("in.cc", 11); len(addrs) != 0 {
+		t.Errorf("expect partial file name not matched, got %v", addrs)
+	}
+}
+
+func TestSiteOps(t *testing.T) {
+	locals := []ValueType{ValueTypeI32, ValueTypeF32}
+	if len(SiteOps(locals, false)) != 4 {
+		t.Error("unexpected ops of step")
+	}
+	if ops := SiteOps(locals, true); len(ops) != 4+4+5+4 {
+		t.Errorf("unexpected ops of pause %v", ops)
+	}
+}
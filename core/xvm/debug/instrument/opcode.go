@@ -0,0 +1,140 @@
+package instrument
+
+import (
+	"fmt"
+)
+
+const (
+	opIf              = 0x04
+	opEnd             = 0x0b
+	opCall            = 0x10
+	opLocalGet        = 0x20
+	opI32Const        = 0x41
+	opI64ExtendUI32   = 0xad
+	opI32ReinterpretF = 0xbc
+	opI64ReinterpretF = 0xbd
+	opRefFunc         = 0xd2
+	opPrefixFC        = 0xfc
+
+	blockTypeEmpty = 0x40
+)
+
+// copyInstr copies the instruction at r to out, the function index referenced by
+// call and ref.func is mapped by remap
+func copyInstr(r *reader, out []byte, remap func(uint32) uint32) ([]byte, error) {
+	start := r.off
+	op, err := r.byte()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case op == opCall || op == opRefFunc:
+		idx, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, op)
+		return appendULEB(out, uint64(remap(idx))), nil
+	case op == 0x00 || op == 0x01 || op == 0x05 || op == opEnd || op == 0x0f ||
+		op == 0x1a || op == 0x1b || op == 0xd1 || (op >= 0x45 && op <= 0xc4):
+		// no immediates
+	case op >= 0x02 && op <= 0x04:
+		// block type
+		_, err = r.sleb()
+	case op == 0x0c || op == 0x0d || (op >= 0x20 && op <= 0x26) || op == 0x3f || op == 0x40:
+		_, err = r.u32()
+	case op == 0x0e:
+		err = skipBrTable(r)
+	case op == 0x11:
+		if _, err = r.u32(); err == nil {
+			_, err = r.u32()
+		}
+	case op == 0x1c:
+		err = skipVec(r, 1)
+	case op >= 0x28 && op <= 0x3e:
+		// memarg
+		if _, err = r.u32(); err == nil {
+			_, err = r.u32()
+		}
+	case op == opI32Const || op == 0x42:
+		_, err = r.sleb()
+	case op == 0x43:
+		_, err = r.bytes(4)
+	case op == 0x44:
+		_, err = r.bytes(8)
+	case op == 0xd0:
+		_, err = r.byte()
+	case op == opPrefixFC:
+		err = skipPrefixFC(r)
+	default:
+		return nil, fmt.Errorf("unsupported opcode 0x%x at %d", op, start)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return append(out, r.buf[start:r.off]...), nil
+}
+
+func skipBrTable(r *reader) error {
+	n, err := r.u32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i <= n; i++ {
+		if _, err := r.u32(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func skipVec(r *reader, size int) error {
+	n, err := r.u32()
+	if err != nil {
+		return err
+	}
+	_, err = r.bytes(int(n) * size)
+	return err
+}
+
+func skipPrefixFC(r *reader) error {
+	sub, err := r.u32()
+	if err != nil {
+		return err
+	}
+	var immediates int
+	switch {
+	case sub <= 7:
+		// saturating truncation
+	case sub == 9 || sub == 11 || sub == 13 || sub == 15 || sub == 16 || sub == 17:
+		immediates = 1
+	case sub == 8 || sub == 10 || sub == 12 || sub == 14:
+		immediates = 2
+	default:
+		return fmt.Errorf("unsupported opcode 0xfc 0x%x", sub)
+	}
+	for i := 0; i < immediates; i++ {
+		if _, err := r.u32(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyExpr copies the constant expression at r to out until the end instruction
+func copyExpr(r *reader, out []byte, remap func(uint32) uint32) ([]byte, error) {
+	for {
+		if r.eof() {
+			return nil, errUnexpectedEOF
+		}
+		isEnd := r.buf[r.off] == opEnd
+		var err error
+		out, err = copyInstr(r, out, remap)
+		if err != nil {
+			return nil, err
+		}
+		if isEnd {
+			return out, nil
+		}
+	}
+}
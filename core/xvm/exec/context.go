@@ -26,6 +26,9 @@ func (e *ErrFuncNotFound) Error() string {
 // ContextConfig configures an execution context
 type ContextConfig struct {
 	GasLimit int64
+	// DebugHook receives the events of code created by NewInterpDebugCode,
+	// the other codes ignore it
+	DebugHook DebugHook
 }
 
 // DefaultContextConfig returns the default configuration of ContextConfig
@@ -11,6 +11,7 @@ import (
 	"github.com/xuperchain/wagon/exec"
 	"github.com/xuperchain/wagon/wasm"
 	"github.com/xuperchain/wagon/wasm/leb128"
+	"github.com/xuperchain/xuperchain/core/xvm/debug/instrument"
 )
 
 var funcTypes = []interface{}{
@@ -71,7 +72,9 @@ func makeExportGlobal(sig *wasm.GlobalVar, v int64) (*wasm.GlobalEntry, error) {
 	}, nil
 }
 
-func makeWagonModule(resolver Resolver) wasm.ResolveModuleFunc {
+// makeWagonModule resolves the imports of module, the functions of instrument.ImportModule
+// are only resolved for the code created by NewInterpDebugCode if debug is set
+func makeWagonModule(resolver Resolver, debug bool) wasm.ResolveModuleFunc {
 	return func(module string, main *wasm.Module) (*wasm.Module, error) {
 		export := wasm.NewModule()
 		export.Export.Entries = map[string]wasm.ExportEntry{}
@@ -83,17 +86,22 @@ func makeWagonModule(resolver Resolver) wasm.ResolveModuleFunc {
 
 			switch importEntry.Type.Kind() {
 			case wasm.ExternalFunction:
-				ifunc, ok := resolver.ResolveFunc(module, field)
-				if !ok {
-					return nil, fmt.Errorf("%s.%s not found", module, field)
-				}
-
 				index := importEntry.Type.(wasm.FuncImport).Type
 				if main.Types == nil || int(index) >= len(main.Types.Entries) {
 					return nil, errors.New("bad function type")
 				}
 				sig := main.Types.Entries[index]
-				fun, err := makeExportFunc(sig, ifunc)
+				var fun *wasm.Function
+				var err error
+				if debug && module == instrument.ImportModule {
+					fun, err = makeDebugFunc(sig, field)
+				} else {
+					ifunc, ok := resolver.ResolveFunc(module, field)
+					if !ok {
+						return nil, fmt.Errorf("%s.%s not found", module, field)
+					}
+					fun, err = makeExportFunc(sig, ifunc)
+				}
 				if err != nil {
 					return nil, err
 				}
@@ -144,10 +152,16 @@ func makeWagonModule(resolver Resolver) wasm.ResolveModuleFunc {
 // InterpCode is the Code interface of interpreter mode
 type InterpCode struct {
 	module *wasm.Module
+	// debug is set if the code is created by NewInterpDebugCode
+	debug *interpDebug
 }
 
 // NewInterpCode instance a Code based on the wasm code and resolver
 func NewInterpCode(wasmCode []byte, resolver Resolver) (code *InterpCode, err error) {
+	return newInterpCode(wasmCode, resolver, false)
+}
+
+func newInterpCode(wasmCode []byte, resolver Resolver, debug bool) (code *InterpCode, err error) {
 	defer func() {
 		ierr := recover()
 		if ierr == nil {
@@ -159,7 +173,7 @@ func NewInterpCode(wasmCode []byte, resolver Resolver) (code *InterpCode, err er
 		err = fmt.Errorf("%s", ierr)
 	}()
 	defer CaptureTrap(&err)
-	importModuleFunc := makeWagonModule(resolver)
+	importModuleFunc := makeWagonModule(resolver, debug)
 	module, err := wasm.LoadModule(bytes.NewBuffer(wasmCode), importModuleFunc)
 	if err != nil {
 		return nil, err
@@ -195,6 +209,8 @@ func (code *InterpCode) NewContext(cfg *ContextConfig) (ictx Context, err error)
 		module:   code.module,
 		vm:       vm,
 		userData: make(map[string]interface{}),
+		debug:    code.debug,
+		hook:     cfg.DebugHook,
 	}
 	vm.UserData = ctx
 	ictx = ctx
@@ -209,6 +225,12 @@ type wagonContext struct {
 	module   *wasm.Module
 	vm       *exec.VM
 	userData map[string]interface{}
+
+	debug *interpDebug
+	hook  DebugHook
+	// locals are reported by instrumented code before pause, the length is
+	// the number of locals of the paused function
+	locals []uint64
 }
 
 func (c *wagonContext) Exec(name string, param []int64) (ret int64, err error) {
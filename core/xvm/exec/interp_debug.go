@@ -0,0 +1,147 @@
+package exec
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/xuperchain/wagon/exec"
+	"github.com/xuperchain/wagon/wasm"
+	"github.com/xuperchain/xuperchain/core/xvm/debug/instrument"
+)
+
+// DebugHook receives the events of the code created by NewInterpDebugCode, it's set by ContextConfig.
+// fn is the index of function and addr is the address of instruction in the original code
+type DebugHook interface {
+	// Step is called before every instruction, returns true to pause at the instruction
+	Step(ctx Context, fn, addr uint32) bool
+	// Pause is called with the values of locals if Step returns true,
+	// the values of float locals are reinterpreted as integers.
+	// The execution continues after Pause returns, call Throw to abort the execution
+	Pause(ctx Context, fn, addr uint32, locals []uint64)
+}
+
+type interpDebug struct {
+	info *instrument.Info
+	// stepGas is the gas used by the instrumentation of an instruction
+	stepGas int64
+}
+
+// NewInterpDebugCode instances a Code of interpreter mode for debugging, the wasm code is instrumented
+// to call ContextConfig.DebugHook before every instruction. The returned Info describes the original code
+func NewInterpDebugCode(wasmCode []byte, resolver Resolver) (*InterpCode, *instrument.Info, error) {
+	instrumented, info, err := instrument.Instrument(wasmCode)
+	if err != nil {
+		return nil, nil, err
+	}
+	code, err := newInterpCode(instrumented, resolver, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	code.debug = &interpDebug{
+		info:    info,
+		stepGas: opsGas(instrument.SiteOps(nil, false)),
+	}
+	return code, info, nil
+}
+
+func opsGas(ops []string) int64 {
+	mapper := new(GasMapper)
+	var gas int64
+	for _, op := range ops {
+		v, _ := mapper.MapGas(op)
+		gas += v
+	}
+	return gas
+}
+
+// debugFuncSigs are the signatures of the functions imported by instrumented code
+var debugFuncSigs = map[string]wasm.FunctionSig{
+	instrument.StepFunc: {
+		ParamTypes:  []wasm.ValueType{wasm.ValueTypeI32, wasm.ValueTypeI32},
+		ReturnTypes: []wasm.ValueType{wasm.ValueTypeI32},
+	},
+	instrument.LocalFunc: {
+		ParamTypes: []wasm.ValueType{wasm.ValueTypeI32, wasm.ValueTypeI64},
+	},
+	instrument.PauseFunc: {
+		ParamTypes: []wasm.ValueType{wasm.ValueTypeI32, wasm.ValueTypeI32},
+	},
+}
+
+func sameValueTypes(a, b []wasm.ValueType) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func makeDebugFunc(sig wasm.FunctionSig, name string) (*wasm.Function, error) {
+	var fun interface{}
+	switch name {
+	case instrument.StepFunc:
+		fun = debugStep
+	case instrument.LocalFunc:
+		fun = debugLocal
+	case instrument.PauseFunc:
+		fun = debugPause
+	default:
+		return nil, fmt.Errorf("%s.%s not found", instrument.ImportModule, name)
+	}
+	expect := debugFuncSigs[name]
+	if !sameValueTypes(sig.ParamTypes, expect.ParamTypes) || !sameValueTypes(sig.ReturnTypes, expect.ReturnTypes) {
+		return nil, fmt.Errorf("bad signature of %s.%s", instrument.ImportModule, name)
+	}
+	return &wasm.Function{
+		Sig:  &sig,
+		Host: reflect.ValueOf(fun),
+		Body: new(wasm.FunctionBody),
+	}, nil
+}
+
+// debugStep gives back the gas used by instrumentation before calling the hook,
+// so that the hook sees the same gas as running the original code
+func debugStep(proc *exec.Process, fn, addr uint32) uint32 {
+	ctx := proc.VM().UserData.(*wagonContext)
+	if ctx.debug == nil {
+		return 0
+	}
+	ctx.vm.GasUsed -= ctx.debug.stepGas
+	if ctx.hook == nil || !ctx.hook.Step(ctx, fn, addr) {
+		return 0
+	}
+	var numLocals int
+	if f := ctx.debug.info.Function(fn); f != nil {
+		numLocals = len(f.Locals)
+	}
+	ctx.locals = ctx.locals[:0]
+	for i := 0; i < numLocals; i++ {
+		ctx.locals = append(ctx.locals, 0)
+	}
+	return 1
+}
+
+// debugLocal records the value of local idx of the paused function,
+// idx is bounded by the number of locals declared by the function
+func debugLocal(proc *exec.Process, idx uint32, value uint64) {
+	ctx := proc.VM().UserData.(*wagonContext)
+	if ctx.debug == nil || idx >= uint32(len(ctx.locals)) {
+		ThrowMessage(fmt.Sprintf("bad local index %d", idx))
+	}
+	ctx.locals[idx] = value
+}
+
+func debugPause(proc *exec.Process, fn, addr uint32) {
+	ctx := proc.VM().UserData.(*wagonContext)
+	if ctx.hook == nil {
+		return
+	}
+	if f := ctx.debug.info.Function(fn); f != nil {
+		ctx.vm.GasUsed -= opsGas(instrument.SiteOps(f.Locals, true)) - ctx.debug.stepGas
+	}
+	ctx.hook.Pause(ctx, fn, addr, ctx.locals)
+}
@@ -4,12 +4,13 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"reflect"
 	"testing"
 
 	"github.com/xuperchain/xuperchain/core/xvm/compile"
 )
 
-func withInterpCode(t testing.TB, watCode string, r Resolver, f func(code *InterpCode)) {
+func compileWatCode(t testing.TB, watCode string) []byte {
 	tmpdir, err := ioutil.TempDir("", "xvm-exec-test")
 	if err != nil {
 		t.Fatal(err)
@@ -32,7 +33,11 @@ func withInterpCode(t testing.TB, watCode string, r Resolver, f func(code *Inter
 	if err != nil {
 		t.Fatal(err)
 	}
-	code, err := NewInterpCode(codebuf, r)
+	return codebuf
+}
+
+func withInterpCode(t testing.TB, watCode string, r Resolver, f func(code *InterpCode)) {
+	code, err := NewInterpCode(compileWatCode(t, watCode), r)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -78,3 +83,73 @@ func TestInterpResolveFunc(t *testing.T) {
 		ctx.Release()
 	})
 }
+
+type recordHook struct {
+	steps  []uint32
+	locals [][]uint64
+	gas    []int64
+}
+
+func (h *recordHook) Step(ctx Context, fn, addr uint32) bool {
+	h.steps = append(h.steps, addr)
+	return len(h.steps) == 2
+}
+
+func (h *recordHook) Pause(ctx Context, fn, addr uint32, locals []uint64) {
+	h.locals = append(h.locals, append([]uint64{}, locals...))
+	h.gas = append(h.gas, ctx.GasUsed())
+}
+
+func TestInterpDebug(t *testing.T) {
+	codebuf := compileWatCode(t, "testdata/add.wat")
+	hook := new(recordHook)
+	code, info, err := NewInterpDebugCode(codebuf, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := DefaultContextConfig()
+	cfg.DebugHook = hook
+	ctx, err := code.NewContext(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ctx.Release()
+	ret, err := ctx.Exec("_add", []int64{1, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ret != 3 {
+		t.Errorf("expect 3 got %d", ret)
+	}
+	// local.get, local.get, i32.add and end
+	if !reflect.DeepEqual(hook.steps, info.Functions[0].Sites) {
+		t.Errorf("expect steps %v, got %v", info.Functions[0].Sites, hook.steps)
+	}
+	if len(hook.locals) != 1 || !reflect.DeepEqual(hook.locals[0], []uint64{1, 2}) {
+		t.Errorf("unexpected locals %v", hook.locals)
+	}
+
+	var gasUsed int64
+	withInterpCode(t, "testdata/add.wat", nil, func(code *InterpCode) {
+		ctx, err := code.NewContext(DefaultContextConfig())
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer ctx.Release()
+		if _, err := ctx.Exec("_add", []int64{1, 2}); err != nil {
+			t.Fatal(err)
+		}
+		gasUsed = ctx.GasUsed()
+	})
+	if ctx.GasUsed() != gasUsed {
+		t.Errorf("expect gas %d got %d", gasUsed, ctx.GasUsed())
+	}
+}
+
+func TestInterpDebugImport(t *testing.T) {
+	r := MapResolver(map[string]interface{}{})
+	_, err := NewInterpCode(compileWatCode(t, "testdata/debug_import.wat"), r)
+	if err == nil {
+		t.Error("expect error resolving debug functions for code not created by NewInterpDebugCode")
+	}
+}
@@ -0,0 +1,10 @@
+(module
+  (type (;0;) (func (param i32 i64)))
+  (type (;1;) (func (result i32)))
+  (import "xvm_debug" "local" (func (;0;) (type 0)))
+  (func (;1;) (type 1) (result i32)
+    i32.const -1
+    i64.const 0
+    call 0
+    i32.const 0)
+  (export "_local" (func 1)))